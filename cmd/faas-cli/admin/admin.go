@@ -0,0 +1,42 @@
+package admincmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func NewAdminGroup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "admin",
+		Short: "Commands for operating the platform",
+	}
+
+	cmd.AddCommand(
+		NewCollectGarbageCmd(),
+	)
+
+	return cmd
+}
+
+func dialGateway(ctx context.Context, addr string, useTLS bool, caFile string) (*grpc.ClientConn, error) {
+	var creds credentials.TransportCredentials
+	if useTLS {
+		if caFile != "" {
+			c, err := credentials.NewClientTLSFromFile(caFile, "")
+			if err != nil {
+				return nil, err
+			}
+			creds = c
+		} else {
+			creds = credentials.NewTLS(nil)
+		}
+	} else {
+		creds = insecure.NewCredentials()
+	}
+
+	return grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(creds))
+}
@@ -0,0 +1,88 @@
+package admincmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func NewCollectGarbageCmd() *cobra.Command {
+	var (
+		gatewayAddr string
+		tls         bool
+		caFile      string
+		timeout     time.Duration
+
+		del    bool
+		minAge time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "gc",
+		Short: "Report orphaned objects and records; remove them with --delete",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			conn, err := dialGateway(ctx, gatewayAddr, tls, caFile)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			req := &faaspb.CollectGarbageRequest{Delete: del}
+			if minAge > 0 {
+				req.MinAge = durationpb.New(minAge)
+			}
+
+			client := faaspb.NewAdminClient(conn)
+			resp, err := client.CollectGarbage(ctx, req)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			var size uint64
+			for _, o := range resp.GetOrphans() {
+				size += o.GetSize()
+				fmt.Fprintf(out, "orphan: kind=%s, store=%s, key=%s, size=%d, updated_at=%s, reason=%s",
+					o.GetKind(), o.GetStore(), o.GetKey(), o.GetSize(),
+					o.GetUpdatedAt().AsTime().Format(time.RFC3339), o.GetReason())
+				switch {
+				case o.GetDeleted():
+					fmt.Fprint(out, ", deleted")
+				case o.GetError() != "":
+					fmt.Fprintf(out, ", error=%s", o.GetError())
+				}
+				fmt.Fprintln(out)
+			}
+
+			if del {
+				fmt.Fprintf(out, "orphans=%d, bytes=%d, deleted=%d, failed=%d\n",
+					len(resp.GetOrphans()), size, resp.GetDeleted(), resp.GetFailed())
+				if resp.GetFailed() > 0 {
+					return fmt.Errorf("%d orphans could not be deleted", resp.GetFailed())
+				}
+				return nil
+			}
+			fmt.Fprintf(out, "orphans=%d, bytes=%d (dry run, pass --delete to remove them)\n", len(resp.GetOrphans()), size)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&gatewayAddr, "gateway", "127.0.0.1:55055", "Gateway gRPC address host:port")
+	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
+	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
+	cmd.Flags().DurationVar(&timeout, "timeout", 10*time.Minute, "Overall timeout")
+
+	cmd.Flags().BoolVar(&del, "delete", false, "Delete the orphans instead of only reporting them")
+	cmd.Flags().DurationVar(&minAge, "min-age", 0, "Leave objects written more recently alone, at least 10m (0 uses the gateway default)")
+
+	return cmd
+}
@@ -0,0 +1,303 @@
+package funccmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"github.com/spf13/cobra"
+)
+
+func NewAliasGroup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "alias",
+		Short: "Commands for managing function aliases (e.g. functions/foo@prod)",
+	}
+
+	cmd.AddCommand(
+		NewSetAliasCmd(),
+		NewGetAliasCmd(),
+		NewListAliasesCmd(),
+		NewDeleteAliasCmd(),
+	)
+
+	return cmd
+}
+
+func NewSetAliasCmd() *cobra.Command {
+	var (
+		functionName string
+		gatewayAddr  string
+		tls          bool
+		caFile       string
+		timeout      time.Duration
+
+		aliasName string
+		revision  uint64
+		split     string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "set",
+		Short: "Point an alias at a revision or split traffic across two",
+		Example: `  faas funcs alias set --name functions/foo --alias prod --revision 3
+  faas funcs alias set --name functions/foo --alias prod --split 3=90,4=10`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if functionName == "" {
+				return fmt.Errorf("--name is required")
+			}
+			if aliasName == "" {
+				return fmt.Errorf("--alias is required")
+			}
+
+			var routes []*faaspb.AliasRoute
+			switch {
+			case revision != 0 && split != "":
+				return fmt.Errorf("--revision and --split are mutually exclusive")
+			case revision != 0:
+				routes = []*faaspb.AliasRoute{{Revision: revision, Weight: 100}}
+			case split != "":
+				var err error
+				if routes, err = parseSplit(split); err != nil {
+					return err
+				}
+			default:
+				return fmt.Errorf("one of --revision or --split is required")
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			conn, err := dialGateway(ctx, gatewayAddr, tls, caFile)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			client := faaspb.NewFunctionsClient(conn)
+			alias, err := client.UpdateAlias(ctx, &faaspb.UpdateAliasRequest{
+				Alias: &faaspb.FunctionAlias{
+					Function: functionName,
+					Name:     aliasName,
+					Routes:   routes,
+				},
+			})
+			if err != nil {
+				return err
+			}
+
+			printAlias(cmd, "alias: ", alias)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&functionName, "name", "", "Function name, e.g. functions/my-func")
+	cmd.Flags().StringVar(&gatewayAddr, "gateway", "127.0.0.1:55055", "Gateway gRPC address host:port")
+	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
+	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
+	cmd.Flags().DurationVar(&timeout, "timeout", 15*time.Second, "Overall timeout")
+
+	cmd.Flags().StringVar(&aliasName, "alias", "", "Alias name, e.g. prod")
+	cmd.Flags().Uint64Var(&revision, "revision", 0, "Send all traffic to this revision")
+	cmd.Flags().StringVar(&split, "split", "", "Weighted split as rev=weight,rev=weight adding up to 100")
+
+	return cmd
+}
+
+func NewGetAliasCmd() *cobra.Command {
+	var (
+		functionName string
+		gatewayAddr  string
+		tls          bool
+		caFile       string
+		timeout      time.Duration
+
+		aliasName string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "Get an alias",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if functionName == "" {
+				return fmt.Errorf("--name is required")
+			}
+			if aliasName == "" {
+				return fmt.Errorf("--alias is required")
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			conn, err := dialGateway(ctx, gatewayAddr, tls, caFile)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			client := faaspb.NewFunctionsClient(conn)
+			alias, err := client.GetAlias(ctx, &faaspb.GetAliasRequest{
+				Function: functionName,
+				Name:     aliasName,
+			})
+			if err != nil {
+				return err
+			}
+
+			printAlias(cmd, "alias: ", alias)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&functionName, "name", "", "Function name, e.g. functions/my-func")
+	cmd.Flags().StringVar(&gatewayAddr, "gateway", "127.0.0.1:55055", "Gateway gRPC address host:port")
+	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
+	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
+	cmd.Flags().DurationVar(&timeout, "timeout", 15*time.Second, "Overall timeout")
+
+	cmd.Flags().StringVar(&aliasName, "alias", "", "Alias name, e.g. prod")
+
+	return cmd
+}
+
+func NewListAliasesCmd() *cobra.Command {
+	var (
+		functionName string
+		gatewayAddr  string
+		tls          bool
+		caFile       string
+		timeout      time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List aliases of a function",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if functionName == "" {
+				return fmt.Errorf("--name is required")
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			conn, err := dialGateway(ctx, gatewayAddr, tls, caFile)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			client := faaspb.NewFunctionsClient(conn)
+			resp, err := client.ListAliases(ctx, &faaspb.ListAliasesRequest{Function: functionName})
+			if err != nil {
+				return err
+			}
+
+			for _, alias := range resp.GetAliases() {
+				printAlias(cmd, "", alias)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&functionName, "name", "", "Function name, e.g. functions/my-func")
+	cmd.Flags().StringVar(&gatewayAddr, "gateway", "127.0.0.1:55055", "Gateway gRPC address host:port")
+	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
+	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
+	cmd.Flags().DurationVar(&timeout, "timeout", 15*time.Second, "Overall timeout")
+
+	return cmd
+}
+
+func NewDeleteAliasCmd() *cobra.Command {
+	var (
+		functionName string
+		gatewayAddr  string
+		tls          bool
+		caFile       string
+		timeout      time.Duration
+
+		aliasName string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete an alias",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if functionName == "" {
+				return fmt.Errorf("--name is required")
+			}
+			if aliasName == "" {
+				return fmt.Errorf("--alias is required")
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			conn, err := dialGateway(ctx, gatewayAddr, tls, caFile)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			client := faaspb.NewFunctionsClient(conn)
+			if _, err := client.DeleteAlias(ctx, &faaspb.DeleteAliasRequest{
+				Function: functionName,
+				Name:     aliasName,
+			}); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "deleted: alias=%s@%s\n", functionName, aliasName)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&functionName, "name", "", "Function name, e.g. functions/my-func")
+	cmd.Flags().StringVar(&gatewayAddr, "gateway", "127.0.0.1:55055", "Gateway gRPC address host:port")
+	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
+	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
+	cmd.Flags().DurationVar(&timeout, "timeout", 15*time.Second, "Overall timeout")
+
+	cmd.Flags().StringVar(&aliasName, "alias", "", "Alias name, e.g. prod")
+
+	return cmd
+}
+
+// parseSplit parses "3=90,4=10" into alias routes.
+func parseSplit(s string) ([]*faaspb.AliasRoute, error) {
+	var routes []*faaspb.AliasRoute
+	for _, part := range strings.Split(s, ",") {
+		rev, weight, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return nil, fmt.Errorf("--split: %q is not rev=weight", part)
+		}
+		r, err := strconv.ParseUint(rev, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("--split: invalid revision %q", rev)
+		}
+		w, err := strconv.ParseUint(weight, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("--split: invalid weight %q", weight)
+		}
+		routes = append(routes, &faaspb.AliasRoute{Revision: r, Weight: uint32(w)})
+	}
+	return routes, nil
+}
+
+func printAlias(cmd *cobra.Command, prefix string, a *faaspb.FunctionAlias) {
+	routes := make([]string, 0, len(a.GetRoutes()))
+	for _, r := range a.GetRoutes() {
+		routes = append(routes, fmt.Sprintf("%d=%d", r.GetRevision(), r.GetWeight()))
+	}
+
+	updatedAt := ""
+	if ts := a.GetUpdatedAt(); ts != nil {
+		updatedAt = ts.AsTime().Format(time.RFC3339Nano)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "%sname=%s@%s, routes=%s, updated_at=%s\n",
+		prefix, a.GetFunction(), a.GetName(), strings.Join(routes, ","), updatedAt)
+}
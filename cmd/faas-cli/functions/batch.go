@@ -0,0 +1,136 @@
+package funccmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+)
+
+// batchLine is one parameter set of a params file and where it came from.
+type batchLine struct {
+	Line       int
+	Parameters string
+}
+
+// batchMapping is written for every item, so results can be joined back to
+// the params file.
+type batchMapping struct {
+	Line    int    `json:"line"`
+	BatchID string `json:"batch_id"`
+	Task    string `json:"task,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// readParamsFile reads one parameter set per line; blank lines are skipped.
+func readParamsFile(path string) ([]batchLine, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var out []batchLine
+	r := bufio.NewReader(f)
+	for n := 1; ; n++ {
+		line, err := r.ReadBytes('\n')
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			out = append(out, batchLine{Line: n, Parameters: string(line)})
+		}
+		if errors.Is(err, io.EOF) {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+type batchSummary struct {
+	BatchID  string
+	Created  int
+	Rejected int
+}
+
+// executeBatch streams the items while reading the responses, printing
+// progress as they arrive and a mapping line for each.
+func executeBatch(
+	ctx context.Context,
+	client faaspb.FunctionsClient,
+	req *faaspb.ExecuteFunctionRequest,
+	failThreshold float64,
+	items []batchLine,
+	progress io.Writer,
+	mapping io.Writer,
+) (*batchSummary, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := client.BatchExecuteFunction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		if err := stream.Send(&faaspb.BatchExecuteFunctionRequest{
+			Payload:          &faaspb.BatchExecuteFunctionRequest_Execute{Execute: req},
+			FailureThreshold: failThreshold,
+		}); err != nil {
+			// Recv reports why the server ended the call.
+			return
+		}
+		for _, it := range items {
+			if err := stream.Send(&faaspb.BatchExecuteFunctionRequest{
+				Payload: &faaspb.BatchExecuteFunctionRequest_Item{Item: &faaspb.BatchItem{Parameters: it.Parameters}},
+			}); err != nil {
+				return
+			}
+		}
+		_ = stream.CloseSend()
+	}()
+
+	sum := &batchSummary{}
+	enc := json.NewEncoder(mapping)
+	for {
+		res, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			fmt.Fprintln(progress)
+			return sum, err
+		}
+		if res.GetIndex() >= uint64(len(items)) {
+			return sum, fmt.Errorf("response for unknown item %d", res.GetIndex())
+		}
+
+		sum.BatchID = res.GetBatchId()
+		m := batchMapping{
+			Line:    items[res.GetIndex()].Line,
+			BatchID: res.GetBatchId(),
+			Task:    res.GetTask(),
+		}
+		if e := res.GetError(); e != nil {
+			m.Error = e.GetMessage()
+			sum.Rejected++
+		} else {
+			sum.Created++
+		}
+		if err := enc.Encode(m); err != nil {
+			return sum, err
+		}
+		fmt.Fprintf(progress, "\rsubmitted %d/%d, created=%d, rejected=%d", sum.Created+sum.Rejected, len(items), sum.Created, sum.Rejected)
+	}
+	fmt.Fprintln(progress)
+
+	if done := sum.Created + sum.Rejected; done != len(items) {
+		return sum, fmt.Errorf("gateway answered %d of %d items", done, len(items))
+	}
+	return sum, nil
+}
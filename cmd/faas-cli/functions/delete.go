@@ -17,11 +17,12 @@ func NewDeleteFunctionCmd() *cobra.Command {
 		caFile       string
 		timeout      time.Duration
 		force        bool
+		cancelTasks  bool
 	)
 
 	cmd := &cobra.Command{
 		Use:   "delete",
-		Short: "Delete a function from",
+		Short: "Delete a function; it can be undeleted until it is purged",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
 
@@ -46,7 +47,8 @@ func NewDeleteFunctionCmd() *cobra.Command {
 
 			client := faaspb.NewFunctionsClient(conn)
 			if _, err := client.DeleteFunction(ctx, &faaspb.DeleteFunctionRequest{
-				Name: functionName,
+				Name:  functionName,
+				Force: cancelTasks,
 			}); err != nil {
 				return err
 			}
@@ -64,6 +66,7 @@ func NewDeleteFunctionCmd() *cobra.Command {
 
 	// safety latch
 	cmd.Flags().BoolVar(&force, "force", false, "Actually perform deletion")
+	cmd.Flags().BoolVar(&cancelTasks, "cancel-tasks", false, "Delete even with pending or processing tasks and cancel them")
 
 	return cmd
 }
@@ -0,0 +1,170 @@
+package funccmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	archiveutils "github.com/10Narratives/faas/pkg/archive"
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"github.com/spf13/cobra"
+)
+
+func NewDownloadFunctionCmd() *cobra.Command {
+	var (
+		functionName string
+		revision     uint64
+		out          string
+		extract      bool
+		gatewayAddr  string
+		tls          bool
+		caFile       string
+		timeout      time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "download",
+		Short: "Download the source bundle of a function",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if functionName == "" {
+				return fmt.Errorf("--name is required")
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			conn, err := dialGateway(ctx, gatewayAddr, tls, caFile)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			client := faaspb.NewFunctionsClient(conn)
+			stream, err := client.DownloadFunction(ctx, &faaspb.DownloadFunctionRequest{
+				Name:     functionName,
+				Revision: revision,
+			})
+			if err != nil {
+				return err
+			}
+
+			first, err := stream.Recv()
+			if err != nil {
+				return err
+			}
+			fn := first.GetFunction()
+			if fn == nil {
+				return fmt.Errorf("first message must be function metadata")
+			}
+			bundle := fn.GetSourceBundle()
+			format := bundleFormat(bundle)
+
+			// The bundle is spooled next to its destination and only moved
+			// into place or extracted once the digest has been checked.
+			target := out
+			spoolDir := os.TempDir()
+			if !extract {
+				if target == "" {
+					target = "."
+				}
+				if st, err := os.Stat(target); err == nil && st.IsDir() {
+					target = filepath.Join(target, fmt.Sprintf("%s-%d.%s", shortFunctionName(fn.GetName()), fn.GetRevision(), format))
+				}
+				spoolDir = filepath.Dir(target)
+			} else if target == "" {
+				target = fmt.Sprintf("%s-%d", shortFunctionName(fn.GetName()), fn.GetRevision())
+			}
+
+			tmp, err := os.CreateTemp(spoolDir, ".faas-download-*")
+			if err != nil {
+				return err
+			}
+			defer os.Remove(tmp.Name())
+			defer tmp.Close()
+
+			h := sha256.New()
+			n, err := receiveBundle(stream, io.MultiWriter(tmp, h))
+			if err != nil {
+				return err
+			}
+			if got := hex.EncodeToString(h.Sum(nil)); bundle.GetSha256() != "" && got != bundle.GetSha256() {
+				return fmt.Errorf("sha256 mismatch: expected %s, got %s", bundle.GetSha256(), got)
+			}
+
+			if extract {
+				if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+					return err
+				}
+				if err := archiveutils.Extract(tmp, format, target); err != nil {
+					return fmt.Errorf("extract: %w", err)
+				}
+			} else {
+				if err := tmp.Close(); err != nil {
+					return err
+				}
+				if err := os.Rename(tmp.Name(), target); err != nil {
+					return err
+				}
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(),
+				"downloaded: name=%s, revision=%d, size=%d, sha256=%s, extracted=%t, output=%s\n",
+				fn.GetName(), fn.GetRevision(), n, bundle.GetSha256(), extract, target,
+			)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&functionName, "name", "", "Function name, e.g. functions/my-func")
+	cmd.Flags().Uint64Var(&revision, "revision", 0, "Revision to download (default: latest)")
+	cmd.Flags().StringVar(&out, "out", "", "Output file or directory (default: <name>-<revision> in the current directory)")
+	cmd.Flags().BoolVar(&extract, "extract", false, "Extract the bundle into the --out directory")
+	cmd.Flags().StringVar(&gatewayAddr, "gateway", "127.0.0.1:55055", "Gateway gRPC address host:port")
+	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
+	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
+	cmd.Flags().DurationVar(&timeout, "timeout", 5*time.Minute, "Overall timeout")
+
+	return cmd
+}
+
+func receiveBundle(stream faaspb.Functions_DownloadFunctionClient, w io.Writer) (int64, error) {
+	var n int64
+	for {
+		msg, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return n, nil
+		}
+		if err != nil {
+			return n, err
+		}
+
+		written, err := w.Write(msg.GetData())
+		n += int64(written)
+		if err != nil {
+			return n, err
+		}
+	}
+}
+
+// bundleFormat returns the archive format of the bundle. Servers that do
+// not report it encode it in the object key, e.g. "bundles/foo/3.zip".
+func bundleFormat(bundle *faaspb.SourceBundle) string {
+	if f := bundle.GetFormat(); f != "" {
+		return f
+	}
+	if strings.HasSuffix(bundle.GetObjectKey(), "."+archiveutils.FormatTarGZ) {
+		return archiveutils.FormatTarGZ
+	}
+	return archiveutils.FormatZip
+}
+
+func shortFunctionName(name string) string {
+	return strings.ReplaceAll(strings.TrimPrefix(name, "functions/"), "/", "_")
+}
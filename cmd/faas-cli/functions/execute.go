@@ -2,9 +2,15 @@ package funccmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
+	taskcmd "github.com/10Narratives/faas/cmd/faas-cli/tasks"
 	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
 	"github.com/spf13/cobra"
 )
@@ -17,7 +23,16 @@ func NewExecuteFunctionCmd() *cobra.Command {
 		caFile       string
 		timeout      time.Duration
 
-		parameters string
+		revision      uint64
+		parameters    string
+		inputs        []string
+		labels        map[string]string
+		annotations   map[string]string
+		inheritLabels []string
+		wait          bool
+		paramsFile    string
+		mappingOut    string
+		failThreshold float64
 	)
 
 	cmd := &cobra.Command{
@@ -27,8 +42,23 @@ func NewExecuteFunctionCmd() *cobra.Command {
 			if functionName == "" {
 				return fmt.Errorf("--name is required")
 			}
+			if wait && len(inputs) > 0 {
+				return fmt.Errorf("--wait cannot be combined with --input")
+			}
+			if paramsFile != "" && (parameters != "" || len(inputs) > 0 || wait) {
+				return fmt.Errorf("--params-file cannot be combined with --params, --input or --wait")
+			}
 
-			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			var (
+				ctx    context.Context
+				cancel context.CancelFunc
+			)
+			if paramsFile != "" && !cmd.Flags().Changed("timeout") {
+				// A large batch easily outlasts the default timeout.
+				ctx, cancel = context.WithCancel(cmd.Context())
+			} else {
+				ctx, cancel = context.WithTimeout(cmd.Context(), timeout)
+			}
 			defer cancel()
 
 			conn, err := dialGateway(ctx, gatewayAddr, tls, caFile)
@@ -37,11 +67,36 @@ func NewExecuteFunctionCmd() *cobra.Command {
 			}
 			defer conn.Close()
 
+			req := &faaspb.ExecuteFunctionRequest{
+				Name:          functionName,
+				Revision:      revision,
+				Parameters:    parameters,
+				Labels:        labels,
+				Annotations:   annotations,
+				InheritLabels: inheritLabels,
+			}
+
 			client := faaspb.NewFunctionsClient(conn)
-			resp, err := client.ExecuteFunction(ctx, &faaspb.ExecuteFunctionRequest{
-				Name:       functionName,
-				Parameters: parameters,
-			})
+
+			if paramsFile != "" {
+				return runBatch(ctx, cmd, client, req, failThreshold, paramsFile, mappingOut)
+			}
+
+			if wait {
+				resp, err := client.InvokeFunction(ctx, &faaspb.InvokeFunctionRequest{Execute: req})
+				if err != nil {
+					return err
+				}
+				printInvokeResponse(cmd.OutOrStdout(), resp)
+				return nil
+			}
+
+			var resp *faaspb.ExecuteFunctionResponse
+			if len(inputs) == 0 {
+				resp, err = client.ExecuteFunction(ctx, req)
+			} else {
+				resp, err = executeWithInputs(ctx, client, req, inputs)
+			}
 			if err != nil {
 				return err
 			}
@@ -51,13 +106,165 @@ func NewExecuteFunctionCmd() *cobra.Command {
 		},
 	}
 
-	cmd.Flags().StringVar(&functionName, "name", "", "Function name, e.g. functions/my-func")
+	cmd.Flags().StringVar(&functionName, "name", "", "Function name, optionally with an alias, e.g. functions/my-func@prod")
 	cmd.Flags().StringVar(&gatewayAddr, "gateway", "127.0.0.1:55055", "Gateway gRPC address host:port")
 	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
 	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
 	cmd.Flags().DurationVar(&timeout, "timeout", 15*time.Second, "Overall timeout")
 
+	cmd.Flags().Uint64Var(&revision, "revision", 0, "Revision to run (0 runs the latest)")
 	cmd.Flags().StringVar(&parameters, "params", "", "Execute parameters as string (format is application-specific)")
+	cmd.Flags().StringArrayVar(&inputs, "input", nil, "Input file, as path or name=path; repeatable, e.g. --input data.csv=./big.csv")
+	cmd.Flags().StringToStringVar(&labels, "labels", nil, "Task labels, e.g. --labels env=dev,cost-center=42")
+	cmd.Flags().StringToStringVar(&annotations, "annotations", nil, "Task annotations, e.g. --annotations ticket=OPS-1234")
+	cmd.Flags().StringSliceVar(&inheritLabels, "inherit-labels", nil, "Function label keys to copy to the task, or * for all, e.g. --inherit-labels team,env")
+	cmd.Flags().BoolVar(&wait, "wait", false, "Wait for the task to end, up to --timeout, and print its result")
+	cmd.Flags().StringVar(&paramsFile, "params-file", "", "JSONL file with one parameter set per line; creates one task per line as a batch")
+	cmd.Flags().Float64Var(&failThreshold, "failure-threshold", 0, "With --params-file, the fraction of tasks (0-1) that may fail without failing the job")
+	cmd.Flags().StringVar(&mappingOut, "mapping-out", "", "Where to write the line to task mapping of --params-file (default <params-file>.tasks.jsonl)")
 
 	return cmd
 }
+
+func runBatch(
+	ctx context.Context,
+	cmd *cobra.Command,
+	client faaspb.FunctionsClient,
+	req *faaspb.ExecuteFunctionRequest,
+	failThreshold float64,
+	paramsFile, mappingOut string,
+) error {
+	items, err := readParamsFile(paramsFile)
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return fmt.Errorf("%s has no parameter sets", paramsFile)
+	}
+
+	if mappingOut == "" {
+		mappingOut = strings.TrimSuffix(paramsFile, filepath.Ext(paramsFile)) + ".tasks.jsonl"
+	}
+	f, err := os.Create(mappingOut)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	sum, err := executeBatch(ctx, client, req, failThreshold, items, cmd.ErrOrStderr(), f)
+	if err != nil {
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "batch: id=%s, job=jobs/%s, created=%d, rejected=%d, mapping=%s\n",
+		sum.BatchID, sum.BatchID, sum.Created, sum.Rejected, mappingOut)
+	return nil
+}
+
+// printInvokeResponse prints the task; when it is still running only its
+// name and state are useful, to follow it with "tasks get".
+func printInvokeResponse(w io.Writer, resp *faaspb.InvokeFunctionResponse) {
+	t := resp.GetTask()
+	if !resp.GetDone() {
+		fmt.Fprintf(w, "task: name=%s, state=%s, done=false\n", t.GetName(), t.GetState().String())
+		return
+	}
+
+	result := ""
+	r := t.GetResult()
+	switch v := r.GetData().(type) {
+	case *faaspb.TaskResult_InlineResult:
+		result = taskcmd.RenderResult(v.InlineResult, r.GetContentType())
+	case *faaspb.TaskResult_ObjectKey:
+		result = v.ObjectKey
+	case *faaspb.TaskResult_ErrorMessage:
+		result = v.ErrorMessage
+	}
+	fmt.Fprintf(w, "task: name=%s, state=%s, done=true, result_content_type=%s, result=%s\n",
+		t.GetName(), t.GetState().String(), r.GetContentType(), result)
+}
+
+func executeWithInputs(
+	ctx context.Context,
+	client faaspb.FunctionsClient,
+	req *faaspb.ExecuteFunctionRequest,
+	inputs []string,
+) (*faaspb.ExecuteFunctionResponse, error) {
+	stream, err := client.ExecuteFunctionWithInputs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := stream.Send(&faaspb.ExecuteFunctionWithInputsRequest{
+		Payload: &faaspb.ExecuteFunctionWithInputsRequest_Execute{Execute: req},
+	}); err != nil {
+		return nil, err
+	}
+
+	for _, spec := range inputs {
+		name, path := parseInputSpec(spec)
+		if err := sendInput(stream, name, path); err != nil {
+			if errors.Is(err, io.EOF) {
+				// The server ended the call early; its status explains why.
+				return stream.CloseAndRecv()
+			}
+			return nil, fmt.Errorf("input %s: %w", name, err)
+		}
+	}
+
+	return stream.CloseAndRecv()
+}
+
+// parseInputSpec splits "name=path"; a bare path is named by its base name.
+func parseInputSpec(spec string) (name, path string) {
+	if name, path, ok := strings.Cut(spec, "="); ok {
+		return name, path
+	}
+	return filepath.Base(spec), spec
+}
+
+func sendInput(stream faaspb.Functions_ExecuteFunctionWithInputsClient, name, path string) error {
+	sha, _, err := fileSHA256AndSize(path)
+	if err != nil {
+		return err
+	}
+
+	if err := stream.Send(&faaspb.ExecuteFunctionWithInputsRequest{
+		Payload: &faaspb.ExecuteFunctionWithInputsRequest_InputHeader{
+			InputHeader: &faaspb.TaskInputHeader{Name: name, Sha256: sha},
+		},
+	}); err != nil {
+		return err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	const chunkSize = 1 << 20
+	buf := make([]byte, chunkSize)
+
+	for {
+		n, readErr := f.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&faaspb.ExecuteFunctionWithInputsRequest{
+				Payload: &faaspb.ExecuteFunctionWithInputsRequest_InputData{
+					InputData: &faaspb.TaskInputData{Data: buf[:n]},
+				},
+			}); err != nil {
+				return err
+			}
+		}
+		if readErr == io.EOF {
+			return nil
+		}
+		if readErr != nil {
+			return readErr
+		}
+	}
+}
@@ -6,16 +6,23 @@ import (
 
 func NewFunctionsGroup() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "funcs",
-		Short: "Commands for managing serverless functions",
+		Use:     "funcs",
+		Aliases: []string{"functions"},
+		Short:   "Commands for managing serverless functions",
 	}
 
 	cmd.AddCommand(
 		NewUploadFunctionCmd(),
 		NewGetFunctionCmd(),
 		NewListFunctionsCmd(),
+		NewListFunctionRevisionsCmd(),
+		NewUpdateFunctionCmd(),
 		NewDeleteFunctionCmd(),
+		NewUndeleteFunctionCmd(),
+		NewDownloadFunctionCmd(),
+		NewNamespaceUsageCmd(),
 		NewExecuteFunctionCmd(),
+		NewAliasGroup(),
 	)
 
 	return cmd
@@ -16,6 +16,8 @@ func NewGetFunctionCmd() *cobra.Command {
 		tls          bool
 		caFile       string
 		timeout      time.Duration
+		buildLog     bool
+		revision     uint64
 	)
 
 	cmd := &cobra.Command{
@@ -36,9 +38,18 @@ func NewGetFunctionCmd() *cobra.Command {
 			defer conn.Close()
 
 			client := faaspb.NewFunctionsClient(conn)
-			fn, err := client.GetFunction(ctx, &faaspb.GetFunctionRequest{
-				Name: functionName,
-			})
+
+			var fn *faaspb.Function
+			if revision == 0 {
+				fn, err = client.GetFunction(ctx, &faaspb.GetFunctionRequest{
+					Name: functionName,
+				})
+			} else {
+				fn, err = client.GetFunctionRevision(ctx, &faaspb.GetFunctionRevisionRequest{
+					Name:     functionName,
+					Revision: revision,
+				})
+			}
 			if err != nil {
 				return err
 			}
@@ -62,16 +73,34 @@ func NewGetFunctionCmd() *cobra.Command {
 			}
 
 			fmt.Fprintf(cmd.OutOrStdout(),
-				"function: name=%s, display_name=%s, uploaded_at=%s, bundle_bucket=%s, bundle_object_key=%s, bundle_size=%d, bundle_sha256=%s\n",
+				"function: name=%s, revision=%d, etag=%s, display_name=%s, description=%s, labels=%v, annotations=%v, timeout=%s, memory_bytes=%d, runtime=%s, entrypoint=%s, handler=%s, uploaded_at=%s, bundle_bucket=%s, bundle_object_key=%s, bundle_size=%d, bundle_sha256=%s, env=%v, secret_env=%v, build_state=%s, build_error=%s\n",
 				fn.GetName(),
+				fn.GetRevision(),
+				fn.GetEtag(),
 				fn.GetDisplayName(),
+				fn.GetDescription(),
+				fn.GetLabels(),
+				fn.GetAnnotations(),
+				fn.GetTimeout().AsDuration(),
+				fn.GetMemoryBytes(),
+				fn.GetRuntime(),
+				fn.GetEntrypoint(),
+				fn.GetHandler(),
 				uploadedAt,
 				bucket,
 				objectKey,
 				size,
 				sha256hex,
+				fn.GetEnv(),
+				fn.GetSecretEnv(),
+				fn.GetBuild().GetState().String(),
+				fn.GetBuild().GetErrorMessage(),
 			)
 
+			if buildLog {
+				fmt.Fprint(cmd.OutOrStdout(), fn.GetBuild().GetLog())
+			}
+
 			return nil
 		},
 	}
@@ -81,6 +110,8 @@ func NewGetFunctionCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
 	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
 	cmd.Flags().DurationVar(&timeout, "timeout", 15*time.Second, "Overall timeout")
+	cmd.Flags().Uint64Var(&revision, "revision", 0, "Revision to get (0 gets the latest)")
+	cmd.Flags().BoolVar(&buildLog, "build-log", false, "Print the build log after the metadata")
 
 	return cmd
 }
@@ -16,9 +16,12 @@ func NewListFunctionsCmd() *cobra.Command {
 		caFile      string
 		timeout     time.Duration
 
-		pageSize  int32
-		pageToken string
-		all       bool
+		pageSize    int32
+		pageToken   string
+		all         bool
+		filter      string
+		orderBy     string
+		showDeleted bool
 	)
 
 	cmd := &cobra.Command{
@@ -59,22 +62,34 @@ func NewListFunctionsCmd() *cobra.Command {
 					sha256hex = sb.GetSha256()
 				}
 
+				deleted := ""
+				if ts := fn.GetPurgeTime(); fn.GetState() == faaspb.FunctionState_FUNCTION_STATE_DELETED && ts != nil {
+					deleted = ", purge_time=" + ts.AsTime().Format(time.RFC3339)
+				}
+
 				fmt.Fprintf(cmd.OutOrStdout(),
-					"name=%s, display_name=%s, uploaded_at=%s, bundle_bucket=%s, bundle_object_key=%s, bundle_size=%d, bundle_sha256=%s\n",
+					"name=%s, revision=%d, display_name=%s, uploaded_at=%s, bundle_bucket=%s, bundle_object_key=%s, bundle_size=%d, bundle_sha256=%s, build_state=%s, state=%s%s\n",
 					fn.GetName(),
+					fn.GetRevision(),
 					fn.GetDisplayName(),
 					uploadedAt,
 					bucket,
 					objectKey,
 					size,
 					sha256hex,
+					fn.GetBuild().GetState().String(),
+					fn.GetState().String(),
+					deleted,
 				)
 			}
 
 			if !all {
 				resp, err := client.ListFunctions(ctx, &faaspb.ListFunctionsRequest{
-					PageSize:  pageSize,
-					PageToken: pageToken,
+					PageSize:    pageSize,
+					PageToken:   pageToken,
+					Filter:      filter,
+					OrderBy:     orderBy,
+					ShowDeleted: showDeleted,
 				})
 				if err != nil {
 					return err
@@ -94,8 +109,11 @@ func NewListFunctionsCmd() *cobra.Command {
 			token := pageToken
 			for {
 				resp, err := client.ListFunctions(ctx, &faaspb.ListFunctionsRequest{
-					PageSize:  pageSize,
-					PageToken: token,
+					PageSize:    pageSize,
+					PageToken:   token,
+					Filter:      filter,
+					OrderBy:     orderBy,
+					ShowDeleted: showDeleted,
 				})
 				if err != nil {
 					return err
@@ -123,6 +141,9 @@ func NewListFunctionsCmd() *cobra.Command {
 	cmd.Flags().Int32Var(&pageSize, "page-size", 50, "Max results per page (0 lets server decide)")
 	cmd.Flags().StringVar(&pageToken, "page-token", "", "Page token (from next_page_token)")
 	cmd.Flags().BoolVar(&all, "all", false, "Fetch all pages automatically")
+	cmd.Flags().StringVar(&filter, "filter", "", `Filter, e.g. 'labels.team = "images" AND uploaded_at >= "2024-01-01T00:00:00Z"'`)
+	cmd.Flags().BoolVar(&showDeleted, "show-deleted", false, "Include deleted functions that have not been purged yet")
+	cmd.Flags().StringVar(&orderBy, "order-by", "", "Sort order, e.g. \"uploaded_at desc\" (default: name)")
 
 	return cmd
 }
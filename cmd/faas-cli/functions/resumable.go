@@ -0,0 +1,211 @@
+package funccmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const uploadChunkSize = 1 << 20
+
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// uploadResumable sends the archive through an upload session. After a
+// transient failure it asks the gateway for the committed offset and
+// continues from there. The session name is kept in the user cache dir, so
+// rerunning an interrupted upload of the same archive resumes it too.
+func uploadResumable(
+	ctx context.Context,
+	client faaspb.FunctionsClient,
+	meta *faaspb.UploadFunctionMetadata,
+	archivePath string,
+	size int64,
+	sha string,
+	retries int,
+	log io.Writer,
+) (*faaspb.Function, error) {
+	statePath := uploadStatePath(sha)
+
+	session := resumeSession(ctx, client, statePath, meta.GetFunctionName(), uint64(size))
+	if session == nil {
+		var err error
+		session, err = client.StartUpload(ctx, &faaspb.StartUploadRequest{Metadata: meta, Size: uint64(size)})
+		if err != nil {
+			return nil, err
+		}
+		saveUploadState(statePath, session.GetName())
+	} else {
+		fmt.Fprintf(log, "resuming %s at offset %d\n", session.GetName(), session.GetCommittedOffset())
+	}
+
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	offset := session.GetCommittedOffset()
+	for attempt := 0; offset < uint64(size); {
+		s, err := sendChunks(ctx, client, session.GetName(), f, offset)
+		if err == nil {
+			offset = s.GetCommittedOffset()
+			continue
+		}
+		if !isRetryable(err) || attempt >= retries {
+			return nil, err
+		}
+		attempt++
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(time.Duration(attempt) * time.Second):
+		}
+
+		s, gerr := client.GetUploadSession(ctx, &faaspb.GetUploadSessionRequest{Name: session.GetName()})
+		if gerr != nil {
+			if !isRetryable(gerr) {
+				return nil, gerr
+			}
+			continue
+		}
+		offset = s.GetCommittedOffset()
+		fmt.Fprintf(log, "upload interrupted (%v), resuming at offset %d\n", status.Convert(err).Message(), offset)
+	}
+
+	fn, err := finalizeUpload(ctx, client, session.GetName(), sha, retries, log)
+	if err != nil {
+		return nil, err
+	}
+	_ = os.Remove(statePath)
+	return fn, nil
+}
+
+// finalizeUpload retries when the response may have been lost or another
+// finalize of the session is still running. Finalizing a session again
+// returns the revision it was already turned into, not a new one.
+func finalizeUpload(
+	ctx context.Context,
+	client faaspb.FunctionsClient,
+	name, sha string,
+	retries int,
+	log io.Writer,
+) (*faaspb.Function, error) {
+	for attempt := 0; ; {
+		fn, err := client.FinalizeUpload(ctx, &faaspb.FinalizeUploadRequest{Name: name, Sha256: sha})
+		if err == nil {
+			return fn, nil
+		}
+		if !isFinalizeRetryable(err) || attempt >= retries || ctx.Err() != nil {
+			return nil, err
+		}
+		attempt++
+
+		fmt.Fprintf(log, "finalize interrupted (%v), retrying\n", status.Convert(err).Message())
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(time.Duration(attempt) * time.Second):
+		}
+	}
+}
+
+// sendChunks streams the file from offset to the end.
+func sendChunks(ctx context.Context, client faaspb.FunctionsClient, name string, f *os.File, offset uint64) (*faaspb.UploadSession, error) {
+	if _, err := f.Seek(int64(offset), io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	stream, err := client.UploadChunks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, uploadChunkSize)
+	for {
+		n, readErr := io.ReadFull(f, buf)
+		if n > 0 {
+			err := stream.Send(&faaspb.UploadChunkRequest{
+				Name:   name,
+				Offset: offset,
+				Data:   buf[:n],
+				Crc32C: crc32.Checksum(buf[:n], castagnoli),
+			})
+			if errors.Is(err, io.EOF) {
+				// The server ended the stream; the real error comes from
+				// CloseAndRecv.
+				return stream.CloseAndRecv()
+			}
+			if err != nil {
+				return nil, err
+			}
+			offset += uint64(n)
+		}
+		if errors.Is(readErr, io.EOF) || errors.Is(readErr, io.ErrUnexpectedEOF) {
+			break
+		}
+		if readErr != nil {
+			return nil, readErr
+		}
+	}
+
+	return stream.CloseAndRecv()
+}
+
+func isRetryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.Aborted, codes.Internal, codes.Unknown:
+		return true
+	default:
+		return false
+	}
+}
+
+func isFinalizeRetryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
+
+// resumeSession returns the session recorded for this archive if the gateway
+// still has it.
+func resumeSession(ctx context.Context, client faaspb.FunctionsClient, statePath, functionName string, size uint64) *faaspb.UploadSession {
+	b, err := os.ReadFile(statePath)
+	if err != nil {
+		return nil
+	}
+
+	s, err := client.GetUploadSession(ctx, &faaspb.GetUploadSessionRequest{Name: string(b)})
+	if err != nil || s.GetFunctionName() != functionName || s.GetSize() != size {
+		return nil
+	}
+	return s
+}
+
+func uploadStatePath(sha string) string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, "faas", "uploads", sha)
+}
+
+// saveUploadState is best effort: without it only in-process resumption works.
+func saveUploadState(path, sessionName string) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return
+	}
+	_ = os.WriteFile(path, []byte(sessionName), 0o600)
+}
@@ -0,0 +1,98 @@
+package funccmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"github.com/spf13/cobra"
+)
+
+func NewListFunctionRevisionsCmd() *cobra.Command {
+	var (
+		functionName string
+		gatewayAddr  string
+		tls          bool
+		caFile       string
+		timeout      time.Duration
+
+		pageSize  int32
+		pageToken string
+		all       bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "revisions",
+		Short: "List function revisions, newest first",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if functionName == "" {
+				return fmt.Errorf("--name is required")
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			conn, err := dialGateway(ctx, gatewayAddr, tls, caFile)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			client := faaspb.NewFunctionsClient(conn)
+
+			printRev := func(fn *faaspb.Function) {
+				uploadedAt := ""
+				if ts := fn.GetUploadedAt(); ts != nil {
+					uploadedAt = ts.AsTime().Format(time.RFC3339Nano)
+				}
+
+				fmt.Fprintf(cmd.OutOrStdout(),
+					"revision=%d, uploaded_at=%s, bundle_object_key=%s, bundle_sha256=%s, build_state=%s\n",
+					fn.GetRevision(),
+					uploadedAt,
+					fn.GetSourceBundle().GetObjectKey(),
+					fn.GetSourceBundle().GetSha256(),
+					fn.GetBuild().GetState().String(),
+				)
+			}
+
+			token := pageToken
+			for {
+				resp, err := client.ListFunctionRevisions(ctx, &faaspb.ListFunctionRevisionsRequest{
+					Name:      functionName,
+					PageSize:  pageSize,
+					PageToken: token,
+				})
+				if err != nil {
+					return err
+				}
+
+				for _, fn := range resp.GetRevisions() {
+					printRev(fn)
+				}
+
+				token = resp.GetNextPageToken()
+				if token == "" {
+					return nil
+				}
+				if !all {
+					fmt.Fprintf(cmd.OutOrStdout(), "next_page_token=%s\n", token)
+					return nil
+				}
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&functionName, "name", "", "Function name, e.g. functions/my-func")
+	cmd.Flags().StringVar(&gatewayAddr, "gateway", "127.0.0.1:55055", "Gateway gRPC address host:port")
+	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
+	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
+	cmd.Flags().DurationVar(&timeout, "timeout", 15*time.Second, "Overall timeout")
+
+	cmd.Flags().Int32Var(&pageSize, "page-size", 50, "Max results per page (0 lets server decide)")
+	cmd.Flags().StringVar(&pageToken, "page-token", "", "Page token (from next_page_token)")
+	cmd.Flags().BoolVar(&all, "all", false, "Fetch all pages automatically")
+
+	return cmd
+}
@@ -0,0 +1,63 @@
+package funccmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"github.com/spf13/cobra"
+)
+
+func NewUndeleteFunctionCmd() *cobra.Command {
+	var (
+		functionName string
+		gatewayAddr  string
+		tls          bool
+		caFile       string
+		timeout      time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "undelete",
+		Short: "Restore a deleted function before it is purged",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			if functionName == "" {
+				return fmt.Errorf("--name is required")
+			}
+			if gatewayAddr == "" {
+				return fmt.Errorf("--gateway is required")
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			conn, err := dialGateway(ctx, gatewayAddr, tls, caFile)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			client := faaspb.NewFunctionsClient(conn)
+			fn, err := client.UndeleteFunction(ctx, &faaspb.UndeleteFunctionRequest{
+				Name: functionName,
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "undeleted: name=%s, revision=%d\n", fn.GetName(), fn.GetRevision())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&functionName, "name", "", "Function name, e.g. functions/my-func")
+	cmd.Flags().StringVar(&gatewayAddr, "gateway", "127.0.0.1:55055", "Gateway gRPC address host:port")
+	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
+	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
+	cmd.Flags().DurationVar(&timeout, "timeout", 15*time.Second, "Overall timeout")
+
+	return cmd
+}
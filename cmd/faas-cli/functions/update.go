@@ -0,0 +1,152 @@
+package funccmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// updateFlagPaths maps update flags to the field mask paths they set.
+var updateFlagPaths = []struct{ flag, path string }{
+	{"display-name", "display_name"},
+	{"description", "description"},
+	{"labels", "labels"},
+	{"annotations", "annotations"},
+	{"exec-timeout", "timeout"},
+	{"memory-bytes", "memory_bytes"},
+	{"runtime", "runtime"},
+	{"env", "env"},
+	{"secret-env", "secret_env"},
+	{"parameters-schema-file", "parameters_schema"},
+	{"output-schema-file", "output_schema"},
+	{"output-content-type", "output_content_type"},
+}
+
+func NewUpdateFunctionCmd() *cobra.Command {
+	var (
+		functionName string
+		gatewayAddr  string
+		tls          bool
+		caFile       string
+		timeout      time.Duration
+
+		etag        string
+		displayName string
+		description string
+		labels      map[string]string
+		annotations map[string]string
+		execTimeout time.Duration
+		memoryBytes uint64
+		runtime     string
+		env         map[string]string
+		secretEnv   map[string]string
+		schemaFile  string
+
+		outputSchemaFile  string
+		outputContentType string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Update metadata of the latest function revision",
+		Long: "Only the flags given are updated; pass a flag with an empty value to clear it,\n" +
+			"e.g. --labels \"\". Code changes are made by uploading a new revision.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if functionName == "" {
+				return fmt.Errorf("--name is required")
+			}
+
+			mask := &fieldmaskpb.FieldMask{}
+			for _, f := range updateFlagPaths {
+				if cmd.Flags().Changed(f.flag) {
+					mask.Paths = append(mask.Paths, f.path)
+				}
+			}
+			if len(mask.Paths) == 0 {
+				return fmt.Errorf("nothing to update")
+			}
+
+			schema, err := readOptionalFile(schemaFile)
+			if err != nil {
+				return err
+			}
+			outputSchema, err := readOptionalFile(outputSchemaFile)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			conn, err := dialGateway(ctx, gatewayAddr, tls, caFile)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			client := faaspb.NewFunctionsClient(conn)
+			fn, err := client.UpdateFunction(ctx, &faaspb.UpdateFunctionRequest{
+				Function: &faaspb.Function{
+					Name:        functionName,
+					Etag:        etag,
+					DisplayName: displayName,
+					Description: description,
+					Labels:      labels,
+					Annotations: annotations,
+					Timeout:     durationOrNil(execTimeout),
+					MemoryBytes: memoryBytes,
+					Runtime:     runtime,
+					Env:         env,
+					SecretEnv:   secretEnv,
+
+					ParametersSchema:  string(schema),
+					OutputSchema:      string(outputSchema),
+					OutputContentType: outputContentType,
+				},
+				UpdateMask: mask,
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "updated: name=%s, revision=%d, etag=%s, fields=%v\n",
+				fn.GetName(), fn.GetRevision(), fn.GetEtag(), mask.GetPaths())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&functionName, "name", "", "Function name, e.g. functions/my-func")
+	cmd.Flags().StringVar(&gatewayAddr, "gateway", "127.0.0.1:55055", "Gateway gRPC address host:port")
+	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
+	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
+	cmd.Flags().DurationVar(&timeout, "timeout", 15*time.Second, "Overall timeout")
+
+	cmd.Flags().StringVar(&etag, "etag", "", "Fail if the function changed since this etag was read")
+	cmd.Flags().StringVar(&displayName, "display-name", "", "Human-readable name")
+	cmd.Flags().StringVar(&description, "description", "", "Function description")
+	cmd.Flags().StringToStringVar(&labels, "labels", nil, "Labels, e.g. --labels team=images,tier=web")
+	cmd.Flags().StringToStringVar(&annotations, "annotations", nil, "Free-form annotations, e.g. --annotations owner=alice@example.com")
+	cmd.Flags().DurationVar(&execTimeout, "exec-timeout", 0, "Execution timeout (0 uses the agent default)")
+	cmd.Flags().Uint64Var(&memoryBytes, "memory-bytes", 0, "Memory requested by the function, in bytes")
+	cmd.Flags().StringVar(&runtime, "runtime", "", "Language runtime, e.g. python3.12")
+	cmd.Flags().StringToStringVar(&env, "env", nil, "Environment variables, e.g. --env LOG_LEVEL=debug")
+	cmd.Flags().StringToStringVar(&secretEnv, "secret-env", nil, "Environment variables from secrets, e.g. --secret-env DB_PASSWORD=secrets/db-password")
+	cmd.Flags().StringVar(&schemaFile, "parameters-schema-file", "", "JSON Schema file execution parameters must satisfy (\"\" accepts any)")
+	cmd.Flags().StringVar(&outputSchemaFile, "output-schema-file", "", "JSON Schema file successful outputs must satisfy (\"\" accepts any)")
+	cmd.Flags().StringVar(&outputContentType, "output-content-type", "", "Media type of outputs, e.g. application/json or text/csv")
+
+	return cmd
+}
+
+// readOptionalFile reads path; an empty path yields no content.
+func readOptionalFile(path string) ([]byte, error) {
+	if path == "" {
+		return nil, nil
+	}
+	return os.ReadFile(path)
+}
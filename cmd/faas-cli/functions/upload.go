@@ -5,6 +5,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"io/fs"
@@ -17,17 +18,37 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"gopkg.in/yaml.v3"
 )
 
+// bundleManifest is where the server looks for the manifest in a bundle.
+const bundleManifest = "manifest.yaml"
+
 func NewUploadFunctionCmd() *cobra.Command {
 	var (
 		functionName string
 		srcDir       string
+		manifestPath string
 		gatewayAddr  string
 		format       string
 		tls          bool
 		caFile       string
 		timeout      time.Duration
+
+		env       map[string]string
+		secretEnv map[string]string
+
+		displayName string
+		description string
+		labels      map[string]string
+		annotations map[string]string
+		execTimeout time.Duration
+		memoryBytes uint64
+		runtime     string
+
+		resumableThreshold int64
+		retries            int
 	)
 
 	cmd := &cobra.Command{
@@ -37,8 +58,15 @@ func NewUploadFunctionCmd() *cobra.Command {
 			if functionName == "" {
 				return fmt.Errorf("--name is required")
 			}
+			if manifestPath != "" && srcDir == "" {
+				dir, err := manifestSourceDir(manifestPath)
+				if err != nil {
+					return err
+				}
+				srcDir = dir
+			}
 			if srcDir == "" {
-				return fmt.Errorf("--path is required")
+				return fmt.Errorf("--path or --manifest is required")
 			}
 
 			absSrc, err := filepath.Abs(srcDir)
@@ -51,7 +79,7 @@ func NewUploadFunctionCmd() *cobra.Command {
 
 			switch format {
 			case "zip", "":
-				if err := zipDir(absSrc, archivePath); err != nil {
+				if err := zipDir(absSrc, archivePath, manifestPath); err != nil {
 					return fmt.Errorf("zipDir: %w", err)
 				}
 			default:
@@ -74,14 +102,37 @@ func NewUploadFunctionCmd() *cobra.Command {
 			defer conn.Close()
 
 			client := faaspb.NewFunctionsClient(conn)
-			fn, err := uploadArchive(ctx, client, functionName, archivePath, faaspb.UploadFunctionMetadata_FORMAT_ZIP)
+			meta := &faaspb.UploadFunctionMetadata{
+				FunctionName: functionName,
+				Format:       faaspb.UploadFunctionMetadata_FORMAT_ZIP,
+				Env:          env,
+				SecretEnv:    secretEnv,
+				Sha256:       sha,
+				DisplayName:  displayName,
+				Description:  description,
+				Labels:       labels,
+				Annotations:  annotations,
+				Timeout:      durationOrNil(execTimeout),
+				MemoryBytes:  memoryBytes,
+				Runtime:      runtime,
+			}
+
+			var fn *faaspb.Function
+			if resumableThreshold >= 0 && size >= resumableThreshold {
+				fn, err = uploadResumable(ctx, client, meta, archivePath, size, sha, retries, cmd.ErrOrStderr())
+			} else {
+				fn, err = uploadArchive(ctx, client, meta, archivePath)
+			}
 			if err != nil {
 				return err
 			}
+			if got := fn.GetSourceBundle().GetSha256(); got != sha {
+				return fmt.Errorf("server stored sha256 %s, local archive is %s", got, sha)
+			}
 
 			fmt.Fprintf(cmd.OutOrStdout(),
-				"uploaded: name=%s, local_archive_size=%d, local_sha256=%s, bundle_bucket=%s, bundle_object_key=%s\n",
-				fn.GetName(), size, sha, fn.GetSourceBundle().GetBucket(), fn.GetSourceBundle().GetObjectKey(),
+				"uploaded: name=%s, revision=%d, local_archive_size=%d, local_sha256=%s, bundle_bucket=%s, bundle_object_key=%s, build_state=%s\n",
+				fn.GetName(), fn.GetRevision(), size, sha, fn.GetSourceBundle().GetBucket(), fn.GetSourceBundle().GetObjectKey(), fn.GetBuild().GetState().String(),
 			)
 			return nil
 		},
@@ -89,15 +140,38 @@ func NewUploadFunctionCmd() *cobra.Command {
 
 	cmd.Flags().StringVar(&functionName, "name", "", "Function name, e.g. functions/my-func")
 	cmd.Flags().StringVar(&srcDir, "path", "", "Path to user code directory")
+	cmd.Flags().StringVar(&manifestPath, "manifest", "", "Path to manifest.yaml; added to the bundle root, --path defaults to its upload.source_dir")
 	cmd.Flags().StringVar(&gatewayAddr, "gateway", "127.0.0.1:55055", "Gateway gRPC address host:port")
 	cmd.Flags().StringVar(&format, "format", "zip", "Archive format: zip")
 	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
 	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
 	cmd.Flags().DurationVar(&timeout, "timeout", 2*time.Minute, "Overall timeout")
 
+	cmd.Flags().Int64Var(&resumableThreshold, "resumable-threshold", 32<<20, "Archives of at least this many bytes are uploaded in resumable chunks (-1 disables)")
+	cmd.Flags().IntVar(&retries, "retries", 5, "Reconnect attempts for resumable uploads")
+
+	cmd.Flags().StringToStringVar(&env, "env", nil, "Environment variables, e.g. --env LOG_LEVEL=debug")
+	cmd.Flags().StringToStringVar(&secretEnv, "secret-env", nil, "Environment variables from secrets, e.g. --secret-env DB_PASSWORD=secrets/db-password")
+
+	// Unset metadata keeps the value of the previous revision.
+	cmd.Flags().StringVar(&displayName, "display-name", "", "Human-readable name")
+	cmd.Flags().StringVar(&description, "description", "", "Function description")
+	cmd.Flags().StringToStringVar(&labels, "labels", nil, "Labels, e.g. --labels team=images,tier=web")
+	cmd.Flags().StringToStringVar(&annotations, "annotations", nil, "Free-form annotations, e.g. --annotations owner=alice@example.com")
+	cmd.Flags().DurationVar(&execTimeout, "exec-timeout", 0, "Execution timeout (0 uses the agent default)")
+	cmd.Flags().Uint64Var(&memoryBytes, "memory-bytes", 0, "Memory requested by the function, in bytes")
+	cmd.Flags().StringVar(&runtime, "runtime", "", "Language runtime, e.g. python3.12")
+
 	return cmd
 }
 
+func durationOrNil(d time.Duration) *durationpb.Duration {
+	if d == 0 {
+		return nil
+	}
+	return durationpb.New(d)
+}
+
 func dialGateway(ctx context.Context, addr string, useTLS bool, caFile string) (*grpc.ClientConn, error) {
 	var creds credentials.TransportCredentials
 	if useTLS {
@@ -120,9 +194,8 @@ func dialGateway(ctx context.Context, addr string, useTLS bool, caFile string) (
 func uploadArchive(
 	ctx context.Context,
 	client faaspb.FunctionsClient,
-	functionName string,
+	meta *faaspb.UploadFunctionMetadata,
 	archivePath string,
-	format faaspb.UploadFunctionMetadata_Format,
 ) (*faaspb.Function, error) {
 	stream, err := client.UploadFunction(ctx)
 	if err != nil {
@@ -131,10 +204,7 @@ func uploadArchive(
 
 	if err := stream.Send(&faaspb.UploadFunctionRequest{
 		Payload: &faaspb.UploadFunctionRequest_UploadFunctionMetadata{
-			UploadFunctionMetadata: &faaspb.UploadFunctionMetadata{
-				FunctionName: functionName,
-				Format:       format,
-			},
+			UploadFunctionMetadata: meta,
 		},
 	}); err != nil {
 		return nil, err
@@ -152,13 +222,19 @@ func uploadArchive(
 	for {
 		n, readErr := f.Read(buf)
 		if n > 0 {
-			if err := stream.Send(&faaspb.UploadFunctionRequest{
+			err := stream.Send(&faaspb.UploadFunctionRequest{
 				Payload: &faaspb.UploadFunctionRequest_UploadFunctionData{
 					UploadFunctionData: &faaspb.UploadFunctionData{
 						Data: buf[:n],
 					},
 				},
-			}); err != nil {
+			})
+			if errors.Is(err, io.EOF) {
+				// The server answered early, e.g. because it already
+				// stores this bundle; the response says how.
+				break
+			}
+			if err != nil {
 				return nil, err
 			}
 		}
@@ -174,7 +250,34 @@ func uploadArchive(
 	return stream.CloseAndRecv()
 }
 
-func zipDir(srcDir, dstZip string) error {
+// manifestSourceDir returns upload.source_dir from the manifest, resolved
+// relative to the manifest's directory.
+func manifestSourceDir(manifestPath string) (string, error) {
+	data, err := os.ReadFile(manifestPath)
+	if err != nil {
+		return "", err
+	}
+
+	var m struct {
+		Upload struct {
+			SourceDir string `yaml:"source_dir"`
+		} `yaml:"upload"`
+	}
+	if err := yaml.Unmarshal(data, &m); err != nil {
+		return "", fmt.Errorf("parse %s: %w", manifestPath, err)
+	}
+	if m.Upload.SourceDir == "" {
+		return "", fmt.Errorf("%s has no upload.source_dir, pass --path", manifestPath)
+	}
+	if filepath.IsAbs(m.Upload.SourceDir) {
+		return m.Upload.SourceDir, nil
+	}
+	return filepath.Join(filepath.Dir(manifestPath), m.Upload.SourceDir), nil
+}
+
+// zipDir archives srcDir into dstZip. A non-empty manifestPath is stored at
+// the archive root as manifest.yaml, replacing any manifest in srcDir.
+func zipDir(srcDir, dstZip, manifestPath string) error {
 	out, err := os.Create(dstZip)
 	if err != nil {
 		return err
@@ -184,6 +287,12 @@ func zipDir(srcDir, dstZip string) error {
 	zw := zip.NewWriter(out)
 	defer zw.Close()
 
+	if manifestPath != "" {
+		if err := zipFile(zw, manifestPath, bundleManifest); err != nil {
+			return err
+		}
+	}
+
 	return filepath.WalkDir(srcDir, func(path string, d fs.DirEntry, walkErr error) error {
 		if walkErr != nil {
 			return walkErr
@@ -197,33 +306,40 @@ func zipDir(srcDir, dstZip string) error {
 			return err
 		}
 		rel = filepath.ToSlash(rel)
-
-		info, err := d.Info()
-		if err != nil {
-			return err
+		if manifestPath != "" && rel == bundleManifest {
+			return nil
 		}
+		return zipFile(zw, path, rel)
+	})
+}
 
-		h, err := zip.FileInfoHeader(info)
-		if err != nil {
-			return err
-		}
-		h.Name = rel
-		h.Method = zip.Deflate
+func zipFile(zw *zip.Writer, path, name string) error {
 
-		w, err := zw.CreateHeader(h)
-		if err != nil {
-			return err
-		}
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
 
-		in, err := os.Open(path)
-		if err != nil {
-			return err
-		}
-		defer in.Close()
+	h, err := zip.FileInfoHeader(info)
+	if err != nil {
+		return err
+	}
+	h.Name = name
+	h.Method = zip.Deflate
 
-		_, err = io.Copy(w, in)
+	w, err := zw.CreateHeader(h)
+	if err != nil {
 		return err
-	})
+	}
+
+	in, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	_, err = io.Copy(w, in)
+	return err
 }
 
 func fileSHA256AndSize(path string) (shaHex string, size int64, err error) {
@@ -0,0 +1,55 @@
+package funccmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"github.com/spf13/cobra"
+)
+
+func NewNamespaceUsageCmd() *cobra.Command {
+	var (
+		namespace   string
+		gatewayAddr string
+		tls         bool
+		caFile      string
+		timeout     time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "usage",
+		Short: "Show bundle storage used by a namespace",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			conn, err := dialGateway(ctx, gatewayAddr, tls, caFile)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			client := faaspb.NewFunctionsClient(conn)
+			u, err := client.GetNamespaceUsage(ctx, &faaspb.GetNamespaceUsageRequest{Namespace: namespace})
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(),
+				"usage: namespace=%s, bundle_bytes=%d, quota_bytes=%d, max_bundle_bytes=%d\n",
+				u.GetNamespace(), u.GetBundleBytes(), u.GetQuotaBytes(), u.GetMaxBundleBytes(),
+			)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&namespace, "namespace", "", "Namespace, e.g. team-a for functions/team-a/... (default: default)")
+	cmd.Flags().StringVar(&gatewayAddr, "gateway", "127.0.0.1:55055", "Gateway gRPC address host:port")
+	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
+	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
+	cmd.Flags().DurationVar(&timeout, "timeout", 15*time.Second, "Overall timeout")
+
+	return cmd
+}
@@ -0,0 +1,55 @@
+package jobcmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"github.com/spf13/cobra"
+)
+
+func NewGetJobCmd() *cobra.Command {
+	var (
+		jobName     string
+		gatewayAddr string
+		tls         bool
+		caFile      string
+		timeout     time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "Get a job with its task counters",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if jobName == "" {
+				return fmt.Errorf("--name is required")
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			conn, err := dialGateway(ctx, gatewayAddr, tls, caFile)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			j, err := faaspb.NewJobsClient(conn).GetJob(ctx, &faaspb.GetJobRequest{Name: jobName})
+			if err != nil {
+				return err
+			}
+
+			printJob(cmd.OutOrStdout(), j)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&jobName, "name", "", "Job name, e.g. jobs/0b5c...")
+	cmd.Flags().StringVar(&gatewayAddr, "gateway", "127.0.0.1:55055", "Gateway gRPC address host:port")
+	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
+	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
+	cmd.Flags().DurationVar(&timeout, "timeout", 15*time.Second, "Overall timeout")
+
+	return cmd
+}
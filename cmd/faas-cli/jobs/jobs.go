@@ -0,0 +1,71 @@
+package jobcmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func NewJobsGroup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "jobs",
+		Short: "Commands for jobs grouping the tasks of a batch",
+	}
+
+	cmd.AddCommand(
+		NewGetJobCmd(),
+		NewListJobsCmd(),
+		NewWatchJobCmd(),
+		NewCancelJobCmd(),
+	)
+
+	return cmd
+}
+
+func dialGateway(ctx context.Context, addr string, useTLS bool, caFile string) (*grpc.ClientConn, error) {
+	var creds credentials.TransportCredentials
+	if useTLS {
+		if caFile != "" {
+			c, err := credentials.NewClientTLSFromFile(caFile, "")
+			if err != nil {
+				return nil, err
+			}
+			creds = c
+		} else {
+			creds = credentials.NewTLS(nil)
+		}
+	} else {
+		creds = insecure.NewCredentials()
+	}
+
+	return grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(creds))
+}
+
+func printJob(w io.Writer, j *faaspb.Job) {
+	endedAt := ""
+	if ts := j.GetEndedAt(); ts != nil {
+		endedAt = ts.AsTime().Format(time.RFC3339Nano)
+	}
+
+	fmt.Fprintf(w,
+		"job: name=%s, function=%s, state=%s, total=%d, succeeded=%d, failed=%d, canceled=%d, active=%d, sealed=%t, failure_threshold=%g, ended_at=%s\n",
+		j.GetName(),
+		j.GetFunction(),
+		j.GetState().String(),
+		j.GetTotal(),
+		j.GetSucceeded(),
+		j.GetFailed(),
+		j.GetCanceled(),
+		j.GetActive(),
+		j.GetSealed(),
+		j.GetFailureThreshold(),
+		endedAt,
+	)
+}
@@ -0,0 +1,59 @@
+package jobcmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"github.com/spf13/cobra"
+)
+
+func NewListJobsCmd() *cobra.Command {
+	var (
+		pageSize    int32
+		pageToken   string
+		gatewayAddr string
+		tls         bool
+		caFile      string
+		timeout     time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List jobs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			conn, err := dialGateway(ctx, gatewayAddr, tls, caFile)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			resp, err := faaspb.NewJobsClient(conn).ListJobs(ctx, &faaspb.ListJobsRequest{
+				PageSize:  pageSize,
+				PageToken: pageToken,
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, j := range resp.GetJobs() {
+				printJob(cmd.OutOrStdout(), j)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "next_page_token=%s\n", resp.GetNextPageToken())
+			return nil
+		},
+	}
+
+	cmd.Flags().Int32Var(&pageSize, "page-size", 50, "Page size")
+	cmd.Flags().StringVar(&pageToken, "page-token", "", "Page token from a previous call")
+	cmd.Flags().StringVar(&gatewayAddr, "gateway", "127.0.0.1:55055", "Gateway gRPC address host:port")
+	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
+	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
+	cmd.Flags().DurationVar(&timeout, "timeout", 15*time.Second, "Overall timeout")
+
+	return cmd
+}
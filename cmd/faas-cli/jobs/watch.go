@@ -0,0 +1,72 @@
+package jobcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"github.com/spf13/cobra"
+)
+
+func NewWatchJobCmd() *cobra.Command {
+	var (
+		jobName     string
+		gatewayAddr string
+		tls         bool
+		caFile      string
+		timeout     time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "watch",
+		Short: "Print a job on every change until it and its tasks have ended",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if jobName == "" {
+				return fmt.Errorf("--name is required")
+			}
+
+			var (
+				ctx    context.Context
+				cancel context.CancelFunc
+			)
+			if timeout > 0 {
+				ctx, cancel = context.WithTimeout(cmd.Context(), timeout)
+			} else {
+				ctx, cancel = context.WithCancel(cmd.Context())
+			}
+			defer cancel()
+
+			conn, err := dialGateway(ctx, gatewayAddr, tls, caFile)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			stream, err := faaspb.NewJobsClient(conn).WatchJob(ctx, &faaspb.WatchJobRequest{Name: jobName})
+			if err != nil {
+				return err
+			}
+			for {
+				j, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				printJob(cmd.OutOrStdout(), j)
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&jobName, "name", "", "Job name, e.g. jobs/0b5c...")
+	cmd.Flags().StringVar(&gatewayAddr, "gateway", "127.0.0.1:55055", "Gateway gRPC address host:port")
+	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
+	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
+	cmd.Flags().DurationVar(&timeout, "timeout", 0, "Overall timeout, 0 for none")
+
+	return cmd
+}
@@ -5,8 +5,13 @@ import (
 	"os/signal"
 	"syscall"
 
+	admincmd "github.com/10Narratives/faas/cmd/faas-cli/admin"
 	funccmd "github.com/10Narratives/faas/cmd/faas-cli/functions"
+	jobcmd "github.com/10Narratives/faas/cmd/faas-cli/jobs"
+	schedcmd "github.com/10Narratives/faas/cmd/faas-cli/schedules"
+	secretcmd "github.com/10Narratives/faas/cmd/faas-cli/secrets"
 	taskcmd "github.com/10Narratives/faas/cmd/faas-cli/tasks"
+	triggercmd "github.com/10Narratives/faas/cmd/faas-cli/triggers"
 	errorutils "github.com/10Narratives/faas/pkg/errors"
 	"github.com/spf13/cobra"
 )
@@ -24,6 +29,11 @@ func main() {
 	rootCmd.AddCommand(
 		funccmd.NewFunctionsGroup(),
 		taskcmd.NewTaskGroup(),
+		jobcmd.NewJobsGroup(),
+		schedcmd.NewSchedulesGroup(),
+		triggercmd.NewTriggersGroup(),
+		secretcmd.NewSecretsGroup(),
+		admincmd.NewAdminGroup(),
 	)
 
 	errorutils.Try(rootCmd.ExecuteContext(ctx))
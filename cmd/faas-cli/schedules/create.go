@@ -0,0 +1,86 @@
+package schedcmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"github.com/spf13/cobra"
+)
+
+func NewCreateScheduleCmd() *cobra.Command {
+	var (
+		scheduleName string
+		gatewayAddr  string
+		tls          bool
+		caFile       string
+		timeout      time.Duration
+
+		cronExpr     string
+		timeZone     string
+		functionName string
+		parameters   string
+		missedRuns   string
+		paused       bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a schedule executing a function on a cron expression",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if scheduleName == "" {
+				return fmt.Errorf("--name is required")
+			}
+			if cronExpr == "" || functionName == "" {
+				return fmt.Errorf("--cron and --function are required")
+			}
+			policy, err := parseMissedRunPolicy(missedRuns)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			conn, err := dialGateway(ctx, gatewayAddr, tls, caFile)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			s, err := faaspb.NewSchedulesClient(conn).CreateSchedule(ctx, &faaspb.CreateScheduleRequest{
+				Schedule: &faaspb.Schedule{
+					Name:            scheduleName,
+					Cron:            cronExpr,
+					TimeZone:        timeZone,
+					Function:        functionName,
+					Parameters:      parameters,
+					MissedRunPolicy: policy,
+					Paused:          paused,
+				},
+			})
+			if err != nil {
+				return err
+			}
+
+			printSchedule(cmd.OutOrStdout(), "created: ", s)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&scheduleName, "name", "", "Schedule name, e.g. schedules/nightly-report")
+	cmd.Flags().StringVar(&gatewayAddr, "gateway", "127.0.0.1:55055", "Gateway gRPC address host:port")
+	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
+	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
+	cmd.Flags().DurationVar(&timeout, "timeout", 15*time.Second, "Overall timeout")
+
+	cmd.Flags().StringVar(&cronExpr, "cron", "", "Five-field cron expression or descriptor, e.g. \"30 2 * * *\" or @hourly")
+	cmd.Flags().StringVar(&timeZone, "time-zone", "", "IANA time zone of the expression, e.g. Europe/Berlin (default UTC)")
+	cmd.Flags().StringVar(&functionName, "function", "", "Function name, optionally with an alias, e.g. functions/report@prod")
+	cmd.Flags().StringVar(&parameters, "params", "", "JSON parameters passed to every run")
+	cmd.Flags().StringVar(&missedRuns, "missed-runs", "skip", "What to do with slots missed during an outage: skip, run-once or catch-up")
+	cmd.Flags().BoolVar(&paused, "paused", false, "Create the schedule paused")
+
+	return cmd
+}
@@ -0,0 +1,56 @@
+package schedcmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"github.com/spf13/cobra"
+)
+
+func NewDeleteScheduleCmd() *cobra.Command {
+	var (
+		scheduleName string
+		gatewayAddr  string
+		tls          bool
+		caFile       string
+		timeout      time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a schedule; tasks it created are kept",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if scheduleName == "" {
+				return fmt.Errorf("--name is required")
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			conn, err := dialGateway(ctx, gatewayAddr, tls, caFile)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			if _, err := faaspb.NewSchedulesClient(conn).DeleteSchedule(ctx, &faaspb.DeleteScheduleRequest{
+				Name: scheduleName,
+			}); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "deleted: name=%s\n", scheduleName)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&scheduleName, "name", "", "Schedule name, e.g. schedules/nightly-report")
+	cmd.Flags().StringVar(&gatewayAddr, "gateway", "127.0.0.1:55055", "Gateway gRPC address host:port")
+	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
+	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
+	cmd.Flags().DurationVar(&timeout, "timeout", 15*time.Second, "Overall timeout")
+
+	return cmd
+}
@@ -0,0 +1,55 @@
+package schedcmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"github.com/spf13/cobra"
+)
+
+func NewResumeScheduleCmd() *cobra.Command {
+	var (
+		scheduleName string
+		gatewayAddr  string
+		tls          bool
+		caFile       string
+		timeout      time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "resume",
+		Short: "Resume a paused schedule from its next slot; slots passed while paused do not run",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if scheduleName == "" {
+				return fmt.Errorf("--name is required")
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			conn, err := dialGateway(ctx, gatewayAddr, tls, caFile)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			s, err := faaspb.NewSchedulesClient(conn).ResumeSchedule(ctx, &faaspb.ResumeScheduleRequest{Name: scheduleName})
+			if err != nil {
+				return err
+			}
+
+			printSchedule(cmd.OutOrStdout(), "resumed: ", s)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&scheduleName, "name", "", "Schedule name, e.g. schedules/nightly-report")
+	cmd.Flags().StringVar(&gatewayAddr, "gateway", "127.0.0.1:55055", "Gateway gRPC address host:port")
+	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
+	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
+	cmd.Flags().DurationVar(&timeout, "timeout", 15*time.Second, "Overall timeout")
+
+	return cmd
+}
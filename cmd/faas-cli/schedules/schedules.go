@@ -0,0 +1,97 @@
+package schedcmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func NewSchedulesGroup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedules",
+		Short: "Commands for cron schedules executing functions",
+	}
+
+	cmd.AddCommand(
+		NewCreateScheduleCmd(),
+		NewGetScheduleCmd(),
+		NewListSchedulesCmd(),
+		NewUpdateScheduleCmd(),
+		NewPauseScheduleCmd(),
+		NewResumeScheduleCmd(),
+		NewDeleteScheduleCmd(),
+	)
+
+	return cmd
+}
+
+func dialGateway(ctx context.Context, addr string, useTLS bool, caFile string) (*grpc.ClientConn, error) {
+	var creds credentials.TransportCredentials
+	if useTLS {
+		if caFile != "" {
+			c, err := credentials.NewClientTLSFromFile(caFile, "")
+			if err != nil {
+				return nil, err
+			}
+			creds = c
+		} else {
+			creds = credentials.NewTLS(nil)
+		}
+	} else {
+		creds = insecure.NewCredentials()
+	}
+
+	return grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(creds))
+}
+
+// missedRunPolicies maps --missed-runs values to policies.
+var missedRunPolicies = map[string]faaspb.MissedRunPolicy{
+	"skip":     faaspb.MissedRunPolicy_MISSED_RUN_POLICY_SKIP,
+	"run-once": faaspb.MissedRunPolicy_MISSED_RUN_POLICY_RUN_ONCE,
+	"catch-up": faaspb.MissedRunPolicy_MISSED_RUN_POLICY_CATCH_UP,
+}
+
+func parseMissedRunPolicy(s string) (faaspb.MissedRunPolicy, error) {
+	p, ok := missedRunPolicies[s]
+	if !ok {
+		return 0, fmt.Errorf("--missed-runs must be skip, run-once or catch-up, got %q", s)
+	}
+	return p, nil
+}
+
+func printSchedule(w io.Writer, prefix string, s *faaspb.Schedule) {
+	tz := s.GetTimeZone()
+	if tz == "" {
+		tz = "UTC"
+	}
+
+	fmt.Fprintf(w,
+		"%sname=%s, cron=%q, time_zone=%s, function=%s, missed_run_policy=%s, paused=%t, next_run=%s, last_run=%s, last_task=%s, last_error=%s\n",
+		prefix,
+		s.GetName(),
+		s.GetCron(),
+		tz,
+		s.GetFunction(),
+		s.GetMissedRunPolicy().String(),
+		s.GetPaused(),
+		formatTimestamp(s.GetNextRunTime()),
+		formatTimestamp(s.GetLastRunTime()),
+		s.GetLastTask(),
+		s.GetLastError(),
+	)
+}
+
+func formatTimestamp(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.AsTime().Format(time.RFC3339)
+}
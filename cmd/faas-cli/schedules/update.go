@@ -0,0 +1,107 @@
+package schedcmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// updateFlagPaths maps update flags to the field mask paths they set.
+var updateFlagPaths = []struct{ flag, path string }{
+	{"cron", "cron"},
+	{"time-zone", "time_zone"},
+	{"function", "function"},
+	{"params", "parameters"},
+	{"missed-runs", "missed_run_policy"},
+}
+
+func NewUpdateScheduleCmd() *cobra.Command {
+	var (
+		scheduleName string
+		gatewayAddr  string
+		tls          bool
+		caFile       string
+		timeout      time.Duration
+
+		cronExpr     string
+		timeZone     string
+		functionName string
+		parameters   string
+		missedRuns   string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Update a schedule",
+		Long: "Only the flags given are updated. Changing --cron or --time-zone moves the\n" +
+			"next run to the first slot of the new schedule.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if scheduleName == "" {
+				return fmt.Errorf("--name is required")
+			}
+
+			mask := &fieldmaskpb.FieldMask{}
+			for _, f := range updateFlagPaths {
+				if cmd.Flags().Changed(f.flag) {
+					mask.Paths = append(mask.Paths, f.path)
+				}
+			}
+			if len(mask.Paths) == 0 {
+				return fmt.Errorf("nothing to update")
+			}
+
+			var policy faaspb.MissedRunPolicy
+			if cmd.Flags().Changed("missed-runs") {
+				var err error
+				if policy, err = parseMissedRunPolicy(missedRuns); err != nil {
+					return err
+				}
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			conn, err := dialGateway(ctx, gatewayAddr, tls, caFile)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			s, err := faaspb.NewSchedulesClient(conn).UpdateSchedule(ctx, &faaspb.UpdateScheduleRequest{
+				Schedule: &faaspb.Schedule{
+					Name:            scheduleName,
+					Cron:            cronExpr,
+					TimeZone:        timeZone,
+					Function:        functionName,
+					Parameters:      parameters,
+					MissedRunPolicy: policy,
+				},
+				UpdateMask: mask,
+			})
+			if err != nil {
+				return err
+			}
+
+			printSchedule(cmd.OutOrStdout(), "updated: ", s)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&scheduleName, "name", "", "Schedule name, e.g. schedules/nightly-report")
+	cmd.Flags().StringVar(&gatewayAddr, "gateway", "127.0.0.1:55055", "Gateway gRPC address host:port")
+	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
+	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
+	cmd.Flags().DurationVar(&timeout, "timeout", 15*time.Second, "Overall timeout")
+
+	cmd.Flags().StringVar(&cronExpr, "cron", "", "Five-field cron expression or descriptor, e.g. \"30 2 * * *\" or @hourly")
+	cmd.Flags().StringVar(&timeZone, "time-zone", "", "IANA time zone of the expression; \"\" means UTC")
+	cmd.Flags().StringVar(&functionName, "function", "", "Function name, optionally with an alias, e.g. functions/report@prod")
+	cmd.Flags().StringVar(&parameters, "params", "", "JSON parameters passed to every run")
+	cmd.Flags().StringVar(&missedRuns, "missed-runs", "", "What to do with slots missed during an outage: skip, run-once or catch-up")
+
+	return cmd
+}
@@ -0,0 +1,72 @@
+package secretcmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"github.com/spf13/cobra"
+)
+
+func NewCreateSecretCmd() *cobra.Command {
+	var (
+		secretName  string
+		gatewayAddr string
+		tls         bool
+		caFile      string
+		timeout     time.Duration
+
+		value    string
+		fromFile string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create secret",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			if secretName == "" {
+				return fmt.Errorf("--name is required")
+			}
+
+			v, err := readSecretValue(cmd, value, fromFile)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			conn, err := dialGateway(ctx, gatewayAddr, tls, caFile)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			client := faaspb.NewSecretsClient(conn)
+			s, err := client.CreateSecret(ctx, &faaspb.CreateSecretRequest{
+				Name:  secretName,
+				Value: v,
+			})
+			if err != nil {
+				return err
+			}
+
+			printSecret(cmd, "created: ", s)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&secretName, "name", "", "Secret name, e.g. secrets/db-password")
+	cmd.Flags().StringVar(&gatewayAddr, "gateway", "127.0.0.1:55055", "Gateway gRPC address host:port")
+	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
+	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
+	cmd.Flags().DurationVar(&timeout, "timeout", 15*time.Second, "Overall timeout")
+
+	cmd.Flags().StringVar(&value, "value", "", "Secret value (prefer --from-file)")
+	cmd.Flags().StringVar(&fromFile, "from-file", "", "Read secret value from file, '-' for stdin")
+
+	return cmd
+}
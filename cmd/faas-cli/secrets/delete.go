@@ -0,0 +1,66 @@
+package secretcmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"github.com/spf13/cobra"
+)
+
+func NewDeleteSecretCmd() *cobra.Command {
+	var (
+		secretName  string
+		gatewayAddr string
+		tls         bool
+		caFile      string
+		timeout     time.Duration
+		force       bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete secret",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			if secretName == "" {
+				return fmt.Errorf("--name is required")
+			}
+			if !force {
+				return fmt.Errorf("refusing to delete without --force")
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			conn, err := dialGateway(ctx, gatewayAddr, tls, caFile)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			client := faaspb.NewSecretsClient(conn)
+			if _, err := client.DeleteSecret(ctx, &faaspb.DeleteSecretRequest{
+				Name: secretName,
+			}); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "deleted: name=%s\n", secretName)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&secretName, "name", "", "Secret name, e.g. secrets/db-password")
+	cmd.Flags().StringVar(&gatewayAddr, "gateway", "127.0.0.1:55055", "Gateway gRPC address host:port")
+	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
+	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
+	cmd.Flags().DurationVar(&timeout, "timeout", 15*time.Second, "Overall timeout")
+
+	// safety latch
+	cmd.Flags().BoolVar(&force, "force", false, "Actually perform deletion")
+
+	return cmd
+}
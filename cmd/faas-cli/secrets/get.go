@@ -0,0 +1,58 @@
+package secretcmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"github.com/spf13/cobra"
+)
+
+func NewGetSecretCmd() *cobra.Command {
+	var (
+		secretName  string
+		gatewayAddr string
+		tls         bool
+		caFile      string
+		timeout     time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "Get secret metadata (the value is never returned)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if secretName == "" {
+				return fmt.Errorf("--name is required")
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			conn, err := dialGateway(ctx, gatewayAddr, tls, caFile)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			client := faaspb.NewSecretsClient(conn)
+			s, err := client.GetSecret(ctx, &faaspb.GetSecretRequest{
+				Name: secretName,
+			})
+			if err != nil {
+				return err
+			}
+
+			printSecret(cmd, "secret: ", s)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&secretName, "name", "", "Secret name, e.g. secrets/db-password")
+	cmd.Flags().StringVar(&gatewayAddr, "gateway", "127.0.0.1:55055", "Gateway gRPC address host:port")
+	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
+	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
+	cmd.Flags().DurationVar(&timeout, "timeout", 15*time.Second, "Overall timeout")
+
+	return cmd
+}
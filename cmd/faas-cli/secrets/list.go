@@ -0,0 +1,65 @@
+package secretcmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"github.com/spf13/cobra"
+)
+
+func NewListSecretsCmd() *cobra.Command {
+	var (
+		gatewayAddr string
+		tls         bool
+		caFile      string
+		timeout     time.Duration
+
+		pageSize  int32
+		pageToken string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List secrets metadata",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			conn, err := dialGateway(ctx, gatewayAddr, tls, caFile)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			client := faaspb.NewSecretsClient(conn)
+			resp, err := client.ListSecrets(ctx, &faaspb.ListSecretsRequest{
+				PageSize:  pageSize,
+				PageToken: pageToken,
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, s := range resp.GetSecrets() {
+				printSecret(cmd, "", s)
+			}
+
+			if t := resp.GetNextPageToken(); t != "" {
+				fmt.Fprintf(cmd.OutOrStdout(), "next_page_token=%s\n", t)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&gatewayAddr, "gateway", "127.0.0.1:55055", "Gateway gRPC address host:port")
+	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
+	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
+	cmd.Flags().DurationVar(&timeout, "timeout", 15*time.Second, "Overall timeout")
+
+	cmd.Flags().Int32Var(&pageSize, "page-size", 50, "Max results per page (0 lets server decide)")
+	cmd.Flags().StringVar(&pageToken, "page-token", "", "Page token (from next_page_token)")
+
+	return cmd
+}
@@ -0,0 +1,82 @@
+package secretcmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func NewSecretsGroup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "secrets",
+		Short: "Commands for managing encrypted secrets",
+	}
+
+	cmd.AddCommand(
+		NewCreateSecretCmd(),
+		NewGetSecretCmd(),
+		NewListSecretsCmd(),
+		NewUpdateSecretCmd(),
+		NewDeleteSecretCmd(),
+	)
+
+	return cmd
+}
+
+func dialGateway(ctx context.Context, addr string, useTLS bool, caFile string) (*grpc.ClientConn, error) {
+	var creds credentials.TransportCredentials
+	if useTLS {
+		if caFile != "" {
+			c, err := credentials.NewClientTLSFromFile(caFile, "")
+			if err != nil {
+				return nil, err
+			}
+			creds = c
+		} else {
+			creds = credentials.NewTLS(nil)
+		}
+	} else {
+		creds = insecure.NewCredentials()
+	}
+
+	return grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(creds))
+}
+
+// readSecretValue takes the value from --value, --from-file or stdin ("-").
+// Reading from a file or stdin keeps the value out of shell history.
+func readSecretValue(cmd *cobra.Command, value, fromFile string) ([]byte, error) {
+	switch {
+	case value != "" && fromFile != "":
+		return nil, fmt.Errorf("--value and --from-file are mutually exclusive")
+	case value != "":
+		return []byte(value), nil
+	case fromFile == "-":
+		return io.ReadAll(cmd.InOrStdin())
+	case fromFile != "":
+		return os.ReadFile(fromFile)
+	default:
+		return nil, fmt.Errorf("one of --value or --from-file is required")
+	}
+}
+
+func printSecret(cmd *cobra.Command, prefix string, s *faaspb.Secret) {
+	createdAt := ""
+	if ts := s.GetCreatedAt(); ts != nil {
+		createdAt = ts.AsTime().Format(time.RFC3339Nano)
+	}
+	updatedAt := ""
+	if ts := s.GetUpdatedAt(); ts != nil {
+		updatedAt = ts.AsTime().Format(time.RFC3339Nano)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "%sname=%s, created_at=%s, updated_at=%s\n",
+		prefix, s.GetName(), createdAt, updatedAt)
+}
@@ -0,0 +1,178 @@
+package taskcmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"time"
+
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"github.com/spf13/cobra"
+)
+
+func NewListArtifactsCmd() *cobra.Command {
+	var (
+		taskName    string
+		gatewayAddr string
+		tls         bool
+		caFile      string
+		timeout     time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "artifacts",
+		Short: "List output artifacts of a task",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if taskName == "" {
+				return fmt.Errorf("--name is required")
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			conn, err := dialGateway(ctx, gatewayAddr, tls, caFile)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			client := faaspb.NewTasksClient(conn)
+			resp, err := client.ListTaskArtifacts(ctx, &faaspb.ListTaskArtifactsRequest{Name: taskName})
+			if err != nil {
+				return err
+			}
+
+			for _, a := range resp.GetArtifacts() {
+				fmt.Fprintf(cmd.OutOrStdout(),
+					"artifact: name=%s, size=%d, sha256=%s, content_type=%s\n",
+					a.GetName(), a.GetSize(), a.GetSha256(), a.GetContentType(),
+				)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&taskName, "name", "", "Task name, e.g. tasks/my-task")
+	cmd.Flags().StringVar(&gatewayAddr, "gateway", "127.0.0.1:55055", "Gateway gRPC address host:port")
+	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
+	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
+	cmd.Flags().DurationVar(&timeout, "timeout", 15*time.Second, "Overall timeout")
+
+	return cmd
+}
+
+func NewDownloadArtifactCmd() *cobra.Command {
+	var (
+		taskName    string
+		artifact    string
+		output      string
+		gatewayAddr string
+		tls         bool
+		caFile      string
+		timeout     time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "download",
+		Short: "Download an output artifact of a task",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if taskName == "" {
+				return fmt.Errorf("--name is required")
+			}
+			if artifact == "" {
+				return fmt.Errorf("--artifact is required")
+			}
+			if output == "" {
+				output = path.Base(artifact)
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			conn, err := dialGateway(ctx, gatewayAddr, tls, caFile)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			client := faaspb.NewTasksClient(conn)
+			stream, err := client.DownloadTaskArtifact(ctx, &faaspb.DownloadTaskArtifactRequest{
+				Name:     taskName,
+				Artifact: artifact,
+			})
+			if err != nil {
+				return err
+			}
+
+			first, err := stream.Recv()
+			if err != nil {
+				return err
+			}
+			meta := first.GetArtifact()
+			if meta == nil {
+				return fmt.Errorf("first message must be artifact metadata")
+			}
+
+			var w io.Writer = cmd.OutOrStdout()
+			if output != "-" {
+				f, err := os.Create(output)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				w = f
+			}
+
+			h := sha256.New()
+			n, err := receiveArtifact(stream, io.MultiWriter(w, h))
+			if err != nil {
+				return err
+			}
+			if got := hex.EncodeToString(h.Sum(nil)); meta.GetSha256() != "" && got != meta.GetSha256() {
+				return fmt.Errorf("sha256 mismatch: expected %s, got %s", meta.GetSha256(), got)
+			}
+
+			if output != "-" {
+				fmt.Fprintf(cmd.ErrOrStderr(),
+					"downloaded: artifact=%s, size=%d, sha256=%s, content_type=%s, output=%s\n",
+					meta.GetName(), n, meta.GetSha256(), meta.GetContentType(), output,
+				)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&taskName, "name", "", "Task name, e.g. tasks/my-task")
+	cmd.Flags().StringVar(&artifact, "artifact", "", "Artifact name, e.g. report.csv")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output file, '-' for stdout (default: artifact base name)")
+	cmd.Flags().StringVar(&gatewayAddr, "gateway", "127.0.0.1:55055", "Gateway gRPC address host:port")
+	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
+	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
+	cmd.Flags().DurationVar(&timeout, "timeout", 5*time.Minute, "Overall timeout")
+
+	return cmd
+}
+
+func receiveArtifact(stream faaspb.Tasks_DownloadTaskArtifactClient, w io.Writer) (int64, error) {
+	var n int64
+	for {
+		msg, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return n, nil
+		}
+		if err != nil {
+			return n, err
+		}
+
+		written, err := w.Write(msg.GetData())
+		n += int64(written)
+		if err != nil {
+			return n, err
+		}
+	}
+}
@@ -12,6 +12,7 @@ import (
 func NewCancelTaskCmd() *cobra.Command {
 	var (
 		taskName    string
+		filter      string
 		gatewayAddr string
 		tls         bool
 		caFile      string
@@ -20,10 +21,10 @@ func NewCancelTaskCmd() *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "cancel",
-		Short: "Cancel task",
+		Short: "Cancel a task, or all tasks matching --filter",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if taskName == "" {
-				return fmt.Errorf("--name is required")
+			if (taskName == "") == (filter == "") {
+				return fmt.Errorf("exactly one of --name and --filter is required")
 			}
 
 			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
@@ -36,6 +37,13 @@ func NewCancelTaskCmd() *cobra.Command {
 			defer conn.Close()
 
 			client := faaspb.NewTasksClient(conn)
+			if filter != "" {
+				return forEachTask(ctx, client, filter, "canceled", cmd.OutOrStdout(), func(name string) error {
+					_, err := client.CancelTask(ctx, &faaspb.CancelTaskRequest{Name: name})
+					return err
+				})
+			}
+
 			t, err := client.CancelTask(ctx, &faaspb.CancelTaskRequest{
 				Name: taskName,
 			})
@@ -71,6 +79,7 @@ func NewCancelTaskCmd() *cobra.Command {
 	}
 
 	cmd.Flags().StringVar(&taskName, "name", "", "Task name, e.g. tasks/my-task")
+	cmd.Flags().StringVar(&filter, "filter", "", `Cancel every matching task, e.g. 'labels.env = "dev" AND state = pending'`)
 	cmd.Flags().StringVar(&gatewayAddr, "gateway", "127.0.0.1:55055", "Gateway gRPC address host:port")
 	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
 	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
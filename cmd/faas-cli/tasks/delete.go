@@ -12,6 +12,7 @@ import (
 func NewDeleteTaskCmd() *cobra.Command {
 	var (
 		taskName    string
+		filter      string
 		gatewayAddr string
 		tls         bool
 		caFile      string
@@ -20,10 +21,10 @@ func NewDeleteTaskCmd() *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "delete",
-		Short: "Delete task",
+		Short: "Delete a task, or all tasks matching --filter",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if taskName == "" {
-				return fmt.Errorf("--name is required")
+			if (taskName == "") == (filter == "") {
+				return fmt.Errorf("exactly one of --name and --filter is required")
 			}
 
 			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
@@ -36,6 +37,13 @@ func NewDeleteTaskCmd() *cobra.Command {
 			defer conn.Close()
 
 			client := faaspb.NewTasksClient(conn)
+			if filter != "" {
+				return forEachTask(ctx, client, filter, "deleted", cmd.OutOrStdout(), func(name string) error {
+					_, err := client.DeleteTask(ctx, &faaspb.DeleteTaskRequest{Name: name})
+					return err
+				})
+			}
+
 			_, err = client.DeleteTask(ctx, &faaspb.DeleteTaskRequest{
 				Name: taskName,
 			})
@@ -49,6 +57,7 @@ func NewDeleteTaskCmd() *cobra.Command {
 	}
 
 	cmd.Flags().StringVar(&taskName, "name", "", "Task name, e.g. tasks/my-task")
+	cmd.Flags().StringVar(&filter, "filter", "", `Delete every matching task, e.g. 'labels.env = "dev" AND state = succeeded'`)
 	cmd.Flags().StringVar(&gatewayAddr, "gateway", "127.0.0.1:55055", "Gateway gRPC address host:port")
 	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
 	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
@@ -1,9 +1,15 @@
 package taskcmd
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"mime"
+	"os"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
 	"github.com/spf13/cobra"
@@ -37,6 +43,7 @@ func NewGetTaskCmd() *cobra.Command {
 		tls         bool
 		caFile      string
 		timeout     time.Duration
+		resultOut   string
 	)
 
 	cmd := &cobra.Command{
@@ -81,11 +88,20 @@ func NewGetTaskCmd() *cobra.Command {
 
 			resultType := ""
 			resultValue := ""
+			contentType := ""
+			artifacts := 0
 			if r := t.GetResult(); r != nil {
+				artifacts = len(r.GetArtifacts())
+				contentType = r.GetContentType()
 				switch v := r.GetData().(type) {
 				case *faaspb.TaskResult_InlineResult:
 					resultType = "inline"
-					resultValue = string(v.InlineResult)
+					resultValue = RenderResult(v.InlineResult, contentType)
+					if resultOut != "" {
+						if err := os.WriteFile(resultOut, v.InlineResult, 0o644); err != nil {
+							return err
+						}
+					}
 				case *faaspb.TaskResult_ObjectKey:
 					resultType = "object_key"
 					resultValue = v.ObjectKey
@@ -98,16 +114,25 @@ func NewGetTaskCmd() *cobra.Command {
 			}
 
 			fmt.Fprintf(cmd.OutOrStdout(),
-				"task: name=%s, function=%s, state=%s, created_at=%s, started_at=%s, ended_at=%s, parameters=%s, result_type=%s, result=%s\n",
+				"task: name=%s, function=%s, function_revision=%d, batch_id=%s, state=%s, attempt=%d, last_error=%q, labels=%v, annotations=%v, created_at=%s, started_at=%s, ended_at=%s, parameters=%s, result_type=%s, result_content_type=%s, result=%s, inputs=%d, artifacts=%d\n",
 				t.GetName(),
 				t.GetFunction(),
+				t.GetFunctionRevision(),
+				t.GetBatchId(),
 				t.GetState().String(),
+				t.GetAttempt(),
+				t.GetLastError(),
+				t.GetLabels(),
+				t.GetAnnotations(),
 				createdAt,
 				startedAt,
 				endedAt,
 				t.GetParameters(),
 				resultType,
+				contentType,
 				resultValue,
+				len(t.GetInputs()),
+				artifacts,
 			)
 			return nil
 		},
@@ -118,6 +143,26 @@ func NewGetTaskCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
 	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
 	cmd.Flags().DurationVar(&timeout, "timeout", 15*time.Second, "Overall timeout")
+	cmd.Flags().StringVar(&resultOut, "result-out", "", "Write the raw inline result to this file")
 
 	return cmd
 }
+
+// RenderResult prints JSON compacted onto one line and text as is; other
+// results are summarized, see --result-out.
+func RenderResult(b []byte, contentType string) string {
+	mt, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case mt == "application/json" || strings.HasSuffix(mt, "+json"):
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, b); err == nil {
+			return buf.String()
+		}
+	case strings.HasPrefix(mt, "text/"):
+	case contentType == "" && utf8.Valid(b):
+		// Results stored before content types were recorded.
+	default:
+		return fmt.Sprintf("<%d bytes of %s>", len(b), contentType)
+	}
+	return string(b)
+}
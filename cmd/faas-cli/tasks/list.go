@@ -18,6 +18,7 @@ func NewListTasksCmd() *cobra.Command {
 
 		pageSize  int32
 		pageToken string
+		filter    string
 	)
 
 	cmd := &cobra.Command{
@@ -37,6 +38,7 @@ func NewListTasksCmd() *cobra.Command {
 			resp, err := client.ListTasks(ctx, &faaspb.ListTasksRequest{
 				PageSize:  pageSize,
 				PageToken: pageToken,
+				Filter:    filter,
 			})
 			if err != nil {
 				return err
@@ -53,10 +55,11 @@ func NewListTasksCmd() *cobra.Command {
 				}
 
 				fmt.Fprintf(cmd.OutOrStdout(),
-					"task: name=%s, function=%s, state=%s, created_at=%s\n",
+					"task: name=%s, function=%s, state=%s, labels=%v, created_at=%s\n",
 					t.GetName(),
 					t.GetFunction(),
 					t.GetState().String(),
+					t.GetLabels(),
 					createdAt,
 				)
 			}
@@ -73,6 +76,7 @@ func NewListTasksCmd() *cobra.Command {
 
 	cmd.Flags().Int32Var(&pageSize, "page-size", 0, "Max number of tasks to return (0 = server default)")
 	cmd.Flags().StringVar(&pageToken, "page-token", "", "Pagination token from previous response")
+	cmd.Flags().StringVar(&filter, "filter", "", `Filter over labels.<key>, function, batch_id, state and created_at, e.g. 'labels.env = "dev" AND state = pending'`)
 
 	return cmd
 }
@@ -0,0 +1,67 @@
+package taskcmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// selectTasks returns the names of all tasks matching filter.
+func selectTasks(ctx context.Context, client faaspb.TasksClient, filter string) ([]string, error) {
+	var (
+		names []string
+		token string
+	)
+	for {
+		resp, err := client.ListTasks(ctx, &faaspb.ListTasksRequest{
+			PageSize:  500,
+			PageToken: token,
+			Filter:    filter,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range resp.GetTasks() {
+			names = append(names, t.GetName())
+		}
+		if resp.GetNextPageToken() == "" {
+			return names, nil
+		}
+		token = resp.GetNextPageToken()
+	}
+}
+
+// forEachTask applies op to every task matching filter and prints a summary.
+// Tasks op rejects with FailedPrecondition, e.g. already finished ones, are
+// counted as skipped.
+func forEachTask(ctx context.Context, client faaspb.TasksClient, filter, verb string, out io.Writer, op func(name string) error) error {
+	names, err := selectTasks(ctx, client, filter)
+	if err != nil {
+		return err
+	}
+
+	var done, skipped, failed int
+	for _, name := range names {
+		err := op(name)
+		switch {
+		case err == nil:
+			done++
+			fmt.Fprintf(out, "%s: %s\n", verb, name)
+		case status.Code(err) == codes.FailedPrecondition || status.Code(err) == codes.NotFound:
+			skipped++
+		default:
+			failed++
+			fmt.Fprintf(out, "failed: %s: %v\n", name, err)
+		}
+	}
+
+	fmt.Fprintf(out, "matched=%d, %s=%d, skipped=%d, failed=%d\n", len(names), verb, done, skipped, failed)
+	if failed > 0 {
+		return fmt.Errorf("%d of %d tasks failed", failed, len(names))
+	}
+	return nil
+}
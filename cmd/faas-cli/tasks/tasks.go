@@ -11,8 +11,11 @@ func NewTaskGroup() *cobra.Command {
 	cmd.AddCommand(
 		NewGetTaskCmd(),
 		NewListTasksCmd(),
+		NewUpdateTaskCmd(),
 		NewCancelTaskCmd(),
 		NewDeleteTaskCmd(),
+		NewListArtifactsCmd(),
+		NewDownloadArtifactCmd(),
 	)
 
 	return cmd
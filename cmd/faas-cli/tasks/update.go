@@ -0,0 +1,82 @@
+package taskcmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func NewUpdateTaskCmd() *cobra.Command {
+	var (
+		taskName    string
+		gatewayAddr string
+		tls         bool
+		caFile      string
+		timeout     time.Duration
+
+		labels      map[string]string
+		annotations map[string]string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Update task labels or annotations",
+		Long:  "Only the flags given are updated; pass a flag with an empty value to clear it, e.g. --labels \"\".",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if taskName == "" {
+				return fmt.Errorf("--name is required")
+			}
+
+			mask := &fieldmaskpb.FieldMask{}
+			for _, path := range []string{"labels", "annotations"} {
+				if cmd.Flags().Changed(path) {
+					mask.Paths = append(mask.Paths, path)
+				}
+			}
+			if len(mask.Paths) == 0 {
+				return fmt.Errorf("nothing to update")
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			conn, err := dialGateway(ctx, gatewayAddr, tls, caFile)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			client := faaspb.NewTasksClient(conn)
+			t, err := client.UpdateTask(ctx, &faaspb.UpdateTaskRequest{
+				Task: &faaspb.Task{
+					Name:        taskName,
+					Labels:      labels,
+					Annotations: annotations,
+				},
+				UpdateMask: mask,
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "updated: name=%s, labels=%v, annotations=%v\n",
+				t.GetName(), t.GetLabels(), t.GetAnnotations())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&taskName, "name", "", "Task name, e.g. tasks/my-task")
+	cmd.Flags().StringVar(&gatewayAddr, "gateway", "127.0.0.1:55055", "Gateway gRPC address host:port")
+	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
+	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
+	cmd.Flags().DurationVar(&timeout, "timeout", 15*time.Second, "Overall timeout")
+
+	cmd.Flags().StringToStringVar(&labels, "labels", nil, "Labels, e.g. --labels env=dev,cost-center=42")
+	cmd.Flags().StringToStringVar(&annotations, "annotations", nil, "Free-form annotations, e.g. --annotations ticket=OPS-1234")
+
+	return cmd
+}
@@ -0,0 +1,89 @@
+package triggercmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"github.com/spf13/cobra"
+)
+
+func NewCreateTriggerCmd() *cobra.Command {
+	var (
+		triggerName string
+		gatewayAddr string
+		tls         bool
+		caFile      string
+		timeout     time.Duration
+
+		functionName string
+		subject      string
+		stream       string
+		consumer     string
+		filter       string
+		concurrency  int32
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a trigger executing a function for every NATS message",
+		Long: "With --subject alone the trigger subscribes to core NATS and a message is lost\n" +
+			"if its task cannot be created. With --stream it reads a JetStream stream and\n" +
+			"acknowledges each message once its task exists; --subject then filters the\n" +
+			"stream, or --consumer reads through an existing durable consumer.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if triggerName == "" {
+				return fmt.Errorf("--name is required")
+			}
+			if functionName == "" {
+				return fmt.Errorf("--function is required")
+			}
+			if subject == "" && stream == "" {
+				return fmt.Errorf("--subject or --stream is required")
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			conn, err := dialGateway(ctx, gatewayAddr, tls, caFile)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			t, err := faaspb.NewTriggersClient(conn).CreateTrigger(ctx, &faaspb.CreateTriggerRequest{
+				Trigger: &faaspb.Trigger{
+					Name:        triggerName,
+					Function:    functionName,
+					Subject:     subject,
+					Stream:      stream,
+					Consumer:    consumer,
+					Filter:      filter,
+					Concurrency: concurrency,
+				},
+			})
+			if err != nil {
+				return err
+			}
+
+			printTrigger(cmd.OutOrStdout(), "created: ", t)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&triggerName, "name", "", "Trigger name, e.g. triggers/orders")
+	cmd.Flags().StringVar(&gatewayAddr, "gateway", "127.0.0.1:55055", "Gateway gRPC address host:port")
+	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
+	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
+	cmd.Flags().DurationVar(&timeout, "timeout", 15*time.Second, "Overall timeout")
+
+	cmd.Flags().StringVar(&functionName, "function", "", "Function name, optionally with an alias, e.g. functions/orders@prod")
+	cmd.Flags().StringVar(&subject, "subject", "", "NATS subject, wildcards allowed, e.g. orders.>")
+	cmd.Flags().StringVar(&stream, "stream", "", "JetStream stream to read")
+	cmd.Flags().StringVar(&consumer, "consumer", "", "Existing durable consumer on --stream to read through")
+	cmd.Flags().StringVar(&filter, "filter", "", "Messages to execute on, e.g. 'subject = \"orders.eu.*\" AND headers.Type = \"created\"'")
+	cmd.Flags().Int32Var(&concurrency, "concurrency", 1, "Messages turned into tasks at once, 1 to 256")
+
+	return cmd
+}
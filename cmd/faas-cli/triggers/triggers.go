@@ -0,0 +1,72 @@
+package triggercmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func NewTriggersGroup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "triggers",
+		Short: "Commands for NATS triggers executing functions on messages",
+	}
+
+	cmd.AddCommand(
+		NewCreateTriggerCmd(),
+		NewGetTriggerCmd(),
+		NewListTriggersCmd(),
+		NewUpdateTriggerCmd(),
+		NewDeleteTriggerCmd(),
+	)
+
+	return cmd
+}
+
+func dialGateway(ctx context.Context, addr string, useTLS bool, caFile string) (*grpc.ClientConn, error) {
+	var creds credentials.TransportCredentials
+	if useTLS {
+		if caFile != "" {
+			c, err := credentials.NewClientTLSFromFile(caFile, "")
+			if err != nil {
+				return nil, err
+			}
+			creds = c
+		} else {
+			creds = credentials.NewTLS(nil)
+		}
+	} else {
+		creds = insecure.NewCredentials()
+	}
+
+	return grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(creds))
+}
+
+func printTrigger(w io.Writer, prefix string, t *faaspb.Trigger) {
+	fmt.Fprintf(w,
+		"%sname=%s, function=%s, subject=%s, stream=%s, consumer=%s, filter=%q, concurrency=%d\n",
+		prefix,
+		t.GetName(),
+		t.GetFunction(),
+		t.GetSubject(),
+		t.GetStream(),
+		t.GetConsumer(),
+		t.GetFilter(),
+		t.GetConcurrency(),
+	)
+}
+
+func formatTimestamp(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.AsTime().Format(time.RFC3339)
+}
@@ -0,0 +1,103 @@
+package triggercmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// updateFlagPaths maps update flags to the field mask paths they set.
+var updateFlagPaths = []struct{ flag, path string }{
+	{"function", "function"},
+	{"subject", "subject"},
+	{"stream", "stream"},
+	{"consumer", "consumer"},
+	{"filter", "filter"},
+	{"concurrency", "concurrency"},
+}
+
+func NewUpdateTriggerCmd() *cobra.Command {
+	var (
+		triggerName string
+		gatewayAddr string
+		tls         bool
+		caFile      string
+		timeout     time.Duration
+
+		functionName string
+		subject      string
+		stream       string
+		consumer     string
+		filter       string
+		concurrency  int32
+	)
+
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Update a trigger",
+		Long: "Only the flags given are updated; pass an empty value to clear one. Gateways\n" +
+			"pick up the change on their next sync.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if triggerName == "" {
+				return fmt.Errorf("--name is required")
+			}
+
+			mask := &fieldmaskpb.FieldMask{}
+			for _, f := range updateFlagPaths {
+				if cmd.Flags().Changed(f.flag) {
+					mask.Paths = append(mask.Paths, f.path)
+				}
+			}
+			if len(mask.Paths) == 0 {
+				return fmt.Errorf("nothing to update")
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+
+			conn, err := dialGateway(ctx, gatewayAddr, tls, caFile)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			t, err := faaspb.NewTriggersClient(conn).UpdateTrigger(ctx, &faaspb.UpdateTriggerRequest{
+				Trigger: &faaspb.Trigger{
+					Name:        triggerName,
+					Function:    functionName,
+					Subject:     subject,
+					Stream:      stream,
+					Consumer:    consumer,
+					Filter:      filter,
+					Concurrency: concurrency,
+				},
+				UpdateMask: mask,
+			})
+			if err != nil {
+				return err
+			}
+
+			printTrigger(cmd.OutOrStdout(), "updated: ", t)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&triggerName, "name", "", "Trigger name, e.g. triggers/orders")
+	cmd.Flags().StringVar(&gatewayAddr, "gateway", "127.0.0.1:55055", "Gateway gRPC address host:port")
+	cmd.Flags().BoolVar(&tls, "tls", false, "Use TLS")
+	cmd.Flags().StringVar(&caFile, "tls-ca", "", "CA file (PEM), optional")
+	cmd.Flags().DurationVar(&timeout, "timeout", 15*time.Second, "Overall timeout")
+
+	cmd.Flags().StringVar(&functionName, "function", "", "Function name, optionally with an alias, e.g. functions/orders@prod")
+	cmd.Flags().StringVar(&subject, "subject", "", "NATS subject, wildcards allowed, e.g. orders.>")
+	cmd.Flags().StringVar(&stream, "stream", "", "JetStream stream to read")
+	cmd.Flags().StringVar(&consumer, "consumer", "", "Existing durable consumer on --stream to read through")
+	cmd.Flags().StringVar(&filter, "filter", "", "Messages to execute on")
+	cmd.Flags().Int32Var(&concurrency, "concurrency", 0, "Messages turned into tasks at once, 1 to 256")
+
+	return cmd
+}
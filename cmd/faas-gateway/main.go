@@ -5,6 +5,8 @@ import (
 	"flag"
 	"os/signal"
 	"syscall"
+	// Schedules name IANA time zones; hosts may lack a zone database.
+	_ "time/tzdata"
 
 	gatewayapp "github.com/10Narratives/faas/internal/app/gateway"
 	configutils "github.com/10Narratives/faas/pkg/config"
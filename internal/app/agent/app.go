@@ -3,11 +3,16 @@ package agentapp
 import (
 	"context"
 	"fmt"
-	"time"
 
 	natscomp "github.com/10Narratives/faas/internal/app/components/nats"
 	funcrepo "github.com/10Narratives/faas/internal/repositories/functions"
+	jobrepo "github.com/10Narratives/faas/internal/repositories/jobs"
+	secretrepo "github.com/10Narratives/faas/internal/repositories/secrets"
 	taskrepo "github.com/10Narratives/faas/internal/repositories/tasks"
+	buildsrv "github.com/10Narratives/faas/internal/services/builder"
+	execsrv "github.com/10Narratives/faas/internal/services/executor"
+	secretsrv "github.com/10Narratives/faas/internal/services/secrets"
+	taskconsumer "github.com/10Narratives/faas/internal/transport/jetstream/tasks"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 )
@@ -22,6 +27,9 @@ type App struct {
 
 	funcMeta *funcrepo.MetadataRepository
 	funcObj  *funcrepo.ObjectRepository
+
+	taskConsumer  *taskconsumer.Consumer
+	buildConsumer *taskconsumer.Consumer
 }
 
 func NewApp(cfg *Config, log *zap.Logger) (*App, error) {
@@ -31,9 +39,46 @@ func NewApp(cfg *Config, log *zap.Logger) (*App, error) {
 	}
 	log.Info("connection to unified storage established")
 
+	secretCipher, err := secretsrv.NewCipherFromBase64(cfg.Secrets.MasterKey)
+	if err != nil {
+		return nil, fmt.Errorf("cannot initialize secrets cipher: %w", err)
+	}
+
 	taskRepo := taskrepo.NewRepository(unifiedStorage.TaskMeta)
+	taskObjRepo := taskrepo.NewObjectRepository(unifiedStorage.TaskObj)
 	funcMetaRepo := funcrepo.NewMetadataRepository(unifiedStorage.FuncMeta)
 	funcObjRepo := funcrepo.NewObjectRepository(unifiedStorage.FuncObj)
+	secretRepo := secretrepo.NewRepository(unifiedStorage.SecretMeta)
+	jobRepo := jobrepo.NewRepository(unifiedStorage.JobMeta)
+
+	secretService := secretsrv.NewService(secretRepo, funcMetaRepo, secretCipher)
+	execService := execsrv.NewService(
+		execsrv.Config{
+			WorkDir:          cfg.Executor.WorkDir,
+			Command:          cfg.Executor.Command,
+			Runtimes:         cfg.Executor.Runtimes,
+			Timeout:          cfg.Executor.Timeout,
+			MaxTimeout:       cfg.Executor.MaxTimeout,
+			MaxOutputSize:    cfg.Executor.MaxOutputSize,
+			MaxArtifacts:     cfg.Executor.MaxArtifacts,
+			MaxArtifactsSize: cfg.Executor.MaxArtifactsSize,
+		},
+		taskRepo, taskObjRepo, funcMetaRepo, funcObjRepo, secretService, jobRepo, log,
+	)
+
+	buildService := buildsrv.NewService(
+		buildsrv.Config{
+			WorkDir:    cfg.Builder.WorkDir,
+			Script:     cfg.Builder.Script,
+			Shell:      cfg.Builder.Shell,
+			Timeout:    cfg.Builder.Timeout,
+			MaxLogSize: cfg.Builder.MaxLogSize,
+		},
+		funcMetaRepo, funcObjRepo, log,
+	)
+
+	taskConsumer := taskconsumer.NewConsumer(unifiedStorage.JS, execService, cfg.Executor.Concurrency, log)
+	buildConsumer := taskconsumer.NewBuildConsumer(unifiedStorage.JS, buildService, cfg.Builder.Concurrency, log)
 
 	return &App{
 		cfg:            cfg,
@@ -42,6 +87,8 @@ func NewApp(cfg *Config, log *zap.Logger) (*App, error) {
 		taskRepo:       taskRepo,
 		funcMeta:       funcMetaRepo,
 		funcObj:        funcObjRepo,
+		taskConsumer:   taskConsumer,
+		buildConsumer:  buildConsumer,
 	}, nil
 }
 
@@ -50,17 +97,16 @@ func (a *App) Startup(ctx context.Context) error {
 
 	errGroup.Go(func() error {
 		a.log.Info("agent online")
-		ticker := time.NewTicker(1 * time.Minute)
-		defer ticker.Stop()
-
-		select {
-		case <-ticker.C:
-			a.log.Info("work done")
-			return nil
-		case <-ctx.Done():
-			a.log.Info("work canceled")
-			return nil
-		}
+		defer a.log.Info("agent stopped consuming tasks")
+
+		return a.taskConsumer.Startup(ctx)
+	})
+
+	errGroup.Go(func() error {
+		a.log.Info("builder online")
+		defer a.log.Info("agent stopped consuming builds")
+
+		return a.buildConsumer.Startup(ctx)
 	})
 
 	return errGroup.Wait()
@@ -1,9 +1,49 @@
 package agentapp
 
+import (
+	"time"
+
+	execsrv "github.com/10Narratives/faas/internal/services/executor"
+)
+
 type Config struct {
 	UnifiedStorage UnifiedStorageConfig `yaml:"unified_storage"`
+	Secrets        SecretsConfig        `yaml:"secrets"`
+	Executor       ExecutorConfig       `yaml:"executor"`
+	Builder        BuilderConfig        `yaml:"builder"`
 }
 
 type UnifiedStorageConfig struct {
 	URL string `yaml:"url" env-required:"true"`
 }
+
+type SecretsConfig struct {
+	// MasterKey is a base64-encoded 32-byte key; it must match the gateway's.
+	MasterKey string `yaml:"master_key" env:"FAAS_SECRETS_MASTER_KEY" env-required:"true"`
+}
+
+type ExecutorConfig struct {
+	WorkDir string `yaml:"work_dir" env-default:"/tmp/faas"`
+	// Command runs functions uploaded without a manifest entrypoint.
+	Command []string `yaml:"command" env-default:"python3,main.py"`
+	// Runtimes launch manifest entrypoints by runtime name or its leading
+	// letters, e.g. "python" for "python3.12"; empty means the built-in
+	// python and node runtimes.
+	Runtimes map[string]execsrv.Runtime `yaml:"runtimes"`
+	Timeout  time.Duration              `yaml:"timeout" env-default:"5m"`
+	// MaxTimeout caps the timeout a function asks for.
+	MaxTimeout       time.Duration `yaml:"max_timeout" env-default:"1h"`
+	MaxOutputSize    int           `yaml:"max_output_size" env-default:"1048576"`
+	MaxArtifacts     int           `yaml:"max_artifacts" env-default:"100"`
+	MaxArtifactsSize int64         `yaml:"max_artifacts_size" env-default:"1073741824"`
+	Concurrency      int           `yaml:"concurrency" env-default:"4"`
+}
+
+type BuilderConfig struct {
+	WorkDir     string        `yaml:"work_dir" env-default:"/tmp/faas-builds"`
+	Script      string        `yaml:"script" env-default:"build.sh"`
+	Shell       []string      `yaml:"shell" env-default:"sh"`
+	Timeout     time.Duration `yaml:"timeout" env-default:"15m"`
+	MaxLogSize  int           `yaml:"max_log_size" env-default:"65536"`
+	Concurrency int           `yaml:"concurrency" env-default:"1"`
+}
@@ -14,6 +14,10 @@ const (
 	tasksStream     = "TASKS"
 	tasksBucket     = "tasks"
 	functionsBucket = "functions"
+	secretsBucket   = "secrets"
+	jobsBucket      = "jobs"
+	schedulesBucket = "schedules"
+	triggersBucket  = "triggers"
 )
 
 func NewConnection(dsn string) (*nats.Conn, error) {
@@ -34,8 +38,13 @@ type UnifiedStorage struct {
 	JS         jetstream.JetStream
 	TaskStream jetstream.Stream
 	TaskMeta   jetstream.KeyValue
+	TaskObj    jetstream.ObjectStore
 	FuncObj    jetstream.ObjectStore
 	FuncMeta   jetstream.KeyValue
+	SecretMeta jetstream.KeyValue
+	JobMeta    jetstream.KeyValue
+	SchedMeta  jetstream.KeyValue
+	TrigMeta   jetstream.KeyValue
 }
 
 func NewUnifiedStorage(url string) (*UnifiedStorage, error) {
@@ -62,6 +71,11 @@ func NewUnifiedStorage(url string) (*UnifiedStorage, error) {
 		return nil, fmt.Errorf("connect to kv %s: %w", tasksBucket, err)
 	}
 
+	taskObj, err := js.ObjectStore(ctx, tasksBucket)
+	if err != nil {
+		return nil, fmt.Errorf("connect to obj %s: %w", tasksBucket, err)
+	}
+
 	funcMeta, err := js.KeyValue(ctx, functionsBucket)
 	if err != nil {
 		return nil, fmt.Errorf("connect to kv %s: %w", functionsBucket, err)
@@ -72,12 +86,37 @@ func NewUnifiedStorage(url string) (*UnifiedStorage, error) {
 		return nil, fmt.Errorf("connect to obj %s: %w", functionsBucket, err)
 	}
 
+	secretMeta, err := js.KeyValue(ctx, secretsBucket)
+	if err != nil {
+		return nil, fmt.Errorf("connect to kv %s: %w", secretsBucket, err)
+	}
+
+	jobMeta, err := js.KeyValue(ctx, jobsBucket)
+	if err != nil {
+		return nil, fmt.Errorf("connect to kv %s: %w", jobsBucket, err)
+	}
+
+	schedMeta, err := js.KeyValue(ctx, schedulesBucket)
+	if err != nil {
+		return nil, fmt.Errorf("connect to kv %s: %w", schedulesBucket, err)
+	}
+
+	trigMeta, err := js.KeyValue(ctx, triggersBucket)
+	if err != nil {
+		return nil, fmt.Errorf("connect to kv %s: %w", triggersBucket, err)
+	}
+
 	return &UnifiedStorage{
 		Conn:       conn,
 		JS:         js,
 		TaskStream: taskStream,
 		TaskMeta:   taskMeta,
+		TaskObj:    taskObj,
 		FuncMeta:   funcMeta,
 		FuncObj:    funcObj,
+		SecretMeta: secretMeta,
+		JobMeta:    jobMeta,
+		SchedMeta:  schedMeta,
+		TrigMeta:   trigMeta,
 	}, nil
 }
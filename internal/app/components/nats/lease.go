@@ -0,0 +1,89 @@
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// Lease elects one holder among gateway replicas for a periodic job. It is
+// a KV key naming the holder and its expiry, taken over with CAS once it
+// expires. The holder renews it by acquiring again before the TTL ends.
+type Lease struct {
+	kv     jetstream.KeyValue
+	key    string
+	ttl    time.Duration
+	holder string
+}
+
+type storedLease struct {
+	Holder    string    `json:"holder"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func NewLease(kv jetstream.KeyValue, key string, ttl time.Duration) *Lease {
+	return &Lease{kv: kv, key: key, ttl: ttl, holder: uuid.NewString()}
+}
+
+// Acquire takes or renews the lease. It reports false while another
+// holder's lease is valid or when another replica won the race.
+func (l *Lease) Acquire(ctx context.Context) (bool, error) {
+	now := time.Now().UTC()
+	value, err := json.Marshal(storedLease{Holder: l.holder, ExpiresAt: now.Add(l.ttl)})
+	if err != nil {
+		return false, err
+	}
+
+	e, err := l.kv.Get(ctx, l.key)
+	if errors.Is(err, jetstream.ErrKeyNotFound) {
+		_, err = l.kv.Create(ctx, l.key, value)
+		if errors.Is(err, jetstream.ErrKeyExists) {
+			return false, nil
+		}
+		return err == nil, err
+	}
+	if err != nil {
+		return false, err
+	}
+
+	var cur storedLease
+	if err := json.Unmarshal(e.Value(), &cur); err != nil {
+		return false, err
+	}
+	if cur.Holder != l.holder && now.Before(cur.ExpiresAt) {
+		return false, nil
+	}
+
+	_, err = l.kv.Update(ctx, l.key, value, e.Revision())
+	if errors.Is(err, jetstream.ErrKeyExists) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Release gives the lease up if it is still held, so another replica can
+// take over without waiting for the TTL.
+func (l *Lease) Release(ctx context.Context) error {
+	e, err := l.kv.Get(ctx, l.key)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	var cur storedLease
+	if err := json.Unmarshal(e.Value(), &cur); err != nil || cur.Holder != l.holder {
+		return err
+	}
+
+	err = l.kv.Delete(ctx, l.key, jetstream.LastRevision(e.Revision()))
+	if errors.Is(err, jetstream.ErrKeyExists) {
+		return nil
+	}
+	return err
+}
@@ -3,17 +3,34 @@ package gatewayapp
 import (
 	"context"
 	"fmt"
+	"time"
 
 	grpcsrv "github.com/10Narratives/faas/internal/app/components/grpc/server"
 	natscomp "github.com/10Narratives/faas/internal/app/components/nats"
+	gcdomain "github.com/10Narratives/faas/internal/domains/gc"
 	funcrepo "github.com/10Narratives/faas/internal/repositories/functions"
+	jobrepo "github.com/10Narratives/faas/internal/repositories/jobs"
+	schedrepo "github.com/10Narratives/faas/internal/repositories/schedules"
+	secretrepo "github.com/10Narratives/faas/internal/repositories/secrets"
 	taskrepo "github.com/10Narratives/faas/internal/repositories/tasks"
+	triggerrepo "github.com/10Narratives/faas/internal/repositories/triggers"
 	funcsrv "github.com/10Narratives/faas/internal/services/functions"
+	gcsrv "github.com/10Narratives/faas/internal/services/gc"
+	jobsrv "github.com/10Narratives/faas/internal/services/jobs"
+	schedsrv "github.com/10Narratives/faas/internal/services/schedules"
+	secretsrv "github.com/10Narratives/faas/internal/services/secrets"
 	tasksrv "github.com/10Narratives/faas/internal/services/tasks"
+	triggersrv "github.com/10Narratives/faas/internal/services/triggers"
+	adminapi "github.com/10Narratives/faas/internal/transport/grpc/api/admin"
 	funcapi "github.com/10Narratives/faas/internal/transport/grpc/api/functions"
+	jobapi "github.com/10Narratives/faas/internal/transport/grpc/api/jobs"
+	schedapi "github.com/10Narratives/faas/internal/transport/grpc/api/schedules"
+	secretapi "github.com/10Narratives/faas/internal/transport/grpc/api/secrets"
 	taskapi "github.com/10Narratives/faas/internal/transport/grpc/api/tasks"
+	triggerapi "github.com/10Narratives/faas/internal/transport/grpc/api/triggers"
 	healthapi "github.com/10Narratives/faas/internal/transport/grpc/dev/health"
 	reflectapi "github.com/10Narratives/faas/internal/transport/grpc/dev/reflect"
+	triggerconsumer "github.com/10Narratives/faas/internal/transport/jetstream/triggers"
 
 	"github.com/10Narratives/faas/internal/transport/grpc/interceptors/logging"
 	"github.com/10Narratives/faas/internal/transport/grpc/interceptors/recovery"
@@ -23,6 +40,26 @@ import (
 	"google.golang.org/grpc"
 )
 
+// gcLeaseKey is the functions bucket key electing the replica that runs
+// the garbage collector.
+const gcLeaseKey = "lease.gc"
+
+// purgeLeaseKey is the functions bucket key electing the replica that
+// purges deleted functions.
+const purgeLeaseKey = "lease.purge"
+
+// taskExpiryLeaseKey is the tasks bucket key electing the replica that
+// deletes tasks past their retention.
+const taskExpiryLeaseKey = "lease.expiry"
+
+// jobReconcileLeaseKey is the jobs bucket key electing the replica that
+// repairs job counters.
+const jobReconcileLeaseKey = "lease.reconcile"
+
+// schedulerLeaseKey is the schedules bucket key electing the replica that
+// fires due schedules.
+const schedulerLeaseKey = "lease.scheduler"
+
 type App struct {
 	cfg *Config
 	log *zap.Logger
@@ -32,8 +69,27 @@ type App struct {
 	taskRepo *taskrepo.Repository
 	taskPub  *taskrepo.Publisher
 
+	taskService     *tasksrv.Service
+	taskExpiryLease *natscomp.Lease
+
+	jobService        *jobsrv.Service
+	jobReconcileLease *natscomp.Lease
+
 	funcMeta *funcrepo.MetadataRepository
 	funcObj  *funcrepo.ObjectRepository
+	funcPub  *funcrepo.Publisher
+
+	funcService *funcsrv.Service
+	gcService   *gcsrv.Service
+	gcLease     *natscomp.Lease
+	purgeLease  *natscomp.Lease
+
+	schedService   *schedsrv.Service
+	schedulerLease *natscomp.Lease
+
+	triggerDispatcher *triggerconsumer.Dispatcher
+
+	secretRepo *secretrepo.Repository
 
 	grpcServer *grpcsrv.Component
 }
@@ -45,13 +101,49 @@ func NewApp(cfg *Config, log *zap.Logger) (*App, error) {
 	}
 	log.Info("connection to unified storage established")
 
+	secretCipher, err := secretsrv.NewCipherFromBase64(cfg.Secrets.MasterKey)
+	if err != nil {
+		return nil, fmt.Errorf("cannot initialize secrets cipher: %w", err)
+	}
+
 	taskRepo := taskrepo.NewRepository(unifiedStorage.TaskMeta)
 	taskPub := taskrepo.NewPublisher(unifiedStorage.JS)
+	taskObjRepo := taskrepo.NewObjectRepository(unifiedStorage.TaskObj)
 	funcMetaRepo := funcrepo.NewMetadataRepository(unifiedStorage.FuncMeta)
 	funcObjRepo := funcrepo.NewObjectRepository(unifiedStorage.FuncObj)
+	funcPub := funcrepo.NewPublisher(unifiedStorage.JS)
+	secretRepo := secretrepo.NewRepository(unifiedStorage.SecretMeta)
+	jobRepo := jobrepo.NewRepository(unifiedStorage.JobMeta)
+	schedRepo := schedrepo.NewRepository(unifiedStorage.SchedMeta)
+	triggerRepo := triggerrepo.NewRepository(unifiedStorage.TrigMeta)
+	consumerRepo := triggerrepo.NewConsumerRepository(unifiedStorage.JS)
 
-	taskService := tasksrv.NewService(taskRepo, taskPub)
-	funcService := funcsrv.NewService(funcMetaRepo, funcObjRepo, taskService)
+	taskService := tasksrv.NewService(taskRepo, taskPub, taskObjRepo, jobRepo)
+	jobService := jobsrv.NewService(jobRepo, taskService)
+	secretService := secretsrv.NewService(secretRepo, funcMetaRepo, secretCipher)
+	funcService := funcsrv.NewService(
+		funcsrv.Config{
+			MaxBundleSize:    cfg.Functions.MaxBundleSize,
+			NamespaceQuota:   cfg.Functions.NamespaceQuota,
+			UploadSessionTTL: cfg.Functions.UploadSessionTTL,
+			FinalizeTimeout:  cfg.Functions.FinalizeTimeout,
+			DeleteRetention:  cfg.Functions.DeleteRetention,
+			MaxInvokeWait:    cfg.Functions.InvokeMaxWait,
+		},
+		funcMetaRepo, funcObjRepo, taskService, jobService, funcPub, secretService,
+	)
+	schedService := schedsrv.NewService(
+		schedsrv.Config{
+			MissedRunGrace: cfg.Schedules.MissedRunGrace,
+			MaxRunsPerTick: cfg.Schedules.MaxRunsPerTick,
+		},
+		schedRepo, funcService,
+	)
+	triggerService := triggersrv.NewService(triggerRepo, consumerRepo, funcService)
+	gcService := gcsrv.NewService(
+		gcsrv.Config{MinAge: cfg.GC.MinAge},
+		funcMetaRepo, funcObjRepo, taskRepo, taskObjRepo,
+	)
 
 	grpcServer := grpcsrv.NewComponent(cfg.Server.Grpc.Address,
 		grpcsrv.WithServerOptions(
@@ -71,18 +163,40 @@ func NewApp(cfg *Config, log *zap.Logger) (*App, error) {
 			reflectapi.NewRegistration(),
 			taskapi.NewRegistration(taskService),
 			funcapi.NewRegistration(funcService),
+			secretapi.NewRegistration(secretService),
+			jobapi.NewRegistration(jobService),
+			schedapi.NewRegistration(schedService),
+			triggerapi.NewRegistration(triggerService),
+			adminapi.NewRegistration(gcService),
 		),
 	)
 
 	return &App{
-		cfg:            cfg,
-		log:            log,
-		grpcServer:     grpcServer,
-		unifiedStorage: unifiedStorage,
-		taskRepo:       taskRepo,
-		taskPub:        taskPub,
-		funcMeta:       funcMetaRepo,
-		funcObj:        funcObjRepo,
+		cfg:               cfg,
+		log:               log,
+		grpcServer:        grpcServer,
+		unifiedStorage:    unifiedStorage,
+		taskRepo:          taskRepo,
+		taskPub:           taskPub,
+		taskService:       taskService,
+		taskExpiryLease:   natscomp.NewLease(unifiedStorage.TaskMeta, taskExpiryLeaseKey, 2*cfg.Tasks.ExpireInterval),
+		jobService:        jobService,
+		jobReconcileLease: natscomp.NewLease(unifiedStorage.JobMeta, jobReconcileLeaseKey, 2*cfg.Jobs.ReconcileInterval),
+		funcMeta:          funcMetaRepo,
+		funcObj:           funcObjRepo,
+		funcPub:           funcPub,
+		funcService:       funcService,
+		gcService:         gcService,
+		gcLease:           natscomp.NewLease(unifiedStorage.FuncMeta, gcLeaseKey, 2*cfg.GC.Interval),
+		purgeLease:        natscomp.NewLease(unifiedStorage.FuncMeta, purgeLeaseKey, 2*cfg.Functions.PurgeInterval),
+		schedService:      schedService,
+		// A tick firing many runs may outlast a shorter lease; the claims
+		// keep slots from firing twice even then.
+		schedulerLease: natscomp.NewLease(unifiedStorage.SchedMeta, schedulerLeaseKey, 3*cfg.Schedules.Interval),
+		triggerDispatcher: triggerconsumer.NewDispatcher(
+			unifiedStorage.Conn, consumerRepo, triggerService, cfg.Triggers.SyncInterval, log,
+		),
+		secretRepo: secretRepo,
 	}, nil
 }
 
@@ -96,6 +210,44 @@ func (a *App) Startup(ctx context.Context) error {
 		return a.grpcServer.Startup(ctx)
 	})
 
+	errGroup.Go(func() error {
+		a.runUploadJanitor(ctx)
+		return nil
+	})
+
+	errGroup.Go(func() error {
+		a.runLeased(ctx, "purge", a.purgeLease, a.cfg.Functions.PurgeInterval, a.purgeDeletedFunctions)
+		return nil
+	})
+
+	if a.cfg.Tasks.Retention > 0 {
+		errGroup.Go(func() error {
+			a.runLeased(ctx, "task expiry", a.taskExpiryLease, a.cfg.Tasks.ExpireInterval, a.expireTasks)
+			return nil
+		})
+	}
+
+	errGroup.Go(func() error {
+		a.runLeased(ctx, "job reconcile", a.jobReconcileLease, a.cfg.Jobs.ReconcileInterval, a.reconcileJobs)
+		return nil
+	})
+
+	errGroup.Go(func() error {
+		a.runLeased(ctx, "gc", a.gcLease, a.cfg.GC.Interval, a.collectGarbage)
+		return nil
+	})
+
+	errGroup.Go(func() error {
+		a.runLeased(ctx, "scheduler", a.schedulerLease, a.cfg.Schedules.Interval, a.runDueSchedules)
+		return nil
+	})
+
+	if a.cfg.Triggers.SyncInterval > 0 {
+		errGroup.Go(func() error {
+			return a.triggerDispatcher.Startup(ctx)
+		})
+	}
+
 	return errGroup.Wait()
 }
 
@@ -119,3 +271,135 @@ func (a *App) Shutdown(ctx context.Context) error {
 
 	return errGroup.Wait()
 }
+
+// runUploadJanitor removes expired upload sessions until ctx is done.
+func (a *App) runUploadJanitor(ctx context.Context) {
+	interval := a.cfg.Functions.UploadGCInterval
+	if interval <= 0 {
+		interval = 10 * time.Minute
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			n, err := a.funcService.ExpireUploadSessions(ctx, now)
+			if err != nil {
+				a.log.Warn("cannot remove expired upload sessions", zap.Error(err))
+			}
+			if n > 0 {
+				a.log.Info("removed expired upload sessions", zap.Int("count", n))
+			}
+		}
+	}
+}
+
+// runLeased calls fn every interval while this replica holds lease, until
+// ctx is done, and then releases the lease. name tells the loops apart in
+// logs; a non-positive interval disables the loop.
+func (a *App) runLeased(
+	ctx context.Context,
+	name string,
+	lease *natscomp.Lease,
+	interval time.Duration,
+	fn func(ctx context.Context, now time.Time),
+) {
+	if interval <= 0 {
+		return
+	}
+	log := a.log.With(zap.String("loop", name))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	defer func() {
+		releaseCtx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		if err := lease.Release(releaseCtx); err != nil {
+			log.Warn("cannot release lease", zap.Error(err))
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			held, err := lease.Acquire(ctx)
+			if err != nil {
+				log.Warn("cannot acquire lease", zap.Error(err))
+				continue
+			}
+			if held {
+				fn(ctx, now)
+			}
+		}
+	}
+}
+
+// purgeDeletedFunctions purges deleted functions past their retention.
+func (a *App) purgeDeletedFunctions(ctx context.Context, now time.Time) {
+	n, err := a.funcService.PurgeDeletedFunctions(ctx, now)
+	if err != nil {
+		a.log.Warn("cannot purge deleted functions", zap.Error(err))
+	}
+	if n > 0 {
+		a.log.Info("purged deleted functions", zap.Int("count", n))
+	}
+}
+
+// expireTasks deletes tasks past Tasks.Retention.
+func (a *App) expireTasks(ctx context.Context, now time.Time) {
+	n, err := a.taskService.ExpireTasks(ctx, now.Add(-a.cfg.Tasks.Retention))
+	if err != nil {
+		a.log.Warn("cannot delete expired tasks", zap.Error(err))
+	}
+	if n > 0 {
+		a.log.Info("deleted expired tasks", zap.Int("count", n))
+	}
+}
+
+// reconcileJobs repairs job counters that drifted from the jobs' tasks.
+func (a *App) reconcileJobs(ctx context.Context, _ time.Time) {
+	n, err := a.jobService.ReconcileJobs(ctx)
+	if err != nil {
+		a.log.Warn("cannot reconcile jobs", zap.Error(err))
+	}
+	if n > 0 {
+		a.log.Info("repaired job counters", zap.Int("count", n))
+	}
+}
+
+// collectGarbage finds orphans, deleting them if GC.Delete is set.
+func (a *App) collectGarbage(ctx context.Context, _ time.Time) {
+	res, err := a.gcService.CollectGarbage(ctx, &gcdomain.CollectGarbageArgs{Delete: a.cfg.GC.Delete})
+	if err != nil {
+		a.log.Warn("cannot collect garbage", zap.Error(err))
+		return
+	}
+	for _, o := range res.Orphans {
+		a.log.Info("orphan",
+			zap.String("kind", o.Kind), zap.String("store", o.Store), zap.String("key", o.Key),
+			zap.Uint64("size", o.Size), zap.String("reason", o.Reason),
+			zap.Bool("deleted", o.Deleted), zap.String("error", o.Error),
+		)
+	}
+	if len(res.Orphans) > 0 {
+		a.log.Info("collected garbage",
+			zap.Int("orphans", len(res.Orphans)), zap.Int("deleted", res.Deleted), zap.Int("failed", res.Failed))
+	}
+}
+
+// runDueSchedules fires the schedules that are due.
+func (a *App) runDueSchedules(ctx context.Context, now time.Time) {
+	res, err := a.schedService.RunDueSchedules(ctx, now.UTC())
+	if err != nil {
+		a.log.Warn("cannot run due schedules", zap.Error(err))
+	}
+	if res != nil && res.Runs > 0 {
+		a.log.Info("ran due schedules", zap.Int("runs", res.Runs), zap.Int("failed", res.Failed))
+	}
+}
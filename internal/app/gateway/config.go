@@ -1,8 +1,17 @@
 package gatewayapp
 
+import "time"
+
 type Config struct {
 	Server         ServerConfig         `yaml:"server"`
 	UnifiedStorage UnifiedStorageConfig `yaml:"unified_storage"`
+	Secrets        SecretsConfig        `yaml:"secrets"`
+	Functions      FunctionsConfig      `yaml:"functions"`
+	Tasks          TasksConfig          `yaml:"tasks"`
+	Jobs           JobsConfig           `yaml:"jobs"`
+	GC             GCConfig             `yaml:"gc"`
+	Schedules      SchedulesConfig      `yaml:"schedules"`
+	Triggers       TriggersConfig       `yaml:"triggers"`
 }
 
 type ServerConfig struct {
@@ -16,3 +25,74 @@ type GrpcConfig struct {
 type UnifiedStorageConfig struct {
 	URL string `yaml:"url" env-required:"true"`
 }
+
+type SecretsConfig struct {
+	// MasterKey is a base64-encoded 32-byte key used to encrypt secret values.
+	MasterKey string `yaml:"master_key" env:"FAAS_SECRETS_MASTER_KEY" env-required:"true"`
+}
+
+type FunctionsConfig struct {
+	// MaxBundleSize bounds a single uploaded bundle in bytes; 0 disables it.
+	MaxBundleSize uint64 `yaml:"max_bundle_size" env-default:"104857600"`
+	// NamespaceQuota bounds the bundle bytes of a namespace, each distinct
+	// bundle counted once; 0 disables it.
+	NamespaceQuota uint64 `yaml:"namespace_quota" env-default:"0"`
+	// UploadSessionTTL is how long a resumable upload may stay idle before
+	// the janitor, running every UploadGCInterval, removes it.
+	UploadSessionTTL time.Duration `yaml:"upload_session_ttl" env-default:"24h"`
+	UploadGCInterval time.Duration `yaml:"upload_gc_interval" env-default:"10m"`
+	// FinalizeTimeout bounds finalizing an upload; a session left finalizing
+	// for longer, e.g. by a crashed replica, can be finalized again.
+	FinalizeTimeout time.Duration `yaml:"finalize_timeout" env-default:"15m"`
+	// DeleteRetention is how long a deleted function can be undeleted;
+	// afterwards one gateway replica, holding a lease, purges it within
+	// PurgeInterval. With 0 the next run purges it.
+	DeleteRetention time.Duration `yaml:"delete_retention" env-default:"168h"`
+	PurgeInterval   time.Duration `yaml:"purge_interval" env-default:"10m"`
+	// InvokeMaxWait caps how long InvokeFunction holds a call open, even
+	// when the client deadline is later.
+	InvokeMaxWait time.Duration `yaml:"invoke_max_wait" env-default:"5m"`
+}
+
+type TasksConfig struct {
+	// Retention is how long finished tasks are kept with their inputs and
+	// results; afterwards one gateway replica, holding a lease, deletes
+	// them within ExpireInterval. 0 keeps them forever.
+	Retention      time.Duration `yaml:"retention" env-default:"720h"`
+	ExpireInterval time.Duration `yaml:"expire_interval" env-default:"1h"`
+}
+
+type JobsConfig struct {
+	// ReconcileInterval is how often one gateway replica, holding a lease,
+	// recounts the tasks of unfinished jobs to repair lost counter updates;
+	// 0 disables it.
+	ReconcileInterval time.Duration `yaml:"reconcile_interval" env-default:"5m"`
+}
+
+type GCConfig struct {
+	// Interval is how often one gateway replica, holding a lease, looks for
+	// orphaned objects and records; 0 disables the job.
+	Interval time.Duration `yaml:"interval" env-default:"6h"`
+	// MinAge protects everything written more recently; at least 10m.
+	MinAge time.Duration `yaml:"min_age" env-default:"1h"`
+	// Delete removes the orphans found; otherwise the job only logs them.
+	Delete bool `yaml:"delete" env-default:"false"`
+}
+
+type SchedulesConfig struct {
+	// Interval is how often one gateway replica, holding a lease, fires the
+	// due schedules; 0 disables them.
+	Interval time.Duration `yaml:"interval" env-default:"10s"`
+	// MissedRunGrace is how late a slot may fire before the schedule's
+	// missed run policy applies; keep it above Interval.
+	MissedRunGrace time.Duration `yaml:"missed_run_grace" env-default:"1m"`
+	// MaxRunsPerTick bounds the runs of one schedule per tick when catching
+	// up on missed slots.
+	MaxRunsPerTick int `yaml:"max_runs_per_tick" env-default:"100"`
+}
+
+type TriggersConfig struct {
+	// SyncInterval is how often every gateway replica picks up created,
+	// updated and deleted triggers; 0 disables them on this replica.
+	SyncInterval time.Duration `yaml:"sync_interval" env-default:"10s"`
+}
@@ -0,0 +1,34 @@
+package funcdomain
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	archiveutils "github.com/10Narratives/faas/pkg/archive"
+	digestutils "github.com/10Narratives/faas/pkg/digest"
+)
+
+// BundleOpener reads a stored bundle or build artifact.
+type BundleOpener interface {
+	OpenBundle(ctx context.Context, bundle *SourceBundle) (io.ReadCloser, error)
+}
+
+// ExtractBundle unpacks bundle into dst and checks it against its recorded
+// digest.
+func ExtractBundle(ctx context.Context, opener BundleOpener, bundle *SourceBundle, dst string) error {
+	rc, err := opener.OpenBundle(ctx, bundle)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	// A corrupted object usually fails extraction too; report the integrity
+	// error in that case since it is the actual cause.
+	dr := digestutils.NewReader(rc)
+	extractErr := archiveutils.Extract(dr, string(bundle.ArchiveFormat()), dst)
+	if err := dr.Verify(bundle.SHA256); err != nil {
+		return fmt.Errorf("integrity check of %s: %w", bundle.ObjectKey, err)
+	}
+	return extractErr
+}
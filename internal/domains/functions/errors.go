@@ -9,4 +9,38 @@ var (
 	ErrInvalidName           = errors.New("invalid function name")
 	ErrInvalidPageToken      = errors.New("invalid page token")
 	ErrUnsupportedFormat     = errors.New("unsupported upload format")
+	ErrInvalidEnv            = errors.New("invalid environment")
+	ErrFunctionNotReady      = errors.New("function is not ready")
+	ErrBuildSuperseded       = errors.New("function build superseded")
+	ErrInvalidDigest         = errors.New("invalid sha256 digest")
+	ErrDigestMismatch        = errors.New("sha256 digest mismatch")
+	ErrRevisionNotFound      = errors.New("function revision not found")
+	ErrRevisionConflict      = errors.New("concurrent upload created the same revision")
+	ErrAliasNotFound         = errors.New("function alias not found")
+	ErrInvalidAlias          = errors.New("invalid function alias")
+	ErrInvalidMetadata       = errors.New("invalid function metadata")
+	ErrETagMismatch          = errors.New("function was modified concurrently")
+	ErrInvalidManifest       = errors.New("invalid function manifest")
+	ErrBundleNotFound        = errors.New("function bundle not found")
+	ErrBundleTooLarge        = errors.New("function bundle exceeds the maximum size")
+	ErrQuotaExceeded         = errors.New("namespace storage quota exceeded")
+	ErrInvalidUploadSession  = errors.New("invalid upload session name")
+	ErrUploadNotFound        = errors.New("upload session not found")
+	ErrUploadOffsetMismatch  = errors.New("chunk offset does not match the committed offset")
+	ErrChunkChecksumMismatch = errors.New("chunk crc32c mismatch")
+	ErrUploadIncomplete      = errors.New("upload session is incomplete")
+	ErrUploadFinalizing      = errors.New("upload session is already being finalized")
+	ErrUploadFinalized       = errors.New("upload session is already finalized")
+	ErrUploadModified        = errors.New("upload session was modified concurrently")
+	ErrBlobNotFound          = errors.New("bundle blob not found")
+	ErrBlobExists            = errors.New("bundle blob already exists")
+	ErrInvalidFilter         = errors.New("invalid list filter")
+	ErrInvalidOrderBy        = errors.New("invalid list order")
+	ErrFunctionDeleted       = errors.New("function is deleted")
+	ErrFunctionNotDeleted    = errors.New("function is not deleted")
+	ErrFunctionHasTasks      = errors.New("function has pending or processing tasks")
+	ErrInvalidParameters     = errors.New("parameters do not match the function's schema")
+	// ErrOutputContractViolation fails a task whose output does not match
+	// the declared content type or schema.
+	ErrOutputContractViolation = errors.New("output contract violation")
 )
@@ -0,0 +1,209 @@
+package funcdomain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	filterutils "github.com/10Narratives/faas/pkg/filter"
+)
+
+// FunctionFilter is a parsed AIP-160 style list filter. Terms are joined
+// with AND (or whitespace) and may be negated with NOT or "-":
+//
+//	labels.team = "images" AND runtime = "python3.12"
+//	name = "functions/img-*" uploaded_at >= "2024-01-01T00:00:00Z"
+//	labels:tier NOT labels.env = "dev"
+//
+// Supported fields are name and runtime (= and !=, a trailing "*" matches a
+// prefix), labels.<key> (= and !=, labels:<key> or labels.<key>:* tests
+// presence) and uploaded_at (=, !=, <, <=, >, >= with RFC 3339 values).
+// OR and parentheses are not supported.
+type FunctionFilter struct {
+	src   string
+	terms []filterTerm
+}
+
+type filterTerm struct {
+	negate bool
+	match  func(*Function) bool
+}
+
+// ParseFunctionFilter parses a list filter. An empty filter matches
+// every function.
+func ParseFunctionFilter(s string) (*FunctionFilter, error) {
+	s = strings.TrimSpace(s)
+	terms, err := filterutils.Parse(s)
+	if err != nil {
+		return nil, filterErr(err)
+	}
+
+	f := &FunctionFilter{src: s}
+	for _, t := range terms {
+		match, err := compileTerm(t)
+		if err != nil {
+			return nil, filterErr(err)
+		}
+		f.terms = append(f.terms, filterTerm{negate: t.Negate, match: match})
+	}
+	return f, nil
+}
+
+// String returns the filter as given, without surrounding whitespace.
+func (f *FunctionFilter) String() string {
+	if f == nil {
+		return ""
+	}
+	return f.src
+}
+
+// Match reports whether fn satisfies every term. A nil filter matches
+// everything.
+func (f *FunctionFilter) Match(fn *Function) bool {
+	if f == nil {
+		return true
+	}
+	for _, t := range f.terms {
+		if t.match(fn) == t.negate {
+			return false
+		}
+	}
+	return true
+}
+
+func compileTerm(t filterutils.Term) (func(*Function) bool, error) {
+	if match, ok, err := filterutils.Labels(t); ok {
+		if err != nil {
+			return nil, err
+		}
+		return func(fn *Function) bool { return match(fn.Labels) }, nil
+	}
+
+	switch t.Field {
+	case "name":
+		if !strings.HasPrefix(t.Value, "functions/") {
+			t.Value = "functions/" + t.Value
+		}
+		return stringTerm(t, func(fn *Function) string { return string(fn.Name) })
+
+	case "runtime":
+		return stringTerm(t, func(fn *Function) string { return fn.Runtime })
+
+	case "uploaded_at":
+		ts, err := time.Parse(time.RFC3339Nano, t.Value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: uploaded_at wants an RFC 3339 timestamp, got %q", ErrInvalidFilter, t.Value)
+		}
+		cmp, err := filterutils.Compare(t)
+		if err != nil {
+			return nil, err
+		}
+		return func(fn *Function) bool { return cmp(fn.UploadedAt.Compare(ts)) }, nil
+	}
+
+	return nil, fmt.Errorf("%w: unknown field %q", ErrInvalidFilter, t.Field)
+}
+
+func stringTerm(t filterutils.Term, get func(*Function) string) (func(*Function) bool, error) {
+	match, err := filterutils.String(t)
+	if err != nil {
+		return nil, err
+	}
+	return func(fn *Function) bool { return match(get(fn)) }, nil
+}
+
+// filterErr reports parse errors as ErrInvalidFilter.
+func filterErr(err error) error {
+	if !errors.Is(err, filterutils.ErrInvalidFilter) {
+		return err
+	}
+	return fmt.Errorf("%w: %s", ErrInvalidFilter, strings.TrimPrefix(err.Error(), filterutils.ErrInvalidFilter.Error()+": "))
+}
+
+// OrderField is one key of a list order.
+type OrderField struct {
+	Field string
+	Desc  bool
+}
+
+// FunctionOrder sorts listed functions. Name ascending is always the final
+// key, so the order is total.
+type FunctionOrder []OrderField
+
+const (
+	OrderByName       = "name"
+	OrderByUploadedAt = "uploaded_at"
+)
+
+// ParseFunctionOrder parses an AIP-132 order_by such as
+// "uploaded_at desc, name". An empty string orders by name.
+func ParseFunctionOrder(s string) (FunctionOrder, error) {
+	var order FunctionOrder
+	if strings.TrimSpace(s) == "" {
+		return order, nil
+	}
+
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(s, ",") {
+		words := strings.Fields(part)
+		if len(words) == 0 || len(words) > 2 {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidOrderBy, strings.TrimSpace(part))
+		}
+
+		f := OrderField{Field: words[0]}
+		if f.Field != OrderByName && f.Field != OrderByUploadedAt {
+			return nil, fmt.Errorf("%w: unknown field %q, want %s or %s", ErrInvalidOrderBy, f.Field, OrderByName, OrderByUploadedAt)
+		}
+		if seen[f.Field] {
+			return nil, fmt.Errorf("%w: %s is given twice", ErrInvalidOrderBy, f.Field)
+		}
+		seen[f.Field] = true
+
+		if len(words) == 2 {
+			switch strings.ToLower(words[1]) {
+			case "asc":
+			case "desc":
+				f.Desc = true
+			default:
+				return nil, fmt.Errorf("%w: direction %q, want asc or desc", ErrInvalidOrderBy, words[1])
+			}
+		}
+		order = append(order, f)
+	}
+	return order, nil
+}
+
+// String returns the order in canonical form, e.g. "uploaded_at desc, name".
+func (o FunctionOrder) String() string {
+	parts := make([]string, 0, len(o))
+	for _, f := range o {
+		if f.Desc {
+			parts = append(parts, f.Field+" desc")
+		} else {
+			parts = append(parts, f.Field)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Compare returns a negative number when a sorts before b, a positive number
+// when after and 0 only for functions with the same name.
+func (o FunctionOrder) Compare(a, b *Function) int {
+	for _, f := range o {
+		var c int
+		switch f.Field {
+		case OrderByName:
+			c = strings.Compare(string(a.Name), string(b.Name))
+		case OrderByUploadedAt:
+			c = a.UploadedAt.Compare(b.UploadedAt)
+		}
+		if f.Desc {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return strings.Compare(string(a.Name), string(b.Name))
+}
@@ -3,12 +3,24 @@ package funcdomain
 import (
 	"context"
 	"io"
+	"time"
+
+	taskdomain "github.com/10Narratives/faas/internal/domains/tasks"
+	"github.com/google/uuid"
 )
 
 type FunctionUploader interface {
 	UploadFunction(ctx context.Context, args *UploadFunctionArgs) (*UploadFunctionResult, error)
 }
 
+// ResumableUploader uploads a bundle through an upload session.
+type ResumableUploader interface {
+	StartUpload(ctx context.Context, args *StartUploadArgs) (*UploadSessionResult, error)
+	WriteUploadChunk(ctx context.Context, args *WriteUploadChunkArgs) (*UploadSessionResult, error)
+	GetUploadSession(ctx context.Context, args *GetUploadSessionArgs) (*UploadSessionResult, error)
+	FinalizeUpload(ctx context.Context, args *FinalizeUploadArgs) (*UploadFunctionResult, error)
+}
+
 type FunctionGetter interface {
 	GetFunction(ctx context.Context, args *GetFunctionArgs) (*GetFunctionResult, error)
 }
@@ -17,27 +29,126 @@ type FunctionLister interface {
 	ListFunctions(ctx context.Context, args *ListFunctionsArgs) (*ListFunctionsResult, error)
 }
 
+type FunctionUpdater interface {
+	UpdateFunction(ctx context.Context, args *UpdateFunctionArgs) (*UpdateFunctionResult, error)
+}
+
 type FunctionDeleter interface {
 	DeleteFunction(ctx context.Context, args *DeleteFunctionArgs) error
 }
 
+// FunctionUndeleter restores a soft-deleted function before it is purged.
+type FunctionUndeleter interface {
+	UndeleteFunction(ctx context.Context, args *UndeleteFunctionArgs) (*UndeleteFunctionResult, error)
+}
+
+type FunctionDownloader interface {
+	DownloadFunction(ctx context.Context, args *DownloadFunctionArgs) (*DownloadFunctionResult, error)
+}
+
+type UsageGetter interface {
+	GetNamespaceUsage(ctx context.Context, args *GetNamespaceUsageArgs) (*GetNamespaceUsageResult, error)
+}
+
+type FunctionRevisionLister interface {
+	ListFunctionRevisions(ctx context.Context, args *ListFunctionRevisionsArgs) (*ListFunctionRevisionsResult, error)
+}
+
+type AliasUpdater interface {
+	UpdateAlias(ctx context.Context, args *UpdateAliasArgs) (*UpdateAliasResult, error)
+}
+
+type AliasGetter interface {
+	GetAlias(ctx context.Context, args *GetAliasArgs) (*GetAliasResult, error)
+}
+
+type AliasLister interface {
+	ListAliases(ctx context.Context, args *ListAliasesArgs) (*ListAliasesResult, error)
+}
+
+type AliasDeleter interface {
+	DeleteAlias(ctx context.Context, args *DeleteAliasArgs) error
+}
+
 type FunctionExecutor interface {
 	ExecuteFunction(ctx context.Context, args *ExecuteFunctionArgs) (*ExecuteFunctionResult, error)
 }
 
+type FunctionInvoker interface {
+	InvokeFunction(ctx context.Context, args *InvokeFunctionArgs) (*InvokeFunctionResult, error)
+}
+
+type FunctionBatchExecutor interface {
+	BatchExecuteFunction(ctx context.Context, args *BatchExecuteFunctionArgs) (*BatchExecuteFunctionResult, error)
+}
+
+type FunctionBuilder interface {
+	BuildFunction(ctx context.Context, args *BuildFunctionArgs) error
+}
+
+type BuildPublisher interface {
+	PublishBuild(ctx context.Context, msg *BuildFunctionMessage) error
+}
+
 type UploadFunctionArgs struct {
 	Name        FunctionName
 	DisplayName string
+	Description string
+	Labels      map[string]string
+	Annotations map[string]string
+	Timeout     time.Duration
+	MemoryBytes uint64
+	Runtime     string
 	Format      UploadFunctionFormat
-	Data        io.ReadCloser
+	Env         map[string]string
+	SecretEnv   map[string]string
+	// SHA256 is the digest declared by the client; the stored bundle must
+	// match it.
+	SHA256 string
+	Data   io.ReadCloser
 }
 
 type UploadFunctionResult struct {
 	Function *Function
 }
 
+// StartUploadArgs carries the same metadata as UploadFunctionArgs; Data and
+// SHA256 are ignored, the digest is declared on finalize.
+type StartUploadArgs struct {
+	Upload *UploadFunctionArgs
+	// Size is the expected bundle size, 0 if unknown.
+	Size uint64
+}
+
+// WriteUploadChunkArgs appends Data at Offset, which must equal the
+// committed offset of the session.
+type WriteUploadChunkArgs struct {
+	Name   UploadSessionName
+	Offset uint64
+	Data   []byte
+	// CRC32C is the Castagnoli checksum of Data.
+	CRC32C uint32
+}
+
+type GetUploadSessionArgs struct {
+	Name UploadSessionName
+}
+
+type UploadSessionResult struct {
+	Session *UploadSession
+}
+
+type FinalizeUploadArgs struct {
+	Name   UploadSessionName
+	SHA256 string
+}
+
 type GetFunctionArgs struct {
 	Name FunctionName
+	// Revision selects a revision; 0 means the latest.
+	Revision uint64
+	// ShowDeleted also returns soft-deleted functions.
+	ShowDeleted bool
 }
 
 type GetFunctionResult struct {
@@ -47,6 +158,12 @@ type GetFunctionResult struct {
 type ListFunctionsArgs struct {
 	PageSize  int32
 	PageToken string
+	// Filter and OrderBy are optional; page tokens are only valid with the
+	// filter and order they were issued for.
+	Filter  *FunctionFilter
+	OrderBy FunctionOrder
+	// ShowDeleted includes soft-deleted functions.
+	ShowDeleted bool
 }
 
 type ListFunctionsResult struct {
@@ -54,15 +171,180 @@ type ListFunctionsResult struct {
 	NextPageToken string
 }
 
+type ListFunctionRevisionsArgs struct {
+	Name        FunctionName
+	PageSize    int32
+	PageToken   string
+	ShowDeleted bool
+}
+
+// ListFunctionRevisionsResult lists revisions newest first.
+type ListFunctionRevisionsResult struct {
+	Revisions     []*Function
+	NextPageToken string
+}
+
+// UpdateFunctionArgs updates the latest revision. Only the fields named in
+// Paths are copied from Function; ETag, when set, must match the stored one.
+type UpdateFunctionArgs struct {
+	Name     FunctionName
+	Function *Function
+	Paths    []string
+	ETag     uint64
+}
+
+type UpdateFunctionResult struct {
+	Function *Function
+}
+
 type DeleteFunctionArgs struct {
 	Name FunctionName
+	// Force deletes a function with pending or processing tasks and cancels
+	// them; otherwise such a delete fails with ErrFunctionHasTasks.
+	Force bool
+}
+
+type UndeleteFunctionArgs struct {
+	Name FunctionName
+}
+
+type UndeleteFunctionResult struct {
+	Function *Function
+}
+
+type DownloadFunctionArgs struct {
+	Name FunctionName
+	// Revision selects a revision; 0 means the latest.
+	Revision uint64
+}
+
+// DownloadFunctionResult holds the open source bundle; the caller must close
+// Data.
+type DownloadFunctionResult struct {
+	Function *Function
+	Data     io.ReadCloser
+}
+
+type GetNamespaceUsageArgs struct {
+	// Namespace defaults to DefaultNamespace.
+	Namespace string
+}
+
+type GetNamespaceUsageResult struct {
+	Usage *NamespaceUsage
 }
 
 type ExecuteFunctionArgs struct {
-	Name       FunctionName
-	Parameters string
+	Name FunctionName
+	// Revision pins the revision to run; 0 means the latest.
+	Revision uint64
+	// Alias resolves the revision through a function alias instead.
+	Alias       string
+	Parameters  string
+	Labels      map[string]string
+	Annotations map[string]string
+	// InheritLabels lists function label keys copied to the task; "*"
+	// copies all of them. Labels take precedence.
+	InheritLabels []string
+	// Inputs optionally streams input files stored with the task.
+	Inputs taskdomain.TaskInputIterator
 }
 
 type ExecuteFunctionResult struct {
 	TaskName string
 }
+
+// InvokeFunctionArgs executes like ExecuteFunctionArgs, then waits for the
+// task to end within the context deadline and the server's limit.
+type InvokeFunctionArgs struct {
+	ExecuteFunctionArgs
+}
+
+// InvokeFunctionResult holds the task as last seen; Done is false if it was
+// still pending or processing when the wait ended. If the caller went away
+// first, Task holds only the name.
+type InvokeFunctionResult struct {
+	Task *taskdomain.Task
+	Done bool
+}
+
+// BatchExecuteFunctionArgs executes like ExecuteFunctionArgs once per item,
+// all on the same revision; its Parameters and Inputs are not used.
+type BatchExecuteFunctionArgs struct {
+	ExecuteFunctionArgs
+	// FailureThreshold is the fraction of tasks that may fail without
+	// failing the batch's job.
+	FailureThreshold float64
+	Items            BatchItemIterator
+	// Report receives the outcome of each item, in order. An error from it
+	// ends the batch.
+	Report func(*BatchItemResult) error
+}
+
+// BatchItemIterator yields the items of a batch. Next returns io.EOF after
+// the last item.
+type BatchItemIterator interface {
+	Next() (*BatchItem, error)
+}
+
+type BatchItem struct {
+	Parameters string
+}
+
+type BatchItemResult struct {
+	BatchID string
+	// Index is the item's position in the batch, from 0.
+	Index    uint64
+	TaskName string
+	// Err rejects this item only, e.g. parameters failing the schema.
+	Err error
+}
+
+type BatchExecuteFunctionResult struct {
+	BatchID  string
+	Created  uint64
+	Rejected uint64
+}
+
+type BuildFunctionArgs struct {
+	Name     FunctionName
+	Revision uint64
+	BuildID  uuid.UUID
+}
+
+type BuildFunctionMessage struct {
+	FunctionName FunctionName `json:"function_name"`
+	Revision     uint64       `json:"revision,omitempty"`
+	BuildID      uuid.UUID    `json:"build_id"`
+}
+
+// UpdateAliasArgs creates the alias or replaces its routes.
+type UpdateAliasArgs struct {
+	Alias *FunctionAlias
+}
+
+type UpdateAliasResult struct {
+	Alias *FunctionAlias
+}
+
+type GetAliasArgs struct {
+	Function FunctionName
+	Name     string
+}
+
+type GetAliasResult struct {
+	Alias *FunctionAlias
+}
+
+type ListAliasesArgs struct {
+	Function FunctionName
+}
+
+type ListAliasesResult struct {
+	Aliases []*FunctionAlias
+}
+
+type DeleteAliasArgs struct {
+	Function FunctionName
+	Name     string
+}
@@ -0,0 +1,352 @@
+package funcdomain
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ManifestFile is where the manifest is expected inside a bundle.
+const ManifestFile = "manifest.yaml"
+
+// MaxManifestSize bounds how much of the manifest file is read.
+const MaxManifestSize = 64 << 10
+
+const (
+	ManifestVersion1   = 1
+	MaxRetryAttempts   = 10
+	MaxRetryBackoff    = 15 * time.Minute
+	maxManifestNameLen = 63
+)
+
+// Manifest is the validated function configuration from a bundle.
+type Manifest struct {
+	Version     int
+	Name        string
+	Runtime     string
+	Entrypoint  string
+	Handler     string
+	Timeout     time.Duration
+	MemoryBytes uint64
+	Env         map[string]string
+	Retry       *RetryPolicy
+	// ParametersSchema is the parameter schema converted to JSON.
+	ParametersSchema json.RawMessage
+	// OutputSchema and OutputContentType describe what a successful
+	// execution writes to stdout.
+	OutputSchema      json.RawMessage
+	OutputContentType string
+}
+
+// RetryPolicy controls how often a failed execution is attempted.
+// MaxAttempts counts the first attempt too.
+type RetryPolicy struct {
+	MaxAttempts int           `json:"max_attempts"`
+	Backoff     time.Duration `json:"backoff,omitempty"`
+}
+
+// Delay is the wait before the attempt following the given one: Backoff,
+// doubled for every attempt after the first, at most MaxRetryBackoff.
+func (p *RetryPolicy) Delay(attempt int) time.Duration {
+	d := p.Backoff
+	for i := 1; i < attempt && d < MaxRetryBackoff; i++ {
+		d *= 2
+	}
+	return min(d, MaxRetryBackoff)
+}
+
+// FieldViolation describes one invalid manifest field.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// ManifestError lists every problem found in a manifest.
+type ManifestError struct {
+	Violations []FieldViolation
+}
+
+func (e *ManifestError) Error() string {
+	parts := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		if v.Field == "" {
+			parts = append(parts, v.Description)
+			continue
+		}
+		parts = append(parts, v.Field+": "+v.Description)
+	}
+	return fmt.Sprintf("%s: %s", ErrInvalidManifest, strings.Join(parts, "; "))
+}
+
+func (e *ManifestError) Unwrap() error {
+	return ErrInvalidManifest
+}
+
+func (e *ManifestError) add(field, format string, args ...any) {
+	e.Violations = append(e.Violations, FieldViolation{Field: field, Description: fmt.Sprintf(format, args...)})
+}
+
+// manifestFile is the on-disk format. Unknown fields are rejected.
+type manifestFile struct {
+	Version    int               `yaml:"version"`
+	Name       string            `yaml:"name"`
+	Runtime    string            `yaml:"runtime"`
+	Entrypoint string            `yaml:"entrypoint"`
+	Handler    string            `yaml:"handler"`
+	Timeout    string            `yaml:"timeout"`
+	Resources  manifestResources `yaml:"resources"`
+	Env        map[string]string `yaml:"env"`
+	Retry      *manifestRetry    `yaml:"retry"`
+	Parameters any               `yaml:"parameters"`
+	Output     *manifestOutput   `yaml:"output"`
+	// Upload is read by the CLI only.
+	Upload struct {
+		SourceDir string `yaml:"source_dir"`
+	} `yaml:"upload"`
+}
+
+type manifestResources struct {
+	Memory string `yaml:"memory"`
+}
+
+type manifestOutput struct {
+	ContentType string `yaml:"content_type"`
+	Schema      any    `yaml:"schema"`
+}
+
+type manifestRetry struct {
+	MaxAttempts int    `yaml:"max_attempts"`
+	Backoff     string `yaml:"backoff"`
+}
+
+var (
+	manifestNamePattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+	handlerPattern      = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.:]*$`)
+)
+
+// ParseManifest decodes and validates a manifest. Validation problems are
+// reported together as a *ManifestError.
+func ParseManifest(data []byte) (*Manifest, error) {
+	var mf manifestFile
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&mf); err != nil {
+		me := &ManifestError{}
+		if errors.Is(err, io.EOF) {
+			me.add("", "manifest is empty")
+		} else {
+			me.add("", "%s", strings.TrimPrefix(err.Error(), "yaml: "))
+		}
+		return nil, me
+	}
+
+	me := &ManifestError{}
+	m := &Manifest{
+		Version:    mf.Version,
+		Name:       mf.Name,
+		Runtime:    mf.Runtime,
+		Entrypoint: mf.Entrypoint,
+		Handler:    mf.Handler,
+		Env:        mf.Env,
+	}
+
+	switch mf.Version {
+	case 0:
+		me.add("version", "is required")
+	case ManifestVersion1:
+	default:
+		me.add("version", "unsupported version %d, want %d", mf.Version, ManifestVersion1)
+	}
+
+	switch {
+	case mf.Name == "":
+		me.add("name", "is required")
+	case len(mf.Name) > maxManifestNameLen || !manifestNamePattern.MatchString(mf.Name):
+		me.add("name", "must be lowercase letters, digits and dashes, at most %d characters", maxManifestNameLen)
+	}
+
+	switch {
+	case mf.Runtime == "":
+		me.add("runtime", "is required")
+	case !runtimePattern.MatchString(mf.Runtime):
+		me.add("runtime", "invalid runtime %q", mf.Runtime)
+	}
+
+	switch {
+	case mf.Entrypoint == "":
+		me.add("entrypoint", "is required")
+	case path.IsAbs(mf.Entrypoint) || path.Clean(mf.Entrypoint) != mf.Entrypoint || strings.HasPrefix(mf.Entrypoint, ".."):
+		me.add("entrypoint", "must be a clean path relative to the bundle root")
+	}
+
+	if mf.Handler != "" && !handlerPattern.MatchString(mf.Handler) {
+		me.add("handler", "invalid handler %q", mf.Handler)
+	}
+
+	if mf.Timeout != "" {
+		d, err := time.ParseDuration(mf.Timeout)
+		switch {
+		case err != nil:
+			me.add("timeout", "invalid duration %q", mf.Timeout)
+		case d <= 0 || d > MaxFunctionTimeout:
+			me.add("timeout", "must be between 0 and %s", MaxFunctionTimeout)
+		default:
+			m.Timeout = d
+		}
+	}
+
+	if mf.Resources.Memory != "" {
+		n, err := ParseMemory(mf.Resources.Memory)
+		if err != nil {
+			me.add("resources.memory", "%v", err)
+		}
+		m.MemoryBytes = n
+	}
+
+	for k := range mf.Env {
+		if err := validateEnvName(k); err != nil {
+			me.add("env."+k, "%s", strings.TrimPrefix(err.Error(), ErrInvalidEnv.Error()+": "))
+		}
+	}
+
+	if r := mf.Retry; r != nil {
+		m.Retry = &RetryPolicy{MaxAttempts: r.MaxAttempts}
+		if r.MaxAttempts < 1 || r.MaxAttempts > MaxRetryAttempts {
+			me.add("retry.max_attempts", "must be between 1 and %d", MaxRetryAttempts)
+		}
+		if r.Backoff != "" {
+			d, err := time.ParseDuration(r.Backoff)
+			switch {
+			case err != nil:
+				me.add("retry.backoff", "invalid duration %q", r.Backoff)
+			case d < 0 || d > MaxRetryBackoff:
+				me.add("retry.backoff", "must be between 0 and %s", MaxRetryBackoff)
+			}
+			m.Retry.Backoff = d
+		}
+	}
+
+	if mf.Parameters != nil {
+		schema, err := schemaToJSON(mf.Parameters)
+		if err == nil {
+			_, err = CompileSchema(schema)
+		}
+		if err != nil {
+			me.add("parameters", "%v", err)
+		}
+		m.ParametersSchema = schema
+	}
+
+	if o := mf.Output; o != nil {
+		m.OutputContentType = o.ContentType
+		if o.ContentType != "" {
+			if _, _, err := mime.ParseMediaType(o.ContentType); err != nil {
+				me.add("output.content_type", "invalid media type %q", o.ContentType)
+			}
+		}
+		if o.Schema != nil {
+			schema, err := schemaToJSON(o.Schema)
+			if err == nil {
+				_, err = CompileSchema(schema)
+			}
+			switch {
+			case err != nil:
+				me.add("output.schema", "%v", err)
+			case o.ContentType != "" && !IsJSONContentType(o.ContentType):
+				me.add("output.schema", "needs a JSON content_type, got %q", o.ContentType)
+			}
+			m.OutputSchema = schema
+		}
+	}
+
+	if len(me.Violations) > 0 {
+		return nil, me
+	}
+	return m, nil
+}
+
+// CheckBundle validates the manifest against the bundle it came from: the
+// manifest name must match the function and the entrypoint must exist.
+func (m *Manifest) CheckBundle(name FunctionName, files map[string]struct{}) error {
+	me := &ManifestError{}
+	if short := strings.TrimPrefix(string(name), "functions/"); m.Name != short {
+		me.add("name", "%q does not match function %q", m.Name, name)
+	}
+	if _, ok := files[m.Entrypoint]; !ok {
+		me.add("entrypoint", "file %q is not in the bundle", m.Entrypoint)
+	}
+	if len(me.Violations) > 0 {
+		return me
+	}
+	return nil
+}
+
+// ParseMemory parses a byte count with an optional Ki, Mi or Gi suffix.
+func ParseMemory(s string) (uint64, error) {
+	mult := uint64(1)
+	num := s
+	for suffix, m := range map[string]uint64{"Ki": 1 << 10, "Mi": 1 << 20, "Gi": 1 << 30} {
+		if rest, ok := strings.CutSuffix(s, suffix); ok {
+			num, mult = rest, m
+			break
+		}
+	}
+
+	n, err := strconv.ParseUint(num, 10, 64)
+	if err != nil || n == 0 {
+		return 0, fmt.Errorf("invalid quantity %q, e.g. 128Mi", s)
+	}
+	return n * mult, nil
+}
+
+func schemaToJSON(v any) (json.RawMessage, error) {
+	if _, ok := v.(map[string]any); !ok {
+		return nil, errors.New("must be a mapping")
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("cannot be represented as JSON: %w", err)
+	}
+	return b, nil
+}
+
+// ApplyManifest fills the function from the manifest. Runtime, timeout,
+// memory and env set explicitly on upload take precedence.
+func (f *Function) ApplyManifest(m *Manifest) {
+	if f.Runtime == "" {
+		f.Runtime = m.Runtime
+	}
+	if f.Timeout == 0 {
+		f.Timeout = m.Timeout
+	}
+	if f.MemoryBytes == 0 {
+		f.MemoryBytes = m.MemoryBytes
+	}
+	if len(m.Env) > 0 {
+		env := make(map[string]string, len(m.Env)+len(f.Env))
+		for k, v := range m.Env {
+			env[k] = v
+		}
+		for k, v := range f.Env {
+			env[k] = v
+		}
+		f.Env = env
+	}
+	f.Entrypoint = m.Entrypoint
+	f.Handler = m.Handler
+	f.Retry = m.Retry
+	f.ParametersSchema = m.ParametersSchema
+	f.OutputSchema = m.OutputSchema
+	f.OutputContentType = m.OutputContentType
+}
@@ -1,22 +1,71 @@
 package funcdomain
 
 import (
+	"encoding/json"
 	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
+	labelutils "github.com/10Narratives/faas/pkg/labels"
 	"github.com/google/uuid"
 )
 
 type FunctionName string
 
+// DefaultNamespace owns functions whose name has no namespace segment.
+const DefaultNamespace = "default"
+
+// Namespace returns the first segment of a nested name such as
+// "functions/team-a/resize", or DefaultNamespace for "functions/resize".
+// Storage quotas are accounted per namespace.
+func (n FunctionName) Namespace() string {
+	ns, _, nested := strings.Cut(strings.TrimPrefix(string(n), "functions/"), "/")
+	if !nested || ns == "" {
+		return DefaultNamespace
+	}
+	return ns
+}
+
+// NamespaceUsage is the bundle storage used by a namespace: each distinct
+// bundle counts once, however many revisions share it. Zero limits mean
+// unlimited.
+type NamespaceUsage struct {
+	Namespace      string
+	BundleBytes    uint64
+	QuotaBytes     uint64
+	MaxBundleBytes uint64
+}
+
 func ParseFunctionName(s string) (FunctionName, error) {
 	const prefix = "functions/"
 	if len(s) <= len(prefix) || s[:len(prefix)] != prefix {
 		return "", fmt.Errorf("%w: %q", ErrInvalidName, s)
 	}
+	// "@" separates an alias in function references.
+	if strings.Contains(s, "@") {
+		return "", fmt.Errorf("%w: %q", ErrInvalidName, s)
+	}
 	return FunctionName(s), nil
 }
 
+// ParseFunctionRef parses "functions/foo" or "functions/foo@alias".
+func ParseFunctionRef(s string) (FunctionName, string, error) {
+	base, alias, hasAlias := strings.Cut(s, "@")
+
+	name, err := ParseFunctionName(base)
+	if err != nil {
+		return "", "", err
+	}
+	if !hasAlias {
+		return name, "", nil
+	}
+	if err := ValidateAliasName(alias); err != nil {
+		return "", "", err
+	}
+	return name, alias, nil
+}
+
 type UploadFunctionFormat string
 
 const (
@@ -28,13 +77,350 @@ type SourceBundle struct {
 	Bucket    string `json:"bucket"`
 	ObjectKey string `json:"object_key"`
 	Size      uint64 `json:"size"`
-	SHA256    string `json:"sha_256"`
+	// SHA256 is the lowercase hex digest of the stored object.
+	SHA256 string               `json:"sha_256"`
+	Format UploadFunctionFormat `json:"format,omitempty"`
+}
+
+// ArchiveFormat returns the bundle format, falling back to the object key
+// suffix for bundles stored before the format was recorded.
+func (b *SourceBundle) ArchiveFormat() UploadFunctionFormat {
+	if b.Format != "" {
+		return b.Format
+	}
+	if strings.HasSuffix(b.ObjectKey, "."+string(TarGZFormat)) {
+		return TarGZFormat
+	}
+	return ZipFormat
 }
 
+// Function is one immutable revision of a function. Uploading under an
+// existing name adds a revision; reads without a revision return the latest.
 type Function struct {
-	InternalID  uuid.UUID     `json:"internal_id"`
-	Name        FunctionName  `json:"name"`
-	DisplayName string        `json:"display_name"`
-	UploadedAt  time.Time     `json:"uploaded_at"`
-	Bundle      *SourceBundle `json:"bundle,omitzero"`
+	InternalID  uuid.UUID         `json:"internal_id"`
+	Name        FunctionName      `json:"name"`
+	Revision    uint64            `json:"revision"`
+	DisplayName string            `json:"display_name"`
+	Description string            `json:"description,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	// Annotations hold free-form metadata that is not used for selection.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Timeout overrides the agent's execution timeout when set, up to the
+	// agent's maximum.
+	Timeout time.Duration `json:"timeout,omitempty"`
+	// MemoryBytes limits the address space of the function process; 0
+	// leaves it unlimited.
+	MemoryBytes uint64 `json:"memory_bytes,omitempty"`
+	// Runtime names the language runtime, e.g. "python3.12".
+	Runtime string `json:"runtime,omitempty"`
+	// Entrypoint, Handler and Retry come from the bundle manifest and are
+	// fixed for the revision. Agents launch Entrypoint, or Handler within
+	// it, through the command configured for Runtime.
+	Entrypoint string       `json:"entrypoint,omitempty"`
+	Handler    string       `json:"handler,omitempty"`
+	Retry      *RetryPolicy `json:"retry,omitempty"`
+	// ParametersSchema, OutputSchema and OutputContentType come from the
+	// manifest and may be changed with UpdateFunction. Outputs are checked
+	// by agents; a mismatch fails the task.
+	ParametersSchema  json.RawMessage `json:"parameters_schema,omitempty"`
+	OutputSchema      json.RawMessage `json:"output_schema,omitempty"`
+	OutputContentType string          `json:"output_content_type,omitempty"`
+
+	UploadedAt time.Time         `json:"uploaded_at"`
+	Bundle     *SourceBundle     `json:"bundle,omitzero"`
+	Env        map[string]string `json:"env,omitempty"`
+	// SecretEnv maps an environment variable name to a secret name
+	// ("secrets/..."). Values are resolved by agents at execution time only.
+	SecretEnv map[string]string `json:"secret_env,omitempty"`
+	Build     *FunctionBuild    `json:"build,omitempty"`
+	// ETag is the storage revision of the latest record, used for
+	// optimistic concurrency on updates. It is not stored.
+	ETag uint64 `json:"-"`
+	// DeleteTime is set while the function is soft-deleted; it is purged
+	// together with its bundles after PurgeTime. Both apply to the function
+	// as a whole rather than to a revision.
+	DeleteTime time.Time `json:"delete_time,omitzero"`
+	PurgeTime  time.Time `json:"purge_time,omitzero"`
+}
+
+// IsDeleted reports whether the function is soft-deleted.
+func (f *Function) IsDeleted() bool {
+	return !f.DeleteTime.IsZero()
+}
+
+// BlobRelease reports what dropping a reference to a stored bundle
+// released.
+type BlobRelease struct {
+	// Namespace is set once the namespace no longer references the bundle,
+	// so its size no longer counts against the namespace quota.
+	Namespace bool
+	// Object is set once nothing references the bundle and its object must
+	// be deleted.
+	Object bool
+}
+
+// PurgeItem is an object a purged function still has to release: a source
+// bundle, which is reference-counted per namespace, or a build artifact.
+// An item with only Credit is namespace usage left to return after its
+// bundle was released.
+type PurgeItem struct {
+	Bundle   *SourceBundle `json:"bundle,omitempty"`
+	Artifact bool          `json:"artifact,omitempty"`
+	Credit   uint64        `json:"credit,omitempty"`
+}
+
+// IsReady reports whether the function has a built artifact to execute.
+func (f *Function) IsReady() bool {
+	return f.Build != nil && f.Build.State == BuildStateReady && f.Build.Artifact != nil
+}
+
+type BuildState int
+
+const (
+	BuildStateUnspecified BuildState = iota
+	BuildStateBuilding
+	BuildStateReady
+	BuildStateFailed
+)
+
+// FunctionBuild tracks the asynchronous build of an uploaded bundle. Only
+// Artifact is ever executed; the uploaded bundle is build input.
+type FunctionBuild struct {
+	ID           uuid.UUID     `json:"id"`
+	State        BuildState    `json:"state"`
+	StartedAt    time.Time     `json:"started_at"`
+	EndedAt      time.Time     `json:"ended_at"`
+	Artifact     *SourceBundle `json:"artifact,omitempty"`
+	Log          string        `json:"log,omitempty"`
+	ErrorMessage string        `json:"error_message,omitempty"`
+}
+
+func NewFunctionBuild() *FunctionBuild {
+	return &FunctionBuild{
+		ID:        uuid.New(),
+		State:     BuildStateBuilding,
+		StartedAt: time.Now().UTC(),
+	}
+}
+
+// ReservedEnvPrefix is used by the platform for variables injected into
+// every execution; user-defined variables cannot shadow them.
+const ReservedEnvPrefix = "FAAS_"
+
+var envNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func ValidateEnv(env, secretEnv map[string]string) error {
+	for k := range env {
+		if err := validateEnvName(k); err != nil {
+			return err
+		}
+	}
+	for k, ref := range secretEnv {
+		if err := validateEnvName(k); err != nil {
+			return err
+		}
+		if _, ok := env[k]; ok {
+			return fmt.Errorf("%w: %q is defined both as env and secret_env", ErrInvalidEnv, k)
+		}
+		if !strings.HasPrefix(ref, "secrets/") || len(ref) == len("secrets/") {
+			return fmt.Errorf("%w: %q must reference a secret name, got %q", ErrInvalidEnv, k, ref)
+		}
+	}
+	return nil
+}
+
+func validateEnvName(k string) error {
+	if !envNamePattern.MatchString(k) {
+		return fmt.Errorf("%w: invalid variable name %q", ErrInvalidEnv, k)
+	}
+	if strings.HasPrefix(strings.ToUpper(k), ReservedEnvPrefix) {
+		return fmt.Errorf("%w: variable %q uses reserved prefix %s", ErrInvalidEnv, k, ReservedEnvPrefix)
+	}
+	return nil
+}
+
+var aliasNamePattern = regexp.MustCompile(`^[a-z][a-z0-9-]{0,62}$`)
+
+func ValidateAliasName(alias string) error {
+	if !aliasNamePattern.MatchString(alias) {
+		return fmt.Errorf("%w: %q", ErrInvalidAlias, alias)
+	}
+	return nil
+}
+
+// AliasWeightTotal is what the route weights of an alias must add up to.
+const AliasWeightTotal = 100
+
+// AliasRoute sends Weight percent of the alias traffic to Revision.
+type AliasRoute struct {
+	Revision uint64 `json:"revision"`
+	Weight   uint32 `json:"weight"`
+}
+
+// FunctionAlias is a named pointer to one revision, or a weighted split
+// across two for canaries.
+type FunctionAlias struct {
+	Function  FunctionName `json:"function"`
+	Name      string       `json:"name"`
+	Routes    []AliasRoute `json:"routes"`
+	UpdatedAt time.Time    `json:"updated_at"`
+}
+
+// Normalize fills in the weight of a single route and validates the routes.
+func (a *FunctionAlias) Normalize() error {
+	if err := ValidateAliasName(a.Name); err != nil {
+		return err
+	}
+	if len(a.Routes) == 1 && a.Routes[0].Weight == 0 {
+		a.Routes[0].Weight = AliasWeightTotal
+	}
+
+	switch len(a.Routes) {
+	case 1, 2:
+	default:
+		return fmt.Errorf("%w: alias needs one or two routes, got %d", ErrInvalidAlias, len(a.Routes))
+	}
+
+	var total uint32
+	seen := make(map[uint64]struct{}, len(a.Routes))
+	for _, r := range a.Routes {
+		if r.Revision == 0 {
+			return fmt.Errorf("%w: route revision is required", ErrInvalidAlias)
+		}
+		if _, dup := seen[r.Revision]; dup {
+			return fmt.Errorf("%w: revision %d is routed twice", ErrInvalidAlias, r.Revision)
+		}
+		seen[r.Revision] = struct{}{}
+		total += r.Weight
+	}
+	if total != AliasWeightTotal {
+		return fmt.Errorf("%w: route weights add up to %d, want %d", ErrInvalidAlias, total, AliasWeightTotal)
+	}
+	return nil
+}
+
+// Pick returns the revision serving the request with the given roll, which
+// must be in [0, AliasWeightTotal).
+func (a *FunctionAlias) Pick(roll uint32) uint64 {
+	for _, r := range a.Routes {
+		if roll < r.Weight {
+			return r.Revision
+		}
+		roll -= r.Weight
+	}
+	return a.Routes[len(a.Routes)-1].Revision
+}
+
+const (
+	MaxDisplayNameLength = 128
+	MaxDescriptionLength = 2048
+	MaxFunctionTimeout   = time.Hour
+)
+
+var (
+	runtimePattern = regexp.MustCompile(`^[a-z][a-z0-9.+-]{0,63}$`)
+)
+
+// ValidateMetadata checks the user-editable fields of a function.
+func (f *Function) ValidateMetadata() error {
+	if len(f.DisplayName) > MaxDisplayNameLength {
+		return fmt.Errorf("%w: display_name is longer than %d bytes", ErrInvalidMetadata, MaxDisplayNameLength)
+	}
+	if len(f.Description) > MaxDescriptionLength {
+		return fmt.Errorf("%w: description is longer than %d bytes", ErrInvalidMetadata, MaxDescriptionLength)
+	}
+	if err := ValidateLabels(f.Labels); err != nil {
+		return err
+	}
+	if err := ValidateAnnotations(f.Annotations); err != nil {
+		return err
+	}
+	if f.Timeout < 0 || f.Timeout > MaxFunctionTimeout {
+		return fmt.Errorf("%w: timeout must be between 0 and %s", ErrInvalidMetadata, MaxFunctionTimeout)
+	}
+	if f.Runtime != "" && !runtimePattern.MatchString(f.Runtime) {
+		return fmt.Errorf("%w: invalid runtime %q", ErrInvalidMetadata, f.Runtime)
+	}
+	if err := validateSchema("parameters_schema", f.ParametersSchema); err != nil {
+		return err
+	}
+	if err := validateOutputContract(f.OutputContentType, f.OutputSchema); err != nil {
+		return err
+	}
+	return ValidateEnv(f.Env, f.SecretEnv)
+}
+
+func ValidateLabels(labels map[string]string) error {
+	return metadataErr(labelutils.Validate(labels), labelutils.ErrInvalidLabels)
+}
+
+func ValidateAnnotations(annotations map[string]string) error {
+	return metadataErr(labelutils.ValidateAnnotations(annotations), labelutils.ErrInvalidAnnotations)
+}
+
+// metadataErr reports a validation error from labelutils as
+// ErrInvalidMetadata.
+func metadataErr(err, sentinel error) error {
+	if err == nil {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrInvalidMetadata, strings.TrimPrefix(err.Error(), sentinel.Error()+": "))
+}
+
+// Update mask paths accepted by UpdateFunction.
+const (
+	FieldDisplayName = "display_name"
+	FieldDescription = "description"
+	FieldLabels      = "labels"
+	FieldAnnotations = "annotations"
+	FieldTimeout     = "timeout"
+	FieldMemoryBytes = "memory_bytes"
+	FieldRuntime     = "runtime"
+	FieldEnv         = "env"
+	FieldSecretEnv   = "secret_env"
+	// FieldParametersSchema replaces the schema from the manifest; an empty
+	// schema accepts any parameters.
+	FieldParametersSchema = "parameters_schema"
+	FieldOutputSchema     = "output_schema"
+	// FieldOutputContentType set to "" leaves outputs untyped unless there
+	// is an output schema.
+	FieldOutputContentType = "output_content_type"
+)
+
+// ApplyUpdate copies the fields named by paths from src into f.
+func (f *Function) ApplyUpdate(src *Function, paths []string) error {
+	if len(paths) == 0 {
+		return fmt.Errorf("%w: update_mask is required", ErrInvalidArgument)
+	}
+	for _, p := range paths {
+		switch p {
+		case FieldDisplayName:
+			f.DisplayName = src.DisplayName
+		case FieldDescription:
+			f.Description = src.Description
+		case FieldLabels:
+			f.Labels = src.Labels
+		case FieldAnnotations:
+			f.Annotations = src.Annotations
+		case FieldTimeout:
+			f.Timeout = src.Timeout
+		case FieldMemoryBytes:
+			f.MemoryBytes = src.MemoryBytes
+		case FieldRuntime:
+			f.Runtime = src.Runtime
+		case FieldEnv:
+			f.Env = src.Env
+		case FieldSecretEnv:
+			f.SecretEnv = src.SecretEnv
+		case FieldParametersSchema:
+			f.ParametersSchema = src.ParametersSchema
+		case FieldOutputSchema:
+			f.OutputSchema = src.OutputSchema
+		case FieldOutputContentType:
+			f.OutputContentType = src.OutputContentType
+		default:
+			return fmt.Errorf("%w: field %q cannot be updated", ErrInvalidArgument, p)
+		}
+	}
+	return nil
 }
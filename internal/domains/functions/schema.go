@@ -0,0 +1,251 @@
+package funcdomain
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"strconv"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// MaxSchemaSize bounds a parameters or output schema set with
+// UpdateFunction; one from a manifest is bounded by MaxManifestSize.
+const MaxSchemaSize = MaxManifestSize
+
+// schemaURL names the schema being compiled. References to any other
+// document fail: a schema must be self-contained.
+const schemaURL = "mem:///schema.json"
+
+// ParametersError lists every way execution parameters violate the
+// function's schema. Fields are paths into the parameters, e.g.
+// "parameters.items[1].size".
+type ParametersError struct {
+	Violations []FieldViolation
+}
+
+func (e *ParametersError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrInvalidParameters, joinViolations(e.Violations))
+}
+
+func (e *ParametersError) Unwrap() error {
+	return ErrInvalidParameters
+}
+
+// OutputError lists every way a successful output violates the function's
+// output schema. Fields are paths into the output, e.g. "output.total".
+type OutputError struct {
+	Violations []FieldViolation
+}
+
+func (e *OutputError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrOutputContractViolation, joinViolations(e.Violations))
+}
+
+func (e *OutputError) Unwrap() error {
+	return ErrOutputContractViolation
+}
+
+func joinViolations(violations []FieldViolation) string {
+	parts := make([]string, 0, len(violations))
+	for _, v := range violations {
+		parts = append(parts, v.Field+": "+v.Description)
+	}
+	return strings.Join(parts, "; ")
+}
+
+// Schema is a compiled JSON Schema for execution parameters or outputs.
+// Schemas without "$schema" are read as draft 2020-12; "format" is an
+// annotation only.
+type Schema struct {
+	schema *jsonschema.Schema
+}
+
+// CompileSchema compiles a schema. A nil or empty schema yields nil, which
+// accepts any document.
+func CompileSchema(raw json.RawMessage) (*Schema, error) {
+	if len(bytes.TrimSpace(raw)) == 0 {
+		return nil, nil
+	}
+
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("is not valid JSON: %w", err)
+	}
+	if _, ok := doc.(map[string]any); !ok {
+		return nil, errors.New("must be a JSON object")
+	}
+
+	c := jsonschema.NewCompiler()
+	c.DefaultDraft(jsonschema.Draft2020)
+	c.UseLoader(noLoader{})
+	if err := c.AddResource(schemaURL, doc); err != nil {
+		return nil, err
+	}
+	schema, err := c.Compile(schemaURL)
+	if err != nil {
+		return nil, errors.New(strings.ReplaceAll(err.Error(), schemaURL, "schema"))
+	}
+	return &Schema{schema: schema}, nil
+}
+
+// validateSchema checks that raw, the value of field, is a usable schema.
+func validateSchema(field string, raw json.RawMessage) error {
+	if len(raw) > MaxSchemaSize {
+		return fmt.Errorf("%w: %s is larger than %d bytes", ErrInvalidMetadata, field, MaxSchemaSize)
+	}
+	if _, err := CompileSchema(raw); err != nil {
+		return fmt.Errorf("%w: %s %v", ErrInvalidMetadata, field, err)
+	}
+	return nil
+}
+
+// check decodes data and validates it. Violations are reported with field
+// paths starting at root.
+func (s *Schema) check(data, root string) []FieldViolation {
+	doc, err := jsonschema.UnmarshalJSON(strings.NewReader(data))
+	if err != nil {
+		return []FieldViolation{{Field: root, Description: "is not valid JSON: " + err.Error()}}
+	}
+	if s == nil {
+		return nil
+	}
+
+	var ve *jsonschema.ValidationError
+	if err := s.schema.Validate(doc); !errors.As(err, &ve) {
+		return nil
+	}
+	var violations []FieldViolation
+	collectViolations(&violations, ve, doc, root)
+	return violations
+}
+
+var schemaPrinter = message.NewPrinter(language.English)
+
+// collectViolations adds a violation for every leaf of the error tree: the
+// inner nodes only say that a subschema failed.
+func collectViolations(violations *[]FieldViolation, ve *jsonschema.ValidationError, doc any, root string) {
+	if len(ve.Causes) > 0 {
+		for _, c := range ve.Causes {
+			collectViolations(violations, c, doc, root)
+		}
+		return
+	}
+	*violations = append(*violations, FieldViolation{
+		Field:       fieldPath(doc, root, ve.InstanceLocation),
+		Description: ve.ErrorKind.LocalizedString(schemaPrinter),
+	})
+}
+
+// fieldPath renders an instance location as a field path, using the
+// document to tell array indexes from object keys.
+func fieldPath(doc any, root string, location []string) string {
+	var sb strings.Builder
+	sb.WriteString(root)
+	for _, tok := range location {
+		switch v := doc.(type) {
+		case []any:
+			sb.WriteString("[" + tok + "]")
+			if i, err := strconv.Atoi(tok); err == nil && i >= 0 && i < len(v) {
+				doc = v[i]
+			} else {
+				doc = nil
+			}
+		case map[string]any:
+			sb.WriteString("." + tok)
+			doc = v[tok]
+		default:
+			sb.WriteString("." + tok)
+			doc = nil
+		}
+	}
+	return sb.String()
+}
+
+type noLoader struct{}
+
+func (noLoader) Load(url string) (any, error) {
+	return nil, fmt.Errorf("cannot load %s: references must stay within the schema", url)
+}
+
+// ValidateParameters checks parameters, a JSON document, against the
+// function's schema. Empty parameters are validated as an empty object, so
+// a schema with only optional properties accepts a call without any.
+// Violations are reported together as a *ParametersError.
+func (f *Function) ValidateParameters(params string) error {
+	schema, err := CompileSchema(f.ParametersSchema)
+	if err != nil {
+		return fmt.Errorf("%w: parameters_schema %v", ErrInvalidMetadata, err)
+	}
+	if strings.TrimSpace(params) == "" {
+		params = "{}"
+	}
+	if v := schema.check(params, "parameters"); len(v) > 0 {
+		return &ParametersError{Violations: v}
+	}
+	return nil
+}
+
+// DefaultOutputContentType is assumed for outputs of functions that
+// declare an output schema but no content type.
+const DefaultOutputContentType = "application/json"
+
+// IsJSONContentType reports whether ct is application/json or a +json
+// type such as application/problem+json.
+func IsJSONContentType(ct string) bool {
+	mt, _, err := mime.ParseMediaType(ct)
+	if err != nil {
+		return false
+	}
+	return mt == "application/json" || strings.HasSuffix(mt, "+json")
+}
+
+// OutputType returns the declared content type of outputs, or "" if the
+// function declares neither a type nor a schema.
+func (f *Function) OutputType() string {
+	if f.OutputContentType != "" {
+		return f.OutputContentType
+	}
+	if len(f.OutputSchema) > 0 {
+		return DefaultOutputContentType
+	}
+	return ""
+}
+
+// ValidateOutput checks the output of a successful execution against the
+// declared content type and schema. Violations are reported together as
+// an *OutputError.
+func (f *Function) ValidateOutput(out []byte) error {
+	ct := f.OutputType()
+	if ct == "" || !IsJSONContentType(ct) {
+		return nil
+	}
+
+	schema, err := CompileSchema(f.OutputSchema)
+	if err != nil {
+		return fmt.Errorf("%w: output_schema %v", ErrInvalidMetadata, err)
+	}
+	if v := schema.check(string(out), "output"); len(v) > 0 {
+		return &OutputError{Violations: v}
+	}
+	return nil
+}
+
+// validateOutputContract checks the declared content type and schema
+// together: a schema only applies to JSON outputs.
+func validateOutputContract(ct string, schema json.RawMessage) error {
+	if ct != "" {
+		if _, _, err := mime.ParseMediaType(ct); err != nil {
+			return fmt.Errorf("%w: invalid output_content_type %q", ErrInvalidMetadata, ct)
+		}
+		if len(schema) > 0 && !IsJSONContentType(ct) {
+			return fmt.Errorf("%w: output_schema needs a JSON output_content_type, got %q", ErrInvalidMetadata, ct)
+		}
+	}
+	return validateSchema("output_schema", schema)
+}
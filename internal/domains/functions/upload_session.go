@@ -0,0 +1,102 @@
+package funcdomain
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UploadSessionName is "uploads/<uuid>".
+type UploadSessionName string
+
+func ParseUploadSessionName(s string) (UploadSessionName, uuid.UUID, error) {
+	const prefix = "uploads/"
+	rest, ok := strings.CutPrefix(s, prefix)
+	if !ok {
+		return "", uuid.Nil, fmt.Errorf("%w: %q", ErrInvalidUploadSession, s)
+	}
+	id, err := uuid.Parse(rest)
+	if err != nil {
+		return "", uuid.Nil, fmt.Errorf("%w: %q", ErrInvalidUploadSession, s)
+	}
+	return UploadSessionName(s), id, nil
+}
+
+func NewUploadSessionName(id uuid.UUID) UploadSessionName {
+	return UploadSessionName("uploads/" + id.String())
+}
+
+// UploadSession collects a bundle in parts so an interrupted upload resumes
+// from CommittedOffset instead of starting over. Finalizing it runs a
+// regular upload over the concatenated parts.
+type UploadSession struct {
+	ID       uuid.UUID    `json:"id"`
+	Function FunctionName `json:"function"`
+
+	DisplayName string               `json:"display_name,omitempty"`
+	Description string               `json:"description,omitempty"`
+	Labels      map[string]string    `json:"labels,omitempty"`
+	Annotations map[string]string    `json:"annotations,omitempty"`
+	Timeout     time.Duration        `json:"timeout,omitempty"`
+	MemoryBytes uint64               `json:"memory_bytes,omitempty"`
+	Runtime     string               `json:"runtime,omitempty"`
+	Format      UploadFunctionFormat `json:"format"`
+	Env         map[string]string    `json:"env,omitempty"`
+	SecretEnv   map[string]string    `json:"secret_env,omitempty"`
+
+	// Size is the bundle size declared when the session started; 0 if the
+	// client did not know it.
+	Size            uint64       `json:"size,omitempty"`
+	CommittedOffset uint64       `json:"committed_offset"`
+	Parts           []UploadPart `json:"parts,omitempty"`
+	// Finalizing is set while the session is being turned into a revision,
+	// since FinalizeStartedAt. A finalize still unfinished after the
+	// finalize timeout is presumed dead, e.g. its gateway crashed, and may
+	// be started again.
+	Finalizing        bool      `json:"finalizing,omitempty"`
+	FinalizeStartedAt time.Time `json:"finalize_started_at"`
+	// Revision is the revision the session was finalized into; finalizing
+	// again returns it instead of uploading another one.
+	Revision uint64 `json:"revision,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// ETag is the storage revision the session was read at.
+	ETag uint64 `json:"-"`
+}
+
+// UploadPart is one stored chunk of an upload session.
+type UploadPart struct {
+	ID     uuid.UUID `json:"id"`
+	Offset uint64    `json:"offset"`
+	Size   uint64    `json:"size"`
+}
+
+func (s *UploadSession) Name() UploadSessionName {
+	return NewUploadSessionName(s.ID)
+}
+
+// UploadArgs returns the arguments of the regular upload the session
+// finalizes into.
+func (s *UploadSession) UploadArgs(sha256 string, data io.ReadCloser) *UploadFunctionArgs {
+	return &UploadFunctionArgs{
+		Name:        s.Function,
+		DisplayName: s.DisplayName,
+		Description: s.Description,
+		Labels:      s.Labels,
+		Annotations: s.Annotations,
+		Timeout:     s.Timeout,
+		MemoryBytes: s.MemoryBytes,
+		Runtime:     s.Runtime,
+		Format:      s.Format,
+		Env:         s.Env,
+		SecretEnv:   s.SecretEnv,
+		SHA256:      sha256,
+		Data:        data,
+	}
+}
@@ -0,0 +1,10 @@
+package gcdomain
+
+import "errors"
+
+var (
+	ErrInvalidArgument = errors.New("invalid argument")
+	// ErrModified means a record changed after it was found dangling; it is
+	// left for the next collection.
+	ErrModified = errors.New("record modified since scan")
+)
@@ -0,0 +1,24 @@
+package gcdomain
+
+import (
+	"context"
+	"time"
+)
+
+type GarbageCollector interface {
+	CollectGarbage(ctx context.Context, args *CollectGarbageArgs) (*CollectGarbageResult, error)
+}
+
+type CollectGarbageArgs struct {
+	// Delete removes the orphans found; otherwise they are only reported.
+	Delete bool
+	// MinAge protects objects and records written recently, e.g. a bundle
+	// whose revision is still being created. 0 uses the configured default.
+	MinAge time.Duration
+}
+
+type CollectGarbageResult struct {
+	Orphans []*Orphan
+	Deleted int
+	Failed  int
+}
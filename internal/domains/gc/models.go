@@ -0,0 +1,63 @@
+package gcdomain
+
+import "time"
+
+// Stores scanned by the collector.
+const (
+	StoreFunctionObjects = "objects/functions"
+	StoreTaskObjects     = "objects/tasks"
+	StoreFunctionRecords = "kv/functions"
+)
+
+// Orphan kinds.
+const (
+	KindBundle     = "bundle"
+	KindArtifact   = "artifact"
+	KindUploadPart = "upload_part"
+	KindTaskFile   = "task_file"
+	// KindObject is an object whose key matches no known layout.
+	KindObject = "object"
+
+	KindFunctionRecord = "function_record"
+	KindRevisionRecord = "revision_record"
+	KindAliasRecord    = "alias_record"
+	KindBlobRecord     = "blob_record"
+)
+
+// MinMinAge is the smallest MinAge accepted: anything younger may belong to
+// an upload, build or execution in flight.
+const MinMinAge = 10 * time.Minute
+
+// Orphan is an object nothing references, or a record that references
+// something that no longer exists.
+type Orphan struct {
+	Kind   string
+	Store  string
+	Key    string
+	Size   uint64
+	Reason string
+	// UpdatedAt is when the object or record was last written.
+	UpdatedAt time.Time
+	// Revision is the KV revision of a record; only that version is
+	// deleted, so a record written again since the scan survives.
+	Revision uint64
+
+	Deleted bool
+	Error   string
+}
+
+// Object is an entry of an object store.
+type Object struct {
+	Key     string
+	Size    uint64
+	ModTime time.Time
+}
+
+// MetadataScan is what a scan of the function records found: every object
+// key they reference and the records that are dangling. Objects referenced
+// only by dangling records count as referenced; they are collected once
+// the records are gone.
+type MetadataScan struct {
+	Referenced map[string]bool
+	Dangling   []*Orphan
+}
@@ -0,0 +1,16 @@
+package jobdomain
+
+import "errors"
+
+var (
+	ErrInvalidName       = errors.New("invalid job name")
+	ErrNotFound          = errors.New("job not found")
+	ErrAlreadyExists     = errors.New("job already exists")
+	ErrInvalidThreshold  = errors.New("failure threshold must be between 0 and 1")
+	ErrJobCanceled       = errors.New("job canceled")
+	ErrJobAlreadyEnded   = errors.New("job already ended")
+	ErrJobModified       = errors.New("job was modified concurrently")
+	ErrEmptyPageSize     = errors.New("page size must be greater than 0")
+	ErrInvalidPageToken  = errors.New("invalid page token")
+	ErrInvalidParameters = errors.New("invalid job parameters")
+)
@@ -0,0 +1,114 @@
+package jobdomain
+
+import (
+	"context"
+
+	taskdomain "github.com/10Narratives/faas/internal/domains/tasks"
+)
+
+type JobCreator interface {
+	CreateJob(ctx context.Context, args *CreateJobArgs) (*CreateJobResult, error)
+}
+
+type CreateJobArgs struct {
+	// BatchID is shared by the job's tasks and names the job.
+	BatchID          string
+	Function         string
+	FailureThreshold float64
+}
+
+type CreateJobResult struct {
+	Job *Job
+}
+
+type JobTaskAdder interface {
+	AddJobTasks(ctx context.Context, args *AddJobTasksArgs) (*AddJobTasksResult, error)
+}
+
+// AddJobTasksArgs adds Count created tasks to Total; Seal marks the last
+// call of a batch.
+type AddJobTasksArgs struct {
+	Name  string
+	Count uint64
+	Seal  bool
+}
+
+type AddJobTasksResult struct {
+	Job *Job
+}
+
+type JobTaskRecorder interface {
+	RecordJobTask(ctx context.Context, args *RecordJobTaskArgs) error
+}
+
+// RecordJobTaskArgs reports that a task of the job ended in State.
+type RecordJobTaskArgs struct {
+	Name  string
+	State taskdomain.TaskState
+}
+
+type JobReconciler interface {
+	ReconcileJob(ctx context.Context, args *ReconcileJobArgs) (*ReconcileJobResult, error)
+}
+
+// ReconcileJobArgs replaces the counters of a job with Counted, recounted
+// from its tasks. It fails with ErrJobModified if the counters are no
+// longer Seen, the values read before the tasks were counted.
+type ReconcileJobArgs struct {
+	Name    string
+	Seen    JobCounts
+	Counted JobCounts
+}
+
+type ReconcileJobResult struct {
+	Job *Job
+}
+
+type JobGetter interface {
+	GetJob(ctx context.Context, args *GetJobArgs) (*GetJobResult, error)
+}
+
+type GetJobArgs struct {
+	Name string
+}
+
+type GetJobResult struct {
+	Job *Job
+}
+
+type JobLister interface {
+	ListJobs(ctx context.Context, args *ListJobsArgs) (*ListJobsResult, error)
+}
+
+type ListJobsArgs struct {
+	PageSize  int32
+	PageToken string
+}
+
+type ListJobsResult struct {
+	Jobs          []*Job
+	NextPageToken string
+}
+
+type JobWatcher interface {
+	WatchJob(ctx context.Context, args *WatchJobArgs) error
+}
+
+// WatchJobArgs calls Send with the current job and again on every change
+// until the job is done. An error from Send ends the watch.
+type WatchJobArgs struct {
+	Name string
+	Send func(*Job) error
+}
+
+type JobCanceler interface {
+	CancelJob(ctx context.Context, args *CancelJobArgs) (*CancelJobResult, error)
+}
+
+type CancelJobArgs struct {
+	Name string
+}
+
+type CancelJobResult struct {
+	Job *Job
+}
@@ -0,0 +1,135 @@
+package jobdomain
+
+import (
+	"fmt"
+	"time"
+
+	taskdomain "github.com/10Narratives/faas/internal/domains/tasks"
+)
+
+type JobState int
+
+const (
+	JobStateUnspecified JobState = iota
+	JobStateRunning
+	JobStateSucceeded
+	JobStateFailed
+	JobStateCanceled
+)
+
+// IsTerminal reports whether the job has an outcome. Tasks of a failed or
+// canceled job may still be ending; see Job.Done.
+func (s JobState) IsTerminal() bool {
+	switch s {
+	case JobStateSucceeded, JobStateFailed, JobStateCanceled:
+		return true
+	}
+	return false
+}
+
+type JobName string
+
+const namePrefix = "jobs/"
+
+func ParseJobName(s string) (JobName, error) {
+	if len(s) <= len(namePrefix) || s[:len(namePrefix)] != namePrefix {
+		return "", fmt.Errorf("%w: %q", ErrInvalidName, s)
+	}
+	return JobName(s), nil
+}
+
+// JobNameForBatch names the job grouping the tasks of a batch.
+func JobNameForBatch(batchID string) JobName {
+	return JobName(namePrefix + batchID)
+}
+
+// BatchID is the batch_id of the job's tasks.
+func (n JobName) BatchID() string {
+	return string(n)[len(namePrefix):]
+}
+
+// Job groups the tasks of one batch execution and counts how they ended.
+type Job struct {
+	Name      JobName   `json:"name"`
+	Function  string    `json:"function"`
+	State     JobState  `json:"state"`
+	CreatedAt time.Time `json:"created_at"`
+	EndedAt   time.Time `json:"ended_at"`
+	// FailureThreshold is the fraction of tasks that may fail without
+	// failing the job; with 0 the first failure fails it.
+	FailureThreshold float64 `json:"failure_threshold"`
+	// Sealed is set once all tasks are created; Total is final from then on.
+	Sealed    bool   `json:"sealed"`
+	Total     uint64 `json:"total"`
+	Succeeded uint64 `json:"succeeded"`
+	Failed    uint64 `json:"failed"`
+	Canceled  uint64 `json:"canceled"`
+}
+
+// JobCounts counts the ended tasks of a job by state.
+type JobCounts struct {
+	Succeeded uint64
+	Failed    uint64
+	Canceled  uint64
+}
+
+// Add counts a task that ended in state.
+func (c *JobCounts) Add(state taskdomain.TaskState) {
+	switch state {
+	case taskdomain.TaskStateSucceeded:
+		c.Succeeded++
+	case taskdomain.TaskStateFailed:
+		c.Failed++
+	case taskdomain.TaskStateCanceled:
+		c.Canceled++
+	}
+}
+
+func (j *Job) Counts() JobCounts {
+	return JobCounts{Succeeded: j.Succeeded, Failed: j.Failed, Canceled: j.Canceled}
+}
+
+func (j *Job) SetCounts(c JobCounts) {
+	j.Succeeded, j.Failed, j.Canceled = c.Succeeded, c.Failed, c.Canceled
+}
+
+// Active counts the tasks that are pending or processing.
+func (j *Job) Active() uint64 {
+	ended := j.Succeeded + j.Failed + j.Canceled
+	if ended >= j.Total {
+		return 0
+	}
+	return j.Total - ended
+}
+
+// Done reports whether neither the job nor any of its tasks will change.
+func (j *Job) Done() bool {
+	return j.State.IsTerminal() && j.Sealed && j.Active() == 0
+}
+
+// Record counts a task that ended in state and settles the job.
+func (j *Job) Record(state taskdomain.TaskState, now time.Time) {
+	c := j.Counts()
+	c.Add(state)
+	j.SetCounts(c)
+	j.Settle(now)
+}
+
+// Settle derives the state of a running, sealed job from its counters.
+// The job fails as soon as more than FailureThreshold of its tasks failed,
+// without waiting for the others, and succeeds once all of them ended
+// otherwise.
+func (j *Job) Settle(now time.Time) {
+	if j.State != JobStateRunning || !j.Sealed {
+		return
+	}
+	switch {
+	case float64(j.Failed) > j.FailureThreshold*float64(j.Total):
+		j.State = JobStateFailed
+	case j.Active() == 0:
+		j.State = JobStateSucceeded
+	default:
+		return
+	}
+	j.EndedAt = now
+}
@@ -0,0 +1,16 @@
+package scheddomain
+
+import "errors"
+
+var (
+	ErrInvalidName       = errors.New("invalid schedule name")
+	ErrNotFound          = errors.New("schedule not found")
+	ErrAlreadyExists     = errors.New("schedule already exists")
+	ErrInvalidCron       = errors.New("invalid cron expression")
+	ErrInvalidTimeZone   = errors.New("invalid time zone")
+	ErrInvalidFunction   = errors.New("invalid schedule function")
+	ErrInvalidPolicy     = errors.New("invalid missed run policy")
+	ErrInvalidUpdateMask = errors.New("invalid schedule update mask")
+	ErrInvalidPageToken  = errors.New("invalid page token")
+	ErrInvalidParameters = errors.New("invalid schedule parameters")
+)
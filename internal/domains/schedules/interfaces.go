@@ -0,0 +1,104 @@
+package scheddomain
+
+import (
+	"context"
+	"time"
+)
+
+type ScheduleCreator interface {
+	CreateSchedule(ctx context.Context, args *CreateScheduleArgs) (*CreateScheduleResult, error)
+}
+
+type CreateScheduleArgs struct {
+	Schedule *Schedule
+}
+
+type CreateScheduleResult struct {
+	Schedule *Schedule
+}
+
+type ScheduleGetter interface {
+	GetSchedule(ctx context.Context, args *GetScheduleArgs) (*GetScheduleResult, error)
+}
+
+type GetScheduleArgs struct {
+	Name string
+}
+
+type GetScheduleResult struct {
+	Schedule *Schedule
+}
+
+type ScheduleLister interface {
+	ListSchedules(ctx context.Context, args *ListSchedulesArgs) (*ListSchedulesResult, error)
+}
+
+type ListSchedulesArgs struct {
+	PageSize  int32
+	PageToken string
+}
+
+type ListSchedulesResult struct {
+	Schedules     []*Schedule
+	NextPageToken string
+}
+
+type ScheduleUpdater interface {
+	UpdateSchedule(ctx context.Context, args *UpdateScheduleArgs) (*UpdateScheduleResult, error)
+}
+
+// UpdateScheduleArgs copies the fields named by Paths from Schedule.
+type UpdateScheduleArgs struct {
+	Name     string
+	Schedule *Schedule
+	Paths    []string
+}
+
+type UpdateScheduleResult struct {
+	Schedule *Schedule
+}
+
+type SchedulePauser interface {
+	PauseSchedule(ctx context.Context, args *PauseScheduleArgs) (*PauseScheduleResult, error)
+}
+
+type PauseScheduleArgs struct {
+	Name string
+}
+
+type PauseScheduleResult struct {
+	Schedule *Schedule
+}
+
+type ScheduleResumer interface {
+	ResumeSchedule(ctx context.Context, args *ResumeScheduleArgs) (*ResumeScheduleResult, error)
+}
+
+// ResumeScheduleArgs resumes a paused schedule from the next slot; slots
+// that passed while it was paused are not runs.
+type ResumeScheduleArgs struct {
+	Name string
+}
+
+type ResumeScheduleResult struct {
+	Schedule *Schedule
+}
+
+type ScheduleDeleter interface {
+	DeleteSchedule(ctx context.Context, args *DeleteScheduleArgs) error
+}
+
+type DeleteScheduleArgs struct {
+	Name string
+}
+
+type ScheduleRunner interface {
+	RunDueSchedules(ctx context.Context, now time.Time) (*RunDueSchedulesResult, error)
+}
+
+// RunDueSchedulesResult counts the runs fired and those that could not
+// create a task.
+type RunDueSchedulesResult struct {
+	Runs   int
+	Failed int
+}
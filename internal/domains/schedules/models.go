@@ -0,0 +1,190 @@
+package scheddomain
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	"github.com/robfig/cron/v3"
+)
+
+var scheduleIDPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]{0,127}$`)
+
+type ScheduleName string
+
+const namePrefix = "schedules/"
+
+func ParseScheduleName(s string) (ScheduleName, error) {
+	if len(s) <= len(namePrefix) || s[:len(namePrefix)] != namePrefix {
+		return "", fmt.Errorf("%w: %q", ErrInvalidName, s)
+	}
+	if !scheduleIDPattern.MatchString(s[len(namePrefix):]) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidName, s)
+	}
+	return ScheduleName(s), nil
+}
+
+// MissedRunPolicy decides what happens to slots that passed while no
+// scheduler was running, for example during an outage.
+type MissedRunPolicy int
+
+const (
+	MissedRunPolicyUnspecified MissedRunPolicy = iota
+	// MissedRunSkip drops missed slots.
+	MissedRunSkip
+	// MissedRunOnce runs once for all missed slots together.
+	MissedRunOnce
+	// MissedRunCatchUp runs every missed slot.
+	MissedRunCatchUp
+)
+
+// Schedule executes a function with fixed parameters on a cron schedule.
+type Schedule struct {
+	Name ScheduleName `json:"name"`
+	// Cron is a standard five-field expression or a descriptor such as
+	// "@daily", evaluated in TimeZone.
+	Cron string `json:"cron"`
+	// TimeZone is an IANA name; empty means UTC.
+	TimeZone string `json:"time_zone,omitempty"`
+	// Function is a function name, optionally with "@alias".
+	Function        string          `json:"function"`
+	Parameters      string          `json:"parameters,omitempty"`
+	MissedRunPolicy MissedRunPolicy `json:"missed_run_policy"`
+	Paused          bool            `json:"paused"`
+	CreatedAt       time.Time       `json:"created_at"`
+	UpdatedAt       time.Time       `json:"updated_at"`
+	// LastRunTime is the slot of the last run, LastTask the task it created
+	// and LastError why it could not create one.
+	LastRunTime time.Time `json:"last_run_time"`
+	LastTask    string    `json:"last_task,omitempty"`
+	LastError   string    `json:"last_error,omitempty"`
+	// NextRunTime is the next slot to fire; zero while paused.
+	NextRunTime time.Time `json:"next_run_time"`
+}
+
+// Update mask paths accepted by UpdateSchedule.
+const (
+	FieldCron            = "cron"
+	FieldTimeZone        = "time_zone"
+	FieldFunction        = "function"
+	FieldParameters      = "parameters"
+	FieldMissedRunPolicy = "missed_run_policy"
+)
+
+// ApplyUpdate copies the fields named by paths from src into s. The
+// result is not validated; see Validate.
+func (s *Schedule) ApplyUpdate(src *Schedule, paths []string) error {
+	if len(paths) == 0 {
+		return fmt.Errorf("%w: update_mask is required", ErrInvalidUpdateMask)
+	}
+	for _, p := range paths {
+		switch p {
+		case FieldCron:
+			s.Cron = src.Cron
+		case FieldTimeZone:
+			s.TimeZone = src.TimeZone
+		case FieldFunction:
+			s.Function = src.Function
+		case FieldParameters:
+			s.Parameters = src.Parameters
+		case FieldMissedRunPolicy:
+			s.MissedRunPolicy = src.MissedRunPolicy
+		default:
+			return fmt.Errorf("%w: field %q cannot be updated", ErrInvalidUpdateMask, p)
+		}
+	}
+	return nil
+}
+
+// Validate checks the user-settable fields and that the schedule fires
+// at all.
+func (s *Schedule) Validate(now time.Time) error {
+	if _, err := ParseScheduleName(string(s.Name)); err != nil {
+		return err
+	}
+	if _, _, err := funcdomain.ParseFunctionRef(s.Function); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidFunction, err)
+	}
+	if s.Parameters != "" && !json.Valid([]byte(s.Parameters)) {
+		return fmt.Errorf("%w: parameters are not valid JSON", ErrInvalidParameters)
+	}
+	if s.MissedRunPolicy < MissedRunSkip || s.MissedRunPolicy > MissedRunCatchUp {
+		return ErrInvalidPolicy
+	}
+
+	spec, err := s.Spec()
+	if err != nil {
+		return err
+	}
+	if spec.Next(now).IsZero() {
+		return fmt.Errorf("%w: %q never fires", ErrInvalidCron, s.Cron)
+	}
+	return nil
+}
+
+// Spec parses Cron in TimeZone.
+func (s *Schedule) Spec() (cron.Schedule, error) {
+	loc := time.UTC
+	if s.TimeZone != "" {
+		var err error
+		if loc, err = time.LoadLocation(s.TimeZone); err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidTimeZone, s.TimeZone)
+		}
+	}
+
+	// The time zone is a field of its own; a prefix would bypass it.
+	expr := strings.TrimSpace(s.Cron)
+	if strings.HasPrefix(expr, "TZ=") || strings.HasPrefix(expr, "CRON_TZ=") {
+		return nil, fmt.Errorf("%w: set the time zone separately", ErrInvalidCron)
+	}
+
+	spec, err := cron.ParseStandard(expr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCron, err)
+	}
+	if ss, ok := spec.(*cron.SpecSchedule); ok {
+		ss.Location = loc
+	}
+	return spec, nil
+}
+
+// Plan returns the slots to run at now and the next slot after them. A
+// slot is on time until grace after it; older ones are missed and handled
+// by MissedRunPolicy. At most maxRuns slots are returned; with more due,
+// next is the first one left over.
+func (s *Schedule) Plan(now time.Time, grace time.Duration, maxRuns int) (runs []time.Time, next time.Time, err error) {
+	spec, err := s.Spec()
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	slot := s.NextRunTime
+	if s.Paused || slot.IsZero() || slot.After(now) {
+		return nil, slot, nil
+	}
+
+	missedBefore := now.Add(-grace)
+	if !slot.After(missedBefore) {
+		switch s.MissedRunPolicy {
+		case MissedRunCatchUp:
+			for !slot.IsZero() && !slot.After(missedBefore) && len(runs) < maxRuns {
+				runs = append(runs, slot)
+				slot = spec.Next(slot)
+			}
+		case MissedRunOnce:
+			runs = append(runs, slot)
+			slot = spec.Next(missedBefore)
+		default:
+			slot = spec.Next(missedBefore)
+		}
+	}
+
+	for !slot.IsZero() && !slot.After(now) && len(runs) < maxRuns {
+		runs = append(runs, slot)
+		slot = spec.Next(slot)
+	}
+	return runs, slot, nil
+}
@@ -0,0 +1,15 @@
+package secretdomain
+
+import "errors"
+
+var (
+	ErrSecretNotFound      = errors.New("secret not found")
+	ErrSecretAlreadyExists = errors.New("secret already exists")
+	ErrSecretInUse         = errors.New("secret is referenced by functions")
+	ErrInvalidArgument     = errors.New("invalid argument")
+	ErrInvalidName         = errors.New("invalid secret name")
+	ErrInvalidPageToken    = errors.New("invalid page token")
+	ErrEmptyValue          = errors.New("secret value is empty")
+	ErrValueTooLarge       = errors.New("secret value is too large")
+	ErrDecryptionFailed    = errors.New("secret decryption failed")
+)
@@ -0,0 +1,77 @@
+package secretdomain
+
+import "context"
+
+type SecretCreator interface {
+	CreateSecret(ctx context.Context, args *CreateSecretArgs) (*CreateSecretResult, error)
+}
+
+type SecretGetter interface {
+	GetSecret(ctx context.Context, args *GetSecretArgs) (*GetSecretResult, error)
+}
+
+type SecretLister interface {
+	ListSecrets(ctx context.Context, args *ListSecretsArgs) (*ListSecretsResult, error)
+}
+
+type SecretUpdater interface {
+	UpdateSecret(ctx context.Context, args *UpdateSecretArgs) (*UpdateSecretResult, error)
+}
+
+type SecretDeleter interface {
+	DeleteSecret(ctx context.Context, args *DeleteSecretArgs) error
+}
+
+// SecretResolver decrypts secret values. It is used by agents right before
+// execution and must not be exposed through any public API.
+type SecretResolver interface {
+	ResolveSecrets(ctx context.Context, args *ResolveSecretsArgs) (*ResolveSecretsResult, error)
+}
+
+type CreateSecretArgs struct {
+	Name  SecretName
+	Value []byte
+}
+
+type CreateSecretResult struct {
+	Secret *Secret
+}
+
+type GetSecretArgs struct {
+	Name SecretName
+}
+
+type GetSecretResult struct {
+	Secret *Secret
+}
+
+type ListSecretsArgs struct {
+	PageSize  int32
+	PageToken string
+}
+
+type ListSecretsResult struct {
+	Secrets       []*Secret
+	NextPageToken string
+}
+
+type UpdateSecretArgs struct {
+	Name  SecretName
+	Value []byte
+}
+
+type UpdateSecretResult struct {
+	Secret *Secret
+}
+
+type DeleteSecretArgs struct {
+	Name SecretName
+}
+
+type ResolveSecretsArgs struct {
+	Names []SecretName
+}
+
+type ResolveSecretsResult struct {
+	Values map[SecretName][]byte
+}
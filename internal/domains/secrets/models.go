@@ -0,0 +1,41 @@
+package secretdomain
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// MaxValueSize limits a single secret value; secrets are meant for
+// credentials and small config blobs, not for files.
+const MaxValueSize = 64 << 10
+
+var secretIDPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]{0,127}$`)
+
+type SecretName string
+
+func ParseSecretName(s string) (SecretName, error) {
+	const prefix = "secrets/"
+	if len(s) <= len(prefix) || s[:len(prefix)] != prefix {
+		return "", fmt.Errorf("%w: %q", ErrInvalidName, s)
+	}
+	if !secretIDPattern.MatchString(s[len(prefix):]) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidName, s)
+	}
+	return SecretName(s), nil
+}
+
+// Secret describes a stored secret. The value itself is never part of the
+// model: it is only accepted on write and only decrypted by Resolve.
+type Secret struct {
+	Name      SecretName `json:"name"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// EncryptedSecret is the storage representation of a secret.
+type EncryptedSecret struct {
+	Secret
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
@@ -11,6 +11,7 @@ var (
 	ErrInvalidFunction      = errors.New("invalid function name")
 	ErrTaskNotPending       = errors.New("task is not in pending state")
 	ErrTaskNotProcessing    = errors.New("task is not in processing state")
+	ErrTaskRunning          = errors.New("task is running on another agent")
 	ErrTaskAlreadyCompleted = errors.New("task already completed")
 	ErrCannotCancelTask     = errors.New("cannot cancel task in current state")
 	ErrEmptyPageSize        = errors.New("page size must be greater than 0")
@@ -18,4 +19,11 @@ var (
 	ErrResultAlreadySet     = errors.New("task result already set")
 	ErrInvalidResult        = errors.New("invalid task result")
 	ErrUnknownResultType    = errors.New("unknown result type")
+	ErrArtifactNotFound     = errors.New("task artifact not found")
+	ErrInvalidArtifactName  = errors.New("invalid task artifact name")
+	ErrDuplicateInput       = errors.New("duplicate task input")
+	ErrInputDigestMismatch  = errors.New("task input sha256 mismatch")
+	ErrInvalidFilter        = errors.New("invalid task filter")
+	ErrInvalidMetadata      = errors.New("invalid task metadata")
+	ErrInvalidUpdateMask    = errors.New("invalid task update mask")
 )
@@ -0,0 +1,131 @@
+package taskdomain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	filterutils "github.com/10Narratives/faas/pkg/filter"
+)
+
+// TaskFilter is a parsed AIP-160 style list filter over labels.<key>,
+// function, batch_id, state and created_at, e.g.
+//
+//	labels.env = "dev" AND state = pending
+//	function = "functions/img-*" created_at < "2024-01-01T00:00:00Z"
+//	batch_id = "0b5c..." AND state = failed
+//
+// function and batch_id accept = and != with a trailing "*" for prefixes,
+// state = and != with pending, processing, succeeded, failed or canceled,
+// and created_at all comparisons with RFC 3339 values.
+type TaskFilter struct {
+	terms []taskTerm
+}
+
+type taskTerm struct {
+	negate bool
+	match  func(*Task) bool
+}
+
+var taskStateNames = map[string]TaskState{
+	"pending":    TaskStatePending,
+	"processing": TaskStateProcessing,
+	"succeeded":  TaskStateSucceeded,
+	"failed":     TaskStateFailed,
+	"canceled":   TaskStateCanceled,
+}
+
+// ParseTaskFilter parses a list filter. An empty filter matches every task.
+func ParseTaskFilter(s string) (*TaskFilter, error) {
+	terms, err := filterutils.Parse(s)
+	if err != nil {
+		return nil, filterErr(err)
+	}
+
+	f := &TaskFilter{}
+	for _, t := range terms {
+		match, err := compileTaskTerm(t)
+		if err != nil {
+			return nil, filterErr(err)
+		}
+		f.terms = append(f.terms, taskTerm{negate: t.Negate, match: match})
+	}
+	return f, nil
+}
+
+// Match reports whether t satisfies every term. A nil filter matches
+// everything.
+func (f *TaskFilter) Match(t *Task) bool {
+	if f == nil {
+		return true
+	}
+	for _, term := range f.terms {
+		if term.match(t) == term.negate {
+			return false
+		}
+	}
+	return true
+}
+
+func compileTaskTerm(t filterutils.Term) (func(*Task) bool, error) {
+	if match, ok, err := filterutils.Labels(t); ok {
+		if err != nil {
+			return nil, err
+		}
+		return func(task *Task) bool { return match(task.Labels) }, nil
+	}
+
+	switch t.Field {
+	case "function":
+		if !strings.HasPrefix(t.Value, "functions/") {
+			t.Value = "functions/" + t.Value
+		}
+		match, err := filterutils.String(t)
+		if err != nil {
+			return nil, err
+		}
+		return func(task *Task) bool { return match(task.Function) }, nil
+
+	case "batch_id":
+		match, err := filterutils.String(t)
+		if err != nil {
+			return nil, err
+		}
+		return func(task *Task) bool { return match(task.BatchID) }, nil
+
+	case "state":
+		state, ok := taskStateNames[strings.ToLower(t.Value)]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown state %q", ErrInvalidFilter, t.Value)
+		}
+		switch t.Op {
+		case "=":
+			return func(task *Task) bool { return task.State == state }, nil
+		case "!=":
+			return func(task *Task) bool { return task.State != state }, nil
+		}
+		return nil, t.Unsupported()
+
+	case "created_at":
+		ts, err := time.Parse(time.RFC3339Nano, t.Value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: created_at wants an RFC 3339 timestamp, got %q", ErrInvalidFilter, t.Value)
+		}
+		cmp, err := filterutils.Compare(t)
+		if err != nil {
+			return nil, err
+		}
+		return func(task *Task) bool { return cmp(task.CreatedAt.Compare(ts)) }, nil
+	}
+
+	return nil, fmt.Errorf("%w: unknown field %q", ErrInvalidFilter, t.Field)
+}
+
+// filterErr reports parse errors as ErrInvalidFilter.
+func filterErr(err error) error {
+	if !errors.Is(err, filterutils.ErrInvalidFilter) {
+		return err
+	}
+	return fmt.Errorf("%w: %s", ErrInvalidFilter, strings.TrimPrefix(err.Error(), filterutils.ErrInvalidFilter.Error()+": "))
+}
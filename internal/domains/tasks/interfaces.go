@@ -2,6 +2,11 @@ package taskdomain
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 type TaskCreator interface {
@@ -9,8 +14,33 @@ type TaskCreator interface {
 }
 
 type CreateTaskArgs struct {
-	Function   string
-	Parameters string
+	// ID is generated by the repository unless preset; it is preset when
+	// inputs are stored before the task record.
+	ID               uuid.UUID
+	Function         string
+	FunctionRevision uint64
+	Parameters       string
+	Labels           map[string]string
+	Annotations      map[string]string
+	BatchID          string
+	// InputFiles streams input files to store with the task. It is consumed
+	// by the service, which records what was stored in Inputs.
+	InputFiles TaskInputIterator
+	Inputs     []TaskArtifact
+}
+
+// TaskInput is an input file streamed with an execution request.
+type TaskInput struct {
+	Name string
+	// SHA256 is optional; when set, the stored file must match it.
+	SHA256 string
+	Data   io.Reader
+}
+
+// TaskInputIterator yields input files one by one. Next returns io.EOF after
+// the last input. Data of an input is only valid until the next call to Next.
+type TaskInputIterator interface {
+	Next() (*TaskInput, error)
 }
 
 type CreateTaskResult struct {
@@ -49,6 +79,8 @@ type TaskLister interface {
 type ListTasksArgs struct {
 	PageSize  int32
 	PageToken string
+	// Filter is optional; a page may hold fewer than PageSize matches.
+	Filter *TaskFilter
 }
 
 type ListTaskResult struct {
@@ -56,6 +88,23 @@ type ListTaskResult struct {
 	NextPageToken string
 }
 
+type TaskUpdater interface {
+	UpdateTask(ctx context.Context, args *UpdateTaskArgs) (*UpdateTaskResult, error)
+}
+
+// UpdateTaskArgs copies the fields named by Paths, "labels" and
+// "annotations", from Labels and Annotations.
+type UpdateTaskArgs struct {
+	Name        string
+	Labels      map[string]string
+	Annotations map[string]string
+	Paths       []string
+}
+
+type UpdateTaskResult struct {
+	Task *Task
+}
+
 type TaskDeleter interface {
 	DeleteTask(ctx context.Context, args *DeleteTaskArgs) error
 }
@@ -75,3 +124,130 @@ type CancelTaskArgs struct {
 type CancelTaskResult struct {
 	Task *Task
 }
+
+type TaskWaiter interface {
+	WaitTask(ctx context.Context, args *WaitTaskArgs) (*WaitTaskResult, error)
+}
+
+// WaitTaskArgs waits at most Timeout for the task to end.
+type WaitTaskArgs struct {
+	Name    string
+	Timeout time.Duration
+}
+
+// WaitTaskResult holds the last state seen; Done is false if the task was
+// still pending or processing when the wait timed out.
+type WaitTaskResult struct {
+	Task *Task
+	Done bool
+}
+
+type TaskStarter interface {
+	StartTask(ctx context.Context, args *StartTaskArgs) (*StartTaskResult, error)
+}
+
+// StartTaskArgs starts a pending task. A processing task whose attempt was
+// last seen more than StaleAfter ago is taken over: the agent running it is
+// presumed gone. Zero never takes a task over.
+type StartTaskArgs struct {
+	Name       string
+	StaleAfter time.Duration
+}
+
+type StartTaskResult struct {
+	Task *Task
+}
+
+// TaskHeartbeater marks the current attempt of a processing task alive.
+type TaskHeartbeater interface {
+	HeartbeatTask(ctx context.Context, args *HeartbeatTaskArgs) error
+}
+
+// HeartbeatTaskArgs names the attempt being run; the heartbeat fails with
+// ErrTaskNotProcessing once the task ended or another attempt took over.
+type HeartbeatTaskArgs struct {
+	Name    string
+	Attempt int
+}
+
+// TaskRetrier puts a processing task back to pending after a failed
+// attempt, for the execute message to be redelivered.
+type TaskRetrier interface {
+	RetryTask(ctx context.Context, args *RetryTaskArgs) (*RetryTaskResult, error)
+}
+
+type RetryTaskArgs struct {
+	Name         string
+	ErrorMessage string
+}
+
+type RetryTaskResult struct {
+	Task *Task
+}
+
+// RetryError asks for the execute message of a task to be redelivered after
+// Delay: once the task is pending again, or to check on a task running
+// elsewhere.
+type RetryError struct {
+	Delay time.Duration
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("retry task in %s", e.Delay)
+}
+
+type TaskCompleter interface {
+	CompleteTask(ctx context.Context, args *CompleteTaskArgs) (*CompleteTaskResult, error)
+}
+
+type CompleteTaskArgs struct {
+	Name   string
+	Result TaskResult
+}
+
+type CompleteTaskResult struct {
+	Task *Task
+}
+
+type TaskArtifactSaver interface {
+	SaveTaskArtifact(ctx context.Context, args *SaveTaskArtifactArgs) (*SaveTaskArtifactResult, error)
+}
+
+type SaveTaskArtifactArgs struct {
+	Task        TaskName
+	Name        string
+	ContentType string
+	Data        io.Reader
+}
+
+type SaveTaskArtifactResult struct {
+	Artifact *TaskArtifact
+}
+
+type TaskArtifactLister interface {
+	ListTaskArtifacts(ctx context.Context, args *ListTaskArtifactsArgs) (*ListTaskArtifactsResult, error)
+}
+
+type ListTaskArtifactsArgs struct {
+	Name string
+}
+
+type ListTaskArtifactsResult struct {
+	Artifacts []TaskArtifact
+}
+
+type TaskArtifactDownloader interface {
+	DownloadTaskArtifact(ctx context.Context, args *DownloadTaskArtifactArgs) (*DownloadTaskArtifactResult, error)
+}
+
+type DownloadTaskArtifactArgs struct {
+	Name     string
+	Artifact string
+}
+
+// DownloadTaskArtifactResult holds an open artifact; the caller must close
+// Data.
+type DownloadTaskArtifactResult struct {
+	Artifact *TaskArtifact
+	Data     io.ReadCloser
+}
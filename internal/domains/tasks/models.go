@@ -2,8 +2,11 @@ package taskdomain
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
+	labelutils "github.com/10Narratives/faas/pkg/labels"
 	"github.com/google/uuid"
 )
 
@@ -18,6 +21,17 @@ const (
 	TaskStateCanceled
 )
 
+// IsTerminal reports whether a task in this state will never change state
+// again.
+func (s TaskState) IsTerminal() bool {
+	switch s {
+	case TaskStateSucceeded, TaskStateFailed, TaskStateCanceled:
+		return true
+	default:
+		return false
+	}
+}
+
 type TaskName string
 
 func ParseTaskName(s string) (TaskName, error) {
@@ -29,15 +43,77 @@ func ParseTaskName(s string) (TaskName, error) {
 }
 
 type Task struct {
-	ID         uuid.UUID   `json:"id"`
-	Name       TaskName    `json:"name"`
-	Function   string      `json:"function"`
-	Parameters string      `json:"parameters"`
-	State      TaskState   `json:"state"`
-	CreatedAt  time.Time   `json:"created_at"`
-	StartedAt  time.Time   `json:"started_at"`
-	EndedAt    time.Time   `json:"ended_at"`
-	Result     *TaskResult `json:"result,omitempty"`
+	ID       uuid.UUID `json:"id"`
+	Name     TaskName  `json:"name"`
+	Function string    `json:"function"`
+	// FunctionRevision is the revision the task runs; 0 for tasks created
+	// before revisions, which run the latest one.
+	FunctionRevision uint64      `json:"function_revision,omitempty"`
+	Parameters       string      `json:"parameters"`
+	State            TaskState   `json:"state"`
+	CreatedAt        time.Time   `json:"created_at"`
+	StartedAt        time.Time   `json:"started_at"`
+	EndedAt          time.Time   `json:"ended_at"`
+	Result           *TaskResult `json:"result,omitempty"`
+	// Inputs are files uploaded with the execution request; they share the
+	// task's lifetime.
+	Inputs      []TaskArtifact    `json:"inputs,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// BatchID is shared by the tasks created by one batch execution.
+	BatchID string `json:"batch_id,omitempty"`
+	// Attempt counts the executions started, including the current one.
+	// LastError is why the previous attempt failed when the function's
+	// retry policy asked for another.
+	Attempt   int    `json:"attempt,omitempty"`
+	LastError string `json:"last_error,omitempty"`
+	// HeartbeatAt is when the agent running the current attempt last
+	// reported it alive.
+	HeartbeatAt time.Time `json:"heartbeat_at"`
+}
+
+// LastSeen is when the current attempt was last known to be running.
+func (t *Task) LastSeen() time.Time {
+	if t.HeartbeatAt.After(t.StartedAt) {
+		return t.HeartbeatAt
+	}
+	return t.StartedAt
+}
+
+// Update mask paths accepted by UpdateTask.
+const (
+	FieldLabels      = "labels"
+	FieldAnnotations = "annotations"
+)
+
+// ApplyUpdate validates and copies the fields named by args.Paths into t.
+func (t *Task) ApplyUpdate(args *UpdateTaskArgs) error {
+	if len(args.Paths) == 0 {
+		return fmt.Errorf("%w: update_mask is required", ErrInvalidUpdateMask)
+	}
+	for _, p := range args.Paths {
+		switch p {
+		case FieldLabels:
+			if err := labelutils.Validate(args.Labels); err != nil {
+				return metadataErr(err, labelutils.ErrInvalidLabels)
+			}
+			t.Labels = args.Labels
+		case FieldAnnotations:
+			if err := labelutils.ValidateAnnotations(args.Annotations); err != nil {
+				return metadataErr(err, labelutils.ErrInvalidAnnotations)
+			}
+			t.Annotations = args.Annotations
+		default:
+			return fmt.Errorf("%w: field %q cannot be updated", ErrInvalidUpdateMask, p)
+		}
+	}
+	return nil
+}
+
+// metadataErr reports a validation error from labelutils as
+// ErrInvalidMetadata.
+func metadataErr(err, sentinel error) error {
+	return fmt.Errorf("%w: %s", ErrInvalidMetadata, strings.TrimPrefix(err.Error(), sentinel.Error()+": "))
 }
 
 type TaskResultType string
@@ -53,6 +129,49 @@ type TaskResult struct {
 	InlineResult []byte         `json:"inline_result,omitempty"`
 	ObjectKey    string         `json:"object_key,omitempty"`
 	ErrorMessage string         `json:"error_message,omitempty"`
+	// ContentType describes InlineResult: the function's declared type, or
+	// one sniffed from the output.
+	ContentType string         `json:"content_type,omitempty"`
+	Artifacts   []TaskArtifact `json:"artifacts,omitempty"`
+}
+
+// TaskArtifact is a file stored with a task: an input uploaded with the
+// execution request or a file the function wrote to its outputs directory.
+type TaskArtifact struct {
+	Name        string `json:"name"`
+	ObjectKey   string `json:"object_key"`
+	Size        uint64 `json:"size"`
+	SHA256      string `json:"sha_256"`
+	ContentType string `json:"content_type,omitempty"`
+}
+
+// ValidateArtifactName accepts slash-separated relative paths without empty,
+// "." or ".." segments.
+func ValidateArtifactName(name string) error {
+	if name == "" || len(name) > 512 || strings.HasPrefix(name, "/") {
+		return fmt.Errorf("%w: %q", ErrInvalidArtifactName, name)
+	}
+	for _, seg := range strings.Split(name, "/") {
+		if seg == "" || seg == "." || seg == ".." || !artifactSegmentRe.MatchString(seg) {
+			return fmt.Errorf("%w: %q", ErrInvalidArtifactName, name)
+		}
+	}
+	return nil
+}
+
+var artifactSegmentRe = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
+// Artifact returns the artifact with the given name.
+func (tr *TaskResult) Artifact(name string) (*TaskArtifact, bool) {
+	if tr == nil {
+		return nil, false
+	}
+	for i := range tr.Artifacts {
+		if tr.Artifacts[i].Name == name {
+			return &tr.Artifacts[i], true
+		}
+	}
+	return nil, false
 }
 
 func NewInlineResult(b []byte) TaskResult {
@@ -96,5 +215,22 @@ func (tr TaskResult) Validate() error {
 	default:
 		return fmt.Errorf("unknown kind: %q", tr.Type)
 	}
+
+	if tr.Type == TaskResultError && len(tr.Artifacts) > 0 {
+		return fmt.Errorf("failed result cannot have artifacts")
+	}
+	seen := make(map[string]struct{}, len(tr.Artifacts))
+	for _, a := range tr.Artifacts {
+		if err := ValidateArtifactName(a.Name); err != nil {
+			return err
+		}
+		if a.ObjectKey == "" {
+			return fmt.Errorf("artifact %q has no object_key", a.Name)
+		}
+		if _, dup := seen[a.Name]; dup {
+			return fmt.Errorf("duplicate artifact %q", a.Name)
+		}
+		seen[a.Name] = struct{}{}
+	}
 	return nil
 }
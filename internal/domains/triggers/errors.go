@@ -0,0 +1,21 @@
+package triggerdomain
+
+import "errors"
+
+var (
+	ErrInvalidName        = errors.New("invalid trigger name")
+	ErrNotFound           = errors.New("trigger not found")
+	ErrAlreadyExists      = errors.New("trigger already exists")
+	ErrInvalidSource      = errors.New("invalid trigger source")
+	ErrStreamNotFound     = errors.New("stream not found")
+	ErrConsumerNotFound   = errors.New("consumer not found")
+	ErrInvalidFunction    = errors.New("invalid trigger function")
+	ErrInvalidConcurrency = errors.New("invalid trigger concurrency")
+	ErrInvalidFilter      = errors.New("invalid trigger filter")
+	ErrInvalidUpdateMask  = errors.New("invalid trigger update mask")
+	ErrInvalidPageToken   = errors.New("invalid page token")
+	ErrInvalidParameters  = errors.New("invalid trigger parameters")
+	// ErrMessageRejected marks a message that can never become a task, for
+	// example because its payload does not match the function's schema.
+	ErrMessageRejected = errors.New("message rejected")
+)
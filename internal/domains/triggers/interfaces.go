@@ -0,0 +1,82 @@
+package triggerdomain
+
+import "context"
+
+type TriggerCreator interface {
+	CreateTrigger(ctx context.Context, args *CreateTriggerArgs) (*CreateTriggerResult, error)
+}
+
+type CreateTriggerArgs struct {
+	Trigger *Trigger
+}
+
+type CreateTriggerResult struct {
+	Trigger *Trigger
+}
+
+type TriggerGetter interface {
+	GetTrigger(ctx context.Context, args *GetTriggerArgs) (*GetTriggerResult, error)
+}
+
+type GetTriggerArgs struct {
+	Name string
+}
+
+type GetTriggerResult struct {
+	Trigger *Trigger
+}
+
+type TriggerLister interface {
+	ListTriggers(ctx context.Context, args *ListTriggersArgs) (*ListTriggersResult, error)
+}
+
+type ListTriggersArgs struct {
+	PageSize  int32
+	PageToken string
+}
+
+type ListTriggersResult struct {
+	Triggers      []*Trigger
+	NextPageToken string
+}
+
+type TriggerUpdater interface {
+	UpdateTrigger(ctx context.Context, args *UpdateTriggerArgs) (*UpdateTriggerResult, error)
+}
+
+// UpdateTriggerArgs copies the fields named by Paths from Trigger.
+type UpdateTriggerArgs struct {
+	Name    string
+	Trigger *Trigger
+	Paths   []string
+}
+
+type UpdateTriggerResult struct {
+	Trigger *Trigger
+}
+
+type TriggerDeleter interface {
+	DeleteTrigger(ctx context.Context, args *DeleteTriggerArgs) error
+}
+
+type DeleteTriggerArgs struct {
+	Name string
+}
+
+type TriggerFirer interface {
+	FireTrigger(ctx context.Context, args *FireTriggerArgs) (*FireTriggerResult, error)
+}
+
+// FireTriggerArgs turns Message into a task unless the trigger's filter
+// drops it.
+type FireTriggerArgs struct {
+	Trigger *Trigger
+	Message *Message
+}
+
+// FireTriggerResult names the task created; it is empty for a message the
+// filter dropped.
+type FireTriggerResult struct {
+	TaskName string
+	Filtered bool
+}
@@ -0,0 +1,265 @@
+package triggerdomain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	filterutils "github.com/10Narratives/faas/pkg/filter"
+)
+
+// MaxConcurrency bounds Trigger.Concurrency.
+const MaxConcurrency = 256
+
+var (
+	triggerIDPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]{0,127}$`)
+	// streamNamePattern follows the JetStream rules for stream and consumer
+	// names.
+	streamNamePattern = regexp.MustCompile(`^[^.*>/\\\s]{1,255}$`)
+)
+
+type TriggerName string
+
+const namePrefix = "triggers/"
+
+func ParseTriggerName(s string) (TriggerName, error) {
+	if len(s) <= len(namePrefix) || s[:len(namePrefix)] != namePrefix {
+		return "", fmt.Errorf("%w: %q", ErrInvalidName, s)
+	}
+	if !triggerIDPattern.MatchString(s[len(namePrefix):]) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidName, s)
+	}
+	return TriggerName(s), nil
+}
+
+// ID is the name without its collection prefix.
+func (n TriggerName) ID() string {
+	return string(n)[len(namePrefix):]
+}
+
+// Trigger turns NATS messages into tasks of a function. The source is
+// either a core NATS Subject, or a JetStream Stream read through Consumer.
+// Without Consumer the gateway manages a durable consumer of its own,
+// filtered by Subject when set.
+type Trigger struct {
+	Name TriggerName `json:"name"`
+	// Function is a function name, optionally with "@alias".
+	Function string `json:"function"`
+	Subject  string `json:"subject,omitempty"`
+	Stream   string `json:"stream,omitempty"`
+	Consumer string `json:"consumer,omitempty"`
+	// Filter selects the messages that become tasks; see ParseMessageFilter.
+	Filter string `json:"filter,omitempty"`
+	// Concurrency is how many messages are turned into tasks at once. For
+	// a stream it bounds the unacknowledged messages across all gateways.
+	Concurrency int       `json:"concurrency"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Update mask paths accepted by UpdateTrigger.
+const (
+	FieldFunction    = "function"
+	FieldSubject     = "subject"
+	FieldStream      = "stream"
+	FieldConsumer    = "consumer"
+	FieldFilter      = "filter"
+	FieldConcurrency = "concurrency"
+)
+
+// ApplyUpdate copies the fields named by paths from src into t. The
+// result is not validated; see Validate.
+func (t *Trigger) ApplyUpdate(src *Trigger, paths []string) error {
+	if len(paths) == 0 {
+		return fmt.Errorf("%w: update_mask is required", ErrInvalidUpdateMask)
+	}
+	for _, p := range paths {
+		switch p {
+		case FieldFunction:
+			t.Function = src.Function
+		case FieldSubject:
+			t.Subject = src.Subject
+		case FieldStream:
+			t.Stream = src.Stream
+		case FieldConsumer:
+			t.Consumer = src.Consumer
+		case FieldFilter:
+			t.Filter = src.Filter
+		case FieldConcurrency:
+			t.Concurrency = src.Concurrency
+		default:
+			return fmt.Errorf("%w: field %q cannot be updated", ErrInvalidUpdateMask, p)
+		}
+	}
+	return nil
+}
+
+// Validate checks the user-settable fields.
+func (t *Trigger) Validate() error {
+	if _, err := ParseTriggerName(string(t.Name)); err != nil {
+		return err
+	}
+	if _, _, err := funcdomain.ParseFunctionRef(t.Function); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidFunction, err)
+	}
+	if t.Concurrency < 1 || t.Concurrency > MaxConcurrency {
+		return fmt.Errorf("%w: must be between 1 and %d", ErrInvalidConcurrency, MaxConcurrency)
+	}
+	if _, err := ParseMessageFilter(t.Filter); err != nil {
+		return err
+	}
+
+	switch {
+	case t.Stream == "" && t.Consumer != "":
+		return fmt.Errorf("%w: consumer requires a stream", ErrInvalidSource)
+	case t.Stream == "" && t.Subject == "":
+		return fmt.Errorf("%w: subject or stream is required", ErrInvalidSource)
+	case t.Stream != "" && !streamNamePattern.MatchString(t.Stream):
+		return fmt.Errorf("%w: invalid stream name %q", ErrInvalidSource, t.Stream)
+	case t.Consumer != "" && !streamNamePattern.MatchString(t.Consumer):
+		return fmt.Errorf("%w: invalid consumer name %q", ErrInvalidSource, t.Consumer)
+	case t.Consumer != "" && t.Subject != "":
+		return fmt.Errorf("%w: a bound consumer has its own filter subject", ErrInvalidSource)
+	}
+	if t.Subject != "" {
+		return validateSubject(t.Subject)
+	}
+	return nil
+}
+
+// ManagedConsumer names the durable consumer the gateway creates for a
+// stream trigger without Consumer, or returns "" when there is none.
+func (t *Trigger) ManagedConsumer() string {
+	if t.Stream == "" || t.Consumer != "" {
+		return ""
+	}
+	return "faas-trigger-" + t.Name.ID()
+}
+
+// QueueGroup spreads the messages of a subject trigger over the gateways.
+func (t *Trigger) QueueGroup() string {
+	return "faas-trigger-" + t.Name.ID()
+}
+
+// validateSubject accepts subjects with "*" and a trailing ">" wildcard.
+// System subjects starting with "$" are refused.
+func validateSubject(s string) error {
+	if strings.HasPrefix(s, "$") {
+		return fmt.Errorf("%w: system subject %q", ErrInvalidSource, s)
+	}
+	tokens := strings.Split(s, ".")
+	for i, tok := range tokens {
+		switch {
+		case tok == "" || strings.ContainsAny(tok, " \t\r\n"):
+			return fmt.Errorf("%w: invalid subject %q", ErrInvalidSource, s)
+		case tok == ">" && i != len(tokens)-1:
+			return fmt.Errorf("%w: \">\" must be the last token of %q", ErrInvalidSource, s)
+		case tok != "*" && tok != ">" && strings.ContainsAny(tok, "*>"):
+			return fmt.Errorf("%w: wildcards must be whole tokens in %q", ErrInvalidSource, s)
+		}
+	}
+	return nil
+}
+
+// Message is a NATS message delivered to a trigger. Sequence is the stream
+// sequence, 0 for core NATS messages.
+type Message struct {
+	Subject  string
+	Headers  map[string][]string
+	Data     []byte
+	Sequence uint64
+}
+
+// MessageFilter is a parsed filter over subject and headers.<key>, e.g.
+//
+//	subject = "orders.eu.*" AND headers.Type = "created"
+//	headers.Priority:* NOT headers.Test = "true"
+//
+// subject accepts = and != with a trailing "*" for prefixes; headers.<key>
+// = and != against the first value, and :* for presence. Header keys are
+// case sensitive.
+type MessageFilter struct {
+	terms []messageTerm
+}
+
+type messageTerm struct {
+	negate bool
+	match  func(*Message) bool
+}
+
+// ParseMessageFilter parses a trigger filter. An empty filter matches
+// every message.
+func ParseMessageFilter(s string) (*MessageFilter, error) {
+	terms, err := filterutils.Parse(s)
+	if err != nil {
+		return nil, filterErr(err)
+	}
+
+	f := &MessageFilter{}
+	for _, t := range terms {
+		match, err := compileMessageTerm(t)
+		if err != nil {
+			return nil, filterErr(err)
+		}
+		f.terms = append(f.terms, messageTerm{negate: t.Negate, match: match})
+	}
+	return f, nil
+}
+
+// Match reports whether m satisfies every term. A nil filter matches
+// everything.
+func (f *MessageFilter) Match(m *Message) bool {
+	if f == nil {
+		return true
+	}
+	for _, term := range f.terms {
+		if term.match(m) == term.negate {
+			return false
+		}
+	}
+	return true
+}
+
+func compileMessageTerm(t filterutils.Term) (func(*Message) bool, error) {
+	// Headers compare like labels, on their first value.
+	if rest, ok := strings.CutPrefix(t.Field, "headers"); ok && (rest == "" || rest[0] == '.') {
+		lt := t
+		lt.Field = "labels" + rest
+		match, ok, err := filterutils.Labels(lt)
+		if err != nil || !ok {
+			return nil, t.Unsupported()
+		}
+		return func(m *Message) bool { return match(firstValues(m.Headers)) }, nil
+	}
+
+	if t.Field == "subject" {
+		match, err := filterutils.String(t)
+		if err != nil {
+			return nil, err
+		}
+		return func(m *Message) bool { return match(m.Subject) }, nil
+	}
+
+	return nil, fmt.Errorf("%w: unknown field %q", ErrInvalidFilter, t.Field)
+}
+
+func firstValues(h map[string][]string) map[string]string {
+	out := make(map[string]string, len(h))
+	for k, v := range h {
+		if len(v) > 0 {
+			out[k] = v[0]
+		}
+	}
+	return out
+}
+
+// filterErr reports parse errors as ErrInvalidFilter.
+func filterErr(err error) error {
+	if !errors.Is(err, filterutils.ErrInvalidFilter) {
+		return err
+	}
+	return fmt.Errorf("%w: %s", ErrInvalidFilter, strings.TrimPrefix(err.Error(), filterutils.ErrInvalidFilter.Error()+": "))
+}
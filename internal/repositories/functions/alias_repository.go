@@ -0,0 +1,130 @@
+package funcrepo
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"sort"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// Aliases share the functions bucket: "alias.<b64 function name>.<alias>".
+
+func (r *MetadataRepository) PutAlias(ctx context.Context, alias *funcdomain.FunctionAlias) error {
+	if alias == nil || alias.Function == "" || alias.Name == "" {
+		return funcdomain.ErrInvalidArgument
+	}
+
+	b, err := json.Marshal(alias)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.kv.Put(ctx, aliasKey(alias.Function, alias.Name), b)
+	return err
+}
+
+func (r *MetadataRepository) GetAlias(ctx context.Context, args *funcdomain.GetAliasArgs) (*funcdomain.GetAliasResult, error) {
+	if args == nil || args.Function == "" || args.Name == "" {
+		return nil, funcdomain.ErrInvalidArgument
+	}
+
+	alias, err := r.getAlias(ctx, aliasKey(args.Function, args.Name))
+	if err != nil {
+		return nil, err
+	}
+	return &funcdomain.GetAliasResult{Alias: alias}, nil
+}
+
+func (r *MetadataRepository) ListAliases(ctx context.Context, args *funcdomain.ListAliasesArgs) (*funcdomain.ListAliasesResult, error) {
+	if args == nil || args.Function == "" {
+		return nil, funcdomain.ErrInvalidArgument
+	}
+
+	keys, err := r.aliasKeys(ctx, args.Function)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*funcdomain.FunctionAlias, 0, len(keys))
+	for _, k := range keys {
+		alias, err := r.getAlias(ctx, k)
+		if err != nil {
+			if errors.Is(err, funcdomain.ErrAliasNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		out = append(out, alias)
+	}
+	return &funcdomain.ListAliasesResult{Aliases: out}, nil
+}
+
+func (r *MetadataRepository) DeleteAlias(ctx context.Context, args *funcdomain.DeleteAliasArgs) error {
+	if args == nil || args.Function == "" || args.Name == "" {
+		return funcdomain.ErrInvalidArgument
+	}
+
+	key := aliasKey(args.Function, args.Name)
+	if _, err := r.getAlias(ctx, key); err != nil {
+		return err
+	}
+	return r.kv.Delete(ctx, key)
+}
+
+func (r *MetadataRepository) deleteAliases(ctx context.Context, name funcdomain.FunctionName) error {
+	keys, err := r.aliasKeys(ctx, name)
+	if err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if err := r.kv.Delete(ctx, k); err != nil && !errors.Is(err, jetstream.ErrKeyNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *MetadataRepository) aliasKeys(ctx context.Context, name funcdomain.FunctionName) ([]string, error) {
+	keysLister, err := r.kv.ListKeysFiltered(ctx, aliasPrefix(name)+"*")
+	if err != nil {
+		if errors.Is(err, jetstream.ErrNoKeysFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	keys := make([]string, 0, 8)
+	for k := range keysLister.Keys() {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (r *MetadataRepository) getAlias(ctx context.Context, key string) (*funcdomain.FunctionAlias, error) {
+	e, err := r.kv.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			return nil, funcdomain.ErrAliasNotFound
+		}
+		return nil, err
+	}
+
+	var alias funcdomain.FunctionAlias
+	if err := json.Unmarshal(e.Value(), &alias); err != nil {
+		return nil, err
+	}
+	return &alias, nil
+}
+
+func aliasPrefix(name funcdomain.FunctionName) string {
+	return "alias." + base64.RawURLEncoding.EncodeToString([]byte(name)) + "."
+}
+
+func aliasKey(name funcdomain.FunctionName, alias string) string {
+	return aliasPrefix(name) + alias
+}
@@ -0,0 +1,171 @@
+package funcrepo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// Bundle blobs share the functions bucket: "blob.<sha256 hex>". The record
+// points at the object holding the content and counts the revisions that
+// reference it, per namespace.
+
+type storedBlob struct {
+	Bucket    string            `json:"bucket"`
+	ObjectKey string            `json:"object_key"`
+	Size      uint64            `json:"size"`
+	Refs      map[string]uint64 `json:"refs"`
+}
+
+func (b *storedBlob) total() uint64 {
+	var n uint64
+	for _, c := range b.Refs {
+		n += c
+	}
+	return n
+}
+
+func (b *storedBlob) bundle(digest string) *funcdomain.SourceBundle {
+	return &funcdomain.SourceBundle{
+		Bucket:    b.Bucket,
+		ObjectKey: b.ObjectKey,
+		Size:      b.Size,
+		SHA256:    digest,
+	}
+}
+
+// CreateBlob records a freshly stored bundle with one reference from
+// namespace. It fails with ErrBlobExists if the digest is already recorded.
+func (r *MetadataRepository) CreateBlob(ctx context.Context, namespace string, bundle *funcdomain.SourceBundle) error {
+	if bundle == nil || bundle.SHA256 == "" || bundle.ObjectKey == "" {
+		return funcdomain.ErrInvalidArgument
+	}
+
+	b, err := json.Marshal(storedBlob{
+		Bucket:    bundle.Bucket,
+		ObjectKey: bundle.ObjectKey,
+		Size:      bundle.Size,
+		Refs:      map[string]uint64{namespace: 1},
+	})
+	if err != nil {
+		return err
+	}
+
+	key := blobKey(bundle.SHA256)
+	_, err = r.kv.Create(ctx, key, b)
+	if !errors.Is(err, jetstream.ErrKeyExists) {
+		return err
+	}
+
+	// A record whose last reference is being dropped may be replaced.
+	e, err := r.kv.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	var existing storedBlob
+	if err := json.Unmarshal(e.Value(), &existing); err != nil {
+		return err
+	}
+	if existing.total() > 0 {
+		return funcdomain.ErrBlobExists
+	}
+	if _, err := r.kv.Update(ctx, key, b, e.Revision()); err != nil {
+		if errors.Is(err, jetstream.ErrKeyExists) {
+			return funcdomain.ErrBlobExists
+		}
+		return err
+	}
+	return nil
+}
+
+// RefBlob adds a reference from namespace to the stored bundle with the
+// given digest and reports whether it is the namespace's first. Unless
+// verified is set, i.e. the caller has just received and checked the
+// content itself, the namespace must already reference the blob: knowing a
+// digest is not enough to read another namespace's code.
+func (r *MetadataRepository) RefBlob(ctx context.Context, digest, namespace string, verified bool) (*funcdomain.SourceBundle, bool, error) {
+	var (
+		out   *funcdomain.SourceBundle
+		first bool
+	)
+	_, err := r.updateBlob(ctx, digest, func(b *storedBlob) error {
+		if b.total() == 0 || (!verified && b.Refs[namespace] == 0) {
+			return funcdomain.ErrBlobNotFound
+		}
+		first = b.Refs[namespace] == 0
+		b.Refs[namespace]++
+		out = b.bundle(digest)
+		return nil
+	})
+	return out, first, err
+}
+
+// UnrefBlob drops a reference from namespace and reports what it released.
+// Bundles the index does not know, e.g. ones stored before deduplication,
+// belong to the one revision alone.
+func (r *MetadataRepository) UnrefBlob(ctx context.Context, namespace string, bundle *funcdomain.SourceBundle) (funcdomain.BlobRelease, error) {
+	if bundle == nil {
+		return funcdomain.BlobRelease{}, funcdomain.ErrInvalidArgument
+	}
+
+	var out funcdomain.BlobRelease
+	rev, err := r.updateBlob(ctx, bundle.SHA256, func(b *storedBlob) error {
+		if b.ObjectKey != bundle.ObjectKey {
+			return funcdomain.ErrBlobNotFound
+		}
+		if b.Refs[namespace] > 0 {
+			b.Refs[namespace]--
+		}
+		out.Namespace = b.Refs[namespace] == 0
+		if out.Namespace {
+			delete(b.Refs, namespace)
+		}
+		out.Object = b.total() == 0
+		return nil
+	})
+	if errors.Is(err, funcdomain.ErrBlobNotFound) {
+		return funcdomain.BlobRelease{Namespace: true, Object: true}, nil
+	}
+	if err != nil {
+		return funcdomain.BlobRelease{}, err
+	}
+
+	// Only the unreferenced revision is deleted; if the digest was uploaded
+	// again meanwhile, the new record stays. The object is ours either way.
+	if out.Object {
+		err := r.kv.Delete(ctx, blobKey(bundle.SHA256), jetstream.LastRevision(rev))
+		if err != nil && !errors.Is(err, jetstream.ErrKeyNotFound) && !errors.Is(err, jetstream.ErrKeyExists) {
+			return funcdomain.BlobRelease{}, err
+		}
+	}
+	return out, nil
+}
+
+// updateBlob applies mutate with compare-and-swap and returns the KV
+// revision written.
+func (r *MetadataRepository) updateBlob(ctx context.Context, digest string, mutate func(b *storedBlob) error) (uint64, error) {
+	return r.casUpdate(ctx, blobKey(digest), func(e jetstream.KeyValueEntry) ([]byte, error) {
+		if e == nil {
+			return nil, funcdomain.ErrBlobNotFound
+		}
+
+		var b storedBlob
+		if err := json.Unmarshal(e.Value(), &b); err != nil {
+			return nil, err
+		}
+		if b.Refs == nil {
+			b.Refs = map[string]uint64{}
+		}
+		if err := mutate(&b); err != nil {
+			return nil, err
+		}
+		return json.Marshal(b)
+	})
+}
+
+func blobKey(digest string) string {
+	return "blob." + digest
+}
@@ -0,0 +1,257 @@
+package funcrepo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	gcdomain "github.com/10Narratives/faas/internal/domains/gc"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// ScanGarbage reads every record of the functions bucket. It returns the
+// object keys they reference and the dangling records last written before
+// cutoff:
+//   - heads pointing at a revision that does not exist,
+//   - revisions without a head, or newer than the head, which an
+//     interrupted CreateRevision leaves behind,
+//   - aliases without a head,
+//   - blob records no revision references any more.
+//
+// Tombstones are left to the purge that owns them. A record that cannot be
+// decoded fails the scan: what it references is unknown.
+func (r *MetadataRepository) ScanGarbage(ctx context.Context, cutoff time.Time) (*gcdomain.MetadataScan, error) {
+	keysLister, err := r.kv.ListKeys(ctx)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrNoKeysFound) {
+			return &gcdomain.MetadataScan{Referenced: map[string]bool{}}, nil
+		}
+		return nil, err
+	}
+
+	var headKeys, revKeys, aliasKeys, uploadKeys, blobKeys []string
+	for k := range keysLister.Keys() {
+		switch {
+		case strings.HasPrefix(k, headKeyPrefix):
+			headKeys = append(headKeys, k)
+		case strings.HasPrefix(k, "rev."):
+			revKeys = append(revKeys, k)
+		case strings.HasPrefix(k, "alias."):
+			aliasKeys = append(aliasKeys, k)
+		case strings.HasPrefix(k, uploadKeyPrefix):
+			uploadKeys = append(uploadKeys, k)
+		case strings.HasPrefix(k, "blob."):
+			blobKeys = append(blobKeys, k)
+		}
+	}
+
+	scan := &gcdomain.MetadataScan{Referenced: make(map[string]bool)}
+	// digests holds the bundle digests some revision or tombstone uses.
+	digests := make(map[string]bool)
+	ref := func(b *funcdomain.SourceBundle, artifact bool) {
+		if b == nil {
+			return
+		}
+		if b.ObjectKey != "" {
+			scan.Referenced[b.ObjectKey] = true
+		}
+		if !artifact && b.SHA256 != "" {
+			digests[b.SHA256] = true
+		}
+	}
+	refFunction := func(fn *funcdomain.Function) {
+		ref(fn.Bundle, false)
+		if fn.Build != nil {
+			ref(fn.Build.Artifact, true)
+		}
+	}
+	dangling := func(kind string, e jetstream.KeyValueEntry, reason string) {
+		if !e.Created().Before(cutoff) {
+			return
+		}
+		scan.Dangling = append(scan.Dangling, &gcdomain.Orphan{
+			Kind:      kind,
+			Store:     gcdomain.StoreFunctionRecords,
+			Key:       e.Key(),
+			Size:      uint64(len(e.Value())),
+			Reason:    reason,
+			UpdatedAt: e.Created(),
+			Revision:  e.Revision(),
+		})
+	}
+
+	// Heads by the encoded function name shared by all key families.
+	heads := make(map[string]*storedHead, len(headKeys))
+	pointers := make(map[string]jetstream.KeyValueEntry)
+	for _, k := range headKeys {
+		h, e, err := r.getHead(ctx, k)
+		if err != nil {
+			if errors.Is(err, funcdomain.ErrFunctionNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		id := strings.TrimPrefix(k, headKeyPrefix)
+
+		switch {
+		case h.Purge != nil:
+			for _, item := range h.Purge.Pending {
+				ref(item.Bundle, item.Artifact)
+			}
+		case !h.isPointer():
+			fn, err := decodeFunction(e)
+			if err != nil {
+				return nil, err
+			}
+			refFunction(fn)
+			h.Revision = fn.Revision
+		default:
+			pointers[id] = e
+		}
+		heads[id] = h
+	}
+
+	revisions := make(map[string]bool, len(revKeys))
+	for _, k := range revKeys {
+		id, n, ok := parseRevisionKey(k)
+		if !ok {
+			continue
+		}
+		fn, e, err := r.get(ctx, k)
+		if err != nil {
+			if errors.Is(err, funcdomain.ErrFunctionNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		refFunction(fn)
+		revisions[k] = true
+
+		h := heads[id]
+		switch {
+		case h == nil:
+			dangling(gcdomain.KindRevisionRecord, e, "function has no record")
+		case h.Purge == nil && n > h.Revision:
+			dangling(gcdomain.KindRevisionRecord, e, "newer than the latest revision "+strconv.FormatUint(h.Revision, 10))
+		}
+	}
+
+	for id, e := range pointers {
+		rev := heads[id].Revision
+		if !revisions["rev."+id+"."+strconv.FormatUint(rev, 10)] {
+			dangling(gcdomain.KindFunctionRecord, e, "latest revision "+strconv.FormatUint(rev, 10)+" does not exist")
+		}
+	}
+
+	for _, k := range aliasKeys {
+		id, _, _ := strings.Cut(strings.TrimPrefix(k, "alias."), ".")
+		if heads[id] != nil {
+			continue
+		}
+		e, err := r.kv.Get(ctx, k)
+		if err != nil {
+			if errors.Is(err, jetstream.ErrKeyNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		dangling(gcdomain.KindAliasRecord, e, "function has no record")
+	}
+
+	for _, k := range uploadKeys {
+		session, _, err := r.getUploadSession(ctx, k)
+		if err != nil {
+			if errors.Is(err, funcdomain.ErrUploadNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		for _, part := range session.Parts {
+			scan.Referenced[uploadPartKey(session.ID, part.ID)] = true
+		}
+	}
+
+	// Blob records are read last so that every digest in use is known. The
+	// object of a dangling blob stays referenced until the record is gone.
+	for _, k := range blobKeys {
+		e, err := r.kv.Get(ctx, k)
+		if err != nil {
+			if errors.Is(err, jetstream.ErrKeyNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		var b storedBlob
+		if err := json.Unmarshal(e.Value(), &b); err != nil {
+			return nil, err
+		}
+		scan.Referenced[b.ObjectKey] = true
+		if !digests[strings.TrimPrefix(k, "blob.")] {
+			dangling(gcdomain.KindBlobRecord, e, "no revision uses the bundle")
+		}
+	}
+
+	return scan, nil
+}
+
+// DeleteRecord removes a record found by ScanGarbage unless it was written
+// again since, in which case it yields ErrModified.
+func (r *MetadataRepository) DeleteRecord(ctx context.Context, key string, revision uint64) error {
+	if key == "" || revision == 0 {
+		return gcdomain.ErrInvalidArgument
+	}
+	err := r.kv.Delete(ctx, key, jetstream.LastRevision(revision))
+	if errors.Is(err, jetstream.ErrKeyExists) {
+		return gcdomain.ErrModified
+	}
+	return err
+}
+
+// parseRevisionKey splits "rev.<b64>.<n>". The encoded name never contains
+// a dot.
+func parseRevisionKey(key string) (id string, revision uint64, ok bool) {
+	id, n, ok := strings.Cut(strings.TrimPrefix(key, "rev."), ".")
+	if !ok {
+		return "", 0, false
+	}
+	revision, err := strconv.ParseUint(n, 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+	return id, revision, true
+}
+
+// ListObjects lists every object of the functions object store.
+func (r *ObjectRepository) ListObjects(ctx context.Context) ([]gcdomain.Object, error) {
+	infos, err := r.os.List(ctx)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrNoObjectsFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	out := make([]gcdomain.Object, 0, len(infos))
+	for _, info := range infos {
+		if info.Deleted {
+			continue
+		}
+		out = append(out, gcdomain.Object{Key: info.Name, Size: info.Size, ModTime: info.ModTime})
+	}
+	return out, nil
+}
+
+// DeleteObject removes an object found unreferenced by the collector.
+func (r *ObjectRepository) DeleteObject(ctx context.Context, key string) error {
+	if key == "" {
+		return gcdomain.ErrInvalidArgument
+	}
+	if err := r.os.Delete(ctx, key); err != nil && !isObjectNotFound(err) {
+		return err
+	}
+	return nil
+}
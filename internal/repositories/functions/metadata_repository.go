@@ -4,11 +4,15 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	digestutils "github.com/10Narratives/faas/pkg/digest"
 	"github.com/google/uuid"
 	"github.com/nats-io/nats.go/jetstream"
 )
@@ -21,25 +25,88 @@ func NewMetadataRepository(kv jetstream.KeyValue) *MetadataRepository {
 	return &MetadataRepository{kv: kv}
 }
 
-func (r *MetadataRepository) CreateFunction(ctx context.Context, fn *funcdomain.Function) error {
-	if fn == nil || fn.Name == "" || fn.Bundle == nil {
+// The head key "fn.<b64>" points at the latest revision, which is stored
+// under "rev.<b64>.<n>". Records written before revisions existed are full
+// functions under the head key and read as revision 1.
+
+// CreateRevision stores fn as a new revision and makes it the latest one.
+// fn.Revision must be greater than the current latest revision; a concurrent
+// upload that claimed the same number first yields ErrRevisionConflict.
+func (r *MetadataRepository) CreateRevision(ctx context.Context, fn *funcdomain.Function) error {
+	if fn == nil || fn.Name == "" || fn.Bundle == nil || fn.Revision == 0 {
 		return funcdomain.ErrInvalidArgument
 	}
 
-	key := keyFromFunctionName(fn.Name)
+	headKey := keyFromFunctionName(fn.Name)
+
+	latest, latestEntry, _, err := r.latest(ctx, headKey)
+	if errors.Is(err, errPurging) {
+		return funcdomain.ErrFunctionDeleted
+	}
+	if err != nil && !errors.Is(err, funcdomain.ErrFunctionNotFound) {
+		return err
+	}
+	if latest != nil {
+		if latest.IsDeleted() {
+			return funcdomain.ErrFunctionDeleted
+		}
+		if latest.Revision >= fn.Revision {
+			return funcdomain.ErrRevisionConflict
+		}
+		if latestEntry.Key() == headKey {
+			// Move the pre-revision record out of the way of the pointer.
+			if _, err := r.kv.Create(ctx, revisionKey(fn.Name, latest.Revision), latestEntry.Value()); err != nil && !errors.Is(err, jetstream.ErrKeyExists) {
+				return err
+			}
+		}
+		fn.InternalID = latest.InternalID
+	}
 
 	b, err := json.Marshal(toStored(fn))
 	if err != nil {
 		return err
 	}
 
-	_, err = r.kv.Create(ctx, key, b)
+	revKey := revisionKey(fn.Name, fn.Revision)
+	rev, err := r.kv.Create(ctx, revKey, b)
 	if err != nil {
-		if isKVKeyExists(err) {
-			return funcdomain.ErrFunctionAlreadyExists
+		if errors.Is(err, jetstream.ErrKeyExists) {
+			return funcdomain.ErrRevisionConflict
 		}
 		return err
 	}
+
+	head, err := json.Marshal(&storedHead{Name: string(fn.Name), Revision: fn.Revision})
+	if err != nil {
+		return err
+	}
+
+	_, err = r.casUpdate(ctx, headKey, func(e jetstream.KeyValueEntry) ([]byte, error) {
+		if e == nil {
+			return head, nil
+		}
+		// Someone else may have moved the head; only write while we are
+		// still newer.
+		latest, _, err := r.resolveHead(ctx, e)
+		switch {
+		case errors.Is(err, errPurging):
+			return nil, funcdomain.ErrFunctionDeleted
+		case errors.Is(err, funcdomain.ErrFunctionNotFound):
+			return head, nil
+		case err != nil:
+			return nil, err
+		case latest.IsDeleted():
+			return nil, funcdomain.ErrFunctionDeleted
+		case latest.Revision >= fn.Revision:
+			return nil, funcdomain.ErrRevisionConflict
+		}
+		return head, nil
+	})
+	if err != nil {
+		_ = r.kv.Delete(ctx, revKey)
+		return err
+	}
+	fn.ETag = rev
 	return nil
 }
 
@@ -48,39 +115,428 @@ func (r *MetadataRepository) GetFunction(ctx context.Context, args *funcdomain.G
 		return nil, funcdomain.ErrInvalidArgument
 	}
 
-	key := keyFromFunctionName(args.Name)
+	// The head is read even for a specific revision: it carries the
+	// deletion marker of the whole function.
+	latest, _, _, err := r.latest(ctx, keyFromFunctionName(args.Name))
+	if err != nil {
+		return nil, err
+	}
+	if latest.IsDeleted() && !args.ShowDeleted {
+		return nil, funcdomain.ErrFunctionNotFound
+	}
+	if args.Revision == 0 || args.Revision == latest.Revision {
+		return &funcdomain.GetFunctionResult{Function: latest}, nil
+	}
 
-	e, err := r.kv.Get(ctx, key)
+	fn, _, err := r.get(ctx, revisionKey(args.Name, args.Revision))
 	if err != nil {
-		if isKVKeyNotFound(err) {
+		// A pre-revision record is revision 1 but only exists under the
+		// head key.
+		if errors.Is(err, funcdomain.ErrFunctionNotFound) {
+			return nil, funcdomain.ErrRevisionNotFound
+		}
+		return nil, err
+	}
+	fn.DeleteTime, fn.PurgeTime = latest.DeleteTime, latest.PurgeTime
+	return &funcdomain.GetFunctionResult{Function: fn}, nil
+}
+
+// UpdateFunction applies mutate to a stored revision.
+func (r *MetadataRepository) UpdateFunction(
+	ctx context.Context,
+	name funcdomain.FunctionName,
+	revision uint64,
+	mutate func(fn *funcdomain.Function) error,
+) (*funcdomain.Function, error) {
+	if name == "" || revision == 0 || mutate == nil {
+		return nil, funcdomain.ErrInvalidArgument
+	}
+
+	fn, err := r.updateKey(ctx, revisionKey(name, revision), 0, mutate)
+	if !errors.Is(err, funcdomain.ErrFunctionNotFound) {
+		return fn, err
+	}
+
+	// Pre-revision record: the head is the only copy.
+	return r.updateKey(ctx, keyFromFunctionName(name), 0, func(f *funcdomain.Function) error {
+		if f.Revision != revision {
+			return funcdomain.ErrRevisionNotFound
+		}
+		return mutate(f)
+	})
+}
+
+// UpdateLatest applies mutate to the latest revision. A non-zero etag must
+// match the stored record, otherwise ErrETagMismatch is returned.
+func (r *MetadataRepository) UpdateLatest(
+	ctx context.Context,
+	name funcdomain.FunctionName,
+	etag uint64,
+	mutate func(fn *funcdomain.Function) error,
+) (*funcdomain.Function, error) {
+	if name == "" || mutate == nil {
+		return nil, funcdomain.ErrInvalidArgument
+	}
+
+	headKey := keyFromFunctionName(name)
+
+	// Retries resolve the latest revision again: the record may have moved,
+	// e.g. from the head to its revision key.
+	for attempt := 1; ; attempt++ {
+		latest, e, _, err := r.latest(ctx, headKey)
+		if err != nil {
+			return nil, err
+		}
+		if latest.IsDeleted() {
+			return nil, funcdomain.ErrFunctionNotFound
+		}
+		if etag != 0 && e.Revision() != etag {
+			return nil, funcdomain.ErrETagMismatch
+		}
+
+		fn, err := r.updateKey(ctx, e.Key(), e.Revision(), mutate)
+		// Without an etag, a concurrent writer or a new latest revision is
+		// not a conflict: start over from the current latest record.
+		if etag != 0 || !errors.Is(err, funcdomain.ErrETagMismatch) || attempt >= maxCASAttempts {
+			return fn, err
+		}
+	}
+}
+
+// updateKey applies mutate to the function stored under key with
+// compare-and-swap. With expected set, only that KV revision is updated;
+// otherwise concurrent writers are retried.
+func (r *MetadataRepository) updateKey(
+	ctx context.Context,
+	key string,
+	expected uint64,
+	mutate func(fn *funcdomain.Function) error,
+) (*funcdomain.Function, error) {
+	var fn *funcdomain.Function
+	rev, err := r.casUpdate(ctx, key, func(e jetstream.KeyValueEntry) ([]byte, error) {
+		if e == nil {
 			return nil, funcdomain.ErrFunctionNotFound
 		}
+		if expected != 0 && e.Revision() != expected {
+			return nil, funcdomain.ErrETagMismatch
+		}
+
+		var err error
+		if fn, err = decodeFunction(e); err != nil {
+			return nil, err
+		}
+		if err := mutate(fn); err != nil {
+			return nil, err
+		}
+		return json.Marshal(toStored(fn))
+	})
+	if err != nil {
 		return nil, err
 	}
+	fn.ETag = rev
+	return fn, nil
+}
 
-	var sf storedFunction
-	if err := json.Unmarshal(e.Value(), &sf); err != nil {
+// SoftDeleteFunction marks the function deleted until purgeTime. Its
+// revisions stay in place; a deleted function is hidden from reads unless
+// ShowDeleted is set and accepts no new revisions.
+func (r *MetadataRepository) SoftDeleteFunction(
+	ctx context.Context,
+	name funcdomain.FunctionName,
+	deleteTime, purgeTime time.Time,
+) (*funcdomain.Function, error) {
+	if name == "" || deleteTime.IsZero() {
+		return nil, funcdomain.ErrInvalidArgument
+	}
+	return r.updateHead(ctx, name, func(h *storedHead) error {
+		if !h.DeleteTime.IsZero() {
+			return funcdomain.ErrFunctionNotFound
+		}
+		h.DeleteTime, h.PurgeTime = deleteTime, purgeTime
+		return nil
+	})
+}
+
+// UndeleteFunction clears the deletion marker of a function that has not
+// been purged yet.
+func (r *MetadataRepository) UndeleteFunction(ctx context.Context, name funcdomain.FunctionName) (*funcdomain.Function, error) {
+	if name == "" {
+		return nil, funcdomain.ErrInvalidArgument
+	}
+	return r.updateHead(ctx, name, func(h *storedHead) error {
+		if h.DeleteTime.IsZero() {
+			return funcdomain.ErrFunctionNotDeleted
+		}
+		h.DeleteTime, h.PurgeTime = time.Time{}, time.Time{}
+		return nil
+	})
+}
+
+// updateHead applies mutate to the head pointer of a function. A
+// pre-revision record is first copied to its revision key so the head can
+// become a pointer.
+func (r *MetadataRepository) updateHead(
+	ctx context.Context,
+	name funcdomain.FunctionName,
+	mutate func(h *storedHead) error,
+) (*funcdomain.Function, error) {
+	var (
+		fn *funcdomain.Function
+		h  storedHead
+	)
+	_, err := r.casUpdate(ctx, keyFromFunctionName(name), func(e jetstream.KeyValueEntry) ([]byte, error) {
+		if e == nil {
+			return nil, funcdomain.ErrFunctionNotFound
+		}
+		latest, latestEntry, err := r.resolveHead(ctx, e)
+		if err != nil {
+			return nil, err
+		}
+		fn = latest
+
+		h = storedHead{Name: string(fn.Name), Revision: fn.Revision}
+		if latestEntry.Key() == e.Key() {
+			if _, err := r.kv.Create(ctx, revisionKey(fn.Name, fn.Revision), latestEntry.Value()); err != nil && !errors.Is(err, jetstream.ErrKeyExists) {
+				return nil, err
+			}
+		} else if err := json.Unmarshal(e.Value(), &h); err != nil {
+			return nil, err
+		}
+		if err := mutate(&h); err != nil {
+			return nil, err
+		}
+		return json.Marshal(&h)
+	})
+	if err != nil {
 		return nil, err
 	}
+	fn.DeleteTime, fn.PurgeTime = h.DeleteTime, h.PurgeTime
+	return fn, nil
+}
 
-	fn, err := fromStored(&sf)
+// errPurging is returned by latest for a function whose head is a purge
+// tombstone: its records are gone, only its objects remain to be released.
+var errPurging = fmt.Errorf("%w: function is being purged", funcdomain.ErrFunctionNotFound)
+
+// BeginPurge turns the head of a soft-deleted function whose purge time is
+// not after now into a tombstone listing the bundles and artifacts of all
+// revisions, then removes the revisions and aliases. Calling it again for a
+// tombstone finishes the removal. A function that was undeleted in the
+// meantime yields ErrFunctionNotDeleted.
+func (r *MetadataRepository) BeginPurge(ctx context.Context, name funcdomain.FunctionName, now time.Time) error {
+	if name == "" {
+		return funcdomain.ErrInvalidArgument
+	}
+
+	_, err := r.casUpdate(ctx, keyFromFunctionName(name), func(e jetstream.KeyValueEntry) ([]byte, error) {
+		if e == nil {
+			return nil, funcdomain.ErrFunctionNotFound
+		}
+		h, err := decodeHead(e)
+		if err != nil {
+			return nil, err
+		}
+		if h.Purge != nil {
+			return nil, nil
+		}
+		if !h.isPointer() || h.DeleteTime.IsZero() || h.PurgeTime.After(now) {
+			return nil, funcdomain.ErrFunctionNotDeleted
+		}
+
+		items, err := r.purgeItems(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		h.Purge = &storedPurge{Pending: items}
+		return json.Marshal(h)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := r.deleteAliases(ctx, name); err != nil {
+		return err
+	}
+
+	revs, err := r.revisionNumbers(ctx, name)
+	if err != nil {
+		return err
+	}
+	for _, rev := range revs {
+		if err := r.kv.Delete(ctx, revisionKey(name, rev)); err != nil && !errors.Is(err, jetstream.ErrKeyNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+// purgeItems lists the objects referenced by every stored revision.
+func (r *MetadataRepository) purgeItems(ctx context.Context, name funcdomain.FunctionName) ([]funcdomain.PurgeItem, error) {
+	revs, err := r.revisionNumbers(ctx, name)
 	if err != nil {
 		return nil, err
 	}
-	return &funcdomain.GetFunctionResult{Function: fn}, nil
+
+	var items []funcdomain.PurgeItem
+	for _, rev := range revs {
+		fn, _, err := r.get(ctx, revisionKey(name, rev))
+		if err != nil {
+			if errors.Is(err, funcdomain.ErrFunctionNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		items = append(items, funcdomain.PurgeItem{Bundle: fn.Bundle})
+		if b := fn.Build; b != nil && b.Artifact != nil {
+			items = append(items, funcdomain.PurgeItem{Bundle: b.Artifact, Artifact: true})
+		}
+	}
+	return items, nil
 }
 
-func (r *MetadataRepository) DeleteFunction(ctx context.Context, args *funcdomain.DeleteFunctionArgs) error {
-	if args == nil || args.Name == "" {
+// NextPurgeItem takes the next object off the tombstone of a function being
+// purged; it returns nil once nothing is left. The item is gone from the
+// tombstone before the caller releases it, so a crash in between leaks the
+// object rather than releasing it twice. On failure the caller hands it back
+// with RequeuePurgeItem.
+func (r *MetadataRepository) NextPurgeItem(ctx context.Context, name funcdomain.FunctionName) (*funcdomain.PurgeItem, error) {
+	var item *funcdomain.PurgeItem
+	err := r.updateTombstone(ctx, name, func(p *storedPurge) error {
+		item = nil
+		if len(p.Pending) == 0 {
+			return nil
+		}
+		item = &p.Pending[0]
+		p.Pending = p.Pending[1:]
+		return nil
+	})
+	return item, err
+}
+
+// RequeuePurgeItem puts back an item that could not be released.
+func (r *MetadataRepository) RequeuePurgeItem(ctx context.Context, name funcdomain.FunctionName, item *funcdomain.PurgeItem) error {
+	if item == nil {
 		return funcdomain.ErrInvalidArgument
 	}
-	// ВАЖНО: в вашем funcsrv.DeleteFunction() перед удалением уже делается GetFunction(),
-	// поэтому повторная проверка существования тут не нужна.
-	return r.kv.Delete(ctx, keyFromFunctionName(args.Name))
+	return r.updateTombstone(ctx, name, func(p *storedPurge) error {
+		p.Pending = append(p.Pending, *item)
+		return nil
+	})
 }
 
-func (r *MetadataRepository) ListFunctions(ctx context.Context, args *funcdomain.ListFunctionsArgs) (*funcdomain.ListFunctionsResult, error) {
-	if args == nil {
+// FinishPurge removes the tombstone once every item has been released. A
+// tombstone that still lists items, e.g. requeued by a concurrent purge, is
+// left for the next attempt.
+func (r *MetadataRepository) FinishPurge(ctx context.Context, name funcdomain.FunctionName) error {
+	headKey := keyFromFunctionName(name)
+	h, e, err := r.getHead(ctx, headKey)
+	if err != nil {
+		return err
+	}
+	if h.Purge == nil {
+		return funcdomain.ErrFunctionNotDeleted
+	}
+	if len(h.Purge.Pending) > 0 {
+		return nil
+	}
+
+	err = r.kv.Delete(ctx, headKey, jetstream.LastRevision(e.Revision()))
+	if errors.Is(err, jetstream.ErrKeyExists) {
+		return nil
+	}
+	return err
+}
+
+// updateTombstone applies mutate to the tombstone of a function being
+// purged with compare-and-swap.
+func (r *MetadataRepository) updateTombstone(ctx context.Context, name funcdomain.FunctionName, mutate func(p *storedPurge) error) error {
+	if name == "" {
+		return funcdomain.ErrInvalidArgument
+	}
+
+	_, err := r.casUpdate(ctx, keyFromFunctionName(name), func(e jetstream.KeyValueEntry) ([]byte, error) {
+		if e == nil {
+			return nil, funcdomain.ErrFunctionNotFound
+		}
+		h, err := decodeHead(e)
+		if err != nil {
+			return nil, err
+		}
+		if h.Purge == nil {
+			return nil, funcdomain.ErrFunctionNotDeleted
+		}
+		if err := mutate(h.Purge); err != nil {
+			return nil, err
+		}
+		return json.Marshal(h)
+	})
+	return err
+}
+
+// ListPurgeableFunctions returns the soft-deleted functions due for purging
+// at now, including those whose purge was interrupted.
+func (r *MetadataRepository) ListPurgeableFunctions(ctx context.Context, now time.Time) ([]funcdomain.FunctionName, error) {
+	keysLister, err := r.kv.ListKeysFiltered(ctx, headKeyPrefix+"*")
+	if err != nil {
+		if errors.Is(err, jetstream.ErrNoKeysFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var out []funcdomain.FunctionName
+	for k := range keysLister.Keys() {
+		h, _, err := r.getHead(ctx, k)
+		if err != nil {
+			if errors.Is(err, funcdomain.ErrFunctionNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		if !h.isPointer() || h.DeleteTime.IsZero() || (h.Purge == nil && h.PurgeTime.After(now)) {
+			continue
+		}
+		name, err := funcdomain.ParseFunctionName(h.Name)
+		if err != nil {
+			continue
+		}
+		out = append(out, name)
+	}
+	return out, nil
+}
+
+// getHead reads the raw head record.
+func (r *MetadataRepository) getHead(ctx context.Context, headKey string) (*storedHead, jetstream.KeyValueEntry, error) {
+	e, err := r.kv.Get(ctx, headKey)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			return nil, nil, funcdomain.ErrFunctionNotFound
+		}
+		return nil, nil, err
+	}
+
+	h, err := decodeHead(e)
+	if err != nil {
+		return nil, nil, err
+	}
+	return h, e, nil
+}
+
+func decodeHead(e jetstream.KeyValueEntry) (*storedHead, error) {
+	var h storedHead
+	if err := json.Unmarshal(e.Value(), &h); err != nil {
+		return nil, err
+	}
+	return &h, nil
+}
+
+// ListFunctionRevisions lists revisions newest first. PageToken is the last
+// revision number of the previous page.
+func (r *MetadataRepository) ListFunctionRevisions(
+	ctx context.Context,
+	args *funcdomain.ListFunctionRevisionsArgs,
+) (*funcdomain.ListFunctionRevisionsResult, error) {
+	if args == nil || args.Name == "" {
 		return nil, funcdomain.ErrInvalidArgument
 	}
 
@@ -92,76 +548,353 @@ func (r *MetadataRepository) ListFunctions(ctx context.Context, args *funcdomain
 		pageSize = 1000
 	}
 
-	keysLister, err := r.kv.ListKeys(ctx)
+	head, _, _, err := r.latest(ctx, keyFromFunctionName(args.Name))
 	if err != nil {
 		return nil, err
 	}
-
-	keys := make([]string, 0, 128)
-	for k := range keysLister.Keys() {
-		keys = append(keys, k)
+	if head.IsDeleted() && !args.ShowDeleted {
+		return nil, funcdomain.ErrFunctionNotFound
 	}
-	sort.Strings(keys)
 
-	start, err := startIndexFromToken(keys, args.PageToken)
+	revs, err := r.revisionNumbers(ctx, args.Name)
 	if err != nil {
 		return nil, err
 	}
-	if start >= len(keys) {
-		return &funcdomain.ListFunctionsResult{Functions: nil, NextPageToken: ""}, nil
+	if len(revs) == 0 {
+		// A pre-revision record that only has a head.
+		if args.PageToken != "" {
+			return nil, funcdomain.ErrInvalidPageToken
+		}
+		return &funcdomain.ListFunctionRevisionsResult{Revisions: []*funcdomain.Function{head}}, nil
 	}
 
-	end := start + pageSize
-	if end > len(keys) {
-		end = len(keys)
+	start := 0
+	if args.PageToken != "" {
+		last, err := strconv.ParseUint(args.PageToken, 10, 64)
+		if err != nil {
+			return nil, funcdomain.ErrInvalidPageToken
+		}
+		start = sort.Search(len(revs), func(i int) bool { return revs[i] < last })
 	}
 
+	end := min(start+pageSize, len(revs))
+
 	out := make([]*funcdomain.Function, 0, end-start)
-	for _, k := range keys[start:end] {
-		e, err := r.kv.Get(ctx, k)
+	for _, rev := range revs[start:end] {
+		fn, _, err := r.get(ctx, revisionKey(args.Name, rev))
 		if err != nil {
-			if isKVKeyNotFound(err) {
+			if errors.Is(err, funcdomain.ErrFunctionNotFound) {
 				continue
 			}
 			return nil, err
 		}
+		fn.DeleteTime, fn.PurgeTime = head.DeleteTime, head.PurgeTime
+		out = append(out, fn)
+	}
+
+	nextToken := ""
+	if end < len(revs) {
+		nextToken = strconv.FormatUint(revs[end-1], 10)
+	}
+
+	return &funcdomain.ListFunctionRevisionsResult{Revisions: out, NextPageToken: nextToken}, nil
+}
+
+// revisionNumbers returns the stored revision numbers, newest first.
+func (r *MetadataRepository) revisionNumbers(ctx context.Context, name funcdomain.FunctionName) ([]uint64, error) {
+	prefix := revisionPrefix(name)
+
+	keysLister, err := r.kv.ListKeysFiltered(ctx, prefix+"*")
+	if err != nil {
+		if errors.Is(err, jetstream.ErrNoKeysFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	revs := make([]uint64, 0, 16)
+	for k := range keysLister.Keys() {
+		rev, err := strconv.ParseUint(strings.TrimPrefix(k, prefix), 10, 64)
+		if err != nil {
+			continue
+		}
+		revs = append(revs, rev)
+	}
+	sort.Slice(revs, func(i, j int) bool { return revs[i] > revs[j] })
+	return revs, nil
+}
+
+// latest resolves a head key to the latest revision. It returns the entry the
+// function was read from and the head entry itself; for pre-revision records
+// both are the same.
+func (r *MetadataRepository) latest(
+	ctx context.Context,
+	headKey string,
+) (*funcdomain.Function, jetstream.KeyValueEntry, jetstream.KeyValueEntry, error) {
+	e, err := r.kv.Get(ctx, headKey)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			return nil, nil, nil, funcdomain.ErrFunctionNotFound
+		}
+		return nil, nil, nil, err
+	}
+
+	fn, re, err := r.resolveHead(ctx, e)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return fn, re, e, nil
+}
+
+// resolveHead reads the latest revision a head entry points at, together
+// with the entry it was read from.
+func (r *MetadataRepository) resolveHead(ctx context.Context, e jetstream.KeyValueEntry) (*funcdomain.Function, jetstream.KeyValueEntry, error) {
+	sh, err := decodeHead(e)
+	if err != nil {
+		return nil, nil, err
+	}
+	if sh.Purge != nil {
+		return nil, nil, errPurging
+	}
+	if !sh.isPointer() {
+		fn, err := decodeFunction(e)
+		if err != nil {
+			return nil, nil, err
+		}
+		return fn, e, nil
+	}
+
+	name, err := funcdomain.ParseFunctionName(sh.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+	fn, re, err := r.get(ctx, revisionKey(name, sh.Revision))
+	if err != nil {
+		return nil, nil, err
+	}
+	fn.DeleteTime, fn.PurgeTime = sh.DeleteTime, sh.PurgeTime
+	return fn, re, nil
+}
+
+// get reads a full function record. Head pointers are not function records.
+func (r *MetadataRepository) get(ctx context.Context, key string) (*funcdomain.Function, jetstream.KeyValueEntry, error) {
+	e, err := r.kv.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			return nil, nil, funcdomain.ErrFunctionNotFound
+		}
+		return nil, nil, err
+	}
+
+	fn, err := decodeFunction(e)
+	if err != nil {
+		return nil, nil, err
+	}
+	return fn, e, nil
+}
 
-		var sf storedFunction
-		if err := json.Unmarshal(e.Value(), &sf); err != nil {
+func decodeFunction(e jetstream.KeyValueEntry) (*funcdomain.Function, error) {
+	var sf storedFunction
+	if err := json.Unmarshal(e.Value(), &sf); err != nil {
+		return nil, err
+	}
+	if sf.Bundle == nil {
+		return nil, funcdomain.ErrRevisionNotFound
+	}
+	fn, err := fromStored(&sf)
+	if err != nil {
+		return nil, err
+	}
+	fn.ETag = e.Revision()
+	return fn, nil
+}
+
+// ListFunctions returns the latest revision of every function matching the
+// filter, in the requested order. Filtering needs the full records, so every
+// function is read; the page token is a cursor on the last returned function.
+func (r *MetadataRepository) ListFunctions(ctx context.Context, args *funcdomain.ListFunctionsArgs) (*funcdomain.ListFunctionsResult, error) {
+	if args == nil {
+		return nil, funcdomain.ErrInvalidArgument
+	}
+
+	pageSize := int(args.PageSize)
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+	if pageSize > 1000 {
+		pageSize = 1000
+	}
+
+	var cursor *listCursor
+	if args.PageToken != "" {
+		c, err := decodeListCursor(args.PageToken)
+		if err != nil {
 			return nil, err
 		}
-		fn, err := fromStored(&sf)
+		if c.Filter != args.Filter.String() || c.OrderBy != args.OrderBy.String() || c.ShowDeleted != args.ShowDeleted {
+			return nil, funcdomain.ErrInvalidPageToken
+		}
+		cursor = c
+	}
+
+	keysLister, err := r.kv.ListKeysFiltered(ctx, headKeyPrefix+"*")
+	if err != nil {
+		if errors.Is(err, jetstream.ErrNoKeysFound) {
+			return &funcdomain.ListFunctionsResult{}, nil
+		}
+		return nil, err
+	}
+
+	var matched []*funcdomain.Function
+	for k := range keysLister.Keys() {
+		fn, _, _, err := r.latest(ctx, k)
 		if err != nil {
+			if errors.Is(err, funcdomain.ErrFunctionNotFound) {
+				continue
+			}
 			return nil, err
 		}
-		out = append(out, fn)
+		if fn.IsDeleted() && !args.ShowDeleted {
+			continue
+		}
+		if args.Filter.Match(fn) {
+			matched = append(matched, fn)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return args.OrderBy.Compare(matched[i], matched[j]) < 0
+	})
+
+	start := 0
+	if cursor != nil {
+		after := &funcdomain.Function{Name: funcdomain.FunctionName(cursor.Name), UploadedAt: cursor.UploadedAt}
+		start = sort.Search(len(matched), func(i int) bool {
+			return args.OrderBy.Compare(matched[i], after) > 0
+		})
 	}
 
+	end := min(start+pageSize, len(matched))
+	out := matched[start:end]
+
 	nextToken := ""
-	if end < len(keys) {
-		nextToken = keys[end-1] // PageToken = “последний key на странице”
+	if end < len(matched) {
+		last := out[len(out)-1]
+		nextToken, err = encodeListCursor(&listCursor{
+			Filter:      args.Filter.String(),
+			OrderBy:     args.OrderBy.String(),
+			ShowDeleted: args.ShowDeleted,
+			Name:        string(last.Name),
+			UploadedAt:  last.UploadedAt,
+		})
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return &funcdomain.ListFunctionsResult{Functions: out, NextPageToken: nextToken}, nil
 }
 
+// listCursor is the ListFunctions page token: the sort key of the last
+// returned function and the query it belongs to.
+type listCursor struct {
+	Filter      string    `json:"f,omitempty"`
+	OrderBy     string    `json:"o,omitempty"`
+	ShowDeleted bool      `json:"d,omitempty"`
+	Name        string    `json:"n"`
+	UploadedAt  time.Time `json:"u"`
+}
+
+func encodeListCursor(c *listCursor) (string, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodeListCursor(token string) (*listCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, funcdomain.ErrInvalidPageToken
+	}
+	var c listCursor
+	if err := json.Unmarshal(b, &c); err != nil || c.Name == "" {
+		return nil, funcdomain.ErrInvalidPageToken
+	}
+	return &c, nil
+}
+
 // --- storage format ---
 
+// storedHead is what the head key holds once a function has revisions.
+type storedHead struct {
+	Name     string `json:"name"`
+	Revision uint64 `json:"revision"`
+	// DeleteTime and PurgeTime mark a soft-deleted function.
+	DeleteTime time.Time `json:"delete_time,omitzero"`
+	PurgeTime  time.Time `json:"purge_time,omitzero"`
+	// Purge turns the head into a tombstone once purging started.
+	Purge *storedPurge `json:"purge,omitempty"`
+	// Bundle is only set by pre-revision records, which are full functions.
+	Bundle json.RawMessage `json:"bundle,omitempty"`
+}
+
+func (h *storedHead) isPointer() bool {
+	return len(h.Bundle) == 0 || string(h.Bundle) == "null"
+}
+
+// storedPurge lists the objects a purged function has yet to release.
+type storedPurge struct {
+	Pending []funcdomain.PurgeItem `json:"pending"`
+}
+
 type storedFunction struct {
-	InternalID  string                   `json:"internal_id"`
-	Name        string                   `json:"name"`
-	DisplayName string                   `json:"display_name"`
-	UploadedAt  time.Time                `json:"uploaded_at"`
-	Bundle      *funcdomain.SourceBundle `json:"bundle"`
+	InternalID  string                    `json:"internal_id"`
+	Name        string                    `json:"name"`
+	Revision    uint64                    `json:"revision,omitempty"`
+	DisplayName string                    `json:"display_name"`
+	Description string                    `json:"description,omitempty"`
+	Labels      map[string]string         `json:"labels,omitempty"`
+	Annotations map[string]string         `json:"annotations,omitempty"`
+	Timeout     time.Duration             `json:"timeout,omitempty"`
+	MemoryBytes uint64                    `json:"memory_bytes,omitempty"`
+	Runtime     string                    `json:"runtime,omitempty"`
+	Entrypoint  string                    `json:"entrypoint,omitempty"`
+	Handler     string                    `json:"handler,omitempty"`
+	Retry       *funcdomain.RetryPolicy   `json:"retry,omitempty"`
+	Parameters  json.RawMessage           `json:"parameters_schema,omitempty"`
+	Output      json.RawMessage           `json:"output_schema,omitempty"`
+	OutputType  string                    `json:"output_content_type,omitempty"`
+	UploadedAt  time.Time                 `json:"uploaded_at"`
+	Bundle      *funcdomain.SourceBundle  `json:"bundle"`
+	Env         map[string]string         `json:"env,omitempty"`
+	SecretEnv   map[string]string         `json:"secret_env,omitempty"`
+	Build       *funcdomain.FunctionBuild `json:"build,omitempty"`
 }
 
 func toStored(fn *funcdomain.Function) *storedFunction {
 	return &storedFunction{
 		InternalID:  fn.InternalID.String(),
 		Name:        string(fn.Name),
+		Revision:    fn.Revision,
 		DisplayName: fn.DisplayName,
+		Description: fn.Description,
+		Labels:      fn.Labels,
+		Annotations: fn.Annotations,
+		Timeout:     fn.Timeout,
+		MemoryBytes: fn.MemoryBytes,
+		Runtime:     fn.Runtime,
+		Entrypoint:  fn.Entrypoint,
+		Handler:     fn.Handler,
+		Retry:       fn.Retry,
+		Parameters:  fn.ParametersSchema,
+		Output:      fn.OutputSchema,
+		OutputType:  fn.OutputContentType,
 		UploadedAt:  fn.UploadedAt,
 		Bundle:      fn.Bundle,
+		Env:         fn.Env,
+		SecretEnv:   fn.SecretEnv,
+		Build:       fn.Build,
 	}
 }
 
@@ -177,50 +910,113 @@ func fromStored(sf *storedFunction) (*funcdomain.Function, error) {
 	if err != nil {
 		return nil, err
 	}
+	normalizeDigest(sf.Bundle)
+	if sf.Build != nil {
+		normalizeDigest(sf.Build.Artifact)
+	}
+	revision := sf.Revision
+	if revision == 0 {
+		// Written before revisions existed.
+		revision = 1
+	}
 	return &funcdomain.Function{
-		InternalID:  id,
-		Name:        name,
-		DisplayName: sf.DisplayName,
-		UploadedAt:  sf.UploadedAt,
-		Bundle:      sf.Bundle,
+		InternalID:        id,
+		Name:              name,
+		Revision:          revision,
+		DisplayName:       sf.DisplayName,
+		Description:       sf.Description,
+		Labels:            sf.Labels,
+		Annotations:       sf.Annotations,
+		Timeout:           sf.Timeout,
+		MemoryBytes:       sf.MemoryBytes,
+		Runtime:           sf.Runtime,
+		Entrypoint:        sf.Entrypoint,
+		Handler:           sf.Handler,
+		Retry:             sf.Retry,
+		ParametersSchema:  sf.Parameters,
+		OutputSchema:      sf.Output,
+		OutputContentType: sf.OutputType,
+		UploadedAt:        sf.UploadedAt,
+		Bundle:            sf.Bundle,
+		Env:               sf.Env,
+		SecretEnv:         sf.SecretEnv,
+		Build:             sf.Build,
 	}, nil
 }
 
+// normalizeDigest rewrites digests of records written before digests were
+// stored as hex (object store "SHA-256=<base64>" form).
+func normalizeDigest(b *funcdomain.SourceBundle) {
+	if b == nil || b.SHA256 == "" {
+		return
+	}
+	if hexDigest, err := digestutils.NormalizeSHA256(b.SHA256); err == nil {
+		b.SHA256 = hexDigest
+	}
+}
+
 // --- key + paging ---
 
 func keyFromFunctionName(name funcdomain.FunctionName) string {
 	// KV key должен быть subject-like, поэтому кодируем имя в base64url и кладём в один token. [web:61]
 	enc := base64.RawURLEncoding.EncodeToString([]byte(name))
-	return "fn." + enc
+	return headKeyPrefix + enc
 }
 
-// PageToken хранит “последний key предыдущей страницы”.
-func startIndexFromToken(sortedKeys []string, lastKey string) (int, error) {
-	if lastKey == "" {
-		return 0, nil
-	}
-	i := sort.SearchStrings(sortedKeys, lastKey)
-	if i >= len(sortedKeys) || sortedKeys[i] != lastKey {
-		return 0, funcdomain.ErrInvalidPageToken
-	}
-	return i + 1, nil
-}
+const headKeyPrefix = "fn."
 
-// --- error helpers ---
+// revisionPrefix is the key prefix of all revisions of a function.
+func revisionPrefix(name funcdomain.FunctionName) string {
+	return "rev." + base64.RawURLEncoding.EncodeToString([]byte(name)) + "."
+}
 
-func isKVKeyNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	s := strings.ToLower(err.Error())
-	// В примерах nats.go это “nats: key not found”. [web:44]
-	return strings.Contains(s, "key not found") || strings.Contains(s, "not found")
+func revisionKey(name funcdomain.FunctionName, revision uint64) string {
+	return revisionPrefix(name) + strconv.FormatUint(revision, 10)
 }
 
-func isKVKeyExists(err error) bool {
-	if err == nil {
-		return false
+// maxCASAttempts bounds how often casUpdate retries after concurrent
+// writes.
+const maxCASAttempts = 5
+
+// casUpdate reads key, hands the entry to mutate, nil if the key does not
+// exist, and writes the value it returns with compare-and-swap, retrying
+// from a fresh read when someone else wrote first. A nil value leaves the
+// key as it is. It returns the KV revision of the stored value.
+func (r *MetadataRepository) casUpdate(
+	ctx context.Context,
+	key string,
+	mutate func(e jetstream.KeyValueEntry) ([]byte, error),
+) (uint64, error) {
+	for attempt := 1; ; attempt++ {
+		e, err := r.kv.Get(ctx, key)
+		switch {
+		case errors.Is(err, jetstream.ErrKeyNotFound):
+			e = nil
+		case err != nil:
+			return 0, err
+		}
+
+		value, err := mutate(e)
+		if err != nil {
+			return 0, err
+		}
+
+		var rev uint64
+		switch {
+		case value == nil && e == nil:
+			return 0, nil
+		case value == nil:
+			return e.Revision(), nil
+		case e == nil:
+			rev, err = r.kv.Create(ctx, key, value)
+		default:
+			rev, err = r.kv.Update(ctx, key, value, e.Revision())
+		}
+		if err == nil {
+			return rev, nil
+		}
+		if !errors.Is(err, jetstream.ErrKeyExists) || attempt >= maxCASAttempts {
+			return 0, err
+		}
 	}
-	// В примерах nats.go это “nats: key exists”. [web:44]
-	return strings.Contains(strings.ToLower(err.Error()), "key exists")
 }
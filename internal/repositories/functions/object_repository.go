@@ -6,6 +6,8 @@ import (
 	"strings"
 
 	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	digestutils "github.com/10Narratives/faas/pkg/digest"
+	"github.com/google/uuid"
 	"github.com/nats-io/nats.go/jetstream"
 )
 
@@ -17,34 +19,62 @@ func NewObjectRepository(os jetstream.ObjectStore) *ObjectRepository {
 	return &ObjectRepository{os: os}
 }
 
-func (r *ObjectRepository) SaveBundle(
-	ctx context.Context,
-	name funcdomain.FunctionName,
-	format funcdomain.UploadFunctionFormat,
-	data io.ReadCloser,
-) (*funcdomain.SourceBundle, error) {
+// SaveBundle stores bundle content under its declared digest. Each upload
+// gets its own object, "bundles/sha256/<digest>/<uuid>", so a concurrent or
+// corrupt upload of the same digest never overwrites a verified object. The
+// returned SHA256 is the digest of what was actually stored.
+func (r *ObjectRepository) SaveBundle(ctx context.Context, digest string, data io.ReadCloser) (*funcdomain.SourceBundle, error) {
 	if data == nil {
 		return nil, funcdomain.ErrInvalidArgument
 	}
 	defer data.Close()
 
-	key := objectKey(name, format)
+	if digest == "" {
+		return nil, funcdomain.ErrInvalidArgument
+	}
+
+	key := "bundles/sha256/" + digest + "/" + uuid.NewString()
 
-	if _, err := r.os.GetInfo(ctx, key); err == nil {
-		return nil, funcdomain.ErrFunctionAlreadyExists
-	} else if !isObjectNotFound(err) {
+	dr := digestutils.NewReader(data)
+	info, err := r.os.Put(ctx, jetstream.ObjectMeta{Name: key}, dr)
+	if err != nil {
 		return nil, err
 	}
 
-	info, err := r.os.Put(ctx, jetstream.ObjectMeta{Name: key}, data)
+	return &funcdomain.SourceBundle{
+		Bucket:    info.Bucket,
+		ObjectKey: key,
+		Size:      info.Size,
+		SHA256:    dr.SHA256(),
+	}, nil
+}
+
+// SaveArtifact stores a build output. Artifacts are keyed by build ID, so a
+// rebuild never overwrites an artifact that running tasks may still read.
+func (r *ObjectRepository) SaveArtifact(
+	ctx context.Context,
+	name funcdomain.FunctionName,
+	buildID uuid.UUID,
+	data io.Reader,
+) (*funcdomain.SourceBundle, error) {
+	if data == nil {
+		return nil, funcdomain.ErrInvalidArgument
+	}
+
+	key := artifactKey(name, buildID)
+
+	dr := digestutils.NewReader(data)
+	info, err := r.os.Put(ctx, jetstream.ObjectMeta{Name: key}, dr)
 	if err != nil {
 		return nil, err
 	}
 
 	return &funcdomain.SourceBundle{
+		Bucket:    info.Bucket,
 		ObjectKey: key,
 		Size:      info.Size,
-		SHA256:    info.Digest,
+		SHA256:    dr.SHA256(),
+		Format:    funcdomain.TarGZFormat,
 	}, nil
 }
 
@@ -52,7 +82,15 @@ func (r *ObjectRepository) OpenBundle(ctx context.Context, bundle *funcdomain.So
 	if bundle == nil || bundle.ObjectKey == "" {
 		return nil, funcdomain.ErrInvalidArgument
 	}
-	return r.os.Get(ctx, bundle.ObjectKey)
+
+	obj, err := r.os.Get(ctx, bundle.ObjectKey)
+	if err != nil {
+		if isObjectNotFound(err) {
+			return nil, funcdomain.ErrBundleNotFound
+		}
+		return nil, err
+	}
+	return obj, nil
 }
 
 func (r *ObjectRepository) DeleteBundle(ctx context.Context, bundle *funcdomain.SourceBundle) error {
@@ -60,13 +98,17 @@ func (r *ObjectRepository) DeleteBundle(ctx context.Context, bundle *funcdomain.
 		return funcdomain.ErrInvalidArgument
 	}
 
-	return r.os.Delete(ctx, bundle.ObjectKey)
+	// Deleting twice is not an error, so interrupted cleanups can be retried.
+	if err := r.os.Delete(ctx, bundle.ObjectKey); err != nil && !isObjectNotFound(err) {
+		return err
+	}
+	return nil
 }
 
-func objectKey(name funcdomain.FunctionName, format funcdomain.UploadFunctionFormat) string {
+func artifactKey(name funcdomain.FunctionName, buildID uuid.UUID) string {
 	s := strings.TrimPrefix(string(name), "functions/")
 	s = strings.ReplaceAll(s, "/", "_")
-	return s + "." + string(format)
+	return "artifacts/" + s + "/" + buildID.String() + "." + string(funcdomain.TarGZFormat)
 }
 
 func isObjectNotFound(err error) bool {
@@ -0,0 +1,50 @@
+package funcrepo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const (
+	// Builds share the TASKS stream with executions ("task.*").
+	subjectTaskBuild = "task.build"
+
+	streamTasks = "TASKS"
+)
+
+type JS interface {
+	Publish(ctx context.Context, subj string, data []byte, opts ...jetstream.PublishOpt) (*jetstream.PubAck, error)
+}
+
+type Publisher struct {
+	js JS
+}
+
+func NewPublisher(js JS) *Publisher {
+	return &Publisher{js: js}
+}
+
+func (p *Publisher) PublishBuild(ctx context.Context, msg *funcdomain.BuildFunctionMessage) error {
+	if msg == nil {
+		return errors.New("build message is nil")
+	}
+	if msg.FunctionName == "" {
+		return funcdomain.ErrInvalidName
+	}
+
+	b, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("marshal build msg: %w", err)
+	}
+
+	_, err = p.js.Publish(ctx, subjectTaskBuild, b, jetstream.WithExpectStream(streamTasks))
+	if err != nil {
+		return fmt.Errorf("jetstream publish build: %w", err)
+	}
+	return nil
+}
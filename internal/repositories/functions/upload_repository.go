@@ -0,0 +1,170 @@
+package funcrepo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// Upload sessions share the functions bucket: "upload.<uuid>". Their parts
+// are objects under "uploads/<uuid>/".
+
+const uploadKeyPrefix = "upload."
+
+func (r *MetadataRepository) CreateUploadSession(ctx context.Context, session *funcdomain.UploadSession) error {
+	if session == nil || session.ID == uuid.Nil {
+		return funcdomain.ErrInvalidArgument
+	}
+
+	b, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.kv.Create(ctx, uploadKey(session.ID), b)
+	return err
+}
+
+func (r *MetadataRepository) GetUploadSession(ctx context.Context, id uuid.UUID) (*funcdomain.UploadSession, error) {
+	session, _, err := r.getUploadSession(ctx, uploadKey(id))
+	return session, err
+}
+
+// UpdateUploadSession applies mutate with compare-and-swap, retrying on
+// concurrent writes.
+func (r *MetadataRepository) UpdateUploadSession(
+	ctx context.Context,
+	id uuid.UUID,
+	mutate func(session *funcdomain.UploadSession) error,
+) (*funcdomain.UploadSession, error) {
+	var session *funcdomain.UploadSession
+	rev, err := r.casUpdate(ctx, uploadKey(id), func(e jetstream.KeyValueEntry) ([]byte, error) {
+		if e == nil {
+			return nil, funcdomain.ErrUploadNotFound
+		}
+		var err error
+		if session, err = decodeUploadSession(e); err != nil {
+			return nil, err
+		}
+		if err := mutate(session); err != nil {
+			return nil, err
+		}
+		return json.Marshal(session)
+	})
+	if err != nil {
+		return nil, err
+	}
+	session.ETag = rev
+	return session, nil
+}
+
+// DeleteUploadSession removes the session record. A non-zero etag must
+// match the stored revision, otherwise ErrUploadModified is returned.
+func (r *MetadataRepository) DeleteUploadSession(ctx context.Context, id uuid.UUID, etag uint64) error {
+	var opts []jetstream.KVDeleteOpt
+	if etag > 0 {
+		opts = append(opts, jetstream.LastRevision(etag))
+	}
+
+	err := r.kv.Delete(ctx, uploadKey(id), opts...)
+	switch {
+	case err == nil, errors.Is(err, jetstream.ErrKeyNotFound):
+		return nil
+	case errors.Is(err, jetstream.ErrKeyExists):
+		return funcdomain.ErrUploadModified
+	default:
+		return err
+	}
+}
+
+// ListUploadSessions returns all sessions, for garbage collection.
+func (r *MetadataRepository) ListUploadSessions(ctx context.Context) ([]*funcdomain.UploadSession, error) {
+	keysLister, err := r.kv.ListKeysFiltered(ctx, uploadKeyPrefix+"*")
+	if err != nil {
+		if errors.Is(err, jetstream.ErrNoKeysFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var keys []string
+	for k := range keysLister.Keys() {
+		keys = append(keys, k)
+	}
+
+	out := make([]*funcdomain.UploadSession, 0, len(keys))
+	for _, k := range keys {
+		session, _, err := r.getUploadSession(ctx, k)
+		if err != nil {
+			if errors.Is(err, funcdomain.ErrUploadNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		out = append(out, session)
+	}
+	return out, nil
+}
+
+func (r *MetadataRepository) getUploadSession(ctx context.Context, key string) (*funcdomain.UploadSession, uint64, error) {
+	e, err := r.kv.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			return nil, 0, funcdomain.ErrUploadNotFound
+		}
+		return nil, 0, err
+	}
+
+	session, err := decodeUploadSession(e)
+	if err != nil {
+		return nil, 0, err
+	}
+	return session, e.Revision(), nil
+}
+
+func decodeUploadSession(e jetstream.KeyValueEntry) (*funcdomain.UploadSession, error) {
+	var session funcdomain.UploadSession
+	if err := json.Unmarshal(e.Value(), &session); err != nil {
+		return nil, err
+	}
+	session.ETag = e.Revision()
+	return &session, nil
+}
+
+func uploadKey(id uuid.UUID) string {
+	return uploadKeyPrefix + id.String()
+}
+
+// SaveUploadPart stores one chunk of an upload session. Parts are keyed by
+// their own ID, so a retried chunk never overwrites a committed one.
+func (r *ObjectRepository) SaveUploadPart(ctx context.Context, session uuid.UUID, part funcdomain.UploadPart, data io.Reader) error {
+	_, err := r.os.Put(ctx, jetstream.ObjectMeta{Name: uploadPartKey(session, part.ID)}, data)
+	return err
+}
+
+func (r *ObjectRepository) OpenUploadPart(ctx context.Context, session uuid.UUID, part funcdomain.UploadPart) (io.ReadCloser, error) {
+	obj, err := r.os.Get(ctx, uploadPartKey(session, part.ID))
+	if err != nil {
+		if isObjectNotFound(err) {
+			return nil, funcdomain.ErrUploadNotFound
+		}
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (r *ObjectRepository) DeleteUploadPart(ctx context.Context, session uuid.UUID, part funcdomain.UploadPart) error {
+	if err := r.os.Delete(ctx, uploadPartKey(session, part.ID)); err != nil && !isObjectNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func uploadPartKey(session, part uuid.UUID) string {
+	return "uploads/" + session.String() + "/" + part.String()
+}
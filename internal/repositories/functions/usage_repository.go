@@ -0,0 +1,66 @@
+package funcrepo
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// Usage counters share the functions bucket: "usage.<b64 namespace>".
+
+type storedUsage struct {
+	BundleBytes uint64 `json:"bundle_bytes"`
+}
+
+// GetUsage returns the bundle bytes accounted to a namespace.
+func (r *MetadataRepository) GetUsage(ctx context.Context, namespace string) (uint64, error) {
+	e, err := r.kv.Get(ctx, usageKey(namespace))
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	var u storedUsage
+	if err := json.Unmarshal(e.Value(), &u); err != nil {
+		return 0, err
+	}
+	return u.BundleBytes, nil
+}
+
+// AddUsage adjusts the bundle bytes of a namespace. A positive delta that
+// would take the total above a non-zero quota fails with ErrQuotaExceeded;
+// negative deltas never go below zero.
+func (r *MetadataRepository) AddUsage(ctx context.Context, namespace string, delta int64, quota uint64) error {
+	_, err := r.casUpdate(ctx, usageKey(namespace), func(e jetstream.KeyValueEntry) ([]byte, error) {
+		u := &storedUsage{}
+		if e != nil {
+			if err := json.Unmarshal(e.Value(), u); err != nil {
+				return nil, err
+			}
+		}
+
+		switch {
+		case delta >= 0:
+			if quota > 0 && u.BundleBytes+uint64(delta) > quota {
+				return nil, funcdomain.ErrQuotaExceeded
+			}
+			u.BundleBytes += uint64(delta)
+		case uint64(-delta) > u.BundleBytes:
+			u.BundleBytes = 0
+		default:
+			u.BundleBytes -= uint64(-delta)
+		}
+		return json.Marshal(u)
+	})
+	return err
+}
+
+func usageKey(namespace string) string {
+	return "usage." + base64.RawURLEncoding.EncodeToString([]byte(namespace))
+}
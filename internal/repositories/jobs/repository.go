@@ -0,0 +1,332 @@
+package jobrepo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/rand/v2"
+	"sort"
+	"strings"
+	"time"
+
+	jobdomain "github.com/10Narratives/faas/internal/domains/jobs"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// maxUpdateAttempts is high because every task of a job ends with an
+// update of the same record, often from several agents at once.
+const maxUpdateAttempts = 50
+
+// maxRecordAttempts bounds how often RecordJobTask retries a write that
+// failed other than by a conflict, e.g. on a timeout. A count still lost,
+// or applied twice by a retry, is repaired by ReconcileJob.
+const maxRecordAttempts = 3
+
+type Repository struct {
+	kv jetstream.KeyValue
+}
+
+func NewRepository(kv jetstream.KeyValue) *Repository {
+	return &Repository{kv: kv}
+}
+
+func (r *Repository) CreateJob(ctx context.Context, args *jobdomain.CreateJobArgs) (*jobdomain.CreateJobResult, error) {
+	if args == nil || args.BatchID == "" || args.Function == "" {
+		return nil, jobdomain.ErrInvalidParameters
+	}
+	if args.FailureThreshold < 0 || args.FailureThreshold > 1 {
+		return nil, jobdomain.ErrInvalidThreshold
+	}
+
+	job := &jobdomain.Job{
+		Name:             jobdomain.JobNameForBatch(args.BatchID),
+		Function:         args.Function,
+		State:            jobdomain.JobStateRunning,
+		CreatedAt:        time.Now().UTC(),
+		FailureThreshold: args.FailureThreshold,
+	}
+
+	b, err := json.Marshal(job)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := r.kv.Create(ctx, string(job.Name), b); err != nil {
+		if errors.Is(err, jetstream.ErrKeyExists) {
+			return nil, jobdomain.ErrAlreadyExists
+		}
+		return nil, err
+	}
+
+	return &jobdomain.CreateJobResult{Job: job}, nil
+}
+
+func (r *Repository) AddJobTasks(ctx context.Context, args *jobdomain.AddJobTasksArgs) (*jobdomain.AddJobTasksResult, error) {
+	if args == nil {
+		return nil, jobdomain.ErrInvalidParameters
+	}
+
+	job, err := r.update(ctx, args.Name, func(j *jobdomain.Job) error {
+		j.Total += args.Count
+		if args.Seal {
+			j.Sealed = true
+			j.Settle(time.Now().UTC())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &jobdomain.AddJobTasksResult{Job: job}, nil
+}
+
+func (r *Repository) RecordJobTask(ctx context.Context, args *jobdomain.RecordJobTaskArgs) error {
+	if args == nil || !args.State.IsTerminal() {
+		return jobdomain.ErrInvalidParameters
+	}
+
+	for attempt := 1; ; attempt++ {
+		_, err := r.update(ctx, args.Name, func(j *jobdomain.Job) error {
+			j.Record(args.State, time.Now().UTC())
+			return nil
+		})
+		if err == nil || errors.Is(err, jobdomain.ErrNotFound) || errors.Is(err, jobdomain.ErrInvalidName) ||
+			attempt >= maxRecordAttempts {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Duration(attempt) * 100 * time.Millisecond):
+		}
+	}
+}
+
+// ReconcileJob sets counters recounted from the job's tasks and settles the
+// job with them.
+func (r *Repository) ReconcileJob(ctx context.Context, args *jobdomain.ReconcileJobArgs) (*jobdomain.ReconcileJobResult, error) {
+	if args == nil {
+		return nil, jobdomain.ErrInvalidParameters
+	}
+
+	job, err := r.update(ctx, args.Name, func(j *jobdomain.Job) error {
+		if j.Counts() != args.Seen {
+			return jobdomain.ErrJobModified
+		}
+		j.SetCounts(args.Counted)
+		j.Settle(time.Now().UTC())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &jobdomain.ReconcileJobResult{Job: job}, nil
+}
+
+// CancelJob only marks the job; canceling its tasks is up to the caller.
+// A job that failed early keeps its state.
+func (r *Repository) CancelJob(ctx context.Context, args *jobdomain.CancelJobArgs) (*jobdomain.CancelJobResult, error) {
+	if args == nil {
+		return nil, jobdomain.ErrInvalidParameters
+	}
+
+	job, err := r.update(ctx, args.Name, func(j *jobdomain.Job) error {
+		if j.Done() {
+			return jobdomain.ErrJobAlreadyEnded
+		}
+		if j.State == jobdomain.JobStateRunning {
+			j.State = jobdomain.JobStateCanceled
+			j.EndedAt = time.Now().UTC()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &jobdomain.CancelJobResult{Job: job}, nil
+}
+
+func (r *Repository) GetJob(ctx context.Context, args *jobdomain.GetJobArgs) (*jobdomain.GetJobResult, error) {
+	if args == nil {
+		return nil, jobdomain.ErrInvalidParameters
+	}
+	if _, err := jobdomain.ParseJobName(args.Name); err != nil {
+		return nil, err
+	}
+
+	_, job, err := r.getJobEntry(ctx, args.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &jobdomain.GetJobResult{Job: job}, nil
+}
+
+// ListJobs pages through jobs by name; the page token is the last name of
+// the previous page.
+func (r *Repository) ListJobs(ctx context.Context, args *jobdomain.ListJobsArgs) (*jobdomain.ListJobsResult, error) {
+	if args == nil {
+		return nil, jobdomain.ErrInvalidParameters
+	}
+	if args.PageSize <= 0 {
+		return nil, jobdomain.ErrEmptyPageSize
+	}
+
+	keys, err := r.listJobKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	start := 0
+	if args.PageToken != "" {
+		start = sort.SearchStrings(keys, args.PageToken)
+		if start == len(keys) || keys[start] != args.PageToken {
+			return nil, jobdomain.ErrInvalidPageToken
+		}
+		start++
+	}
+
+	jobs := make([]*jobdomain.Job, 0, args.PageSize)
+	end := start
+	for ; end < len(keys) && len(jobs) < int(args.PageSize); end++ {
+		_, job, err := r.getJobEntry(ctx, keys[end])
+		if err != nil {
+			if errors.Is(err, jobdomain.ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+
+	next := ""
+	if end < len(keys) && end > start {
+		next = keys[end-1]
+	}
+	return &jobdomain.ListJobsResult{Jobs: jobs, NextPageToken: next}, nil
+}
+
+// WatchJob follows the job's key; updates are pushed by the KV.
+func (r *Repository) WatchJob(ctx context.Context, args *jobdomain.WatchJobArgs) error {
+	if args == nil || args.Send == nil {
+		return jobdomain.ErrInvalidParameters
+	}
+	if _, err := jobdomain.ParseJobName(args.Name); err != nil {
+		return err
+	}
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	w, err := r.kv.Watch(watchCtx, args.Name)
+	if err != nil {
+		return err
+	}
+	defer w.Stop()
+
+	seen := false
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case e, ok := <-w.Updates():
+			if !ok {
+				return errors.New("job watch closed")
+			}
+			// nil marks the end of the initial values.
+			if e == nil {
+				if !seen {
+					return jobdomain.ErrNotFound
+				}
+				continue
+			}
+			if e.Operation() != jetstream.KeyValuePut {
+				return jobdomain.ErrNotFound
+			}
+
+			var job jobdomain.Job
+			if err := json.Unmarshal(e.Value(), &job); err != nil {
+				return err
+			}
+			seen = true
+			if err := args.Send(&job); err != nil {
+				return err
+			}
+			if job.Done() {
+				return nil
+			}
+		}
+	}
+}
+
+// update applies fn to the job and writes it back, retrying when another
+// writer got there first.
+func (r *Repository) update(ctx context.Context, name string, fn func(*jobdomain.Job) error) (*jobdomain.Job, error) {
+	if _, err := jobdomain.ParseJobName(name); err != nil {
+		return nil, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		entry, job, err := r.getJobEntry(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if err := fn(job); err != nil {
+			return nil, err
+		}
+
+		b, err := json.Marshal(job)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = r.kv.Update(ctx, name, b, entry.Revision())
+		if err == nil {
+			return job, nil
+		}
+		if !errors.Is(err, jetstream.ErrKeyExists) || attempt+1 >= maxUpdateAttempts {
+			return nil, err
+		}
+		// Jitter keeps concurrent writers from colliding in lockstep.
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(time.Duration(rand.IntN(5)+1) * time.Millisecond):
+		}
+	}
+}
+
+func (r *Repository) getJobEntry(ctx context.Context, key string) (jetstream.KeyValueEntry, *jobdomain.Job, error) {
+	entry, err := r.kv.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			return nil, nil, jobdomain.ErrNotFound
+		}
+		return nil, nil, err
+	}
+
+	var job jobdomain.Job
+	if err := json.Unmarshal(entry.Value(), &job); err != nil {
+		return nil, nil, err
+	}
+	if job.Name == "" {
+		job.Name = jobdomain.JobName(key)
+	}
+	return entry, &job, nil
+}
+
+func (r *Repository) listJobKeys(ctx context.Context) ([]string, error) {
+	lister, err := r.kv.ListKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer lister.Stop()
+
+	var keys []string
+	for k := range lister.Keys() {
+		if strings.HasPrefix(k, "jobs/") {
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Strings(keys)
+	return keys, nil
+}
@@ -0,0 +1,186 @@
+package schedrepo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+
+	scheddomain "github.com/10Narratives/faas/internal/domains/schedules"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const maxUpdateAttempts = 5
+
+type Repository struct {
+	kv jetstream.KeyValue
+}
+
+func NewRepository(kv jetstream.KeyValue) *Repository {
+	return &Repository{kv: kv}
+}
+
+func (r *Repository) CreateSchedule(ctx context.Context, s *scheddomain.Schedule) error {
+	if s == nil || s.Name == "" {
+		return scheddomain.ErrInvalidParameters
+	}
+
+	b, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.kv.Create(ctx, string(s.Name), b); err != nil {
+		if errors.Is(err, jetstream.ErrKeyExists) {
+			return scheddomain.ErrAlreadyExists
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *Repository) GetSchedule(ctx context.Context, name scheddomain.ScheduleName) (*scheddomain.Schedule, error) {
+	if name == "" {
+		return nil, scheddomain.ErrInvalidParameters
+	}
+
+	_, s, err := r.getEntry(ctx, string(name))
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// UpdateSchedule applies mutate to the schedule and writes it back,
+// retrying when another writer got there first. Since the write is
+// conditional, mutate may claim a slot: only one caller succeeds with it.
+func (r *Repository) UpdateSchedule(
+	ctx context.Context,
+	name scheddomain.ScheduleName,
+	mutate func(s *scheddomain.Schedule) error,
+) (*scheddomain.Schedule, error) {
+	if name == "" || mutate == nil {
+		return nil, scheddomain.ErrInvalidParameters
+	}
+
+	for attempt := 0; ; attempt++ {
+		entry, s, err := r.getEntry(ctx, string(name))
+		if err != nil {
+			return nil, err
+		}
+		if err := mutate(s); err != nil {
+			return nil, err
+		}
+
+		b, err := json.Marshal(s)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = r.kv.Update(ctx, string(name), b, entry.Revision())
+		if err == nil {
+			return s, nil
+		}
+		if !errors.Is(err, jetstream.ErrKeyExists) || attempt+1 >= maxUpdateAttempts {
+			return nil, err
+		}
+	}
+}
+
+func (r *Repository) DeleteSchedule(ctx context.Context, name scheddomain.ScheduleName) error {
+	if name == "" {
+		return scheddomain.ErrInvalidParameters
+	}
+
+	if _, _, err := r.getEntry(ctx, string(name)); err != nil {
+		return err
+	}
+
+	return r.kv.Delete(ctx, string(name))
+}
+
+// ListSchedules pages through schedules by name; the page token is the
+// last name of the previous page.
+func (r *Repository) ListSchedules(ctx context.Context, args *scheddomain.ListSchedulesArgs) (*scheddomain.ListSchedulesResult, error) {
+	if args == nil {
+		return nil, scheddomain.ErrInvalidParameters
+	}
+
+	pageSize := int(args.PageSize)
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+	if pageSize > 1000 {
+		pageSize = 1000
+	}
+
+	lister, err := r.kv.ListKeys(ctx)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrNoKeysFound) {
+			return &scheddomain.ListSchedulesResult{}, nil
+		}
+		return nil, err
+	}
+	defer lister.Stop()
+
+	var keys []string
+	for k := range lister.Keys() {
+		if strings.HasPrefix(k, "schedules/") {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	start := 0
+	if args.PageToken != "" {
+		i := sort.SearchStrings(keys, args.PageToken)
+		if i >= len(keys) || keys[i] != args.PageToken {
+			return nil, scheddomain.ErrInvalidPageToken
+		}
+		start = i + 1
+	}
+	if start >= len(keys) {
+		return &scheddomain.ListSchedulesResult{}, nil
+	}
+
+	end := min(start+pageSize, len(keys))
+
+	out := make([]*scheddomain.Schedule, 0, end-start)
+	for _, k := range keys[start:end] {
+		_, s, err := r.getEntry(ctx, k)
+		if err != nil {
+			if errors.Is(err, scheddomain.ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		out = append(out, s)
+	}
+
+	next := ""
+	if end < len(keys) {
+		next = keys[end-1]
+	}
+
+	return &scheddomain.ListSchedulesResult{Schedules: out, NextPageToken: next}, nil
+}
+
+func (r *Repository) getEntry(ctx context.Context, key string) (jetstream.KeyValueEntry, *scheddomain.Schedule, error) {
+	entry, err := r.kv.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			return nil, nil, scheddomain.ErrNotFound
+		}
+		return nil, nil, err
+	}
+
+	var s scheddomain.Schedule
+	if err := json.Unmarshal(entry.Value(), &s); err != nil {
+		return nil, nil, err
+	}
+	if s.Name == "" {
+		s.Name = scheddomain.ScheduleName(key)
+	}
+	return entry, &s, nil
+}
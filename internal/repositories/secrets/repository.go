@@ -0,0 +1,162 @@
+package secretrepo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+
+	secretdomain "github.com/10Narratives/faas/internal/domains/secrets"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+type Repository struct {
+	kv jetstream.KeyValue
+}
+
+func NewRepository(kv jetstream.KeyValue) *Repository {
+	return &Repository{kv: kv}
+}
+
+func (r *Repository) CreateSecret(ctx context.Context, s *secretdomain.EncryptedSecret) error {
+	if s == nil || s.Name == "" || len(s.Ciphertext) == 0 {
+		return secretdomain.ErrInvalidArgument
+	}
+
+	b, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.kv.Create(ctx, string(s.Name), b); err != nil {
+		if errors.Is(err, jetstream.ErrKeyExists) {
+			return secretdomain.ErrSecretAlreadyExists
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *Repository) GetSecret(ctx context.Context, name secretdomain.SecretName) (*secretdomain.EncryptedSecret, error) {
+	if name == "" {
+		return nil, secretdomain.ErrInvalidArgument
+	}
+
+	_, s, err := r.getEntry(ctx, string(name))
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (r *Repository) UpdateSecret(ctx context.Context, s *secretdomain.EncryptedSecret) error {
+	if s == nil || s.Name == "" || len(s.Ciphertext) == 0 {
+		return secretdomain.ErrInvalidArgument
+	}
+
+	entry, _, err := r.getEntry(ctx, string(s.Name))
+	if err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.kv.Update(ctx, string(s.Name), b, entry.Revision())
+	return err
+}
+
+func (r *Repository) DeleteSecret(ctx context.Context, args *secretdomain.DeleteSecretArgs) error {
+	if args == nil || args.Name == "" {
+		return secretdomain.ErrInvalidArgument
+	}
+
+	if _, _, err := r.getEntry(ctx, string(args.Name)); err != nil {
+		return err
+	}
+
+	return r.kv.Delete(ctx, string(args.Name))
+}
+
+func (r *Repository) ListSecrets(ctx context.Context, args *secretdomain.ListSecretsArgs) (*secretdomain.ListSecretsResult, error) {
+	if args == nil {
+		return nil, secretdomain.ErrInvalidArgument
+	}
+
+	pageSize := int(args.PageSize)
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+	if pageSize > 1000 {
+		pageSize = 1000
+	}
+
+	lister, err := r.kv.ListKeys(ctx)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrNoKeysFound) {
+			return &secretdomain.ListSecretsResult{}, nil
+		}
+		return nil, err
+	}
+	defer lister.Stop()
+
+	var keys []string
+	for k := range lister.Keys() {
+		if strings.HasPrefix(k, "secrets/") {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	start := 0
+	if args.PageToken != "" {
+		i := sort.SearchStrings(keys, args.PageToken)
+		if i >= len(keys) || keys[i] != args.PageToken {
+			return nil, secretdomain.ErrInvalidPageToken
+		}
+		start = i + 1
+	}
+	if start >= len(keys) {
+		return &secretdomain.ListSecretsResult{}, nil
+	}
+
+	end := min(start+pageSize, len(keys))
+
+	out := make([]*secretdomain.Secret, 0, end-start)
+	for _, k := range keys[start:end] {
+		_, s, err := r.getEntry(ctx, k)
+		if err != nil {
+			if errors.Is(err, secretdomain.ErrSecretNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		out = append(out, &s.Secret)
+	}
+
+	next := ""
+	if end < len(keys) {
+		next = keys[end-1]
+	}
+
+	return &secretdomain.ListSecretsResult{Secrets: out, NextPageToken: next}, nil
+}
+
+func (r *Repository) getEntry(ctx context.Context, key string) (jetstream.KeyValueEntry, *secretdomain.EncryptedSecret, error) {
+	entry, err := r.kv.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			return nil, nil, secretdomain.ErrSecretNotFound
+		}
+		return nil, nil, err
+	}
+
+	var s secretdomain.EncryptedSecret
+	if err := json.Unmarshal(entry.Value(), &s); err != nil {
+		return nil, nil, err
+	}
+	return entry, &s, nil
+}
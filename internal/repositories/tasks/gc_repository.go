@@ -0,0 +1,76 @@
+package taskrepo
+
+import (
+	"context"
+	"errors"
+
+	gcdomain "github.com/10Narratives/faas/internal/domains/gc"
+	taskdomain "github.com/10Narratives/faas/internal/domains/tasks"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// ReferencedObjects returns the object keys of every task input, result
+// and artifact.
+func (r *Repository) ReferencedObjects(ctx context.Context) (map[string]bool, error) {
+	keys, err := r.listAllTaskKeys(ctx)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrNoKeysFound) {
+			return map[string]bool{}, nil
+		}
+		return nil, err
+	}
+
+	refs := make(map[string]bool)
+	for _, k := range keys {
+		_, t, err := r.getTaskEntry(ctx, k)
+		if err != nil {
+			if errors.Is(err, taskdomain.ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		for _, in := range t.Inputs {
+			refs[in.ObjectKey] = true
+		}
+		if res := t.Result; res != nil {
+			if res.ObjectKey != "" {
+				refs[res.ObjectKey] = true
+			}
+			for _, a := range res.Artifacts {
+				refs[a.ObjectKey] = true
+			}
+		}
+	}
+	return refs, nil
+}
+
+// ListObjects lists every object of the tasks object store.
+func (r *ObjectRepository) ListObjects(ctx context.Context) ([]gcdomain.Object, error) {
+	infos, err := r.os.List(ctx)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrNoObjectsFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	out := make([]gcdomain.Object, 0, len(infos))
+	for _, info := range infos {
+		if info.Deleted {
+			continue
+		}
+		out = append(out, gcdomain.Object{Key: info.Name, Size: info.Size, ModTime: info.ModTime})
+	}
+	return out, nil
+}
+
+// DeleteObject removes an object found unreferenced by the collector.
+func (r *ObjectRepository) DeleteObject(ctx context.Context, key string) error {
+	if key == "" {
+		return gcdomain.ErrInvalidArgument
+	}
+	if err := r.os.Delete(ctx, key); err != nil && !isObjectNotFound(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package taskrepo
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	taskdomain "github.com/10Narratives/faas/internal/domains/tasks"
+	digestutils "github.com/10Narratives/faas/pkg/digest"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+type ObjectRepository struct {
+	os jetstream.ObjectStore
+}
+
+func NewObjectRepository(os jetstream.ObjectStore) *ObjectRepository {
+	return &ObjectRepository{os: os}
+}
+
+const (
+	inputsPrefix  = "inputs"
+	outputsPrefix = "outputs"
+)
+
+func (r *ObjectRepository) SaveTaskArtifact(ctx context.Context, args *taskdomain.SaveTaskArtifactArgs) (*taskdomain.SaveTaskArtifactResult, error) {
+	return r.save(ctx, outputsPrefix, args)
+}
+
+// SaveTaskInput stores an input file. Inputs live next to the task's
+// outputs and are removed the same way.
+func (r *ObjectRepository) SaveTaskInput(ctx context.Context, args *taskdomain.SaveTaskArtifactArgs) (*taskdomain.SaveTaskArtifactResult, error) {
+	return r.save(ctx, inputsPrefix, args)
+}
+
+func (r *ObjectRepository) save(ctx context.Context, prefix string, args *taskdomain.SaveTaskArtifactArgs) (*taskdomain.SaveTaskArtifactResult, error) {
+	if args == nil || args.Data == nil {
+		return nil, taskdomain.ErrInvalidResult
+	}
+	if _, err := taskdomain.ParseTaskName(string(args.Task)); err != nil {
+		return nil, err
+	}
+	if err := taskdomain.ValidateArtifactName(args.Name); err != nil {
+		return nil, err
+	}
+
+	meta := jetstream.ObjectMeta{Name: objectKey(args.Task, prefix, args.Name)}
+	if args.ContentType != "" {
+		meta.Headers = nats.Header{"Content-Type": []string{args.ContentType}}
+	}
+
+	dr := digestutils.NewReader(args.Data)
+	info, err := r.os.Put(ctx, meta, dr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &taskdomain.SaveTaskArtifactResult{
+		Artifact: &taskdomain.TaskArtifact{
+			Name:        args.Name,
+			ObjectKey:   info.Name,
+			Size:        info.Size,
+			SHA256:      dr.SHA256(),
+			ContentType: args.ContentType,
+		},
+	}, nil
+}
+
+func (r *ObjectRepository) OpenTaskArtifact(ctx context.Context, artifact *taskdomain.TaskArtifact) (io.ReadCloser, error) {
+	if artifact == nil || artifact.ObjectKey == "" {
+		return nil, taskdomain.ErrArtifactNotFound
+	}
+
+	obj, err := r.os.Get(ctx, artifact.ObjectKey)
+	if err != nil {
+		if isObjectNotFound(err) {
+			return nil, taskdomain.ErrArtifactNotFound
+		}
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (r *ObjectRepository) DeleteTaskArtifact(ctx context.Context, artifact *taskdomain.TaskArtifact) error {
+	if artifact == nil || artifact.ObjectKey == "" {
+		return taskdomain.ErrArtifactNotFound
+	}
+
+	if err := r.os.Delete(ctx, artifact.ObjectKey); err != nil && !isObjectNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// objectKey keeps all files of a task under one prefix:
+// "<task id>/{inputs,outputs}/<name>".
+func objectKey(task taskdomain.TaskName, prefix, name string) string {
+	return strings.TrimPrefix(string(task), "tasks/") + "/" + prefix + "/" + name
+}
+
+func isObjectNotFound(err error) bool {
+	return errors.Is(err, jetstream.ErrObjectNotFound)
+}
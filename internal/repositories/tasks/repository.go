@@ -63,6 +63,176 @@ func (r *Repository) CancelTask(ctx context.Context, args *taskdomain.CancelTask
 	return &taskdomain.CancelTaskResult{Task: t}, nil
 }
 
+func (r *Repository) StartTask(ctx context.Context, args *taskdomain.StartTaskArgs) (*taskdomain.StartTaskResult, error) {
+	if args == nil || args.Name == "" {
+		return nil, taskdomain.ErrInvalidName
+	}
+	if _, err := taskdomain.ParseTaskName(args.Name); err != nil {
+		return nil, err
+	}
+
+	entry, t, err := r.getTaskEntry(ctx, args.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	switch t.State {
+	case taskdomain.TaskStatePending:
+		// ok
+	case taskdomain.TaskStateProcessing:
+		if args.StaleAfter <= 0 || now.Sub(t.LastSeen()) <= args.StaleAfter {
+			return nil, taskdomain.ErrTaskRunning
+		}
+		// The agent running the attempt stopped reporting; it counts as a
+		// failed attempt.
+	default:
+		return nil, taskdomain.ErrTaskNotPending
+	}
+
+	t.State = taskdomain.TaskStateProcessing
+	t.StartedAt = now
+	t.HeartbeatAt = now
+	t.Attempt++
+
+	b, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+
+	// Revision check makes the transition exclusive when the same message
+	// is redelivered to several agents.
+	if _, err := r.kv.Update(ctx, args.Name, b, entry.Revision()); err != nil {
+		if errors.Is(err, jetstream.ErrKeyExists) {
+			return nil, taskdomain.ErrTaskNotPending
+		}
+		return nil, err
+	}
+
+	return &taskdomain.StartTaskResult{Task: t}, nil
+}
+
+// HeartbeatTask records that the given attempt of a processing task is
+// still running.
+func (r *Repository) HeartbeatTask(ctx context.Context, args *taskdomain.HeartbeatTaskArgs) error {
+	if args == nil || args.Name == "" {
+		return taskdomain.ErrInvalidName
+	}
+	if _, err := taskdomain.ParseTaskName(args.Name); err != nil {
+		return err
+	}
+
+	entry, t, err := r.getTaskEntry(ctx, args.Name)
+	if err != nil {
+		return err
+	}
+	if t.State != taskdomain.TaskStateProcessing || t.Attempt != args.Attempt {
+		return taskdomain.ErrTaskNotProcessing
+	}
+
+	t.HeartbeatAt = time.Now().UTC()
+
+	b, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+
+	// A concurrent write is not retried: the next heartbeat will do.
+	_, err = r.kv.Update(ctx, args.Name, b, entry.Revision())
+	return err
+}
+
+// RetryTask moves a processing task back to pending. The revision check
+// keeps a cancellation in the meantime from being overwritten.
+func (r *Repository) RetryTask(ctx context.Context, args *taskdomain.RetryTaskArgs) (*taskdomain.RetryTaskResult, error) {
+	if args == nil || args.Name == "" {
+		return nil, taskdomain.ErrInvalidName
+	}
+	if _, err := taskdomain.ParseTaskName(args.Name); err != nil {
+		return nil, err
+	}
+
+	entry, t, err := r.getTaskEntry(ctx, args.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	switch t.State {
+	case taskdomain.TaskStateProcessing:
+		// ok
+	case taskdomain.TaskStateSucceeded, taskdomain.TaskStateFailed, taskdomain.TaskStateCanceled:
+		return nil, taskdomain.ErrTaskAlreadyCompleted
+	default:
+		return nil, taskdomain.ErrTaskNotProcessing
+	}
+
+	t.State = taskdomain.TaskStatePending
+	t.LastError = args.ErrorMessage
+
+	b, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := r.kv.Update(ctx, args.Name, b, entry.Revision()); err != nil {
+		if errors.Is(err, jetstream.ErrKeyExists) {
+			return nil, taskdomain.ErrTaskAlreadyCompleted
+		}
+		return nil, err
+	}
+
+	return &taskdomain.RetryTaskResult{Task: t}, nil
+}
+
+func (r *Repository) CompleteTask(ctx context.Context, args *taskdomain.CompleteTaskArgs) (*taskdomain.CompleteTaskResult, error) {
+	if args == nil || args.Name == "" {
+		return nil, taskdomain.ErrInvalidName
+	}
+	if _, err := taskdomain.ParseTaskName(args.Name); err != nil {
+		return nil, err
+	}
+	if err := args.Result.Validate(); err != nil {
+		return nil, errors.Join(taskdomain.ErrInvalidResult, err)
+	}
+
+	entry, t, err := r.getTaskEntry(ctx, args.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	switch t.State {
+	case taskdomain.TaskStateProcessing:
+		// ok
+	case taskdomain.TaskStateSucceeded, taskdomain.TaskStateFailed, taskdomain.TaskStateCanceled:
+		return nil, taskdomain.ErrTaskAlreadyCompleted
+	default:
+		return nil, taskdomain.ErrTaskNotProcessing
+	}
+	if t.Result != nil {
+		return nil, taskdomain.ErrResultAlreadySet
+	}
+
+	result := args.Result
+	t.Result = &result
+	t.EndedAt = time.Now().UTC()
+	if result.Type == taskdomain.TaskResultError {
+		t.State = taskdomain.TaskStateFailed
+	} else {
+		t.State = taskdomain.TaskStateSucceeded
+	}
+
+	b, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := r.kv.Update(ctx, args.Name, b, entry.Revision()); err != nil {
+		return nil, err
+	}
+
+	return &taskdomain.CompleteTaskResult{Task: t}, nil
+}
+
 func (r *Repository) CreateTask(ctx context.Context, args *taskdomain.CreateTaskArgs) (*taskdomain.CreateTaskResult, error) {
 	if args == nil {
 		return nil, taskdomain.ErrInvalidParameters
@@ -71,17 +241,25 @@ func (r *Repository) CreateTask(ctx context.Context, args *taskdomain.CreateTask
 		return nil, taskdomain.ErrInvalidFunction
 	}
 
-	id := uuid.New()
+	id := args.ID
+	if id == uuid.Nil {
+		id = uuid.New()
+	}
 	name := "tasks/" + id.String()
 	now := time.Now().UTC()
 
 	t := &taskdomain.Task{
-		ID:         id,
-		Name:       taskdomain.TaskName(name),
-		Function:   args.Function,
-		Parameters: args.Parameters,
-		State:      taskdomain.TaskStatePending,
-		CreatedAt:  now,
+		ID:               id,
+		Name:             taskdomain.TaskName(name),
+		Function:         args.Function,
+		FunctionRevision: args.FunctionRevision,
+		Parameters:       args.Parameters,
+		State:            taskdomain.TaskStatePending,
+		CreatedAt:        now,
+		Inputs:           args.Inputs,
+		Labels:           args.Labels,
+		Annotations:      args.Annotations,
+		BatchID:          args.BatchID,
 	}
 
 	b, err := json.Marshal(t)
@@ -100,6 +278,41 @@ func (r *Repository) CreateTask(ctx context.Context, args *taskdomain.CreateTask
 	return &taskdomain.CreateTaskResult{Name: name}, nil
 }
 
+// UpdateTask applies a labels or annotations update. It retries when the
+// task changes concurrently, e.g. because an agent completed it.
+func (r *Repository) UpdateTask(ctx context.Context, args *taskdomain.UpdateTaskArgs) (*taskdomain.UpdateTaskResult, error) {
+	if args == nil || args.Name == "" {
+		return nil, taskdomain.ErrInvalidName
+	}
+	if _, err := taskdomain.ParseTaskName(args.Name); err != nil {
+		return nil, err
+	}
+
+	const maxAttempts = 5
+	for attempt := 0; ; attempt++ {
+		entry, t, err := r.getTaskEntry(ctx, args.Name)
+		if err != nil {
+			return nil, err
+		}
+		if err := t.ApplyUpdate(args); err != nil {
+			return nil, err
+		}
+
+		b, err := json.Marshal(t)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = r.kv.Update(ctx, args.Name, b, entry.Revision())
+		if err == nil {
+			return &taskdomain.UpdateTaskResult{Task: t}, nil
+		}
+		if !errors.Is(err, jetstream.ErrKeyExists) || attempt+1 >= maxAttempts {
+			return nil, err
+		}
+	}
+}
+
 func (r *Repository) DeleteTask(ctx context.Context, args *taskdomain.DeleteTaskArgs) error {
 	if args == nil || args.Name == "" {
 		return taskdomain.ErrInvalidName
@@ -160,16 +373,12 @@ func (r *Repository) ListTasks(ctx context.Context, args *taskdomain.ListTasksAr
 		return &taskdomain.ListTaskResult{Tasks: []*taskdomain.Task{}, NextPageToken: ""}, nil
 	}
 
-	end := start + int(args.PageSize)
-	if end > len(keys) {
-		end = len(keys)
-	}
-
-	pageKeys := keys[start:end]
-	tasks := make([]*taskdomain.Task, 0, len(pageKeys))
-
-	for _, k := range pageKeys {
-		_, t, err := r.getTaskEntry(ctx, k)
+	// With a filter, keys are scanned until the page is full; the token is
+	// the last key looked at, matched or not.
+	tasks := make([]*taskdomain.Task, 0, args.PageSize)
+	end := start
+	for ; end < len(keys) && len(tasks) < int(args.PageSize); end++ {
+		_, t, err := r.getTaskEntry(ctx, keys[end])
 		if err != nil {
 			// если ключ внезапно пропал между ListKeys и Get — просто пропускаем
 			if errors.Is(err, taskdomain.ErrNotFound) {
@@ -177,12 +386,14 @@ func (r *Repository) ListTasks(ctx context.Context, args *taskdomain.ListTasksAr
 			}
 			return nil, err
 		}
-		tasks = append(tasks, t)
+		if args.Filter.Match(t) {
+			tasks = append(tasks, t)
+		}
 	}
 
 	next := ""
-	if end < len(keys) && len(pageKeys) > 0 {
-		next = pageKeys[len(pageKeys)-1]
+	if end < len(keys) && end > start {
+		next = keys[end-1]
 	}
 
 	return &taskdomain.ListTaskResult{
@@ -238,3 +449,70 @@ func indexOf(ss []string, s string) int {
 	}
 	return -1
 }
+
+// WaitTask watches the task's key until it reaches a terminal state or
+// args.Timeout passes. Updates are pushed by the KV, so nothing is polled.
+func (r *Repository) WaitTask(ctx context.Context, args *taskdomain.WaitTaskArgs) (*taskdomain.WaitTaskResult, error) {
+	if args == nil || args.Name == "" {
+		return nil, taskdomain.ErrInvalidName
+	}
+	if _, err := taskdomain.ParseTaskName(args.Name); err != nil {
+		return nil, err
+	}
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	w, err := r.kv.Watch(watchCtx, args.Name)
+	if err != nil {
+		return nil, err
+	}
+	defer w.Stop()
+
+	timer := time.NewTimer(args.Timeout)
+	defer timer.Stop()
+
+	var last *taskdomain.Task
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+			if last == nil {
+				// The timeout beat the watch's initial value.
+				_, t, err := r.getTaskEntry(ctx, args.Name)
+				if err != nil {
+					return nil, err
+				}
+				return &taskdomain.WaitTaskResult{Task: t, Done: t.State.IsTerminal()}, nil
+			}
+			return &taskdomain.WaitTaskResult{Task: last}, nil
+		case e, ok := <-w.Updates():
+			if !ok {
+				return nil, errors.New("task watch closed")
+			}
+			// nil marks the end of the initial values.
+			if e == nil {
+				if last == nil {
+					return nil, taskdomain.ErrNotFound
+				}
+				continue
+			}
+			if e.Operation() != jetstream.KeyValuePut {
+				return nil, taskdomain.ErrNotFound
+			}
+
+			var t taskdomain.Task
+			if err := json.Unmarshal(e.Value(), &t); err != nil {
+				return nil, err
+			}
+			if t.Name == "" {
+				t.Name = taskdomain.TaskName(args.Name)
+			}
+			last = &t
+			if t.State.IsTerminal() {
+				return &taskdomain.WaitTaskResult{Task: last, Done: true}, nil
+			}
+		}
+	}
+}
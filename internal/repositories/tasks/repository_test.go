@@ -0,0 +1,161 @@
+package taskrepo_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	taskdomain "github.com/10Narratives/faas/internal/domains/tasks"
+	taskrepo "github.com/10Narratives/faas/internal/repositories/tasks"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeKV holds a single task record; updateErr, when set, fails the next
+// update.
+type fakeKV struct {
+	jetstream.KeyValue
+
+	value     []byte
+	revision  uint64
+	updateErr error
+}
+
+func (kv *fakeKV) Get(_ context.Context, key string) (jetstream.KeyValueEntry, error) {
+	if kv.value == nil {
+		return nil, jetstream.ErrKeyNotFound
+	}
+	return &fakeEntry{key: key, value: kv.value, revision: kv.revision}, nil
+}
+
+func (kv *fakeKV) Update(_ context.Context, _ string, value []byte, last uint64) (uint64, error) {
+	if err := kv.updateErr; err != nil {
+		kv.updateErr = nil
+		return 0, err
+	}
+	if last != kv.revision {
+		return 0, jetstream.ErrKeyExists
+	}
+	kv.value = value
+	kv.revision++
+	return kv.revision, nil
+}
+
+func (kv *fakeKV) task(t *testing.T) *taskdomain.Task {
+	t.Helper()
+
+	var task taskdomain.Task
+	require.NoError(t, json.Unmarshal(kv.value, &task))
+	return &task
+}
+
+type fakeEntry struct {
+	jetstream.KeyValueEntry
+
+	key      string
+	value    []byte
+	revision uint64
+}
+
+func (e *fakeEntry) Key() string      { return e.key }
+func (e *fakeEntry) Value() []byte    { return e.value }
+func (e *fakeEntry) Revision() uint64 { return e.revision }
+
+func newKV(t *testing.T, task *taskdomain.Task) *fakeKV {
+	t.Helper()
+
+	b, err := json.Marshal(task)
+	require.NoError(t, err)
+	return &fakeKV{value: b, revision: 1}
+}
+
+func TestRepository_StartTask(t *testing.T) {
+	const staleAfter = 30 * time.Second
+	now := time.Now().UTC()
+
+	tests := []struct {
+		name        string
+		task        taskdomain.Task
+		updateErr   error
+		wantErr     error
+		wantAttempt int
+	}{
+		{
+			name:        "pending",
+			task:        taskdomain.Task{State: taskdomain.TaskStatePending},
+			wantAttempt: 1,
+		},
+		{
+			name:    "processing with a recent heartbeat",
+			task:    taskdomain.Task{State: taskdomain.TaskStateProcessing, Attempt: 1, StartedAt: now.Add(-time.Hour), HeartbeatAt: now.Add(-time.Second)},
+			wantErr: taskdomain.ErrTaskRunning,
+		},
+		{
+			name:        "processing with a stale heartbeat",
+			task:        taskdomain.Task{State: taskdomain.TaskStateProcessing, Attempt: 1, StartedAt: now.Add(-time.Hour), HeartbeatAt: now.Add(-time.Minute)},
+			wantAttempt: 2,
+		},
+		{
+			name:        "processing before heartbeats",
+			task:        taskdomain.Task{State: taskdomain.TaskStateProcessing, Attempt: 1, StartedAt: now.Add(-time.Minute)},
+			wantAttempt: 2,
+		},
+		{
+			name:    "completed",
+			task:    taskdomain.Task{State: taskdomain.TaskStateSucceeded, Attempt: 1},
+			wantErr: taskdomain.ErrTaskNotPending,
+		},
+		{
+			name:      "started concurrently",
+			task:      taskdomain.Task{State: taskdomain.TaskStatePending},
+			updateErr: jetstream.ErrKeyExists,
+			wantErr:   taskdomain.ErrTaskNotPending,
+		},
+		{
+			name:      "store unavailable",
+			task:      taskdomain.Task{State: taskdomain.TaskStatePending},
+			updateErr: context.DeadlineExceeded,
+			wantErr:   context.DeadlineExceeded,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.task.Name = "tasks/1"
+			kv := newKV(t, &tt.task)
+			kv.updateErr = tt.updateErr
+
+			res, err := taskrepo.NewRepository(kv).StartTask(context.Background(), &taskdomain.StartTaskArgs{
+				Name:       "tasks/1",
+				StaleAfter: staleAfter,
+			})
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				if !errors.Is(tt.wantErr, taskdomain.ErrTaskNotPending) {
+					require.NotErrorIs(t, err, taskdomain.ErrTaskNotPending)
+				}
+				require.Equal(t, tt.task.State, kv.task(t).State)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, taskdomain.TaskStateProcessing, res.Task.State)
+			require.Equal(t, tt.wantAttempt, res.Task.Attempt)
+			require.Equal(t, tt.wantAttempt, kv.task(t).Attempt)
+			require.WithinDuration(t, time.Now(), kv.task(t).HeartbeatAt, time.Second)
+		})
+	}
+}
+
+func TestRepository_HeartbeatTask(t *testing.T) {
+	ctx := context.Background()
+	kv := newKV(t, &taskdomain.Task{Name: "tasks/1", State: taskdomain.TaskStateProcessing, Attempt: 2})
+	repo := taskrepo.NewRepository(kv)
+
+	require.NoError(t, repo.HeartbeatTask(ctx, &taskdomain.HeartbeatTaskArgs{Name: "tasks/1", Attempt: 2}))
+	require.WithinDuration(t, time.Now(), kv.task(t).HeartbeatAt, time.Second)
+
+	// Another agent took the task over.
+	err := repo.HeartbeatTask(ctx, &taskdomain.HeartbeatTaskArgs{Name: "tasks/1", Attempt: 1})
+	require.ErrorIs(t, err, taskdomain.ErrTaskNotProcessing)
+}
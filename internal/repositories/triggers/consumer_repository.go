@@ -0,0 +1,97 @@
+package triggerrepo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	triggerdomain "github.com/10Narratives/faas/internal/domains/triggers"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// ackWait is how long a stream message may stay unacknowledged while its
+// task is created before it is redelivered.
+const ackWait = 30 * time.Second
+
+// maxDeliver bounds the deliveries of a message on a managed consumer, so
+// one whose task keeps failing to be created is eventually given up.
+const maxDeliver = 10
+
+// ackBackOff spaces out the redeliveries of messages whose acknowledgement
+// timed out, e.g. because the gateway died; the first entry is the ack
+// wait, the last repeats.
+var ackBackOff = []time.Duration{ackWait, time.Minute, 5 * time.Minute}
+
+// ConsumerRepository manages the JetStream consumers stream triggers read
+// through.
+type ConsumerRepository struct {
+	js jetstream.JetStream
+}
+
+func NewConsumerRepository(js jetstream.JetStream) *ConsumerRepository {
+	return &ConsumerRepository{js: js}
+}
+
+// EnsureConsumer creates or updates the managed consumer of a stream
+// trigger, or checks that a bound consumer exists. A managed consumer
+// starts with messages published after it was created.
+func (r *ConsumerRepository) EnsureConsumer(ctx context.Context, t *triggerdomain.Trigger) error {
+	if t == nil || t.Stream == "" {
+		return triggerdomain.ErrInvalidParameters
+	}
+
+	var err error
+	if durable := t.ManagedConsumer(); durable != "" {
+		_, err = r.js.CreateOrUpdateConsumer(ctx, t.Stream, jetstream.ConsumerConfig{
+			Durable:       durable,
+			Description:   "faas " + string(t.Name),
+			FilterSubject: t.Subject,
+			DeliverPolicy: jetstream.DeliverNewPolicy,
+			AckPolicy:     jetstream.AckExplicitPolicy,
+			BackOff:       ackBackOff,
+			MaxDeliver:    maxDeliver,
+			MaxAckPending: t.Concurrency,
+		})
+	} else {
+		_, err = r.js.Consumer(ctx, t.Stream, t.Consumer)
+	}
+	return consumerErr(err)
+}
+
+// DeleteConsumer removes the managed consumer of a stream trigger. Bound
+// consumers belong to their owners and are kept.
+func (r *ConsumerRepository) DeleteConsumer(ctx context.Context, t *triggerdomain.Trigger) error {
+	if t == nil {
+		return triggerdomain.ErrInvalidParameters
+	}
+	durable := t.ManagedConsumer()
+	if durable == "" {
+		return nil
+	}
+
+	err := r.js.DeleteConsumer(ctx, t.Stream, durable)
+	if errors.Is(err, jetstream.ErrConsumerNotFound) || errors.Is(err, jetstream.ErrStreamNotFound) {
+		return nil
+	}
+	return err
+}
+
+// Consumer returns the consumer a stream trigger reads through.
+func (r *ConsumerRepository) Consumer(ctx context.Context, t *triggerdomain.Trigger) (jetstream.Consumer, error) {
+	name := t.Consumer
+	if name == "" {
+		name = t.ManagedConsumer()
+	}
+	c, err := r.js.Consumer(ctx, t.Stream, name)
+	return c, consumerErr(err)
+}
+
+func consumerErr(err error) error {
+	switch {
+	case errors.Is(err, jetstream.ErrStreamNotFound):
+		return triggerdomain.ErrStreamNotFound
+	case errors.Is(err, jetstream.ErrConsumerNotFound):
+		return triggerdomain.ErrConsumerNotFound
+	}
+	return err
+}
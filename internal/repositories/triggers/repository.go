@@ -0,0 +1,185 @@
+package triggerrepo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+
+	triggerdomain "github.com/10Narratives/faas/internal/domains/triggers"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const maxUpdateAttempts = 5
+
+type Repository struct {
+	kv jetstream.KeyValue
+}
+
+func NewRepository(kv jetstream.KeyValue) *Repository {
+	return &Repository{kv: kv}
+}
+
+func (r *Repository) CreateTrigger(ctx context.Context, t *triggerdomain.Trigger) error {
+	if t == nil || t.Name == "" {
+		return triggerdomain.ErrInvalidParameters
+	}
+
+	b, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.kv.Create(ctx, string(t.Name), b); err != nil {
+		if errors.Is(err, jetstream.ErrKeyExists) {
+			return triggerdomain.ErrAlreadyExists
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *Repository) GetTrigger(ctx context.Context, name triggerdomain.TriggerName) (*triggerdomain.Trigger, error) {
+	if name == "" {
+		return nil, triggerdomain.ErrInvalidParameters
+	}
+
+	_, t, err := r.getEntry(ctx, string(name))
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// UpdateTrigger applies mutate to the trigger and writes it back,
+// retrying when another writer got there first.
+func (r *Repository) UpdateTrigger(
+	ctx context.Context,
+	name triggerdomain.TriggerName,
+	mutate func(t *triggerdomain.Trigger) error,
+) (*triggerdomain.Trigger, error) {
+	if name == "" || mutate == nil {
+		return nil, triggerdomain.ErrInvalidParameters
+	}
+
+	for attempt := 0; ; attempt++ {
+		entry, t, err := r.getEntry(ctx, string(name))
+		if err != nil {
+			return nil, err
+		}
+		if err := mutate(t); err != nil {
+			return nil, err
+		}
+
+		b, err := json.Marshal(t)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = r.kv.Update(ctx, string(name), b, entry.Revision())
+		if err == nil {
+			return t, nil
+		}
+		if !errors.Is(err, jetstream.ErrKeyExists) || attempt+1 >= maxUpdateAttempts {
+			return nil, err
+		}
+	}
+}
+
+func (r *Repository) DeleteTrigger(ctx context.Context, name triggerdomain.TriggerName) error {
+	if name == "" {
+		return triggerdomain.ErrInvalidParameters
+	}
+
+	if _, _, err := r.getEntry(ctx, string(name)); err != nil {
+		return err
+	}
+
+	return r.kv.Delete(ctx, string(name))
+}
+
+// ListTriggers pages through triggers by name; the page token is the
+// last name of the previous page.
+func (r *Repository) ListTriggers(ctx context.Context, args *triggerdomain.ListTriggersArgs) (*triggerdomain.ListTriggersResult, error) {
+	if args == nil {
+		return nil, triggerdomain.ErrInvalidParameters
+	}
+
+	pageSize := int(args.PageSize)
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+	if pageSize > 1000 {
+		pageSize = 1000
+	}
+
+	lister, err := r.kv.ListKeys(ctx)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrNoKeysFound) {
+			return &triggerdomain.ListTriggersResult{}, nil
+		}
+		return nil, err
+	}
+	defer lister.Stop()
+
+	var keys []string
+	for k := range lister.Keys() {
+		if strings.HasPrefix(k, "triggers/") {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	start := 0
+	if args.PageToken != "" {
+		i := sort.SearchStrings(keys, args.PageToken)
+		if i >= len(keys) || keys[i] != args.PageToken {
+			return nil, triggerdomain.ErrInvalidPageToken
+		}
+		start = i + 1
+	}
+	if start >= len(keys) {
+		return &triggerdomain.ListTriggersResult{}, nil
+	}
+
+	end := min(start+pageSize, len(keys))
+
+	out := make([]*triggerdomain.Trigger, 0, end-start)
+	for _, k := range keys[start:end] {
+		_, t, err := r.getEntry(ctx, k)
+		if err != nil {
+			if errors.Is(err, triggerdomain.ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		out = append(out, t)
+	}
+
+	next := ""
+	if end < len(keys) {
+		next = keys[end-1]
+	}
+
+	return &triggerdomain.ListTriggersResult{Triggers: out, NextPageToken: next}, nil
+}
+
+func (r *Repository) getEntry(ctx context.Context, key string) (jetstream.KeyValueEntry, *triggerdomain.Trigger, error) {
+	entry, err := r.kv.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			return nil, nil, triggerdomain.ErrNotFound
+		}
+		return nil, nil, err
+	}
+
+	var t triggerdomain.Trigger
+	if err := json.Unmarshal(entry.Value(), &t); err != nil {
+		return nil, nil, err
+	}
+	if t.Name == "" {
+		t.Name = triggerdomain.TriggerName(key)
+	}
+	return entry, &t, nil
+}
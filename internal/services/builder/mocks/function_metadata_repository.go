@@ -0,0 +1,157 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FunctionMetadataRepository is an autogenerated mock type for the FunctionMetadataRepository type
+type FunctionMetadataRepository struct {
+	mock.Mock
+}
+
+type FunctionMetadataRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FunctionMetadataRepository) EXPECT() *FunctionMetadataRepository_Expecter {
+	return &FunctionMetadataRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetFunction provides a mock function with given fields: ctx, args
+func (_m *FunctionMetadataRepository) GetFunction(ctx context.Context, args *funcdomain.GetFunctionArgs) (*funcdomain.GetFunctionResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFunction")
+	}
+
+	var r0 *funcdomain.GetFunctionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.GetFunctionArgs) (*funcdomain.GetFunctionResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.GetFunctionArgs) *funcdomain.GetFunctionResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.GetFunctionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.GetFunctionArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionMetadataRepository_GetFunction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFunction'
+type FunctionMetadataRepository_GetFunction_Call struct {
+	*mock.Call
+}
+
+// GetFunction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *funcdomain.GetFunctionArgs
+func (_e *FunctionMetadataRepository_Expecter) GetFunction(ctx interface{}, args interface{}) *FunctionMetadataRepository_GetFunction_Call {
+	return &FunctionMetadataRepository_GetFunction_Call{Call: _e.mock.On("GetFunction", ctx, args)}
+}
+
+func (_c *FunctionMetadataRepository_GetFunction_Call) Run(run func(ctx context.Context, args *funcdomain.GetFunctionArgs)) *FunctionMetadataRepository_GetFunction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.GetFunctionArgs))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_GetFunction_Call) Return(_a0 *funcdomain.GetFunctionResult, _a1 error) *FunctionMetadataRepository_GetFunction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_GetFunction_Call) RunAndReturn(run func(context.Context, *funcdomain.GetFunctionArgs) (*funcdomain.GetFunctionResult, error)) *FunctionMetadataRepository_GetFunction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateFunction provides a mock function with given fields: ctx, name, revision, mutate
+func (_m *FunctionMetadataRepository) UpdateFunction(ctx context.Context, name funcdomain.FunctionName, revision uint64, mutate func(*funcdomain.Function) error) (*funcdomain.Function, error) {
+	ret := _m.Called(ctx, name, revision, mutate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFunction")
+	}
+
+	var r0 *funcdomain.Function
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, funcdomain.FunctionName, uint64, func(*funcdomain.Function) error) (*funcdomain.Function, error)); ok {
+		return rf(ctx, name, revision, mutate)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, funcdomain.FunctionName, uint64, func(*funcdomain.Function) error) *funcdomain.Function); ok {
+		r0 = rf(ctx, name, revision, mutate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.Function)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, funcdomain.FunctionName, uint64, func(*funcdomain.Function) error) error); ok {
+		r1 = rf(ctx, name, revision, mutate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionMetadataRepository_UpdateFunction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFunction'
+type FunctionMetadataRepository_UpdateFunction_Call struct {
+	*mock.Call
+}
+
+// UpdateFunction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name funcdomain.FunctionName
+//   - revision uint64
+//   - mutate func(*funcdomain.Function) error
+func (_e *FunctionMetadataRepository_Expecter) UpdateFunction(ctx interface{}, name interface{}, revision interface{}, mutate interface{}) *FunctionMetadataRepository_UpdateFunction_Call {
+	return &FunctionMetadataRepository_UpdateFunction_Call{Call: _e.mock.On("UpdateFunction", ctx, name, revision, mutate)}
+}
+
+func (_c *FunctionMetadataRepository_UpdateFunction_Call) Run(run func(ctx context.Context, name funcdomain.FunctionName, revision uint64, mutate func(*funcdomain.Function) error)) *FunctionMetadataRepository_UpdateFunction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(funcdomain.FunctionName), args[2].(uint64), args[3].(func(*funcdomain.Function) error))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_UpdateFunction_Call) Return(_a0 *funcdomain.Function, _a1 error) *FunctionMetadataRepository_UpdateFunction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_UpdateFunction_Call) RunAndReturn(run func(context.Context, funcdomain.FunctionName, uint64, func(*funcdomain.Function) error) (*funcdomain.Function, error)) *FunctionMetadataRepository_UpdateFunction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFunctionMetadataRepository creates a new instance of FunctionMetadataRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFunctionMetadataRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FunctionMetadataRepository {
+	mock := &FunctionMetadataRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
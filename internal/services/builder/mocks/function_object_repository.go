@@ -0,0 +1,208 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	io "io"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// FunctionObjectRepository is an autogenerated mock type for the FunctionObjectRepository type
+type FunctionObjectRepository struct {
+	mock.Mock
+}
+
+type FunctionObjectRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FunctionObjectRepository) EXPECT() *FunctionObjectRepository_Expecter {
+	return &FunctionObjectRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteBundle provides a mock function with given fields: ctx, bundle
+func (_m *FunctionObjectRepository) DeleteBundle(ctx context.Context, bundle *funcdomain.SourceBundle) error {
+	ret := _m.Called(ctx, bundle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBundle")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.SourceBundle) error); ok {
+		r0 = rf(ctx, bundle)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FunctionObjectRepository_DeleteBundle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBundle'
+type FunctionObjectRepository_DeleteBundle_Call struct {
+	*mock.Call
+}
+
+// DeleteBundle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bundle *funcdomain.SourceBundle
+func (_e *FunctionObjectRepository_Expecter) DeleteBundle(ctx interface{}, bundle interface{}) *FunctionObjectRepository_DeleteBundle_Call {
+	return &FunctionObjectRepository_DeleteBundle_Call{Call: _e.mock.On("DeleteBundle", ctx, bundle)}
+}
+
+func (_c *FunctionObjectRepository_DeleteBundle_Call) Run(run func(ctx context.Context, bundle *funcdomain.SourceBundle)) *FunctionObjectRepository_DeleteBundle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.SourceBundle))
+	})
+	return _c
+}
+
+func (_c *FunctionObjectRepository_DeleteBundle_Call) Return(_a0 error) *FunctionObjectRepository_DeleteBundle_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FunctionObjectRepository_DeleteBundle_Call) RunAndReturn(run func(context.Context, *funcdomain.SourceBundle) error) *FunctionObjectRepository_DeleteBundle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenBundle provides a mock function with given fields: ctx, bundle
+func (_m *FunctionObjectRepository) OpenBundle(ctx context.Context, bundle *funcdomain.SourceBundle) (io.ReadCloser, error) {
+	ret := _m.Called(ctx, bundle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenBundle")
+	}
+
+	var r0 io.ReadCloser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.SourceBundle) (io.ReadCloser, error)); ok {
+		return rf(ctx, bundle)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.SourceBundle) io.ReadCloser); ok {
+		r0 = rf(ctx, bundle)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.SourceBundle) error); ok {
+		r1 = rf(ctx, bundle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionObjectRepository_OpenBundle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenBundle'
+type FunctionObjectRepository_OpenBundle_Call struct {
+	*mock.Call
+}
+
+// OpenBundle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bundle *funcdomain.SourceBundle
+func (_e *FunctionObjectRepository_Expecter) OpenBundle(ctx interface{}, bundle interface{}) *FunctionObjectRepository_OpenBundle_Call {
+	return &FunctionObjectRepository_OpenBundle_Call{Call: _e.mock.On("OpenBundle", ctx, bundle)}
+}
+
+func (_c *FunctionObjectRepository_OpenBundle_Call) Run(run func(ctx context.Context, bundle *funcdomain.SourceBundle)) *FunctionObjectRepository_OpenBundle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.SourceBundle))
+	})
+	return _c
+}
+
+func (_c *FunctionObjectRepository_OpenBundle_Call) Return(_a0 io.ReadCloser, _a1 error) *FunctionObjectRepository_OpenBundle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionObjectRepository_OpenBundle_Call) RunAndReturn(run func(context.Context, *funcdomain.SourceBundle) (io.ReadCloser, error)) *FunctionObjectRepository_OpenBundle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveArtifact provides a mock function with given fields: ctx, name, buildID, data
+func (_m *FunctionObjectRepository) SaveArtifact(ctx context.Context, name funcdomain.FunctionName, buildID uuid.UUID, data io.Reader) (*funcdomain.SourceBundle, error) {
+	ret := _m.Called(ctx, name, buildID, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveArtifact")
+	}
+
+	var r0 *funcdomain.SourceBundle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, funcdomain.FunctionName, uuid.UUID, io.Reader) (*funcdomain.SourceBundle, error)); ok {
+		return rf(ctx, name, buildID, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, funcdomain.FunctionName, uuid.UUID, io.Reader) *funcdomain.SourceBundle); ok {
+		r0 = rf(ctx, name, buildID, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.SourceBundle)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, funcdomain.FunctionName, uuid.UUID, io.Reader) error); ok {
+		r1 = rf(ctx, name, buildID, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionObjectRepository_SaveArtifact_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveArtifact'
+type FunctionObjectRepository_SaveArtifact_Call struct {
+	*mock.Call
+}
+
+// SaveArtifact is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name funcdomain.FunctionName
+//   - buildID uuid.UUID
+//   - data io.Reader
+func (_e *FunctionObjectRepository_Expecter) SaveArtifact(ctx interface{}, name interface{}, buildID interface{}, data interface{}) *FunctionObjectRepository_SaveArtifact_Call {
+	return &FunctionObjectRepository_SaveArtifact_Call{Call: _e.mock.On("SaveArtifact", ctx, name, buildID, data)}
+}
+
+func (_c *FunctionObjectRepository_SaveArtifact_Call) Run(run func(ctx context.Context, name funcdomain.FunctionName, buildID uuid.UUID, data io.Reader)) *FunctionObjectRepository_SaveArtifact_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(funcdomain.FunctionName), args[2].(uuid.UUID), args[3].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *FunctionObjectRepository_SaveArtifact_Call) Return(_a0 *funcdomain.SourceBundle, _a1 error) *FunctionObjectRepository_SaveArtifact_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionObjectRepository_SaveArtifact_Call) RunAndReturn(run func(context.Context, funcdomain.FunctionName, uuid.UUID, io.Reader) (*funcdomain.SourceBundle, error)) *FunctionObjectRepository_SaveArtifact_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFunctionObjectRepository creates a new instance of FunctionObjectRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFunctionObjectRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FunctionObjectRepository {
+	mock := &FunctionObjectRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
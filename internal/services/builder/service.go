@@ -0,0 +1,230 @@
+package buildsrv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	archiveutils "github.com/10Narratives/faas/pkg/archive"
+	bufferutils "github.com/10Narratives/faas/pkg/buffers"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+//go:generate mockery --name FunctionMetadataRepository --output ./mocks --outpkg mocks --with-expecter --filename function_metadata_repository.go
+type FunctionMetadataRepository interface {
+	funcdomain.FunctionGetter
+	UpdateFunction(ctx context.Context, name funcdomain.FunctionName, revision uint64, mutate func(fn *funcdomain.Function) error) (*funcdomain.Function, error)
+}
+
+//go:generate mockery --name FunctionObjectRepository --output ./mocks --outpkg mocks --with-expecter --filename function_object_repository.go
+type FunctionObjectRepository interface {
+	funcdomain.BundleOpener
+	SaveArtifact(ctx context.Context, name funcdomain.FunctionName, buildID uuid.UUID, data io.Reader) (*funcdomain.SourceBundle, error)
+	DeleteBundle(ctx context.Context, bundle *funcdomain.SourceBundle) error
+}
+
+type Config struct {
+	WorkDir    string
+	Script     string
+	Shell      []string
+	Timeout    time.Duration
+	MaxLogSize int
+}
+
+type Service struct {
+	cfg          Config
+	funcMetaRepo FunctionMetadataRepository
+	funcObjRepo  FunctionObjectRepository
+	log          *zap.Logger
+}
+
+func NewService(
+	cfg Config,
+	funcMetaRepo FunctionMetadataRepository,
+	funcObjRepo FunctionObjectRepository,
+	log *zap.Logger,
+) *Service {
+	if cfg.WorkDir == "" {
+		cfg.WorkDir = filepath.Join(os.TempDir(), "faas-builds")
+	}
+	if cfg.Script == "" {
+		cfg.Script = "build.sh"
+	}
+	if len(cfg.Shell) == 0 {
+		cfg.Shell = []string{"sh"}
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 15 * time.Minute
+	}
+	if cfg.MaxLogSize <= 0 {
+		cfg.MaxLogSize = 64 << 10
+	}
+
+	return &Service{
+		cfg:          cfg,
+		funcMetaRepo: funcMetaRepo,
+		funcObjRepo:  funcObjRepo,
+		log:          log,
+	}
+}
+
+// BuildFunction turns the uploaded bundle into an executable artifact. If the
+// bundle has a build script at its root it runs first; the resulting tree is
+// packed as the artifact. Build failures are recorded on the function, only
+// infrastructure errors are returned.
+func (s *Service) BuildFunction(ctx context.Context, args *funcdomain.BuildFunctionArgs) error {
+	if args == nil || args.Name == "" {
+		return funcdomain.ErrInvalidArgument
+	}
+	log := s.log.With(zap.String("function", string(args.Name)), zap.Stringer("build", args.BuildID))
+
+	got, err := s.funcMetaRepo.GetFunction(ctx, &funcdomain.GetFunctionArgs{Name: args.Name, Revision: args.Revision})
+	if err != nil {
+		if errors.Is(err, funcdomain.ErrFunctionNotFound) || errors.Is(err, funcdomain.ErrRevisionNotFound) {
+			log.Info("function is gone, build skipped")
+			return nil
+		}
+		return err
+	}
+	fn := got.Function
+	// Messages published before revisions existed target the latest one.
+	args.Revision = fn.Revision
+	log = log.With(zap.Uint64("revision", fn.Revision))
+	if !isCurrentBuild(fn, args.BuildID) {
+		log.Info("build is not current, skipped")
+		return nil
+	}
+
+	log.Info("build started")
+
+	workDir := filepath.Join(s.cfg.WorkDir, args.BuildID.String())
+	defer func() {
+		if err := os.RemoveAll(workDir); err != nil {
+			log.Warn("cannot remove build directory", zap.Error(err))
+		}
+	}()
+
+	buildLog := bufferutils.NewTailBuffer(s.cfg.MaxLogSize)
+
+	if err := funcdomain.ExtractBundle(ctx, s.funcObjRepo, fn.Bundle, workDir); err != nil {
+		return s.finish(ctx, log, args, nil, buildLog.String(), fmt.Sprintf("prepare bundle: %v", err))
+	}
+
+	if err := s.runScript(ctx, fn, workDir, buildLog); err != nil {
+		return s.finish(ctx, log, args, nil, buildLog.String(), err.Error())
+	}
+
+	artifact, err := s.packArtifact(ctx, args, workDir)
+	if err != nil {
+		return err
+	}
+
+	return s.finish(ctx, log, args, artifact, buildLog.String(), "")
+}
+
+func (s *Service) runScript(ctx context.Context, fn *funcdomain.Function, workDir string, buildLog io.Writer) error {
+	script := filepath.Join(workDir, s.cfg.Script)
+	if _, err := os.Stat(script); errors.Is(err, os.ErrNotExist) {
+		fmt.Fprintf(buildLog, "no %s in bundle, packaging sources as is\n", s.cfg.Script)
+		return nil
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+	defer cancel()
+
+	argv := append(append([]string{}, s.cfg.Shell...), s.cfg.Script)
+	cmd := exec.CommandContext(runCtx, argv[0], argv[1:]...)
+	cmd.Dir = workDir
+	cmd.Stdout = buildLog
+	cmd.Stderr = buildLog
+
+	// Builds get the plain function env but never secrets: those are
+	// only resolved for executions.
+	cmd.Env = []string{
+		"PATH=" + os.Getenv("PATH"),
+		"HOME=" + workDir,
+		"FAAS_FUNCTION_NAME=" + string(fn.Name),
+	}
+	for k, v := range fn.Env {
+		cmd.Env = append(cmd.Env, k+"="+v)
+	}
+
+	err := cmd.Run()
+	switch {
+	case errors.Is(runCtx.Err(), context.DeadlineExceeded):
+		return fmt.Errorf("build timed out after %s", s.cfg.Timeout)
+	case err != nil:
+		return fmt.Errorf("build script failed: %v", err)
+	}
+	return nil
+}
+
+func (s *Service) packArtifact(ctx context.Context, args *funcdomain.BuildFunctionArgs, workDir string) (*funcdomain.SourceBundle, error) {
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(archiveutils.CreateTarGZ(workDir, pw))
+	}()
+
+	artifact, err := s.funcObjRepo.SaveArtifact(ctx, args.Name, args.BuildID, pr)
+	_ = pr.Close()
+	if err != nil {
+		return nil, fmt.Errorf("save artifact: %w", err)
+	}
+	return artifact, nil
+}
+
+func (s *Service) finish(
+	ctx context.Context,
+	log *zap.Logger,
+	args *funcdomain.BuildFunctionArgs,
+	artifact *funcdomain.SourceBundle,
+	buildLog string,
+	errMsg string,
+) error {
+	_, err := s.funcMetaRepo.UpdateFunction(ctx, args.Name, args.Revision, func(fn *funcdomain.Function) error {
+		if !isCurrentBuild(fn, args.BuildID) {
+			return funcdomain.ErrBuildSuperseded
+		}
+
+		fn.Build.EndedAt = time.Now().UTC()
+		fn.Build.Log = buildLog
+		if errMsg != "" {
+			fn.Build.State = funcdomain.BuildStateFailed
+			fn.Build.ErrorMessage = errMsg
+			return nil
+		}
+		fn.Build.State = funcdomain.BuildStateReady
+		fn.Build.Artifact = artifact
+		return nil
+	})
+	if err != nil {
+		if artifact != nil {
+			_ = s.funcObjRepo.DeleteBundle(ctx, artifact)
+		}
+		if errors.Is(err, funcdomain.ErrBuildSuperseded) ||
+			errors.Is(err, funcdomain.ErrFunctionNotFound) ||
+			errors.Is(err, funcdomain.ErrRevisionNotFound) {
+			log.Info("build result discarded", zap.Error(err))
+			return nil
+		}
+		return err
+	}
+
+	if errMsg != "" {
+		log.Info("build failed", zap.String("reason", errMsg))
+	} else {
+		log.Info("build ready")
+	}
+	return nil
+}
+
+func isCurrentBuild(fn *funcdomain.Function, buildID uuid.UUID) bool {
+	return fn.Build != nil && fn.Build.ID == buildID && fn.Build.State == funcdomain.BuildStateBuilding
+}
@@ -0,0 +1,164 @@
+package buildsrv_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"testing"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	buildsrv "github.com/10Narratives/faas/internal/services/builder"
+	"github.com/10Narratives/faas/internal/services/builder/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func zipBundle(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, body := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(body))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func tarGZNames(t *testing.T, b []byte) []string {
+	t.Helper()
+
+	gz, err := gzip.NewReader(bytes.NewReader(b))
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+
+	var names []string
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			return names
+		}
+		require.NoError(t, err)
+		names = append(names, h.Name)
+	}
+}
+
+func building(name funcdomain.FunctionName, archive []byte) *funcdomain.Function {
+	sum := sha256.Sum256(archive)
+	return &funcdomain.Function{
+		Name:     name,
+		Revision: 1,
+		Bundle:   &funcdomain.SourceBundle{ObjectKey: "src.zip", Format: funcdomain.ZipFormat, SHA256: hex.EncodeToString(sum[:])},
+		Build:    funcdomain.NewFunctionBuild(),
+	}
+}
+
+// applyUpdate makes the UpdateFunction mock run the mutation against fn.
+func applyUpdate(fn *funcdomain.Function) func(context.Context, funcdomain.FunctionName, uint64, func(*funcdomain.Function) error) (*funcdomain.Function, error) {
+	return func(_ context.Context, _ funcdomain.FunctionName, _ uint64, mutate func(*funcdomain.Function) error) (*funcdomain.Function, error) {
+		if err := mutate(fn); err != nil {
+			return nil, err
+		}
+		return fn, nil
+	}
+}
+
+func TestService_BuildFunction(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ok: runs build script and stores artifact", func(t *testing.T) {
+		meta := mocks.NewFunctionMetadataRepository(t)
+		objects := mocks.NewFunctionObjectRepository(t)
+		svc := buildsrv.NewService(buildsrv.Config{WorkDir: t.TempDir()}, meta, objects, zap.NewNop())
+
+		archive := zipBundle(t, map[string]string{
+			"main.py":  "print('hi')",
+			"build.sh": "echo building; echo dep > vendor.txt",
+		})
+		fn := building("functions/app", archive)
+		fn.Revision = 3
+		artifact := &funcdomain.SourceBundle{ObjectKey: "artifacts/app.tar.gz"}
+
+		meta.EXPECT().GetFunction(ctx, &funcdomain.GetFunctionArgs{Name: fn.Name, Revision: fn.Revision}).Return(&funcdomain.GetFunctionResult{Function: fn}, nil).Once()
+		objects.EXPECT().OpenBundle(ctx, fn.Bundle).
+			Return(io.NopCloser(bytes.NewReader(archive)), nil).Once()
+		objects.EXPECT().SaveArtifact(ctx, fn.Name, fn.Build.ID, mock.Anything).
+			RunAndReturn(func(_ context.Context, _ funcdomain.FunctionName, _ uuid.UUID, r io.Reader) (*funcdomain.SourceBundle, error) {
+				b, err := io.ReadAll(r)
+				require.NoError(t, err)
+				require.ElementsMatch(t, []string{"main.py", "build.sh", "vendor.txt"}, tarGZNames(t, b))
+				return artifact, nil
+			}).Once()
+		meta.EXPECT().UpdateFunction(ctx, fn.Name, fn.Revision, mock.Anything).RunAndReturn(applyUpdate(fn)).Once()
+
+		err := svc.BuildFunction(ctx, &funcdomain.BuildFunctionArgs{Name: fn.Name, Revision: fn.Revision, BuildID: fn.Build.ID})
+		require.NoError(t, err)
+		require.Equal(t, funcdomain.BuildStateReady, fn.Build.State)
+		require.Equal(t, artifact, fn.Build.Artifact)
+		require.Contains(t, fn.Build.Log, "building")
+		require.True(t, fn.IsReady())
+	})
+
+	t.Run("failed script: records BUILD_FAILED with log", func(t *testing.T) {
+		meta := mocks.NewFunctionMetadataRepository(t)
+		objects := mocks.NewFunctionObjectRepository(t)
+		svc := buildsrv.NewService(buildsrv.Config{WorkDir: t.TempDir()}, meta, objects, zap.NewNop())
+
+		archive := zipBundle(t, map[string]string{
+			"build.sh": "echo missing wheel >&2; exit 1",
+		})
+		fn := building("functions/broken", archive)
+
+		meta.EXPECT().GetFunction(ctx, mock.Anything).Return(&funcdomain.GetFunctionResult{Function: fn}, nil).Once()
+		objects.EXPECT().OpenBundle(ctx, fn.Bundle).
+			Return(io.NopCloser(bytes.NewReader(archive)), nil).Once()
+		meta.EXPECT().UpdateFunction(ctx, fn.Name, fn.Revision, mock.Anything).RunAndReturn(applyUpdate(fn)).Once()
+
+		err := svc.BuildFunction(ctx, &funcdomain.BuildFunctionArgs{Name: fn.Name, BuildID: fn.Build.ID})
+		require.NoError(t, err)
+		require.Equal(t, funcdomain.BuildStateFailed, fn.Build.State)
+		require.Nil(t, fn.Build.Artifact)
+		require.Contains(t, fn.Build.Log, "missing wheel")
+		require.False(t, fn.IsReady())
+	})
+
+	t.Run("corrupted bundle: records BUILD_FAILED", func(t *testing.T) {
+		meta := mocks.NewFunctionMetadataRepository(t)
+		objects := mocks.NewFunctionObjectRepository(t)
+		svc := buildsrv.NewService(buildsrv.Config{WorkDir: t.TempDir()}, meta, objects, zap.NewNop())
+
+		fn := building("functions/app", []byte("original"))
+
+		meta.EXPECT().GetFunction(ctx, mock.Anything).Return(&funcdomain.GetFunctionResult{Function: fn}, nil).Once()
+		objects.EXPECT().OpenBundle(ctx, fn.Bundle).
+			Return(io.NopCloser(bytes.NewReader(zipBundle(t, map[string]string{"main.py": "print('hi')"}))), nil).Once()
+		meta.EXPECT().UpdateFunction(ctx, fn.Name, fn.Revision, mock.Anything).RunAndReturn(applyUpdate(fn)).Once()
+
+		err := svc.BuildFunction(ctx, &funcdomain.BuildFunctionArgs{Name: fn.Name, BuildID: fn.Build.ID})
+		require.NoError(t, err)
+		require.Equal(t, funcdomain.BuildStateFailed, fn.Build.State)
+		require.Contains(t, fn.Build.ErrorMessage, "sha256 mismatch")
+	})
+
+	t.Run("stale build message: skipped", func(t *testing.T) {
+		meta := mocks.NewFunctionMetadataRepository(t)
+		objects := mocks.NewFunctionObjectRepository(t)
+		svc := buildsrv.NewService(buildsrv.Config{WorkDir: t.TempDir()}, meta, objects, zap.NewNop())
+
+		fn := building("functions/app", nil)
+		meta.EXPECT().GetFunction(ctx, mock.Anything).Return(&funcdomain.GetFunctionResult{Function: fn}, nil).Once()
+
+		err := svc.BuildFunction(ctx, &funcdomain.BuildFunctionArgs{Name: fn.Name, BuildID: uuid.New()})
+		require.NoError(t, err)
+	})
+}
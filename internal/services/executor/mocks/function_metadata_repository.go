@@ -0,0 +1,97 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FunctionMetadataRepository is an autogenerated mock type for the FunctionMetadataRepository type
+type FunctionMetadataRepository struct {
+	mock.Mock
+}
+
+type FunctionMetadataRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FunctionMetadataRepository) EXPECT() *FunctionMetadataRepository_Expecter {
+	return &FunctionMetadataRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetFunction provides a mock function with given fields: ctx, args
+func (_m *FunctionMetadataRepository) GetFunction(ctx context.Context, args *funcdomain.GetFunctionArgs) (*funcdomain.GetFunctionResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFunction")
+	}
+
+	var r0 *funcdomain.GetFunctionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.GetFunctionArgs) (*funcdomain.GetFunctionResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.GetFunctionArgs) *funcdomain.GetFunctionResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.GetFunctionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.GetFunctionArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionMetadataRepository_GetFunction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFunction'
+type FunctionMetadataRepository_GetFunction_Call struct {
+	*mock.Call
+}
+
+// GetFunction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *funcdomain.GetFunctionArgs
+func (_e *FunctionMetadataRepository_Expecter) GetFunction(ctx interface{}, args interface{}) *FunctionMetadataRepository_GetFunction_Call {
+	return &FunctionMetadataRepository_GetFunction_Call{Call: _e.mock.On("GetFunction", ctx, args)}
+}
+
+func (_c *FunctionMetadataRepository_GetFunction_Call) Run(run func(ctx context.Context, args *funcdomain.GetFunctionArgs)) *FunctionMetadataRepository_GetFunction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.GetFunctionArgs))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_GetFunction_Call) Return(_a0 *funcdomain.GetFunctionResult, _a1 error) *FunctionMetadataRepository_GetFunction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_GetFunction_Call) RunAndReturn(run func(context.Context, *funcdomain.GetFunctionArgs) (*funcdomain.GetFunctionResult, error)) *FunctionMetadataRepository_GetFunction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFunctionMetadataRepository creates a new instance of FunctionMetadataRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFunctionMetadataRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FunctionMetadataRepository {
+	mock := &FunctionMetadataRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,99 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FunctionObjectRepository is an autogenerated mock type for the FunctionObjectRepository type
+type FunctionObjectRepository struct {
+	mock.Mock
+}
+
+type FunctionObjectRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FunctionObjectRepository) EXPECT() *FunctionObjectRepository_Expecter {
+	return &FunctionObjectRepository_Expecter{mock: &_m.Mock}
+}
+
+// OpenBundle provides a mock function with given fields: ctx, bundle
+func (_m *FunctionObjectRepository) OpenBundle(ctx context.Context, bundle *funcdomain.SourceBundle) (io.ReadCloser, error) {
+	ret := _m.Called(ctx, bundle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenBundle")
+	}
+
+	var r0 io.ReadCloser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.SourceBundle) (io.ReadCloser, error)); ok {
+		return rf(ctx, bundle)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.SourceBundle) io.ReadCloser); ok {
+		r0 = rf(ctx, bundle)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.SourceBundle) error); ok {
+		r1 = rf(ctx, bundle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionObjectRepository_OpenBundle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenBundle'
+type FunctionObjectRepository_OpenBundle_Call struct {
+	*mock.Call
+}
+
+// OpenBundle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bundle *funcdomain.SourceBundle
+func (_e *FunctionObjectRepository_Expecter) OpenBundle(ctx interface{}, bundle interface{}) *FunctionObjectRepository_OpenBundle_Call {
+	return &FunctionObjectRepository_OpenBundle_Call{Call: _e.mock.On("OpenBundle", ctx, bundle)}
+}
+
+func (_c *FunctionObjectRepository_OpenBundle_Call) Run(run func(ctx context.Context, bundle *funcdomain.SourceBundle)) *FunctionObjectRepository_OpenBundle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.SourceBundle))
+	})
+	return _c
+}
+
+func (_c *FunctionObjectRepository_OpenBundle_Call) Return(_a0 io.ReadCloser, _a1 error) *FunctionObjectRepository_OpenBundle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionObjectRepository_OpenBundle_Call) RunAndReturn(run func(context.Context, *funcdomain.SourceBundle) (io.ReadCloser, error)) *FunctionObjectRepository_OpenBundle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFunctionObjectRepository creates a new instance of FunctionObjectRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFunctionObjectRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FunctionObjectRepository {
+	mock := &FunctionObjectRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	secretdomain "github.com/10Narratives/faas/internal/domains/secrets"
+)
+
+// SecretResolver is an autogenerated mock type for the SecretResolver type
+type SecretResolver struct {
+	mock.Mock
+}
+
+type SecretResolver_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SecretResolver) EXPECT() *SecretResolver_Expecter {
+	return &SecretResolver_Expecter{mock: &_m.Mock}
+}
+
+// ResolveSecrets provides a mock function with given fields: ctx, args
+func (_m *SecretResolver) ResolveSecrets(ctx context.Context, args *secretdomain.ResolveSecretsArgs) (*secretdomain.ResolveSecretsResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveSecrets")
+	}
+
+	var r0 *secretdomain.ResolveSecretsResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretdomain.ResolveSecretsArgs) (*secretdomain.ResolveSecretsResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secretdomain.ResolveSecretsArgs) *secretdomain.ResolveSecretsResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretdomain.ResolveSecretsResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secretdomain.ResolveSecretsArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretResolver_ResolveSecrets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveSecrets'
+type SecretResolver_ResolveSecrets_Call struct {
+	*mock.Call
+}
+
+// ResolveSecrets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *secretdomain.ResolveSecretsArgs
+func (_e *SecretResolver_Expecter) ResolveSecrets(ctx interface{}, args interface{}) *SecretResolver_ResolveSecrets_Call {
+	return &SecretResolver_ResolveSecrets_Call{Call: _e.mock.On("ResolveSecrets", ctx, args)}
+}
+
+func (_c *SecretResolver_ResolveSecrets_Call) Run(run func(ctx context.Context, args *secretdomain.ResolveSecretsArgs)) *SecretResolver_ResolveSecrets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*secretdomain.ResolveSecretsArgs))
+	})
+	return _c
+}
+
+func (_c *SecretResolver_ResolveSecrets_Call) Return(_a0 *secretdomain.ResolveSecretsResult, _a1 error) *SecretResolver_ResolveSecrets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretResolver_ResolveSecrets_Call) RunAndReturn(run func(context.Context, *secretdomain.ResolveSecretsArgs) (*secretdomain.ResolveSecretsResult, error)) *SecretResolver_ResolveSecrets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSecretResolver creates a new instance of SecretResolver. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSecretResolver(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SecretResolver {
+	mock := &SecretResolver{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,205 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+
+	taskdomain "github.com/10Narratives/faas/internal/domains/tasks"
+)
+
+// TaskArtifactRepository is an autogenerated mock type for the TaskArtifactRepository type
+type TaskArtifactRepository struct {
+	mock.Mock
+}
+
+type TaskArtifactRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TaskArtifactRepository) EXPECT() *TaskArtifactRepository_Expecter {
+	return &TaskArtifactRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteTaskArtifact provides a mock function with given fields: ctx, artifact
+func (_m *TaskArtifactRepository) DeleteTaskArtifact(ctx context.Context, artifact *taskdomain.TaskArtifact) error {
+	ret := _m.Called(ctx, artifact)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTaskArtifact")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *taskdomain.TaskArtifact) error); ok {
+		r0 = rf(ctx, artifact)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TaskArtifactRepository_DeleteTaskArtifact_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTaskArtifact'
+type TaskArtifactRepository_DeleteTaskArtifact_Call struct {
+	*mock.Call
+}
+
+// DeleteTaskArtifact is a helper method to define mock.On call
+//   - ctx context.Context
+//   - artifact *taskdomain.TaskArtifact
+func (_e *TaskArtifactRepository_Expecter) DeleteTaskArtifact(ctx interface{}, artifact interface{}) *TaskArtifactRepository_DeleteTaskArtifact_Call {
+	return &TaskArtifactRepository_DeleteTaskArtifact_Call{Call: _e.mock.On("DeleteTaskArtifact", ctx, artifact)}
+}
+
+func (_c *TaskArtifactRepository_DeleteTaskArtifact_Call) Run(run func(ctx context.Context, artifact *taskdomain.TaskArtifact)) *TaskArtifactRepository_DeleteTaskArtifact_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*taskdomain.TaskArtifact))
+	})
+	return _c
+}
+
+func (_c *TaskArtifactRepository_DeleteTaskArtifact_Call) Return(_a0 error) *TaskArtifactRepository_DeleteTaskArtifact_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TaskArtifactRepository_DeleteTaskArtifact_Call) RunAndReturn(run func(context.Context, *taskdomain.TaskArtifact) error) *TaskArtifactRepository_DeleteTaskArtifact_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenTaskArtifact provides a mock function with given fields: ctx, artifact
+func (_m *TaskArtifactRepository) OpenTaskArtifact(ctx context.Context, artifact *taskdomain.TaskArtifact) (io.ReadCloser, error) {
+	ret := _m.Called(ctx, artifact)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenTaskArtifact")
+	}
+
+	var r0 io.ReadCloser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *taskdomain.TaskArtifact) (io.ReadCloser, error)); ok {
+		return rf(ctx, artifact)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *taskdomain.TaskArtifact) io.ReadCloser); ok {
+		r0 = rf(ctx, artifact)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *taskdomain.TaskArtifact) error); ok {
+		r1 = rf(ctx, artifact)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TaskArtifactRepository_OpenTaskArtifact_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenTaskArtifact'
+type TaskArtifactRepository_OpenTaskArtifact_Call struct {
+	*mock.Call
+}
+
+// OpenTaskArtifact is a helper method to define mock.On call
+//   - ctx context.Context
+//   - artifact *taskdomain.TaskArtifact
+func (_e *TaskArtifactRepository_Expecter) OpenTaskArtifact(ctx interface{}, artifact interface{}) *TaskArtifactRepository_OpenTaskArtifact_Call {
+	return &TaskArtifactRepository_OpenTaskArtifact_Call{Call: _e.mock.On("OpenTaskArtifact", ctx, artifact)}
+}
+
+func (_c *TaskArtifactRepository_OpenTaskArtifact_Call) Run(run func(ctx context.Context, artifact *taskdomain.TaskArtifact)) *TaskArtifactRepository_OpenTaskArtifact_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*taskdomain.TaskArtifact))
+	})
+	return _c
+}
+
+func (_c *TaskArtifactRepository_OpenTaskArtifact_Call) Return(_a0 io.ReadCloser, _a1 error) *TaskArtifactRepository_OpenTaskArtifact_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TaskArtifactRepository_OpenTaskArtifact_Call) RunAndReturn(run func(context.Context, *taskdomain.TaskArtifact) (io.ReadCloser, error)) *TaskArtifactRepository_OpenTaskArtifact_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveTaskArtifact provides a mock function with given fields: ctx, args
+func (_m *TaskArtifactRepository) SaveTaskArtifact(ctx context.Context, args *taskdomain.SaveTaskArtifactArgs) (*taskdomain.SaveTaskArtifactResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveTaskArtifact")
+	}
+
+	var r0 *taskdomain.SaveTaskArtifactResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *taskdomain.SaveTaskArtifactArgs) (*taskdomain.SaveTaskArtifactResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *taskdomain.SaveTaskArtifactArgs) *taskdomain.SaveTaskArtifactResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*taskdomain.SaveTaskArtifactResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *taskdomain.SaveTaskArtifactArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TaskArtifactRepository_SaveTaskArtifact_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveTaskArtifact'
+type TaskArtifactRepository_SaveTaskArtifact_Call struct {
+	*mock.Call
+}
+
+// SaveTaskArtifact is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *taskdomain.SaveTaskArtifactArgs
+func (_e *TaskArtifactRepository_Expecter) SaveTaskArtifact(ctx interface{}, args interface{}) *TaskArtifactRepository_SaveTaskArtifact_Call {
+	return &TaskArtifactRepository_SaveTaskArtifact_Call{Call: _e.mock.On("SaveTaskArtifact", ctx, args)}
+}
+
+func (_c *TaskArtifactRepository_SaveTaskArtifact_Call) Run(run func(ctx context.Context, args *taskdomain.SaveTaskArtifactArgs)) *TaskArtifactRepository_SaveTaskArtifact_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*taskdomain.SaveTaskArtifactArgs))
+	})
+	return _c
+}
+
+func (_c *TaskArtifactRepository_SaveTaskArtifact_Call) Return(_a0 *taskdomain.SaveTaskArtifactResult, _a1 error) *TaskArtifactRepository_SaveTaskArtifact_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TaskArtifactRepository_SaveTaskArtifact_Call) RunAndReturn(run func(context.Context, *taskdomain.SaveTaskArtifactArgs) (*taskdomain.SaveTaskArtifactResult, error)) *TaskArtifactRepository_SaveTaskArtifact_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTaskArtifactRepository creates a new instance of TaskArtifactRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTaskArtifactRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TaskArtifactRepository {
+	mock := &TaskArtifactRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
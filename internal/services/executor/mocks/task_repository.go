@@ -0,0 +1,321 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	taskdomain "github.com/10Narratives/faas/internal/domains/tasks"
+)
+
+// TaskRepository is an autogenerated mock type for the TaskRepository type
+type TaskRepository struct {
+	mock.Mock
+}
+
+type TaskRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TaskRepository) EXPECT() *TaskRepository_Expecter {
+	return &TaskRepository_Expecter{mock: &_m.Mock}
+}
+
+// CompleteTask provides a mock function with given fields: ctx, args
+func (_m *TaskRepository) CompleteTask(ctx context.Context, args *taskdomain.CompleteTaskArgs) (*taskdomain.CompleteTaskResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteTask")
+	}
+
+	var r0 *taskdomain.CompleteTaskResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *taskdomain.CompleteTaskArgs) (*taskdomain.CompleteTaskResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *taskdomain.CompleteTaskArgs) *taskdomain.CompleteTaskResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*taskdomain.CompleteTaskResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *taskdomain.CompleteTaskArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TaskRepository_CompleteTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteTask'
+type TaskRepository_CompleteTask_Call struct {
+	*mock.Call
+}
+
+// CompleteTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *taskdomain.CompleteTaskArgs
+func (_e *TaskRepository_Expecter) CompleteTask(ctx interface{}, args interface{}) *TaskRepository_CompleteTask_Call {
+	return &TaskRepository_CompleteTask_Call{Call: _e.mock.On("CompleteTask", ctx, args)}
+}
+
+func (_c *TaskRepository_CompleteTask_Call) Run(run func(ctx context.Context, args *taskdomain.CompleteTaskArgs)) *TaskRepository_CompleteTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*taskdomain.CompleteTaskArgs))
+	})
+	return _c
+}
+
+func (_c *TaskRepository_CompleteTask_Call) Return(_a0 *taskdomain.CompleteTaskResult, _a1 error) *TaskRepository_CompleteTask_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TaskRepository_CompleteTask_Call) RunAndReturn(run func(context.Context, *taskdomain.CompleteTaskArgs) (*taskdomain.CompleteTaskResult, error)) *TaskRepository_CompleteTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTask provides a mock function with given fields: ctx, args
+func (_m *TaskRepository) GetTask(ctx context.Context, args *taskdomain.GetTaskArgs) (*taskdomain.GetTaskResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTask")
+	}
+
+	var r0 *taskdomain.GetTaskResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *taskdomain.GetTaskArgs) (*taskdomain.GetTaskResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *taskdomain.GetTaskArgs) *taskdomain.GetTaskResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*taskdomain.GetTaskResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *taskdomain.GetTaskArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TaskRepository_GetTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTask'
+type TaskRepository_GetTask_Call struct {
+	*mock.Call
+}
+
+// GetTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *taskdomain.GetTaskArgs
+func (_e *TaskRepository_Expecter) GetTask(ctx interface{}, args interface{}) *TaskRepository_GetTask_Call {
+	return &TaskRepository_GetTask_Call{Call: _e.mock.On("GetTask", ctx, args)}
+}
+
+func (_c *TaskRepository_GetTask_Call) Run(run func(ctx context.Context, args *taskdomain.GetTaskArgs)) *TaskRepository_GetTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*taskdomain.GetTaskArgs))
+	})
+	return _c
+}
+
+func (_c *TaskRepository_GetTask_Call) Return(_a0 *taskdomain.GetTaskResult, _a1 error) *TaskRepository_GetTask_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TaskRepository_GetTask_Call) RunAndReturn(run func(context.Context, *taskdomain.GetTaskArgs) (*taskdomain.GetTaskResult, error)) *TaskRepository_GetTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HeartbeatTask provides a mock function with given fields: ctx, args
+func (_m *TaskRepository) HeartbeatTask(ctx context.Context, args *taskdomain.HeartbeatTaskArgs) error {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HeartbeatTask")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *taskdomain.HeartbeatTaskArgs) error); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TaskRepository_HeartbeatTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HeartbeatTask'
+type TaskRepository_HeartbeatTask_Call struct {
+	*mock.Call
+}
+
+// HeartbeatTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *taskdomain.HeartbeatTaskArgs
+func (_e *TaskRepository_Expecter) HeartbeatTask(ctx interface{}, args interface{}) *TaskRepository_HeartbeatTask_Call {
+	return &TaskRepository_HeartbeatTask_Call{Call: _e.mock.On("HeartbeatTask", ctx, args)}
+}
+
+func (_c *TaskRepository_HeartbeatTask_Call) Run(run func(ctx context.Context, args *taskdomain.HeartbeatTaskArgs)) *TaskRepository_HeartbeatTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*taskdomain.HeartbeatTaskArgs))
+	})
+	return _c
+}
+
+func (_c *TaskRepository_HeartbeatTask_Call) Return(_a0 error) *TaskRepository_HeartbeatTask_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TaskRepository_HeartbeatTask_Call) RunAndReturn(run func(context.Context, *taskdomain.HeartbeatTaskArgs) error) *TaskRepository_HeartbeatTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetryTask provides a mock function with given fields: ctx, args
+func (_m *TaskRepository) RetryTask(ctx context.Context, args *taskdomain.RetryTaskArgs) (*taskdomain.RetryTaskResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryTask")
+	}
+
+	var r0 *taskdomain.RetryTaskResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *taskdomain.RetryTaskArgs) (*taskdomain.RetryTaskResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *taskdomain.RetryTaskArgs) *taskdomain.RetryTaskResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*taskdomain.RetryTaskResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *taskdomain.RetryTaskArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TaskRepository_RetryTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryTask'
+type TaskRepository_RetryTask_Call struct {
+	*mock.Call
+}
+
+// RetryTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *taskdomain.RetryTaskArgs
+func (_e *TaskRepository_Expecter) RetryTask(ctx interface{}, args interface{}) *TaskRepository_RetryTask_Call {
+	return &TaskRepository_RetryTask_Call{Call: _e.mock.On("RetryTask", ctx, args)}
+}
+
+func (_c *TaskRepository_RetryTask_Call) Run(run func(ctx context.Context, args *taskdomain.RetryTaskArgs)) *TaskRepository_RetryTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*taskdomain.RetryTaskArgs))
+	})
+	return _c
+}
+
+func (_c *TaskRepository_RetryTask_Call) Return(_a0 *taskdomain.RetryTaskResult, _a1 error) *TaskRepository_RetryTask_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TaskRepository_RetryTask_Call) RunAndReturn(run func(context.Context, *taskdomain.RetryTaskArgs) (*taskdomain.RetryTaskResult, error)) *TaskRepository_RetryTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartTask provides a mock function with given fields: ctx, args
+func (_m *TaskRepository) StartTask(ctx context.Context, args *taskdomain.StartTaskArgs) (*taskdomain.StartTaskResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartTask")
+	}
+
+	var r0 *taskdomain.StartTaskResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *taskdomain.StartTaskArgs) (*taskdomain.StartTaskResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *taskdomain.StartTaskArgs) *taskdomain.StartTaskResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*taskdomain.StartTaskResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *taskdomain.StartTaskArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TaskRepository_StartTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartTask'
+type TaskRepository_StartTask_Call struct {
+	*mock.Call
+}
+
+// StartTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *taskdomain.StartTaskArgs
+func (_e *TaskRepository_Expecter) StartTask(ctx interface{}, args interface{}) *TaskRepository_StartTask_Call {
+	return &TaskRepository_StartTask_Call{Call: _e.mock.On("StartTask", ctx, args)}
+}
+
+func (_c *TaskRepository_StartTask_Call) Run(run func(ctx context.Context, args *taskdomain.StartTaskArgs)) *TaskRepository_StartTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*taskdomain.StartTaskArgs))
+	})
+	return _c
+}
+
+func (_c *TaskRepository_StartTask_Call) Return(_a0 *taskdomain.StartTaskResult, _a1 error) *TaskRepository_StartTask_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TaskRepository_StartTask_Call) RunAndReturn(run func(context.Context, *taskdomain.StartTaskArgs) (*taskdomain.StartTaskResult, error)) *TaskRepository_StartTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTaskRepository creates a new instance of TaskRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTaskRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TaskRepository {
+	mock := &TaskRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,121 @@
+package execsrv
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+)
+
+// Placeholders replaced in runtime commands.
+const (
+	placeholderEntrypoint = "{entrypoint}"
+	placeholderHandler    = "{handler}"
+)
+
+// Runtime launches the functions of one language runtime. Arguments may
+// contain {entrypoint} and {handler}, replaced by the manifest values.
+type Runtime struct {
+	// Command runs the entrypoint as a program.
+	Command []string `yaml:"command"`
+	// HandlerCommand calls the manifest handler within the entrypoint.
+	// Functions declaring a handler fail on a runtime without one.
+	HandlerCommand []string `yaml:"handler_command"`
+}
+
+// pythonHandlerShim loads the entrypoint, or the module before ":" in
+// "module:function", calls the handler with the decoded parameters and
+// writes its return value to stdout: strings and bytes as they are,
+// anything else as JSON.
+const pythonHandlerShim = `import importlib, importlib.util, json, os, sys
+entrypoint, handler = sys.argv[1], sys.argv[2]
+sys.path.insert(0, os.getcwd())
+if ":" in handler:
+    module, attr = handler.split(":", 1)
+    target = importlib.import_module(module)
+else:
+    spec = importlib.util.spec_from_file_location("faas_entrypoint", entrypoint)
+    target = importlib.util.module_from_spec(spec)
+    spec.loader.exec_module(target)
+    attr = handler
+for part in attr.split("."):
+    target = getattr(target, part)
+raw = sys.stdin.read()
+try:
+    params = json.loads(raw) if raw.strip() else None
+except ValueError:
+    params = raw
+result = target(params)
+if isinstance(result, bytes):
+    sys.stdout.buffer.write(result)
+elif isinstance(result, str):
+    sys.stdout.write(result)
+elif result is not None:
+    json.dump(result, sys.stdout)
+`
+
+// DefaultRuntimes is used when the configuration names none.
+func DefaultRuntimes() map[string]Runtime {
+	return map[string]Runtime{
+		"python": {
+			Command:        []string{"python3", placeholderEntrypoint},
+			HandlerCommand: []string{"python3", "-c", pythonHandlerShim, placeholderEntrypoint, placeholderHandler},
+		},
+		"node": {
+			Command: []string{"node", placeholderEntrypoint},
+		},
+	}
+}
+
+// launchCommand returns the command running fn. A runtime is looked up by
+// its full name, then by its leading letters, so "python3.12" falls back
+// to "python". Revisions from before manifests have no entrypoint and run
+// the configured default command.
+func (s *Service) launchCommand(fn *funcdomain.Function) ([]string, error) {
+	if fn.Entrypoint == "" {
+		return s.cfg.Command, nil
+	}
+
+	rt, ok := s.cfg.Runtimes[fn.Runtime]
+	if !ok {
+		rt, ok = s.cfg.Runtimes[runtimeFamily(fn.Runtime)]
+	}
+	if !ok {
+		return nil, fmt.Errorf("runtime %q is not available on this agent", fn.Runtime)
+	}
+
+	command := rt.Command
+	if fn.Handler != "" {
+		if len(rt.HandlerCommand) == 0 {
+			return nil, fmt.Errorf("runtime %q does not support handlers", fn.Runtime)
+		}
+		command = rt.HandlerCommand
+	}
+	if len(command) == 0 {
+		return nil, fmt.Errorf("runtime %q has no command", fn.Runtime)
+	}
+
+	r := strings.NewReplacer(placeholderEntrypoint, fn.Entrypoint, placeholderHandler, fn.Handler)
+	out := make([]string, len(command))
+	for i, arg := range command {
+		out[i] = r.Replace(arg)
+	}
+	return out, nil
+}
+
+func runtimeFamily(runtime string) string {
+	i := strings.IndexFunc(runtime, func(r rune) bool { return r < 'a' || r > 'z' })
+	if i < 0 {
+		return runtime
+	}
+	return runtime[:i]
+}
+
+// limitMemory wraps argv in a shell that caps the data segment, which
+// covers heap and anonymous mappings but not address space a runtime only
+// reserves. The limit is rounded up to whole KiB.
+func limitMemory(argv []string, bytes uint64) []string {
+	kib := (bytes + 1023) / 1024
+	return append([]string{"/bin/sh", "-c", `ulimit -d "$1" && shift && exec "$@"`, "sh", strconv.FormatUint(kib, 10)}, argv...)
+}
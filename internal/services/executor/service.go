@@ -0,0 +1,676 @@
+package execsrv
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	jobdomain "github.com/10Narratives/faas/internal/domains/jobs"
+	secretdomain "github.com/10Narratives/faas/internal/domains/secrets"
+	taskdomain "github.com/10Narratives/faas/internal/domains/tasks"
+	bufferutils "github.com/10Narratives/faas/pkg/buffers"
+	digestutils "github.com/10Narratives/faas/pkg/digest"
+	"go.uber.org/zap"
+)
+
+const (
+	redacted = "[REDACTED]"
+
+	// inputsDir holds the files uploaded with the execution request.
+	inputsDir = "inputs"
+	// outputsDir is where functions write files to be kept as artifacts.
+	outputsDir = "outputs"
+
+	// staleHeartbeats is how many heartbeats a running attempt may miss
+	// before a redelivered message takes the task over.
+	staleHeartbeats = 3
+)
+
+//go:generate mockery --name TaskRepository --output ./mocks --outpkg mocks --with-expecter --filename task_repository.go
+type TaskRepository interface {
+	taskdomain.TaskGetter
+	taskdomain.TaskStarter
+	taskdomain.TaskHeartbeater
+	taskdomain.TaskRetrier
+	taskdomain.TaskCompleter
+}
+
+//go:generate mockery --name TaskArtifactRepository --output ./mocks --outpkg mocks --with-expecter --filename task_artifact_repository.go
+type TaskArtifactRepository interface {
+	taskdomain.TaskArtifactSaver
+	OpenTaskArtifact(ctx context.Context, artifact *taskdomain.TaskArtifact) (io.ReadCloser, error)
+	DeleteTaskArtifact(ctx context.Context, artifact *taskdomain.TaskArtifact) error
+}
+
+//go:generate mockery --name FunctionMetadataRepository --output ./mocks --outpkg mocks --with-expecter --filename function_metadata_repository.go
+type FunctionMetadataRepository interface {
+	funcdomain.FunctionGetter
+}
+
+//go:generate mockery --name FunctionObjectRepository --output ./mocks --outpkg mocks --with-expecter --filename function_object_repository.go
+type FunctionObjectRepository interface {
+	funcdomain.BundleOpener
+}
+
+//go:generate mockery --name SecretResolver --output ./mocks --outpkg mocks --with-expecter --filename secret_resolver.go
+type SecretResolver interface {
+	secretdomain.SecretResolver
+}
+
+//go:generate mockery --name JobTaskRecorder --output ./mocks --outpkg mocks --with-expecter --filename job_task_recorder.go
+type JobTaskRecorder interface {
+	jobdomain.JobTaskRecorder
+}
+
+type Config struct {
+	WorkDir string
+	// Command runs functions without a manifest entrypoint; Runtimes launch
+	// the others.
+	Command  []string
+	Runtimes map[string]Runtime
+	// Timeout applies to functions without their own; MaxTimeout caps both.
+	Timeout       time.Duration
+	MaxTimeout    time.Duration
+	MaxOutputSize int
+	// MaxArtifacts and MaxArtifactsSize bound what is collected from the
+	// outputs directory of a single task.
+	MaxArtifacts     int
+	MaxArtifactsSize int64
+	// Heartbeat is how often a running attempt is marked alive. It should
+	// match how often the execute message is extended, so that a task is
+	// only taken over once its message could be redelivered.
+	Heartbeat time.Duration
+}
+
+type Service struct {
+	cfg          Config
+	taskRepo     TaskRepository
+	artifactRepo TaskArtifactRepository
+	funcMetaRepo FunctionMetadataRepository
+	funcObjRepo  FunctionObjectRepository
+	secrets      SecretResolver
+	jobs         JobTaskRecorder
+	log          *zap.Logger
+}
+
+func NewService(
+	cfg Config,
+	taskRepo TaskRepository,
+	artifactRepo TaskArtifactRepository,
+	funcMetaRepo FunctionMetadataRepository,
+	funcObjRepo FunctionObjectRepository,
+	secrets SecretResolver,
+	jobs JobTaskRecorder,
+	log *zap.Logger,
+) *Service {
+	if cfg.WorkDir == "" {
+		cfg.WorkDir = filepath.Join(os.TempDir(), "faas")
+	}
+	if len(cfg.Command) == 0 {
+		cfg.Command = []string{"python3", "main.py"}
+	}
+	if len(cfg.Runtimes) == 0 {
+		cfg.Runtimes = DefaultRuntimes()
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 5 * time.Minute
+	}
+	if cfg.MaxTimeout <= 0 {
+		cfg.MaxTimeout = funcdomain.MaxFunctionTimeout
+	}
+	if cfg.MaxOutputSize <= 0 {
+		cfg.MaxOutputSize = 1 << 20
+	}
+	if cfg.MaxArtifacts <= 0 {
+		cfg.MaxArtifacts = 100
+	}
+	if cfg.MaxArtifactsSize <= 0 {
+		cfg.MaxArtifactsSize = 1 << 30
+	}
+	if cfg.Heartbeat <= 0 {
+		cfg.Heartbeat = 10 * time.Second
+	}
+
+	return &Service{
+		cfg:          cfg,
+		taskRepo:     taskRepo,
+		artifactRepo: artifactRepo,
+		funcMetaRepo: funcMetaRepo,
+		funcObjRepo:  funcObjRepo,
+		secrets:      secrets,
+		jobs:         jobs,
+		log:          log,
+	}
+}
+
+// ExecuteTask runs a pending task to completion. Failures of the function
+// itself are recorded on the task; only infrastructure errors are returned,
+// so the caller can retry delivery. When the function's retry policy allows
+// another attempt, the task goes back to pending and a *RetryError tells
+// the caller when to redeliver.
+//
+// A task already processing is taken over once its attempt missed
+// staleHeartbeats heartbeats; until then a *RetryError keeps the message
+// around to check again, so a task whose agent died is not stranded.
+func (s *Service) ExecuteTask(ctx context.Context, name taskdomain.TaskName) error {
+	log := s.log.With(zap.String("task", string(name)))
+
+	staleAfter := staleHeartbeats * s.cfg.Heartbeat
+	started, err := s.taskRepo.StartTask(ctx, &taskdomain.StartTaskArgs{
+		Name:       string(name),
+		StaleAfter: staleAfter,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, taskdomain.ErrTaskNotPending) || errors.Is(err, taskdomain.ErrNotFound):
+			log.Debug("task is not runnable, skipping", zap.Error(err))
+			return nil
+		case errors.Is(err, taskdomain.ErrTaskRunning):
+			log.Debug("task is running elsewhere, checking again later")
+			return &taskdomain.RetryError{Delay: staleAfter}
+		}
+		return err
+	}
+	task := started.Task
+	log = log.With(zap.String("function", task.Function), zap.Int("attempt", task.Attempt))
+	log.Info("task started")
+
+	stop := s.keepAlive(ctx, log, task)
+	result, retry := s.run(ctx, log, task)
+	stop()
+
+	if retry != nil && task.Attempt < retry.MaxAttempts {
+		_, err := s.taskRepo.RetryTask(ctx, &taskdomain.RetryTaskArgs{
+			Name:         string(name),
+			ErrorMessage: result.ErrorMessage,
+		})
+		if err != nil {
+			if errors.Is(err, taskdomain.ErrTaskAlreadyCompleted) {
+				log.Info("task was completed elsewhere, retry dropped")
+				return nil
+			}
+			return err
+		}
+		delay := retry.Delay(task.Attempt)
+		log.Info("task attempt failed, retrying",
+			zap.Duration("delay", delay), zap.String("error", result.ErrorMessage))
+		return &taskdomain.RetryError{Delay: delay}
+	}
+
+	completed, err := s.taskRepo.CompleteTask(ctx, &taskdomain.CompleteTaskArgs{
+		Name:   string(name),
+		Result: result,
+	})
+	if err != nil {
+		if errors.Is(err, taskdomain.ErrTaskAlreadyCompleted) {
+			log.Info("task was completed elsewhere, result discarded")
+			return nil
+		}
+		return err
+	}
+
+	log.Info("task finished", zap.String("result_type", string(result.Type)))
+	if completed != nil && completed.Task != nil && completed.Task.BatchID != "" {
+		t := completed.Task
+		// Redelivering the task would not help: it is already completed.
+		// The job reconciler repairs a count lost here.
+		if err := s.jobs.RecordJobTask(ctx, &jobdomain.RecordJobTaskArgs{
+			Name:  string(jobdomain.JobNameForBatch(t.BatchID)),
+			State: t.State,
+		}); err != nil {
+			log.Warn("cannot count task on its job", zap.String("batch_id", t.BatchID), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// keepAlive heartbeats the task's attempt until stop is called. stop waits
+// for a heartbeat in flight, so it does not race the task's completion.
+func (s *Service) keepAlive(ctx context.Context, log *zap.Logger, task *taskdomain.Task) (stop func()) {
+	done := make(chan struct{})
+	exited := make(chan struct{})
+	go func() {
+		defer close(exited)
+		t := time.NewTicker(s.cfg.Heartbeat)
+		defer t.Stop()
+		for {
+			select {
+			case <-t.C:
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			}
+
+			err := s.taskRepo.HeartbeatTask(ctx, &taskdomain.HeartbeatTaskArgs{
+				Name:    string(task.Name),
+				Attempt: task.Attempt,
+			})
+			switch {
+			case errors.Is(err, taskdomain.ErrTaskNotProcessing):
+				log.Info("task ended or was taken over, heartbeats stopped")
+				return
+			case err != nil:
+				log.Warn("cannot heartbeat task", zap.Error(err))
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		<-exited
+	}
+}
+
+// run executes the task. The retry policy is returned with failures of the
+// function process itself, which another attempt may not repeat.
+func (s *Service) run(ctx context.Context, log *zap.Logger, task *taskdomain.Task) (taskdomain.TaskResult, *funcdomain.RetryPolicy) {
+	fnName, err := funcdomain.ParseFunctionName(task.Function)
+	if err != nil {
+		return taskdomain.NewError(err.Error()), nil
+	}
+
+	got, err := s.funcMetaRepo.GetFunction(ctx, &funcdomain.GetFunctionArgs{
+		Name:     fnName,
+		Revision: task.FunctionRevision,
+	})
+	if err != nil {
+		return taskdomain.NewError(fmt.Sprintf("load function: %v", err)), nil
+	}
+	fn := got.Function
+	if !fn.IsReady() {
+		return taskdomain.NewError(funcdomain.ErrFunctionNotReady.Error()), nil
+	}
+
+	workDir := filepath.Join(s.cfg.WorkDir, task.ID.String())
+	defer func() {
+		// Inputs are read-only; make them removable first.
+		_ = makeWritable(filepath.Join(workDir, inputsDir))
+		if err := os.RemoveAll(workDir); err != nil {
+			log.Warn("cannot remove work directory", zap.Error(err))
+		}
+	}()
+
+	// Only the build artifact is executed, never the uploaded sources.
+	if err := funcdomain.ExtractBundle(ctx, s.funcObjRepo, fn.Build.Artifact, workDir); err != nil {
+		return taskdomain.NewError(fmt.Sprintf("prepare artifact: %v", err)), nil
+	}
+
+	secretValues, err := s.resolveSecrets(ctx, fn.SecretEnv)
+	if err != nil {
+		return taskdomain.NewError(fmt.Sprintf("resolve secrets: %v", err)), nil
+	}
+
+	if err := s.materializeInputs(ctx, task.Inputs, filepath.Join(workDir, inputsDir)); err != nil {
+		return taskdomain.NewError(fmt.Sprintf("prepare inputs: %v", err)), nil
+	}
+
+	outDir := filepath.Join(workDir, outputsDir)
+	if err := os.MkdirAll(outDir, 0o755); err != nil {
+		return taskdomain.NewError(fmt.Sprintf("prepare outputs: %v", err)), nil
+	}
+
+	argv, err := s.launchCommand(fn)
+	if err != nil {
+		return taskdomain.NewError(err.Error()), nil
+	}
+	if fn.MemoryBytes > 0 {
+		argv = limitMemory(argv, fn.MemoryBytes)
+	}
+
+	env := s.buildEnv(task, fn, secretValues, workDir)
+	r := newRedactor(secretValues)
+
+	timeout := s.cfg.Timeout
+	if fn.Timeout > 0 {
+		timeout = fn.Timeout
+	}
+	timeout = min(timeout, s.cfg.MaxTimeout)
+	runCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(runCtx, argv[0], argv[1:]...)
+	cmd.Dir = workDir
+	cmd.Env = env
+	cmd.Stdin = strings.NewReader(task.Parameters)
+
+	stdout := bufferutils.NewLimitedBuffer(s.cfg.MaxOutputSize)
+	stderr := bufferutils.NewTailBuffer(4 << 10)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	runErr := cmd.Run()
+	switch {
+	case errors.Is(runCtx.Err(), context.DeadlineExceeded):
+		return taskdomain.NewError(fmt.Sprintf("execution timed out after %s", timeout)), fn.Retry
+	case runErr != nil:
+		msg := fmt.Sprintf("execution failed: %v", runErr)
+		if tail := strings.TrimSpace(r.redact(stderr.String())); tail != "" {
+			msg += ": " + tail
+		}
+		return taskdomain.NewError(msg), fn.Retry
+	case stdout.Truncated():
+		return taskdomain.NewError(fmt.Sprintf("output exceeds %d bytes", s.cfg.MaxOutputSize)), nil
+	}
+
+	out := stdout.Bytes()
+	contentType := fn.OutputType()
+	if len(out) == 0 && (contentType == "" || funcdomain.IsJSONContentType(contentType)) {
+		out = []byte("null")
+	}
+	// Checked on the redacted output, which is what callers get, and before
+	// outputs are uploaded: a violation fails the task. Messages may quote
+	// the output, so they are redacted too.
+	out = []byte(r.redact(string(out)))
+	if err := fn.ValidateOutput(out); err != nil {
+		return taskdomain.NewError(r.redact(err.Error())), nil
+	}
+	if contentType == "" {
+		contentType = sniffContentType(out)
+	}
+
+	artifacts, err := s.collectOutputs(ctx, task.Name, outDir, r)
+	if err != nil {
+		return taskdomain.NewError(fmt.Sprintf("collect outputs: %v", err)), nil
+	}
+
+	result := taskdomain.NewInlineResult(out)
+	result.ContentType = contentType
+	result.Artifacts = artifacts
+	return result, nil
+}
+
+// sniffContentType types the output of a function that declares none.
+func sniffContentType(out []byte) string {
+	if json.Valid(out) {
+		return funcdomain.DefaultOutputContentType
+	}
+	return http.DetectContentType(out)
+}
+
+type outputFile struct {
+	name string
+	path string
+}
+
+// collectOutputs uploads the regular files under dir as task artifacts.
+// Limits are checked before anything is uploaded; if an upload fails, the
+// artifacts stored so far are removed. Text artifacts are redacted like the
+// inline result; binary ones are stored as written, since masking bytes in
+// them would corrupt the file.
+func (s *Service) collectOutputs(ctx context.Context, task taskdomain.TaskName, dir string, r *redactor) ([]taskdomain.TaskArtifact, error) {
+	var (
+		files []outputFile
+		total int64
+	)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		// Symlinks are skipped: they could point outside the work directory.
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+		if err := taskdomain.ValidateArtifactName(name); err != nil {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		total += info.Size()
+
+		files = append(files, outputFile{name: name, path: path})
+		if len(files) > s.cfg.MaxArtifacts {
+			return fmt.Errorf("more than %d files", s.cfg.MaxArtifacts)
+		}
+		if total > s.cfg.MaxArtifactsSize {
+			return fmt.Errorf("files exceed %d bytes", s.cfg.MaxArtifactsSize)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	artifacts := make([]taskdomain.TaskArtifact, 0, len(files))
+	for _, f := range files {
+		a, err := s.saveOutput(ctx, task, f, r)
+		if err != nil {
+			for i := range artifacts {
+				_ = s.artifactRepo.DeleteTaskArtifact(ctx, &artifacts[i])
+			}
+			return nil, fmt.Errorf("%s: %w", f.name, err)
+		}
+		artifacts = append(artifacts, *a)
+	}
+	return artifacts, nil
+}
+
+func (s *Service) saveOutput(ctx context.Context, task taskdomain.TaskName, f outputFile, r *redactor) (*taskdomain.TaskArtifact, error) {
+	file, err := os.Open(f.path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	contentType, err := detectContentType(file, f.name)
+	if err != nil {
+		return nil, err
+	}
+
+	var data io.Reader = file
+	// The file is bounded by MaxArtifactsSize, so it can be read whole.
+	if r.enabled() && isTextContentType(contentType) {
+		b, err := io.ReadAll(file)
+		if err != nil {
+			return nil, err
+		}
+		data = strings.NewReader(r.redact(string(b)))
+	}
+
+	res, err := s.artifactRepo.SaveTaskArtifact(ctx, &taskdomain.SaveTaskArtifactArgs{
+		Task:        task,
+		Name:        f.name,
+		ContentType: contentType,
+		Data:        data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Artifact, nil
+}
+
+// isTextContentType reports whether an artifact of type ct is text, which
+// secrets can be masked in.
+func isTextContentType(ct string) bool {
+	mt, _, err := mime.ParseMediaType(ct)
+	if err != nil {
+		return false
+	}
+	return strings.HasPrefix(mt, "text/") || funcdomain.IsJSONContentType(mt) ||
+		mt == "application/xml" || strings.HasSuffix(mt, "+xml")
+}
+
+// detectContentType goes by extension and falls back to sniffing the first
+// 512 bytes. The file is rewound afterwards.
+func detectContentType(f *os.File, name string) (string, error) {
+	if ct := mime.TypeByExtension(filepath.Ext(name)); ct != "" {
+		return ct, nil
+	}
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(f, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	return http.DetectContentType(head[:n]), nil
+}
+
+// materializeInputs downloads the task inputs into dir and makes them
+// read-only, so a function cannot mistake them for scratch space.
+func (s *Service) materializeInputs(ctx context.Context, inputs []taskdomain.TaskArtifact, dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	for i := range inputs {
+		in := &inputs[i]
+		if err := taskdomain.ValidateArtifactName(in.Name); err != nil {
+			return err
+		}
+		if err := s.materializeInput(ctx, in, filepath.Join(dir, filepath.FromSlash(in.Name))); err != nil {
+			return fmt.Errorf("%s: %w", in.Name, err)
+		}
+	}
+
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return os.Chmod(path, 0o555)
+		}
+		return os.Chmod(path, 0o444)
+	})
+}
+
+func (s *Service) materializeInput(ctx context.Context, in *taskdomain.TaskArtifact, dst string) error {
+	rc, err := s.artifactRepo.OpenTaskArtifact(ctx, in)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(dst, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	dr := digestutils.NewReader(rc)
+	if _, err := io.Copy(f, dr); err != nil {
+		return err
+	}
+	if err := dr.Verify(in.SHA256); err != nil {
+		return fmt.Errorf("integrity check: %w", err)
+	}
+	return f.Close()
+}
+
+func makeWritable(dir string) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return os.Chmod(path, 0o755)
+		}
+		return nil
+	})
+}
+
+func (s *Service) resolveSecrets(ctx context.Context, secretEnv map[string]string) (map[string]string, error) {
+	if len(secretEnv) == 0 {
+		return nil, nil
+	}
+
+	names := make([]secretdomain.SecretName, 0, len(secretEnv))
+	for _, ref := range secretEnv {
+		names = append(names, secretdomain.SecretName(ref))
+	}
+
+	res, err := s.secrets.ResolveSecrets(ctx, &secretdomain.ResolveSecretsArgs{Names: names})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]string, len(secretEnv))
+	for k, ref := range secretEnv {
+		out[k] = string(res.Values[secretdomain.SecretName(ref)])
+	}
+	return out, nil
+}
+
+// buildEnv assembles the process environment from scratch. The agent's own
+// environment is not inherited: it may hold the secrets master key.
+func (s *Service) buildEnv(task *taskdomain.Task, fn *funcdomain.Function, secretValues map[string]string, workDir string) []string {
+	env := make([]string, 0, len(fn.Env)+len(secretValues)+9)
+	env = append(env,
+		"PATH="+os.Getenv("PATH"),
+		"HOME="+workDir,
+		"FAAS_TASK_NAME="+string(task.Name),
+		"FAAS_FUNCTION_NAME="+task.Function,
+		"FAAS_INPUT_DIR="+filepath.Join(workDir, inputsDir),
+		"FAAS_OUTPUT_DIR="+filepath.Join(workDir, outputsDir),
+	)
+	for k, v := range map[string]string{
+		"FAAS_RUNTIME":    fn.Runtime,
+		"FAAS_ENTRYPOINT": fn.Entrypoint,
+		"FAAS_HANDLER":    fn.Handler,
+	} {
+		if v != "" {
+			env = append(env, k+"="+v)
+		}
+	}
+	for k, v := range fn.Env {
+		env = append(env, k+"="+v)
+	}
+	for k, v := range secretValues {
+		env = append(env, k+"="+v)
+	}
+	return env
+}
+
+type redactor struct {
+	values []string
+}
+
+func newRedactor(secretValues map[string]string) *redactor {
+	values := make([]string, 0, len(secretValues))
+	for _, v := range secretValues {
+		if v != "" {
+			values = append(values, v)
+		}
+	}
+	// Longest first, so a secret containing another one is masked whole.
+	sort.Slice(values, func(i, j int) bool { return len(values[i]) > len(values[j]) })
+	return &redactor{values: values}
+}
+
+// enabled reports whether there is anything to redact.
+func (r *redactor) enabled() bool {
+	return len(r.values) > 0
+}
+
+func (r *redactor) redact(s string) string {
+	for _, v := range r.values {
+		s = strings.ReplaceAll(s, v, redacted)
+	}
+	return s
+}
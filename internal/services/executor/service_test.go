@@ -0,0 +1,652 @@
+package execsrv_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"os/exec"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	jobdomain "github.com/10Narratives/faas/internal/domains/jobs"
+	secretdomain "github.com/10Narratives/faas/internal/domains/secrets"
+	taskdomain "github.com/10Narratives/faas/internal/domains/tasks"
+	execsrv "github.com/10Narratives/faas/internal/services/executor"
+	"github.com/10Narratives/faas/internal/services/executor/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func zipBundle(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, body := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(body))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func sha256Hex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func readyBuild(artifact *funcdomain.SourceBundle) *funcdomain.FunctionBuild {
+	return &funcdomain.FunctionBuild{ID: uuid.New(), State: funcdomain.BuildStateReady, Artifact: artifact}
+}
+
+type fixture struct {
+	tasks     *mocks.TaskRepository
+	artifacts *mocks.TaskArtifactRepository
+	meta      *mocks.FunctionMetadataRepository
+	objects   *mocks.FunctionObjectRepository
+	secrets   *mocks.SecretResolver
+	jobs      *mocks.JobTaskRecorder
+}
+
+func newFixture(t *testing.T) *fixture {
+	return &fixture{
+		tasks:     mocks.NewTaskRepository(t),
+		artifacts: mocks.NewTaskArtifactRepository(t),
+		meta:      mocks.NewFunctionMetadataRepository(t),
+		objects:   mocks.NewFunctionObjectRepository(t),
+		secrets:   mocks.NewSecretResolver(t),
+		jobs:      mocks.NewJobTaskRecorder(t),
+	}
+}
+
+func (f *fixture) service(t *testing.T, command ...string) *execsrv.Service {
+	return f.serviceWith(t, execsrv.Config{Command: command})
+}
+
+func (f *fixture) serviceWith(t *testing.T, cfg execsrv.Config) *execsrv.Service {
+	cfg.WorkDir = t.TempDir()
+	return execsrv.NewService(cfg, f.tasks, f.artifacts, f.meta, f.objects, f.secrets, f.jobs, zap.NewNop())
+}
+
+// expectRun sets up a started task running fn from an archive of files.
+func (f *fixture) expectRun(ctx context.Context, t *testing.T, task *taskdomain.Task, fn *funcdomain.Function, files map[string]string) {
+	archive := zipBundle(t, files)
+	fn.Bundle = &funcdomain.SourceBundle{ObjectKey: "src.zip"}
+	fn.Build = readyBuild(&funcdomain.SourceBundle{ObjectKey: "artifacts/src.zip", Format: funcdomain.ZipFormat, SHA256: sha256Hex(archive)})
+
+	f.tasks.EXPECT().StartTask(ctx, mock.Anything).Return(&taskdomain.StartTaskResult{Task: task}, nil).Once()
+	f.meta.EXPECT().GetFunction(ctx, mock.Anything).Return(&funcdomain.GetFunctionResult{Function: fn}, nil).Once()
+	f.objects.EXPECT().OpenBundle(ctx, fn.Build.Artifact).
+		Return(io.NopCloser(bytes.NewReader(archive)), nil).Once()
+}
+
+// expectResult expects the task to complete with output, or with an error
+// containing output when failed is set.
+func (f *fixture) expectResult(ctx context.Context, task *taskdomain.Task, output string, failed bool) {
+	f.tasks.EXPECT().
+		CompleteTask(ctx, mock.MatchedBy(func(a *taskdomain.CompleteTaskArgs) bool {
+			if failed {
+				return a.Result.Type == taskdomain.TaskResultError && strings.Contains(a.Result.ErrorMessage, output)
+			}
+			return a.Result.Type == taskdomain.TaskResultInline && string(a.Result.InlineResult) == output
+		})).
+		Return(&taskdomain.CompleteTaskResult{Task: task}, nil).Once()
+}
+
+func TestService_ExecuteTask_InjectsEnvAndRedactsSecrets(t *testing.T) {
+	t.Setenv("FAAS_SECRETS_MASTER_KEY", "agent-only")
+
+	ctx := context.Background()
+	f := newFixture(t)
+
+	task := &taskdomain.Task{
+		ID:               uuid.New(),
+		Name:             "tasks/1",
+		Function:         "functions/echo",
+		FunctionRevision: 2,
+		Parameters:       `{"x":1}`,
+		State:            taskdomain.TaskStateProcessing,
+	}
+	archive := zipBundle(t, map[string]string{"main.sh": "cat; printf ' %s %s [%s]' \"$GREETING\" \"$API_TOKEN\" \"$FAAS_SECRETS_MASTER_KEY\""})
+	fn := &funcdomain.Function{
+		Name:      "functions/echo",
+		Bundle:    &funcdomain.SourceBundle{ObjectKey: "echo.zip", Format: funcdomain.ZipFormat},
+		Build:     readyBuild(&funcdomain.SourceBundle{ObjectKey: "artifacts/echo.zip", Format: funcdomain.ZipFormat, SHA256: sha256Hex(archive)}),
+		Env:       map[string]string{"GREETING": "hi"},
+		SecretEnv: map[string]string{"API_TOKEN": "secrets/token"},
+	}
+
+	f.tasks.EXPECT().StartTask(ctx, &taskdomain.StartTaskArgs{Name: "tasks/1", StaleAfter: 30 * time.Second}).
+		Return(&taskdomain.StartTaskResult{Task: task}, nil).Once()
+	f.meta.EXPECT().GetFunction(ctx, &funcdomain.GetFunctionArgs{Name: "functions/echo", Revision: 2}).
+		Return(&funcdomain.GetFunctionResult{Function: fn}, nil).Once()
+	f.objects.EXPECT().OpenBundle(ctx, fn.Build.Artifact).
+		Return(io.NopCloser(bytes.NewReader(archive)), nil).Once()
+	f.secrets.EXPECT().ResolveSecrets(ctx, &secretdomain.ResolveSecretsArgs{Names: []secretdomain.SecretName{"secrets/token"}}).
+		Return(&secretdomain.ResolveSecretsResult{Values: map[secretdomain.SecretName][]byte{"secrets/token": []byte("tok-123")}}, nil).Once()
+	f.tasks.EXPECT().
+		CompleteTask(ctx, mock.MatchedBy(func(a *taskdomain.CompleteTaskArgs) bool {
+			return a.Name == "tasks/1" &&
+				a.Result.Type == taskdomain.TaskResultInline &&
+				string(a.Result.InlineResult) == `{"x":1} hi [REDACTED] []`
+		})).
+		Return(&taskdomain.CompleteTaskResult{Task: task}, nil).Once()
+
+	err := f.service(t, "sh", "main.sh").ExecuteTask(ctx, "tasks/1")
+	require.NoError(t, err)
+}
+
+func TestService_ExecuteTask_RecordsFailure(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+
+	task := &taskdomain.Task{ID: uuid.New(), Name: "tasks/2", Function: "functions/fail", State: taskdomain.TaskStateProcessing}
+	archive := zipBundle(t, map[string]string{"main.sh": "echo boom >&2; exit 3"})
+	fn := &funcdomain.Function{
+		Name:   "functions/fail",
+		Bundle: &funcdomain.SourceBundle{ObjectKey: "fail.zip"},
+		Build:  readyBuild(&funcdomain.SourceBundle{ObjectKey: "artifacts/fail.zip", Format: funcdomain.ZipFormat, SHA256: sha256Hex(archive)}),
+	}
+
+	f.tasks.EXPECT().StartTask(ctx, mock.Anything).Return(&taskdomain.StartTaskResult{Task: task}, nil).Once()
+	f.meta.EXPECT().GetFunction(ctx, mock.Anything).Return(&funcdomain.GetFunctionResult{Function: fn}, nil).Once()
+	f.objects.EXPECT().OpenBundle(ctx, fn.Build.Artifact).
+		Return(io.NopCloser(bytes.NewReader(archive)), nil).Once()
+	f.tasks.EXPECT().
+		CompleteTask(ctx, mock.MatchedBy(func(a *taskdomain.CompleteTaskArgs) bool {
+			return a.Result.Type == taskdomain.TaskResultError &&
+				bytes.Contains([]byte(a.Result.ErrorMessage), []byte("boom"))
+		})).
+		Return(&taskdomain.CompleteTaskResult{Task: task}, nil).Once()
+
+	err := f.service(t, "sh", "main.sh").ExecuteTask(ctx, "tasks/2")
+	require.NoError(t, err)
+}
+
+func TestService_ExecuteTask_CountsBatchTaskOnJob(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+
+	task := &taskdomain.Task{ID: uuid.New(), Name: "tasks/3", Function: "functions/fail", State: taskdomain.TaskStateProcessing, BatchID: "b1"}
+	archive := zipBundle(t, map[string]string{"main.sh": "exit 1"})
+	fn := &funcdomain.Function{
+		Name:   "functions/fail",
+		Bundle: &funcdomain.SourceBundle{ObjectKey: "fail.zip"},
+		Build:  readyBuild(&funcdomain.SourceBundle{ObjectKey: "artifacts/fail.zip", Format: funcdomain.ZipFormat, SHA256: sha256Hex(archive)}),
+	}
+	ended := *task
+	ended.State = taskdomain.TaskStateFailed
+
+	f.tasks.EXPECT().StartTask(ctx, mock.Anything).Return(&taskdomain.StartTaskResult{Task: task}, nil).Once()
+	f.meta.EXPECT().GetFunction(ctx, mock.Anything).Return(&funcdomain.GetFunctionResult{Function: fn}, nil).Once()
+	f.objects.EXPECT().OpenBundle(ctx, fn.Build.Artifact).
+		Return(io.NopCloser(bytes.NewReader(archive)), nil).Once()
+	f.tasks.EXPECT().CompleteTask(ctx, mock.Anything).Return(&taskdomain.CompleteTaskResult{Task: &ended}, nil).Once()
+	f.jobs.EXPECT().
+		RecordJobTask(ctx, &jobdomain.RecordJobTaskArgs{Name: "jobs/b1", State: taskdomain.TaskStateFailed}).
+		Return(errors.New("kv unavailable")).
+		Once()
+
+	// Counting is best effort; the task itself is done.
+	err := f.service(t, "sh", "main.sh").ExecuteTask(ctx, "tasks/3")
+	require.NoError(t, err)
+}
+
+func TestService_ExecuteTask_OutputContract(t *testing.T) {
+	schema := json.RawMessage(`{"type": "object", "required": ["total"]}`)
+
+	for _, tc := range []struct {
+		name   string
+		script string
+		fn     funcdomain.Function
+		check  func(taskdomain.TaskResult) bool
+	}{
+		{
+			name:   "violation fails the task",
+			script: `echo '{"count": 1}'`,
+			fn:     funcdomain.Function{OutputSchema: schema},
+			check: func(r taskdomain.TaskResult) bool {
+				return r.Type == taskdomain.TaskResultError &&
+					strings.HasPrefix(r.ErrorMessage, "output contract violation: output: ")
+			},
+		},
+		{
+			name:   "match keeps the declared type",
+			script: `echo '{"total": 1}'`,
+			fn:     funcdomain.Function{OutputSchema: schema, OutputContentType: "application/vnd.report+json"},
+			check: func(r taskdomain.TaskResult) bool {
+				return r.Type == taskdomain.TaskResultInline && r.ContentType == "application/vnd.report+json"
+			},
+		},
+		{
+			name:   "undeclared type is sniffed",
+			script: `echo plain words`,
+			check: func(r taskdomain.TaskResult) bool {
+				return r.Type == taskdomain.TaskResultInline && r.ContentType == "text/plain; charset=utf-8"
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			f := newFixture(t)
+
+			task := &taskdomain.Task{ID: uuid.New(), Name: "tasks/9", Function: "functions/report", State: taskdomain.TaskStateProcessing}
+			archive := zipBundle(t, map[string]string{"main.sh": tc.script})
+			fn := tc.fn
+			fn.Name = "functions/report"
+			fn.Bundle = &funcdomain.SourceBundle{ObjectKey: "report.zip"}
+			fn.Build = readyBuild(&funcdomain.SourceBundle{ObjectKey: "artifacts/report.zip", Format: funcdomain.ZipFormat, SHA256: sha256Hex(archive)})
+
+			f.tasks.EXPECT().StartTask(ctx, mock.Anything).Return(&taskdomain.StartTaskResult{Task: task}, nil).Once()
+			f.meta.EXPECT().GetFunction(ctx, mock.Anything).Return(&funcdomain.GetFunctionResult{Function: &fn}, nil).Once()
+			f.objects.EXPECT().OpenBundle(ctx, fn.Build.Artifact).
+				Return(io.NopCloser(bytes.NewReader(archive)), nil).Once()
+			f.tasks.EXPECT().
+				CompleteTask(ctx, mock.MatchedBy(func(a *taskdomain.CompleteTaskArgs) bool { return tc.check(a.Result) })).
+				Return(&taskdomain.CompleteTaskResult{Task: task}, nil).Once()
+
+			err := f.service(t, "sh", "main.sh").ExecuteTask(ctx, "tasks/9")
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestService_ExecuteTask_FunctionTimeout(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+
+	task := &taskdomain.Task{ID: uuid.New(), Name: "tasks/7", Function: "functions/slow", State: taskdomain.TaskStateProcessing}
+	archive := zipBundle(t, map[string]string{"main.sh": "exec sleep 5"})
+	fn := &funcdomain.Function{
+		Name:    "functions/slow",
+		Timeout: 100 * time.Millisecond,
+		Bundle:  &funcdomain.SourceBundle{ObjectKey: "slow.zip"},
+		Build:   readyBuild(&funcdomain.SourceBundle{ObjectKey: "artifacts/slow.zip", Format: funcdomain.ZipFormat, SHA256: sha256Hex(archive)}),
+	}
+
+	f.tasks.EXPECT().StartTask(ctx, mock.Anything).Return(&taskdomain.StartTaskResult{Task: task}, nil).Once()
+	f.meta.EXPECT().GetFunction(ctx, mock.Anything).Return(&funcdomain.GetFunctionResult{Function: fn}, nil).Once()
+	f.objects.EXPECT().OpenBundle(ctx, fn.Build.Artifact).
+		Return(io.NopCloser(bytes.NewReader(archive)), nil).Once()
+	f.tasks.EXPECT().
+		CompleteTask(ctx, mock.MatchedBy(func(a *taskdomain.CompleteTaskArgs) bool {
+			return a.Result.Type == taskdomain.TaskResultError &&
+				a.Result.ErrorMessage == "execution timed out after 100ms"
+		})).
+		Return(&taskdomain.CompleteTaskResult{Task: task}, nil).Once()
+
+	err := f.service(t, "sh", "main.sh").ExecuteTask(ctx, "tasks/7")
+	require.NoError(t, err)
+}
+
+func TestService_ExecuteTask_SkipsNotPending(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+
+	f.tasks.EXPECT().StartTask(ctx, mock.Anything).
+		Return((*taskdomain.StartTaskResult)(nil), taskdomain.ErrTaskNotPending).Once()
+
+	err := f.service(t, "true").ExecuteTask(ctx, "tasks/3")
+	require.NoError(t, err)
+}
+
+func TestService_ExecuteTask_ReturnsStartErrors(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+
+	kvErr := errors.New("nats: timeout")
+	f.tasks.EXPECT().StartTask(ctx, mock.Anything).
+		Return((*taskdomain.StartTaskResult)(nil), kvErr).Once()
+
+	// Returned as is, so that the message is redelivered.
+	err := f.service(t, "true").ExecuteTask(ctx, "tasks/3")
+	require.ErrorIs(t, err, kvErr)
+	var retry *taskdomain.RetryError
+	require.False(t, errors.As(err, &retry))
+}
+
+func TestService_ExecuteTask_ChecksBackOnRunningTask(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+
+	f.tasks.EXPECT().StartTask(ctx, &taskdomain.StartTaskArgs{Name: "tasks/3", StaleAfter: 3 * time.Second}).
+		Return((*taskdomain.StartTaskResult)(nil), taskdomain.ErrTaskRunning).Once()
+
+	err := f.serviceWith(t, execsrv.Config{Command: []string{"true"}, Heartbeat: time.Second}).ExecuteTask(ctx, "tasks/3")
+	var retry *taskdomain.RetryError
+	require.ErrorAs(t, err, &retry)
+	require.Equal(t, 3*time.Second, retry.Delay)
+}
+
+func TestService_ExecuteTask_HeartbeatsRunningAttempt(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+
+	task := &taskdomain.Task{ID: uuid.New(), Name: "tasks/8", Function: "functions/slow", State: taskdomain.TaskStateProcessing, Attempt: 2}
+	f.expectRun(ctx, t, task, &funcdomain.Function{Name: "functions/slow"}, map[string]string{"main.sh": "sleep 0.3; printf 1"})
+
+	var beats atomic.Int32
+	f.tasks.EXPECT().HeartbeatTask(ctx, &taskdomain.HeartbeatTaskArgs{Name: "tasks/8", Attempt: 2}).
+		RunAndReturn(func(context.Context, *taskdomain.HeartbeatTaskArgs) error {
+			beats.Add(1)
+			return nil
+		})
+	f.tasks.EXPECT().
+		CompleteTask(ctx, mock.Anything).
+		RunAndReturn(func(context.Context, *taskdomain.CompleteTaskArgs) (*taskdomain.CompleteTaskResult, error) {
+			n := beats.Load()
+			// No heartbeat may land after the attempt finished.
+			time.Sleep(50 * time.Millisecond)
+			require.Equal(t, n, beats.Load())
+			return &taskdomain.CompleteTaskResult{Task: task}, nil
+		}).Once()
+
+	cfg := execsrv.Config{Command: []string{"sh", "main.sh"}, Heartbeat: 20 * time.Millisecond}
+	require.NoError(t, f.serviceWith(t, cfg).ExecuteTask(ctx, "tasks/8"))
+	require.Greater(t, beats.Load(), int32(1))
+}
+
+func TestService_ExecuteTask_RejectsUnbuiltFunction(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+
+	task := &taskdomain.Task{ID: uuid.New(), Name: "tasks/4", Function: "functions/src", State: taskdomain.TaskStateProcessing}
+	fn := &funcdomain.Function{
+		Name:   "functions/src",
+		Bundle: &funcdomain.SourceBundle{ObjectKey: "src.zip"},
+		Build:  &funcdomain.FunctionBuild{ID: uuid.New(), State: funcdomain.BuildStateBuilding},
+	}
+
+	f.tasks.EXPECT().StartTask(ctx, mock.Anything).Return(&taskdomain.StartTaskResult{Task: task}, nil).Once()
+	f.meta.EXPECT().GetFunction(ctx, mock.Anything).Return(&funcdomain.GetFunctionResult{Function: fn}, nil).Once()
+	f.tasks.EXPECT().
+		CompleteTask(ctx, mock.MatchedBy(func(a *taskdomain.CompleteTaskArgs) bool {
+			return a.Result.Type == taskdomain.TaskResultError &&
+				a.Result.ErrorMessage == funcdomain.ErrFunctionNotReady.Error()
+		})).
+		Return(&taskdomain.CompleteTaskResult{Task: task}, nil).Once()
+
+	err := f.service(t, "true").ExecuteTask(ctx, "tasks/4")
+	require.NoError(t, err)
+}
+
+func TestService_ExecuteTask_RejectsCorruptedArtifact(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+
+	task := &taskdomain.Task{ID: uuid.New(), Name: "tasks/5", Function: "functions/echo", State: taskdomain.TaskStateProcessing}
+	archive := zipBundle(t, map[string]string{"main.sh": "echo ran"})
+	fn := &funcdomain.Function{
+		Name:   "functions/echo",
+		Bundle: &funcdomain.SourceBundle{ObjectKey: "echo.zip"},
+		Build:  readyBuild(&funcdomain.SourceBundle{ObjectKey: "artifacts/echo.zip", Format: funcdomain.ZipFormat, SHA256: sha256Hex([]byte("other"))}),
+	}
+
+	f.tasks.EXPECT().StartTask(ctx, mock.Anything).Return(&taskdomain.StartTaskResult{Task: task}, nil).Once()
+	f.meta.EXPECT().GetFunction(ctx, mock.Anything).Return(&funcdomain.GetFunctionResult{Function: fn}, nil).Once()
+	f.objects.EXPECT().OpenBundle(ctx, fn.Build.Artifact).
+		Return(io.NopCloser(bytes.NewReader(archive)), nil).Once()
+	f.tasks.EXPECT().
+		CompleteTask(ctx, mock.MatchedBy(func(a *taskdomain.CompleteTaskArgs) bool {
+			return a.Result.Type == taskdomain.TaskResultError &&
+				bytes.Contains([]byte(a.Result.ErrorMessage), []byte("sha256 mismatch"))
+		})).
+		Return(&taskdomain.CompleteTaskResult{Task: task}, nil).Once()
+
+	err := f.service(t, "sh", "main.sh").ExecuteTask(ctx, "tasks/5")
+	require.NoError(t, err)
+}
+
+func TestService_ExecuteTask_CollectsOutputs(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+
+	task := &taskdomain.Task{ID: uuid.New(), Name: "tasks/6", Function: "functions/report", State: taskdomain.TaskStateProcessing}
+	archive := zipBundle(t, map[string]string{
+		"main.sh": `printf '{"ok":true}' > "$FAAS_OUTPUT_DIR/summary.json"; mkdir -p "$FAAS_OUTPUT_DIR/raw"; printf 'x' > "$FAAS_OUTPUT_DIR/raw/data"; ln -s /etc/passwd "$FAAS_OUTPUT_DIR/leak"; echo '{"rows":1}'`,
+	})
+	fn := &funcdomain.Function{
+		Name:   "functions/report",
+		Bundle: &funcdomain.SourceBundle{ObjectKey: "report.zip"},
+		Build:  readyBuild(&funcdomain.SourceBundle{ObjectKey: "artifacts/report.zip", Format: funcdomain.ZipFormat, SHA256: sha256Hex(archive)}),
+	}
+
+	f.tasks.EXPECT().StartTask(ctx, mock.Anything).Return(&taskdomain.StartTaskResult{Task: task}, nil).Once()
+	f.meta.EXPECT().GetFunction(ctx, mock.Anything).Return(&funcdomain.GetFunctionResult{Function: fn}, nil).Once()
+	f.objects.EXPECT().OpenBundle(ctx, fn.Build.Artifact).
+		Return(io.NopCloser(bytes.NewReader(archive)), nil).Once()
+
+	saved := map[string]string{}
+	f.artifacts.EXPECT().SaveTaskArtifact(ctx, mock.Anything).
+		RunAndReturn(func(_ context.Context, args *taskdomain.SaveTaskArtifactArgs) (*taskdomain.SaveTaskArtifactResult, error) {
+			require.Equal(t, taskdomain.TaskName("tasks/6"), args.Task)
+			b, err := io.ReadAll(args.Data)
+			require.NoError(t, err)
+			saved[args.Name] = args.ContentType
+			return &taskdomain.SaveTaskArtifactResult{Artifact: &taskdomain.TaskArtifact{
+				Name:        args.Name,
+				ObjectKey:   "6/outputs/" + args.Name,
+				Size:        uint64(len(b)),
+				SHA256:      sha256Hex(b),
+				ContentType: args.ContentType,
+			}}, nil
+		}).Twice()
+	f.tasks.EXPECT().
+		CompleteTask(ctx, mock.MatchedBy(func(a *taskdomain.CompleteTaskArgs) bool {
+			return a.Result.Type == taskdomain.TaskResultInline &&
+				string(a.Result.InlineResult) == "{\"rows\":1}\n" &&
+				len(a.Result.Artifacts) == 2 &&
+				a.Result.Artifacts[0].Name == "raw/data" &&
+				a.Result.Artifacts[1].Name == "summary.json" &&
+				a.Result.Artifacts[1].Size == 11
+		})).
+		Return(&taskdomain.CompleteTaskResult{Task: task}, nil).Once()
+
+	err := f.service(t, "sh", "main.sh").ExecuteTask(ctx, "tasks/6")
+	require.NoError(t, err)
+	require.Equal(t, "application/json", saved["summary.json"])
+	require.Equal(t, "text/plain; charset=utf-8", saved["raw/data"])
+}
+
+func TestService_ExecuteTask_MaterializesInputs(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+
+	input := taskdomain.TaskArtifact{Name: "data/rows.csv", ObjectKey: "7/inputs/data/rows.csv", Size: 4, SHA256: sha256Hex([]byte("a,b\n"))}
+	task := &taskdomain.Task{
+		ID:       uuid.New(),
+		Name:     "tasks/7",
+		Function: "functions/wc",
+		State:    taskdomain.TaskStateProcessing,
+		Inputs:   []taskdomain.TaskArtifact{input},
+	}
+	archive := zipBundle(t, map[string]string{"main.sh": `cat "$FAAS_INPUT_DIR/data/rows.csv"; stat -c %a "$FAAS_INPUT_DIR/data/rows.csv"`})
+	fn := &funcdomain.Function{
+		Name:   "functions/wc",
+		Bundle: &funcdomain.SourceBundle{ObjectKey: "wc.zip"},
+		Build:  readyBuild(&funcdomain.SourceBundle{ObjectKey: "artifacts/wc.zip", Format: funcdomain.ZipFormat, SHA256: sha256Hex(archive)}),
+	}
+
+	f.tasks.EXPECT().StartTask(ctx, mock.Anything).Return(&taskdomain.StartTaskResult{Task: task}, nil).Once()
+	f.meta.EXPECT().GetFunction(ctx, mock.Anything).Return(&funcdomain.GetFunctionResult{Function: fn}, nil).Once()
+	f.objects.EXPECT().OpenBundle(ctx, fn.Build.Artifact).
+		Return(io.NopCloser(bytes.NewReader(archive)), nil).Once()
+	f.artifacts.EXPECT().OpenTaskArtifact(ctx, &task.Inputs[0]).
+		Return(io.NopCloser(bytes.NewReader([]byte("a,b\n"))), nil).Once()
+	f.tasks.EXPECT().
+		CompleteTask(ctx, mock.MatchedBy(func(a *taskdomain.CompleteTaskArgs) bool {
+			return a.Result.Type == taskdomain.TaskResultInline &&
+				string(a.Result.InlineResult) == "a,b\n444\n"
+		})).
+		Return(&taskdomain.CompleteTaskResult{Task: task}, nil).Once()
+
+	svc := f.service(t, "sh", "main.sh")
+	err := svc.ExecuteTask(ctx, "tasks/7")
+	require.NoError(t, err)
+}
+
+func TestService_ExecuteTask_RetriesFailedAttempt(t *testing.T) {
+	policy := &funcdomain.RetryPolicy{MaxAttempts: 3, Backoff: time.Second}
+
+	t.Run("attempts left", func(t *testing.T) {
+		ctx := context.Background()
+		f := newFixture(t)
+
+		task := &taskdomain.Task{ID: uuid.New(), Name: "tasks/r1", Function: "functions/flaky", State: taskdomain.TaskStateProcessing, Attempt: 2}
+		f.expectRun(ctx, t, task, &funcdomain.Function{Name: "functions/flaky", Retry: policy}, map[string]string{"main.sh": "echo busy >&2; exit 1"})
+		f.tasks.EXPECT().
+			RetryTask(ctx, mock.MatchedBy(func(a *taskdomain.RetryTaskArgs) bool {
+				return a.Name == "tasks/r1" && strings.Contains(a.ErrorMessage, "busy")
+			})).
+			Return(&taskdomain.RetryTaskResult{Task: task}, nil).Once()
+
+		err := f.service(t, "sh", "main.sh").ExecuteTask(ctx, "tasks/r1")
+		var retry *taskdomain.RetryError
+		require.ErrorAs(t, err, &retry)
+		require.Equal(t, 2*time.Second, retry.Delay)
+	})
+
+	t.Run("last attempt", func(t *testing.T) {
+		ctx := context.Background()
+		f := newFixture(t)
+
+		task := &taskdomain.Task{ID: uuid.New(), Name: "tasks/r2", Function: "functions/flaky", State: taskdomain.TaskStateProcessing, Attempt: 3}
+		f.expectRun(ctx, t, task, &funcdomain.Function{Name: "functions/flaky", Retry: policy}, map[string]string{"main.sh": "echo busy >&2; exit 1"})
+		f.expectResult(ctx, task, "busy", true)
+
+		err := f.service(t, "sh", "main.sh").ExecuteTask(ctx, "tasks/r2")
+		require.NoError(t, err)
+	})
+
+	t.Run("platform failure", func(t *testing.T) {
+		ctx := context.Background()
+		f := newFixture(t)
+
+		// A runtime the agent lacks fails every attempt the same way.
+		task := &taskdomain.Task{ID: uuid.New(), Name: "tasks/r3", Function: "functions/flaky", State: taskdomain.TaskStateProcessing, Attempt: 1}
+		fn := &funcdomain.Function{Name: "functions/flaky", Retry: policy, Runtime: "cobol85", Entrypoint: "main.cob"}
+		f.expectRun(ctx, t, task, fn, map[string]string{"main.cob": ""})
+		f.expectResult(ctx, task, `runtime "cobol85" is not available on this agent`, true)
+
+		err := f.service(t, "sh", "main.sh").ExecuteTask(ctx, "tasks/r3")
+		require.NoError(t, err)
+	})
+}
+
+func TestService_ExecuteTask_LaunchesEntrypointThroughRuntime(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+
+	task := &taskdomain.Task{ID: uuid.New(), Name: "tasks/e1", Function: "functions/entry", Parameters: "in", State: taskdomain.TaskStateProcessing}
+	fn := &funcdomain.Function{Name: "functions/entry", Runtime: "sh5", Entrypoint: "bin/run.sh"}
+	f.expectRun(ctx, t, task, fn, map[string]string{
+		"main.sh":    "echo default command",
+		"bin/run.sh": `printf '%s:%s' "$0" "$(cat)"`,
+	})
+	f.expectResult(ctx, task, "bin/run.sh:in", false)
+
+	svc := f.serviceWith(t, execsrv.Config{
+		Command:  []string{"sh", "main.sh"},
+		Runtimes: map[string]execsrv.Runtime{"sh": {Command: []string{"sh", "{entrypoint}"}}},
+	})
+	require.NoError(t, svc.ExecuteTask(ctx, "tasks/e1"))
+}
+
+func TestService_ExecuteTask_CallsPythonHandler(t *testing.T) {
+	if _, err := exec.LookPath("python3"); err != nil {
+		t.Skip("python3 is not installed")
+	}
+
+	ctx := context.Background()
+	f := newFixture(t)
+
+	task := &taskdomain.Task{ID: uuid.New(), Name: "tasks/h1", Function: "functions/sum", Parameters: `{"a":2,"b":3}`, State: taskdomain.TaskStateProcessing}
+	fn := &funcdomain.Function{Name: "functions/sum", Runtime: "python3.12", Entrypoint: "app/main.py", Handler: "handle"}
+	f.expectRun(ctx, t, task, fn, map[string]string{
+		"app/main.py": "def handle(params):\n    return {\"sum\": params[\"a\"] + params[\"b\"]}\n",
+	})
+	f.expectResult(ctx, task, `{"sum": 5}`, false)
+
+	require.NoError(t, f.serviceWith(t, execsrv.Config{}).ExecuteTask(ctx, "tasks/h1"))
+}
+
+func TestService_ExecuteTask_LimitsMemory(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+
+	task := &taskdomain.Task{ID: uuid.New(), Name: "tasks/m1", Function: "functions/mem", State: taskdomain.TaskStateProcessing}
+	f.expectRun(ctx, t, task, &funcdomain.Function{Name: "functions/mem", MemoryBytes: 64 << 20}, map[string]string{"main.sh": "ulimit -d"})
+	f.expectResult(ctx, task, "65536\n", false)
+
+	require.NoError(t, f.service(t, "sh", "main.sh").ExecuteTask(ctx, "tasks/m1"))
+}
+
+func TestService_ExecuteTask_CapsFunctionTimeout(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+
+	task := &taskdomain.Task{ID: uuid.New(), Name: "tasks/c1", Function: "functions/slow", State: taskdomain.TaskStateProcessing}
+	f.expectRun(ctx, t, task, &funcdomain.Function{Name: "functions/slow", Timeout: time.Hour}, map[string]string{"main.sh": "exec sleep 5"})
+	f.expectResult(ctx, task, "execution timed out after 100ms", true)
+
+	svc := f.serviceWith(t, execsrv.Config{Command: []string{"sh", "main.sh"}, MaxTimeout: 100 * time.Millisecond})
+	require.NoError(t, svc.ExecuteTask(ctx, "tasks/c1"))
+}
+
+func TestService_ExecuteTask_RedactsTextOutputs(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+
+	task := &taskdomain.Task{ID: uuid.New(), Name: "tasks/8", Function: "functions/report", State: taskdomain.TaskStateProcessing}
+	archive := zipBundle(t, map[string]string{
+		"main.sh": `printf 'token=%s\n' "$API_TOKEN" > "$FAAS_OUTPUT_DIR/creds.txt"; printf '\000%s' "$API_TOKEN" > "$FAAS_OUTPUT_DIR/blob.bin"; printf '{"token":"%s"}' "$API_TOKEN"`,
+	})
+	fn := &funcdomain.Function{
+		Name:      "functions/report",
+		Bundle:    &funcdomain.SourceBundle{ObjectKey: "report.zip"},
+		Build:     readyBuild(&funcdomain.SourceBundle{ObjectKey: "artifacts/report.zip", Format: funcdomain.ZipFormat, SHA256: sha256Hex(archive)}),
+		SecretEnv: map[string]string{"API_TOKEN": "secrets/token"},
+		// The raw token is too long; the schema holds for what callers get.
+		OutputSchema: json.RawMessage(`{"type":"object","properties":{"token":{"type":"string","maxLength":10}}}`),
+	}
+
+	f.tasks.EXPECT().StartTask(ctx, mock.Anything).Return(&taskdomain.StartTaskResult{Task: task}, nil).Once()
+	f.meta.EXPECT().GetFunction(ctx, mock.Anything).Return(&funcdomain.GetFunctionResult{Function: fn}, nil).Once()
+	f.objects.EXPECT().OpenBundle(ctx, fn.Build.Artifact).
+		Return(io.NopCloser(bytes.NewReader(archive)), nil).Once()
+	f.secrets.EXPECT().ResolveSecrets(ctx, mock.Anything).
+		Return(&secretdomain.ResolveSecretsResult{Values: map[secretdomain.SecretName][]byte{"secrets/token": []byte("tok-1234567")}}, nil).Once()
+
+	saved := map[string]string{}
+	f.artifacts.EXPECT().SaveTaskArtifact(ctx, mock.Anything).
+		RunAndReturn(func(_ context.Context, args *taskdomain.SaveTaskArtifactArgs) (*taskdomain.SaveTaskArtifactResult, error) {
+			b, err := io.ReadAll(args.Data)
+			require.NoError(t, err)
+			saved[args.Name] = string(b)
+			return &taskdomain.SaveTaskArtifactResult{Artifact: &taskdomain.TaskArtifact{Name: args.Name, Size: uint64(len(b))}}, nil
+		}).Twice()
+	f.tasks.EXPECT().
+		CompleteTask(ctx, mock.MatchedBy(func(a *taskdomain.CompleteTaskArgs) bool {
+			return a.Result.Type == taskdomain.TaskResultInline &&
+				string(a.Result.InlineResult) == `{"token":"[REDACTED]"}`
+		})).
+		Return(&taskdomain.CompleteTaskResult{Task: task}, nil).Once()
+
+	err := f.service(t, "sh", "main.sh").ExecuteTask(ctx, "tasks/8")
+	require.NoError(t, err)
+	require.Equal(t, "token=[REDACTED]\n", saved["creds.txt"])
+	// Binary artifacts are stored as written.
+	require.Equal(t, "\x00tok-1234567", saved["blob.bin"])
+}
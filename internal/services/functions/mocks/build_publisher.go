@@ -0,0 +1,85 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// BuildPublisher is an autogenerated mock type for the BuildPublisher type
+type BuildPublisher struct {
+	mock.Mock
+}
+
+type BuildPublisher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *BuildPublisher) EXPECT() *BuildPublisher_Expecter {
+	return &BuildPublisher_Expecter{mock: &_m.Mock}
+}
+
+// PublishBuild provides a mock function with given fields: ctx, msg
+func (_m *BuildPublisher) PublishBuild(ctx context.Context, msg *funcdomain.BuildFunctionMessage) error {
+	ret := _m.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishBuild")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.BuildFunctionMessage) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BuildPublisher_PublishBuild_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishBuild'
+type BuildPublisher_PublishBuild_Call struct {
+	*mock.Call
+}
+
+// PublishBuild is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg *funcdomain.BuildFunctionMessage
+func (_e *BuildPublisher_Expecter) PublishBuild(ctx interface{}, msg interface{}) *BuildPublisher_PublishBuild_Call {
+	return &BuildPublisher_PublishBuild_Call{Call: _e.mock.On("PublishBuild", ctx, msg)}
+}
+
+func (_c *BuildPublisher_PublishBuild_Call) Run(run func(ctx context.Context, msg *funcdomain.BuildFunctionMessage)) *BuildPublisher_PublishBuild_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.BuildFunctionMessage))
+	})
+	return _c
+}
+
+func (_c *BuildPublisher_PublishBuild_Call) Return(_a0 error) *BuildPublisher_PublishBuild_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BuildPublisher_PublishBuild_Call) RunAndReturn(run func(context.Context, *funcdomain.BuildFunctionMessage) error) *BuildPublisher_PublishBuild_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBuildPublisher creates a new instance of BuildPublisher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBuildPublisher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BuildPublisher {
+	mock := &BuildPublisher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
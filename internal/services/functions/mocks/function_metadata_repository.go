@@ -0,0 +1,1533 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// FunctionMetadataRepository is an autogenerated mock type for the FunctionMetadataRepository type
+type FunctionMetadataRepository struct {
+	mock.Mock
+}
+
+type FunctionMetadataRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FunctionMetadataRepository) EXPECT() *FunctionMetadataRepository_Expecter {
+	return &FunctionMetadataRepository_Expecter{mock: &_m.Mock}
+}
+
+// AddUsage provides a mock function with given fields: ctx, namespace, delta, quota
+func (_m *FunctionMetadataRepository) AddUsage(ctx context.Context, namespace string, delta int64, quota uint64) error {
+	ret := _m.Called(ctx, namespace, delta, quota)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddUsage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, uint64) error); ok {
+		r0 = rf(ctx, namespace, delta, quota)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FunctionMetadataRepository_AddUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddUsage'
+type FunctionMetadataRepository_AddUsage_Call struct {
+	*mock.Call
+}
+
+// AddUsage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespace string
+//   - delta int64
+//   - quota uint64
+func (_e *FunctionMetadataRepository_Expecter) AddUsage(ctx interface{}, namespace interface{}, delta interface{}, quota interface{}) *FunctionMetadataRepository_AddUsage_Call {
+	return &FunctionMetadataRepository_AddUsage_Call{Call: _e.mock.On("AddUsage", ctx, namespace, delta, quota)}
+}
+
+func (_c *FunctionMetadataRepository_AddUsage_Call) Run(run func(ctx context.Context, namespace string, delta int64, quota uint64)) *FunctionMetadataRepository_AddUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(uint64))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_AddUsage_Call) Return(_a0 error) *FunctionMetadataRepository_AddUsage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_AddUsage_Call) RunAndReturn(run func(context.Context, string, int64, uint64) error) *FunctionMetadataRepository_AddUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BeginPurge provides a mock function with given fields: ctx, name, now
+func (_m *FunctionMetadataRepository) BeginPurge(ctx context.Context, name funcdomain.FunctionName, now time.Time) error {
+	ret := _m.Called(ctx, name, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginPurge")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, funcdomain.FunctionName, time.Time) error); ok {
+		r0 = rf(ctx, name, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FunctionMetadataRepository_BeginPurge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginPurge'
+type FunctionMetadataRepository_BeginPurge_Call struct {
+	*mock.Call
+}
+
+// BeginPurge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name funcdomain.FunctionName
+//   - now time.Time
+func (_e *FunctionMetadataRepository_Expecter) BeginPurge(ctx interface{}, name interface{}, now interface{}) *FunctionMetadataRepository_BeginPurge_Call {
+	return &FunctionMetadataRepository_BeginPurge_Call{Call: _e.mock.On("BeginPurge", ctx, name, now)}
+}
+
+func (_c *FunctionMetadataRepository_BeginPurge_Call) Run(run func(ctx context.Context, name funcdomain.FunctionName, now time.Time)) *FunctionMetadataRepository_BeginPurge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(funcdomain.FunctionName), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_BeginPurge_Call) Return(_a0 error) *FunctionMetadataRepository_BeginPurge_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_BeginPurge_Call) RunAndReturn(run func(context.Context, funcdomain.FunctionName, time.Time) error) *FunctionMetadataRepository_BeginPurge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateBlob provides a mock function with given fields: ctx, namespace, bundle
+func (_m *FunctionMetadataRepository) CreateBlob(ctx context.Context, namespace string, bundle *funcdomain.SourceBundle) error {
+	ret := _m.Called(ctx, namespace, bundle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBlob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *funcdomain.SourceBundle) error); ok {
+		r0 = rf(ctx, namespace, bundle)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FunctionMetadataRepository_CreateBlob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBlob'
+type FunctionMetadataRepository_CreateBlob_Call struct {
+	*mock.Call
+}
+
+// CreateBlob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespace string
+//   - bundle *funcdomain.SourceBundle
+func (_e *FunctionMetadataRepository_Expecter) CreateBlob(ctx interface{}, namespace interface{}, bundle interface{}) *FunctionMetadataRepository_CreateBlob_Call {
+	return &FunctionMetadataRepository_CreateBlob_Call{Call: _e.mock.On("CreateBlob", ctx, namespace, bundle)}
+}
+
+func (_c *FunctionMetadataRepository_CreateBlob_Call) Run(run func(ctx context.Context, namespace string, bundle *funcdomain.SourceBundle)) *FunctionMetadataRepository_CreateBlob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*funcdomain.SourceBundle))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_CreateBlob_Call) Return(_a0 error) *FunctionMetadataRepository_CreateBlob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_CreateBlob_Call) RunAndReturn(run func(context.Context, string, *funcdomain.SourceBundle) error) *FunctionMetadataRepository_CreateBlob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRevision provides a mock function with given fields: ctx, fn
+func (_m *FunctionMetadataRepository) CreateRevision(ctx context.Context, fn *funcdomain.Function) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRevision")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.Function) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FunctionMetadataRepository_CreateRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRevision'
+type FunctionMetadataRepository_CreateRevision_Call struct {
+	*mock.Call
+}
+
+// CreateRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn *funcdomain.Function
+func (_e *FunctionMetadataRepository_Expecter) CreateRevision(ctx interface{}, fn interface{}) *FunctionMetadataRepository_CreateRevision_Call {
+	return &FunctionMetadataRepository_CreateRevision_Call{Call: _e.mock.On("CreateRevision", ctx, fn)}
+}
+
+func (_c *FunctionMetadataRepository_CreateRevision_Call) Run(run func(ctx context.Context, fn *funcdomain.Function)) *FunctionMetadataRepository_CreateRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.Function))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_CreateRevision_Call) Return(_a0 error) *FunctionMetadataRepository_CreateRevision_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_CreateRevision_Call) RunAndReturn(run func(context.Context, *funcdomain.Function) error) *FunctionMetadataRepository_CreateRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateUploadSession provides a mock function with given fields: ctx, session
+func (_m *FunctionMetadataRepository) CreateUploadSession(ctx context.Context, session *funcdomain.UploadSession) error {
+	ret := _m.Called(ctx, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUploadSession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.UploadSession) error); ok {
+		r0 = rf(ctx, session)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FunctionMetadataRepository_CreateUploadSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUploadSession'
+type FunctionMetadataRepository_CreateUploadSession_Call struct {
+	*mock.Call
+}
+
+// CreateUploadSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session *funcdomain.UploadSession
+func (_e *FunctionMetadataRepository_Expecter) CreateUploadSession(ctx interface{}, session interface{}) *FunctionMetadataRepository_CreateUploadSession_Call {
+	return &FunctionMetadataRepository_CreateUploadSession_Call{Call: _e.mock.On("CreateUploadSession", ctx, session)}
+}
+
+func (_c *FunctionMetadataRepository_CreateUploadSession_Call) Run(run func(ctx context.Context, session *funcdomain.UploadSession)) *FunctionMetadataRepository_CreateUploadSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.UploadSession))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_CreateUploadSession_Call) Return(_a0 error) *FunctionMetadataRepository_CreateUploadSession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_CreateUploadSession_Call) RunAndReturn(run func(context.Context, *funcdomain.UploadSession) error) *FunctionMetadataRepository_CreateUploadSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAlias provides a mock function with given fields: ctx, args
+func (_m *FunctionMetadataRepository) DeleteAlias(ctx context.Context, args *funcdomain.DeleteAliasArgs) error {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAlias")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.DeleteAliasArgs) error); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FunctionMetadataRepository_DeleteAlias_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAlias'
+type FunctionMetadataRepository_DeleteAlias_Call struct {
+	*mock.Call
+}
+
+// DeleteAlias is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *funcdomain.DeleteAliasArgs
+func (_e *FunctionMetadataRepository_Expecter) DeleteAlias(ctx interface{}, args interface{}) *FunctionMetadataRepository_DeleteAlias_Call {
+	return &FunctionMetadataRepository_DeleteAlias_Call{Call: _e.mock.On("DeleteAlias", ctx, args)}
+}
+
+func (_c *FunctionMetadataRepository_DeleteAlias_Call) Run(run func(ctx context.Context, args *funcdomain.DeleteAliasArgs)) *FunctionMetadataRepository_DeleteAlias_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.DeleteAliasArgs))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_DeleteAlias_Call) Return(_a0 error) *FunctionMetadataRepository_DeleteAlias_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_DeleteAlias_Call) RunAndReturn(run func(context.Context, *funcdomain.DeleteAliasArgs) error) *FunctionMetadataRepository_DeleteAlias_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUploadSession provides a mock function with given fields: ctx, id, etag
+func (_m *FunctionMetadataRepository) DeleteUploadSession(ctx context.Context, id uuid.UUID, etag uint64) error {
+	ret := _m.Called(ctx, id, etag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUploadSession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uint64) error); ok {
+		r0 = rf(ctx, id, etag)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FunctionMetadataRepository_DeleteUploadSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUploadSession'
+type FunctionMetadataRepository_DeleteUploadSession_Call struct {
+	*mock.Call
+}
+
+// DeleteUploadSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - etag uint64
+func (_e *FunctionMetadataRepository_Expecter) DeleteUploadSession(ctx interface{}, id interface{}, etag interface{}) *FunctionMetadataRepository_DeleteUploadSession_Call {
+	return &FunctionMetadataRepository_DeleteUploadSession_Call{Call: _e.mock.On("DeleteUploadSession", ctx, id, etag)}
+}
+
+func (_c *FunctionMetadataRepository_DeleteUploadSession_Call) Run(run func(ctx context.Context, id uuid.UUID, etag uint64)) *FunctionMetadataRepository_DeleteUploadSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_DeleteUploadSession_Call) Return(_a0 error) *FunctionMetadataRepository_DeleteUploadSession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_DeleteUploadSession_Call) RunAndReturn(run func(context.Context, uuid.UUID, uint64) error) *FunctionMetadataRepository_DeleteUploadSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FinishPurge provides a mock function with given fields: ctx, name
+func (_m *FunctionMetadataRepository) FinishPurge(ctx context.Context, name funcdomain.FunctionName) error {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinishPurge")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, funcdomain.FunctionName) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FunctionMetadataRepository_FinishPurge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinishPurge'
+type FunctionMetadataRepository_FinishPurge_Call struct {
+	*mock.Call
+}
+
+// FinishPurge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name funcdomain.FunctionName
+func (_e *FunctionMetadataRepository_Expecter) FinishPurge(ctx interface{}, name interface{}) *FunctionMetadataRepository_FinishPurge_Call {
+	return &FunctionMetadataRepository_FinishPurge_Call{Call: _e.mock.On("FinishPurge", ctx, name)}
+}
+
+func (_c *FunctionMetadataRepository_FinishPurge_Call) Run(run func(ctx context.Context, name funcdomain.FunctionName)) *FunctionMetadataRepository_FinishPurge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(funcdomain.FunctionName))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_FinishPurge_Call) Return(_a0 error) *FunctionMetadataRepository_FinishPurge_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_FinishPurge_Call) RunAndReturn(run func(context.Context, funcdomain.FunctionName) error) *FunctionMetadataRepository_FinishPurge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAlias provides a mock function with given fields: ctx, args
+func (_m *FunctionMetadataRepository) GetAlias(ctx context.Context, args *funcdomain.GetAliasArgs) (*funcdomain.GetAliasResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAlias")
+	}
+
+	var r0 *funcdomain.GetAliasResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.GetAliasArgs) (*funcdomain.GetAliasResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.GetAliasArgs) *funcdomain.GetAliasResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.GetAliasResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.GetAliasArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionMetadataRepository_GetAlias_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAlias'
+type FunctionMetadataRepository_GetAlias_Call struct {
+	*mock.Call
+}
+
+// GetAlias is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *funcdomain.GetAliasArgs
+func (_e *FunctionMetadataRepository_Expecter) GetAlias(ctx interface{}, args interface{}) *FunctionMetadataRepository_GetAlias_Call {
+	return &FunctionMetadataRepository_GetAlias_Call{Call: _e.mock.On("GetAlias", ctx, args)}
+}
+
+func (_c *FunctionMetadataRepository_GetAlias_Call) Run(run func(ctx context.Context, args *funcdomain.GetAliasArgs)) *FunctionMetadataRepository_GetAlias_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.GetAliasArgs))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_GetAlias_Call) Return(_a0 *funcdomain.GetAliasResult, _a1 error) *FunctionMetadataRepository_GetAlias_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_GetAlias_Call) RunAndReturn(run func(context.Context, *funcdomain.GetAliasArgs) (*funcdomain.GetAliasResult, error)) *FunctionMetadataRepository_GetAlias_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFunction provides a mock function with given fields: ctx, args
+func (_m *FunctionMetadataRepository) GetFunction(ctx context.Context, args *funcdomain.GetFunctionArgs) (*funcdomain.GetFunctionResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFunction")
+	}
+
+	var r0 *funcdomain.GetFunctionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.GetFunctionArgs) (*funcdomain.GetFunctionResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.GetFunctionArgs) *funcdomain.GetFunctionResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.GetFunctionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.GetFunctionArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionMetadataRepository_GetFunction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFunction'
+type FunctionMetadataRepository_GetFunction_Call struct {
+	*mock.Call
+}
+
+// GetFunction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *funcdomain.GetFunctionArgs
+func (_e *FunctionMetadataRepository_Expecter) GetFunction(ctx interface{}, args interface{}) *FunctionMetadataRepository_GetFunction_Call {
+	return &FunctionMetadataRepository_GetFunction_Call{Call: _e.mock.On("GetFunction", ctx, args)}
+}
+
+func (_c *FunctionMetadataRepository_GetFunction_Call) Run(run func(ctx context.Context, args *funcdomain.GetFunctionArgs)) *FunctionMetadataRepository_GetFunction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.GetFunctionArgs))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_GetFunction_Call) Return(_a0 *funcdomain.GetFunctionResult, _a1 error) *FunctionMetadataRepository_GetFunction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_GetFunction_Call) RunAndReturn(run func(context.Context, *funcdomain.GetFunctionArgs) (*funcdomain.GetFunctionResult, error)) *FunctionMetadataRepository_GetFunction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUploadSession provides a mock function with given fields: ctx, id
+func (_m *FunctionMetadataRepository) GetUploadSession(ctx context.Context, id uuid.UUID) (*funcdomain.UploadSession, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUploadSession")
+	}
+
+	var r0 *funcdomain.UploadSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*funcdomain.UploadSession, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *funcdomain.UploadSession); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.UploadSession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionMetadataRepository_GetUploadSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUploadSession'
+type FunctionMetadataRepository_GetUploadSession_Call struct {
+	*mock.Call
+}
+
+// GetUploadSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *FunctionMetadataRepository_Expecter) GetUploadSession(ctx interface{}, id interface{}) *FunctionMetadataRepository_GetUploadSession_Call {
+	return &FunctionMetadataRepository_GetUploadSession_Call{Call: _e.mock.On("GetUploadSession", ctx, id)}
+}
+
+func (_c *FunctionMetadataRepository_GetUploadSession_Call) Run(run func(ctx context.Context, id uuid.UUID)) *FunctionMetadataRepository_GetUploadSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_GetUploadSession_Call) Return(_a0 *funcdomain.UploadSession, _a1 error) *FunctionMetadataRepository_GetUploadSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_GetUploadSession_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*funcdomain.UploadSession, error)) *FunctionMetadataRepository_GetUploadSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUsage provides a mock function with given fields: ctx, namespace
+func (_m *FunctionMetadataRepository) GetUsage(ctx context.Context, namespace string) (uint64, error) {
+	ret := _m.Called(ctx, namespace)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsage")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (uint64, error)); ok {
+		return rf(ctx, namespace)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) uint64); ok {
+		r0 = rf(ctx, namespace)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionMetadataRepository_GetUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsage'
+type FunctionMetadataRepository_GetUsage_Call struct {
+	*mock.Call
+}
+
+// GetUsage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespace string
+func (_e *FunctionMetadataRepository_Expecter) GetUsage(ctx interface{}, namespace interface{}) *FunctionMetadataRepository_GetUsage_Call {
+	return &FunctionMetadataRepository_GetUsage_Call{Call: _e.mock.On("GetUsage", ctx, namespace)}
+}
+
+func (_c *FunctionMetadataRepository_GetUsage_Call) Run(run func(ctx context.Context, namespace string)) *FunctionMetadataRepository_GetUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_GetUsage_Call) Return(_a0 uint64, _a1 error) *FunctionMetadataRepository_GetUsage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_GetUsage_Call) RunAndReturn(run func(context.Context, string) (uint64, error)) *FunctionMetadataRepository_GetUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAliases provides a mock function with given fields: ctx, args
+func (_m *FunctionMetadataRepository) ListAliases(ctx context.Context, args *funcdomain.ListAliasesArgs) (*funcdomain.ListAliasesResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAliases")
+	}
+
+	var r0 *funcdomain.ListAliasesResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.ListAliasesArgs) (*funcdomain.ListAliasesResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.ListAliasesArgs) *funcdomain.ListAliasesResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.ListAliasesResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.ListAliasesArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionMetadataRepository_ListAliases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAliases'
+type FunctionMetadataRepository_ListAliases_Call struct {
+	*mock.Call
+}
+
+// ListAliases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *funcdomain.ListAliasesArgs
+func (_e *FunctionMetadataRepository_Expecter) ListAliases(ctx interface{}, args interface{}) *FunctionMetadataRepository_ListAliases_Call {
+	return &FunctionMetadataRepository_ListAliases_Call{Call: _e.mock.On("ListAliases", ctx, args)}
+}
+
+func (_c *FunctionMetadataRepository_ListAliases_Call) Run(run func(ctx context.Context, args *funcdomain.ListAliasesArgs)) *FunctionMetadataRepository_ListAliases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.ListAliasesArgs))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_ListAliases_Call) Return(_a0 *funcdomain.ListAliasesResult, _a1 error) *FunctionMetadataRepository_ListAliases_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_ListAliases_Call) RunAndReturn(run func(context.Context, *funcdomain.ListAliasesArgs) (*funcdomain.ListAliasesResult, error)) *FunctionMetadataRepository_ListAliases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFunctionRevisions provides a mock function with given fields: ctx, args
+func (_m *FunctionMetadataRepository) ListFunctionRevisions(ctx context.Context, args *funcdomain.ListFunctionRevisionsArgs) (*funcdomain.ListFunctionRevisionsResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFunctionRevisions")
+	}
+
+	var r0 *funcdomain.ListFunctionRevisionsResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.ListFunctionRevisionsArgs) (*funcdomain.ListFunctionRevisionsResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.ListFunctionRevisionsArgs) *funcdomain.ListFunctionRevisionsResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.ListFunctionRevisionsResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.ListFunctionRevisionsArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionMetadataRepository_ListFunctionRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFunctionRevisions'
+type FunctionMetadataRepository_ListFunctionRevisions_Call struct {
+	*mock.Call
+}
+
+// ListFunctionRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *funcdomain.ListFunctionRevisionsArgs
+func (_e *FunctionMetadataRepository_Expecter) ListFunctionRevisions(ctx interface{}, args interface{}) *FunctionMetadataRepository_ListFunctionRevisions_Call {
+	return &FunctionMetadataRepository_ListFunctionRevisions_Call{Call: _e.mock.On("ListFunctionRevisions", ctx, args)}
+}
+
+func (_c *FunctionMetadataRepository_ListFunctionRevisions_Call) Run(run func(ctx context.Context, args *funcdomain.ListFunctionRevisionsArgs)) *FunctionMetadataRepository_ListFunctionRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.ListFunctionRevisionsArgs))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_ListFunctionRevisions_Call) Return(_a0 *funcdomain.ListFunctionRevisionsResult, _a1 error) *FunctionMetadataRepository_ListFunctionRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_ListFunctionRevisions_Call) RunAndReturn(run func(context.Context, *funcdomain.ListFunctionRevisionsArgs) (*funcdomain.ListFunctionRevisionsResult, error)) *FunctionMetadataRepository_ListFunctionRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFunctions provides a mock function with given fields: ctx, args
+func (_m *FunctionMetadataRepository) ListFunctions(ctx context.Context, args *funcdomain.ListFunctionsArgs) (*funcdomain.ListFunctionsResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFunctions")
+	}
+
+	var r0 *funcdomain.ListFunctionsResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.ListFunctionsArgs) (*funcdomain.ListFunctionsResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.ListFunctionsArgs) *funcdomain.ListFunctionsResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.ListFunctionsResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.ListFunctionsArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionMetadataRepository_ListFunctions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFunctions'
+type FunctionMetadataRepository_ListFunctions_Call struct {
+	*mock.Call
+}
+
+// ListFunctions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *funcdomain.ListFunctionsArgs
+func (_e *FunctionMetadataRepository_Expecter) ListFunctions(ctx interface{}, args interface{}) *FunctionMetadataRepository_ListFunctions_Call {
+	return &FunctionMetadataRepository_ListFunctions_Call{Call: _e.mock.On("ListFunctions", ctx, args)}
+}
+
+func (_c *FunctionMetadataRepository_ListFunctions_Call) Run(run func(ctx context.Context, args *funcdomain.ListFunctionsArgs)) *FunctionMetadataRepository_ListFunctions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.ListFunctionsArgs))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_ListFunctions_Call) Return(_a0 *funcdomain.ListFunctionsResult, _a1 error) *FunctionMetadataRepository_ListFunctions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_ListFunctions_Call) RunAndReturn(run func(context.Context, *funcdomain.ListFunctionsArgs) (*funcdomain.ListFunctionsResult, error)) *FunctionMetadataRepository_ListFunctions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPurgeableFunctions provides a mock function with given fields: ctx, now
+func (_m *FunctionMetadataRepository) ListPurgeableFunctions(ctx context.Context, now time.Time) ([]funcdomain.FunctionName, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPurgeableFunctions")
+	}
+
+	var r0 []funcdomain.FunctionName
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]funcdomain.FunctionName, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []funcdomain.FunctionName); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]funcdomain.FunctionName)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionMetadataRepository_ListPurgeableFunctions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPurgeableFunctions'
+type FunctionMetadataRepository_ListPurgeableFunctions_Call struct {
+	*mock.Call
+}
+
+// ListPurgeableFunctions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *FunctionMetadataRepository_Expecter) ListPurgeableFunctions(ctx interface{}, now interface{}) *FunctionMetadataRepository_ListPurgeableFunctions_Call {
+	return &FunctionMetadataRepository_ListPurgeableFunctions_Call{Call: _e.mock.On("ListPurgeableFunctions", ctx, now)}
+}
+
+func (_c *FunctionMetadataRepository_ListPurgeableFunctions_Call) Run(run func(ctx context.Context, now time.Time)) *FunctionMetadataRepository_ListPurgeableFunctions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_ListPurgeableFunctions_Call) Return(_a0 []funcdomain.FunctionName, _a1 error) *FunctionMetadataRepository_ListPurgeableFunctions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_ListPurgeableFunctions_Call) RunAndReturn(run func(context.Context, time.Time) ([]funcdomain.FunctionName, error)) *FunctionMetadataRepository_ListPurgeableFunctions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUploadSessions provides a mock function with given fields: ctx
+func (_m *FunctionMetadataRepository) ListUploadSessions(ctx context.Context) ([]*funcdomain.UploadSession, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUploadSessions")
+	}
+
+	var r0 []*funcdomain.UploadSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*funcdomain.UploadSession, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*funcdomain.UploadSession); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*funcdomain.UploadSession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionMetadataRepository_ListUploadSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUploadSessions'
+type FunctionMetadataRepository_ListUploadSessions_Call struct {
+	*mock.Call
+}
+
+// ListUploadSessions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *FunctionMetadataRepository_Expecter) ListUploadSessions(ctx interface{}) *FunctionMetadataRepository_ListUploadSessions_Call {
+	return &FunctionMetadataRepository_ListUploadSessions_Call{Call: _e.mock.On("ListUploadSessions", ctx)}
+}
+
+func (_c *FunctionMetadataRepository_ListUploadSessions_Call) Run(run func(ctx context.Context)) *FunctionMetadataRepository_ListUploadSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_ListUploadSessions_Call) Return(_a0 []*funcdomain.UploadSession, _a1 error) *FunctionMetadataRepository_ListUploadSessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_ListUploadSessions_Call) RunAndReturn(run func(context.Context) ([]*funcdomain.UploadSession, error)) *FunctionMetadataRepository_ListUploadSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NextPurgeItem provides a mock function with given fields: ctx, name
+func (_m *FunctionMetadataRepository) NextPurgeItem(ctx context.Context, name funcdomain.FunctionName) (*funcdomain.PurgeItem, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NextPurgeItem")
+	}
+
+	var r0 *funcdomain.PurgeItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, funcdomain.FunctionName) (*funcdomain.PurgeItem, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, funcdomain.FunctionName) *funcdomain.PurgeItem); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.PurgeItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, funcdomain.FunctionName) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionMetadataRepository_NextPurgeItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NextPurgeItem'
+type FunctionMetadataRepository_NextPurgeItem_Call struct {
+	*mock.Call
+}
+
+// NextPurgeItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name funcdomain.FunctionName
+func (_e *FunctionMetadataRepository_Expecter) NextPurgeItem(ctx interface{}, name interface{}) *FunctionMetadataRepository_NextPurgeItem_Call {
+	return &FunctionMetadataRepository_NextPurgeItem_Call{Call: _e.mock.On("NextPurgeItem", ctx, name)}
+}
+
+func (_c *FunctionMetadataRepository_NextPurgeItem_Call) Run(run func(ctx context.Context, name funcdomain.FunctionName)) *FunctionMetadataRepository_NextPurgeItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(funcdomain.FunctionName))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_NextPurgeItem_Call) Return(_a0 *funcdomain.PurgeItem, _a1 error) *FunctionMetadataRepository_NextPurgeItem_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_NextPurgeItem_Call) RunAndReturn(run func(context.Context, funcdomain.FunctionName) (*funcdomain.PurgeItem, error)) *FunctionMetadataRepository_NextPurgeItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutAlias provides a mock function with given fields: ctx, alias
+func (_m *FunctionMetadataRepository) PutAlias(ctx context.Context, alias *funcdomain.FunctionAlias) error {
+	ret := _m.Called(ctx, alias)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutAlias")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.FunctionAlias) error); ok {
+		r0 = rf(ctx, alias)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FunctionMetadataRepository_PutAlias_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutAlias'
+type FunctionMetadataRepository_PutAlias_Call struct {
+	*mock.Call
+}
+
+// PutAlias is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alias *funcdomain.FunctionAlias
+func (_e *FunctionMetadataRepository_Expecter) PutAlias(ctx interface{}, alias interface{}) *FunctionMetadataRepository_PutAlias_Call {
+	return &FunctionMetadataRepository_PutAlias_Call{Call: _e.mock.On("PutAlias", ctx, alias)}
+}
+
+func (_c *FunctionMetadataRepository_PutAlias_Call) Run(run func(ctx context.Context, alias *funcdomain.FunctionAlias)) *FunctionMetadataRepository_PutAlias_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.FunctionAlias))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_PutAlias_Call) Return(_a0 error) *FunctionMetadataRepository_PutAlias_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_PutAlias_Call) RunAndReturn(run func(context.Context, *funcdomain.FunctionAlias) error) *FunctionMetadataRepository_PutAlias_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefBlob provides a mock function with given fields: ctx, digest, namespace, verified
+func (_m *FunctionMetadataRepository) RefBlob(ctx context.Context, digest string, namespace string, verified bool) (*funcdomain.SourceBundle, bool, error) {
+	ret := _m.Called(ctx, digest, namespace, verified)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefBlob")
+	}
+
+	var r0 *funcdomain.SourceBundle
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool) (*funcdomain.SourceBundle, bool, error)); ok {
+		return rf(ctx, digest, namespace, verified)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool) *funcdomain.SourceBundle); ok {
+		r0 = rf(ctx, digest, namespace, verified)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.SourceBundle)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, bool) bool); ok {
+		r1 = rf(ctx, digest, namespace, verified)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, bool) error); ok {
+		r2 = rf(ctx, digest, namespace, verified)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FunctionMetadataRepository_RefBlob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefBlob'
+type FunctionMetadataRepository_RefBlob_Call struct {
+	*mock.Call
+}
+
+// RefBlob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - digest string
+//   - namespace string
+//   - verified bool
+func (_e *FunctionMetadataRepository_Expecter) RefBlob(ctx interface{}, digest interface{}, namespace interface{}, verified interface{}) *FunctionMetadataRepository_RefBlob_Call {
+	return &FunctionMetadataRepository_RefBlob_Call{Call: _e.mock.On("RefBlob", ctx, digest, namespace, verified)}
+}
+
+func (_c *FunctionMetadataRepository_RefBlob_Call) Run(run func(ctx context.Context, digest string, namespace string, verified bool)) *FunctionMetadataRepository_RefBlob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_RefBlob_Call) Return(_a0 *funcdomain.SourceBundle, _a1 bool, _a2 error) *FunctionMetadataRepository_RefBlob_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_RefBlob_Call) RunAndReturn(run func(context.Context, string, string, bool) (*funcdomain.SourceBundle, bool, error)) *FunctionMetadataRepository_RefBlob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequeuePurgeItem provides a mock function with given fields: ctx, name, item
+func (_m *FunctionMetadataRepository) RequeuePurgeItem(ctx context.Context, name funcdomain.FunctionName, item *funcdomain.PurgeItem) error {
+	ret := _m.Called(ctx, name, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequeuePurgeItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, funcdomain.FunctionName, *funcdomain.PurgeItem) error); ok {
+		r0 = rf(ctx, name, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FunctionMetadataRepository_RequeuePurgeItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequeuePurgeItem'
+type FunctionMetadataRepository_RequeuePurgeItem_Call struct {
+	*mock.Call
+}
+
+// RequeuePurgeItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name funcdomain.FunctionName
+//   - item *funcdomain.PurgeItem
+func (_e *FunctionMetadataRepository_Expecter) RequeuePurgeItem(ctx interface{}, name interface{}, item interface{}) *FunctionMetadataRepository_RequeuePurgeItem_Call {
+	return &FunctionMetadataRepository_RequeuePurgeItem_Call{Call: _e.mock.On("RequeuePurgeItem", ctx, name, item)}
+}
+
+func (_c *FunctionMetadataRepository_RequeuePurgeItem_Call) Run(run func(ctx context.Context, name funcdomain.FunctionName, item *funcdomain.PurgeItem)) *FunctionMetadataRepository_RequeuePurgeItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(funcdomain.FunctionName), args[2].(*funcdomain.PurgeItem))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_RequeuePurgeItem_Call) Return(_a0 error) *FunctionMetadataRepository_RequeuePurgeItem_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_RequeuePurgeItem_Call) RunAndReturn(run func(context.Context, funcdomain.FunctionName, *funcdomain.PurgeItem) error) *FunctionMetadataRepository_RequeuePurgeItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SoftDeleteFunction provides a mock function with given fields: ctx, name, deleteTime, purgeTime
+func (_m *FunctionMetadataRepository) SoftDeleteFunction(ctx context.Context, name funcdomain.FunctionName, deleteTime time.Time, purgeTime time.Time) (*funcdomain.Function, error) {
+	ret := _m.Called(ctx, name, deleteTime, purgeTime)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDeleteFunction")
+	}
+
+	var r0 *funcdomain.Function
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, funcdomain.FunctionName, time.Time, time.Time) (*funcdomain.Function, error)); ok {
+		return rf(ctx, name, deleteTime, purgeTime)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, funcdomain.FunctionName, time.Time, time.Time) *funcdomain.Function); ok {
+		r0 = rf(ctx, name, deleteTime, purgeTime)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.Function)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, funcdomain.FunctionName, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, name, deleteTime, purgeTime)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionMetadataRepository_SoftDeleteFunction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SoftDeleteFunction'
+type FunctionMetadataRepository_SoftDeleteFunction_Call struct {
+	*mock.Call
+}
+
+// SoftDeleteFunction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name funcdomain.FunctionName
+//   - deleteTime time.Time
+//   - purgeTime time.Time
+func (_e *FunctionMetadataRepository_Expecter) SoftDeleteFunction(ctx interface{}, name interface{}, deleteTime interface{}, purgeTime interface{}) *FunctionMetadataRepository_SoftDeleteFunction_Call {
+	return &FunctionMetadataRepository_SoftDeleteFunction_Call{Call: _e.mock.On("SoftDeleteFunction", ctx, name, deleteTime, purgeTime)}
+}
+
+func (_c *FunctionMetadataRepository_SoftDeleteFunction_Call) Run(run func(ctx context.Context, name funcdomain.FunctionName, deleteTime time.Time, purgeTime time.Time)) *FunctionMetadataRepository_SoftDeleteFunction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(funcdomain.FunctionName), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_SoftDeleteFunction_Call) Return(_a0 *funcdomain.Function, _a1 error) *FunctionMetadataRepository_SoftDeleteFunction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_SoftDeleteFunction_Call) RunAndReturn(run func(context.Context, funcdomain.FunctionName, time.Time, time.Time) (*funcdomain.Function, error)) *FunctionMetadataRepository_SoftDeleteFunction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UndeleteFunction provides a mock function with given fields: ctx, name
+func (_m *FunctionMetadataRepository) UndeleteFunction(ctx context.Context, name funcdomain.FunctionName) (*funcdomain.Function, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UndeleteFunction")
+	}
+
+	var r0 *funcdomain.Function
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, funcdomain.FunctionName) (*funcdomain.Function, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, funcdomain.FunctionName) *funcdomain.Function); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.Function)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, funcdomain.FunctionName) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionMetadataRepository_UndeleteFunction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UndeleteFunction'
+type FunctionMetadataRepository_UndeleteFunction_Call struct {
+	*mock.Call
+}
+
+// UndeleteFunction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name funcdomain.FunctionName
+func (_e *FunctionMetadataRepository_Expecter) UndeleteFunction(ctx interface{}, name interface{}) *FunctionMetadataRepository_UndeleteFunction_Call {
+	return &FunctionMetadataRepository_UndeleteFunction_Call{Call: _e.mock.On("UndeleteFunction", ctx, name)}
+}
+
+func (_c *FunctionMetadataRepository_UndeleteFunction_Call) Run(run func(ctx context.Context, name funcdomain.FunctionName)) *FunctionMetadataRepository_UndeleteFunction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(funcdomain.FunctionName))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_UndeleteFunction_Call) Return(_a0 *funcdomain.Function, _a1 error) *FunctionMetadataRepository_UndeleteFunction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_UndeleteFunction_Call) RunAndReturn(run func(context.Context, funcdomain.FunctionName) (*funcdomain.Function, error)) *FunctionMetadataRepository_UndeleteFunction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnrefBlob provides a mock function with given fields: ctx, namespace, bundle
+func (_m *FunctionMetadataRepository) UnrefBlob(ctx context.Context, namespace string, bundle *funcdomain.SourceBundle) (funcdomain.BlobRelease, error) {
+	ret := _m.Called(ctx, namespace, bundle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnrefBlob")
+	}
+
+	var r0 funcdomain.BlobRelease
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *funcdomain.SourceBundle) (funcdomain.BlobRelease, error)); ok {
+		return rf(ctx, namespace, bundle)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *funcdomain.SourceBundle) funcdomain.BlobRelease); ok {
+		r0 = rf(ctx, namespace, bundle)
+	} else {
+		r0 = ret.Get(0).(funcdomain.BlobRelease)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *funcdomain.SourceBundle) error); ok {
+		r1 = rf(ctx, namespace, bundle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionMetadataRepository_UnrefBlob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnrefBlob'
+type FunctionMetadataRepository_UnrefBlob_Call struct {
+	*mock.Call
+}
+
+// UnrefBlob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespace string
+//   - bundle *funcdomain.SourceBundle
+func (_e *FunctionMetadataRepository_Expecter) UnrefBlob(ctx interface{}, namespace interface{}, bundle interface{}) *FunctionMetadataRepository_UnrefBlob_Call {
+	return &FunctionMetadataRepository_UnrefBlob_Call{Call: _e.mock.On("UnrefBlob", ctx, namespace, bundle)}
+}
+
+func (_c *FunctionMetadataRepository_UnrefBlob_Call) Run(run func(ctx context.Context, namespace string, bundle *funcdomain.SourceBundle)) *FunctionMetadataRepository_UnrefBlob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*funcdomain.SourceBundle))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_UnrefBlob_Call) Return(_a0 funcdomain.BlobRelease, _a1 error) *FunctionMetadataRepository_UnrefBlob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_UnrefBlob_Call) RunAndReturn(run func(context.Context, string, *funcdomain.SourceBundle) (funcdomain.BlobRelease, error)) *FunctionMetadataRepository_UnrefBlob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateFunction provides a mock function with given fields: ctx, name, revision, mutate
+func (_m *FunctionMetadataRepository) UpdateFunction(ctx context.Context, name funcdomain.FunctionName, revision uint64, mutate func(*funcdomain.Function) error) (*funcdomain.Function, error) {
+	ret := _m.Called(ctx, name, revision, mutate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFunction")
+	}
+
+	var r0 *funcdomain.Function
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, funcdomain.FunctionName, uint64, func(*funcdomain.Function) error) (*funcdomain.Function, error)); ok {
+		return rf(ctx, name, revision, mutate)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, funcdomain.FunctionName, uint64, func(*funcdomain.Function) error) *funcdomain.Function); ok {
+		r0 = rf(ctx, name, revision, mutate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.Function)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, funcdomain.FunctionName, uint64, func(*funcdomain.Function) error) error); ok {
+		r1 = rf(ctx, name, revision, mutate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionMetadataRepository_UpdateFunction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFunction'
+type FunctionMetadataRepository_UpdateFunction_Call struct {
+	*mock.Call
+}
+
+// UpdateFunction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name funcdomain.FunctionName
+//   - revision uint64
+//   - mutate func(*funcdomain.Function) error
+func (_e *FunctionMetadataRepository_Expecter) UpdateFunction(ctx interface{}, name interface{}, revision interface{}, mutate interface{}) *FunctionMetadataRepository_UpdateFunction_Call {
+	return &FunctionMetadataRepository_UpdateFunction_Call{Call: _e.mock.On("UpdateFunction", ctx, name, revision, mutate)}
+}
+
+func (_c *FunctionMetadataRepository_UpdateFunction_Call) Run(run func(ctx context.Context, name funcdomain.FunctionName, revision uint64, mutate func(*funcdomain.Function) error)) *FunctionMetadataRepository_UpdateFunction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(funcdomain.FunctionName), args[2].(uint64), args[3].(func(*funcdomain.Function) error))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_UpdateFunction_Call) Return(_a0 *funcdomain.Function, _a1 error) *FunctionMetadataRepository_UpdateFunction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_UpdateFunction_Call) RunAndReturn(run func(context.Context, funcdomain.FunctionName, uint64, func(*funcdomain.Function) error) (*funcdomain.Function, error)) *FunctionMetadataRepository_UpdateFunction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLatest provides a mock function with given fields: ctx, name, etag, mutate
+func (_m *FunctionMetadataRepository) UpdateLatest(ctx context.Context, name funcdomain.FunctionName, etag uint64, mutate func(*funcdomain.Function) error) (*funcdomain.Function, error) {
+	ret := _m.Called(ctx, name, etag, mutate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLatest")
+	}
+
+	var r0 *funcdomain.Function
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, funcdomain.FunctionName, uint64, func(*funcdomain.Function) error) (*funcdomain.Function, error)); ok {
+		return rf(ctx, name, etag, mutate)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, funcdomain.FunctionName, uint64, func(*funcdomain.Function) error) *funcdomain.Function); ok {
+		r0 = rf(ctx, name, etag, mutate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.Function)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, funcdomain.FunctionName, uint64, func(*funcdomain.Function) error) error); ok {
+		r1 = rf(ctx, name, etag, mutate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionMetadataRepository_UpdateLatest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLatest'
+type FunctionMetadataRepository_UpdateLatest_Call struct {
+	*mock.Call
+}
+
+// UpdateLatest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name funcdomain.FunctionName
+//   - etag uint64
+//   - mutate func(*funcdomain.Function) error
+func (_e *FunctionMetadataRepository_Expecter) UpdateLatest(ctx interface{}, name interface{}, etag interface{}, mutate interface{}) *FunctionMetadataRepository_UpdateLatest_Call {
+	return &FunctionMetadataRepository_UpdateLatest_Call{Call: _e.mock.On("UpdateLatest", ctx, name, etag, mutate)}
+}
+
+func (_c *FunctionMetadataRepository_UpdateLatest_Call) Run(run func(ctx context.Context, name funcdomain.FunctionName, etag uint64, mutate func(*funcdomain.Function) error)) *FunctionMetadataRepository_UpdateLatest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(funcdomain.FunctionName), args[2].(uint64), args[3].(func(*funcdomain.Function) error))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_UpdateLatest_Call) Return(_a0 *funcdomain.Function, _a1 error) *FunctionMetadataRepository_UpdateLatest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_UpdateLatest_Call) RunAndReturn(run func(context.Context, funcdomain.FunctionName, uint64, func(*funcdomain.Function) error) (*funcdomain.Function, error)) *FunctionMetadataRepository_UpdateLatest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUploadSession provides a mock function with given fields: ctx, id, mutate
+func (_m *FunctionMetadataRepository) UpdateUploadSession(ctx context.Context, id uuid.UUID, mutate func(*funcdomain.UploadSession) error) (*funcdomain.UploadSession, error) {
+	ret := _m.Called(ctx, id, mutate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUploadSession")
+	}
+
+	var r0 *funcdomain.UploadSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, func(*funcdomain.UploadSession) error) (*funcdomain.UploadSession, error)); ok {
+		return rf(ctx, id, mutate)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, func(*funcdomain.UploadSession) error) *funcdomain.UploadSession); ok {
+		r0 = rf(ctx, id, mutate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.UploadSession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, func(*funcdomain.UploadSession) error) error); ok {
+		r1 = rf(ctx, id, mutate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionMetadataRepository_UpdateUploadSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUploadSession'
+type FunctionMetadataRepository_UpdateUploadSession_Call struct {
+	*mock.Call
+}
+
+// UpdateUploadSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - mutate func(*funcdomain.UploadSession) error
+func (_e *FunctionMetadataRepository_Expecter) UpdateUploadSession(ctx interface{}, id interface{}, mutate interface{}) *FunctionMetadataRepository_UpdateUploadSession_Call {
+	return &FunctionMetadataRepository_UpdateUploadSession_Call{Call: _e.mock.On("UpdateUploadSession", ctx, id, mutate)}
+}
+
+func (_c *FunctionMetadataRepository_UpdateUploadSession_Call) Run(run func(ctx context.Context, id uuid.UUID, mutate func(*funcdomain.UploadSession) error)) *FunctionMetadataRepository_UpdateUploadSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(func(*funcdomain.UploadSession) error))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_UpdateUploadSession_Call) Return(_a0 *funcdomain.UploadSession, _a1 error) *FunctionMetadataRepository_UpdateUploadSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionMetadataRepository_UpdateUploadSession_Call) RunAndReturn(run func(context.Context, uuid.UUID, func(*funcdomain.UploadSession) error) (*funcdomain.UploadSession, error)) *FunctionMetadataRepository_UpdateUploadSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFunctionMetadataRepository creates a new instance of FunctionMetadataRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFunctionMetadataRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FunctionMetadataRepository {
+	mock := &FunctionMetadataRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,365 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// FunctionObjectRepository is an autogenerated mock type for the FunctionObjectRepository type
+type FunctionObjectRepository struct {
+	mock.Mock
+}
+
+type FunctionObjectRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FunctionObjectRepository) EXPECT() *FunctionObjectRepository_Expecter {
+	return &FunctionObjectRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteBundle provides a mock function with given fields: ctx, bundle
+func (_m *FunctionObjectRepository) DeleteBundle(ctx context.Context, bundle *funcdomain.SourceBundle) error {
+	ret := _m.Called(ctx, bundle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBundle")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.SourceBundle) error); ok {
+		r0 = rf(ctx, bundle)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FunctionObjectRepository_DeleteBundle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBundle'
+type FunctionObjectRepository_DeleteBundle_Call struct {
+	*mock.Call
+}
+
+// DeleteBundle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bundle *funcdomain.SourceBundle
+func (_e *FunctionObjectRepository_Expecter) DeleteBundle(ctx interface{}, bundle interface{}) *FunctionObjectRepository_DeleteBundle_Call {
+	return &FunctionObjectRepository_DeleteBundle_Call{Call: _e.mock.On("DeleteBundle", ctx, bundle)}
+}
+
+func (_c *FunctionObjectRepository_DeleteBundle_Call) Run(run func(ctx context.Context, bundle *funcdomain.SourceBundle)) *FunctionObjectRepository_DeleteBundle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.SourceBundle))
+	})
+	return _c
+}
+
+func (_c *FunctionObjectRepository_DeleteBundle_Call) Return(_a0 error) *FunctionObjectRepository_DeleteBundle_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FunctionObjectRepository_DeleteBundle_Call) RunAndReturn(run func(context.Context, *funcdomain.SourceBundle) error) *FunctionObjectRepository_DeleteBundle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUploadPart provides a mock function with given fields: ctx, session, part
+func (_m *FunctionObjectRepository) DeleteUploadPart(ctx context.Context, session uuid.UUID, part funcdomain.UploadPart) error {
+	ret := _m.Called(ctx, session, part)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUploadPart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, funcdomain.UploadPart) error); ok {
+		r0 = rf(ctx, session, part)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FunctionObjectRepository_DeleteUploadPart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUploadPart'
+type FunctionObjectRepository_DeleteUploadPart_Call struct {
+	*mock.Call
+}
+
+// DeleteUploadPart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session uuid.UUID
+//   - part funcdomain.UploadPart
+func (_e *FunctionObjectRepository_Expecter) DeleteUploadPart(ctx interface{}, session interface{}, part interface{}) *FunctionObjectRepository_DeleteUploadPart_Call {
+	return &FunctionObjectRepository_DeleteUploadPart_Call{Call: _e.mock.On("DeleteUploadPart", ctx, session, part)}
+}
+
+func (_c *FunctionObjectRepository_DeleteUploadPart_Call) Run(run func(ctx context.Context, session uuid.UUID, part funcdomain.UploadPart)) *FunctionObjectRepository_DeleteUploadPart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(funcdomain.UploadPart))
+	})
+	return _c
+}
+
+func (_c *FunctionObjectRepository_DeleteUploadPart_Call) Return(_a0 error) *FunctionObjectRepository_DeleteUploadPart_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FunctionObjectRepository_DeleteUploadPart_Call) RunAndReturn(run func(context.Context, uuid.UUID, funcdomain.UploadPart) error) *FunctionObjectRepository_DeleteUploadPart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenBundle provides a mock function with given fields: ctx, bundle
+func (_m *FunctionObjectRepository) OpenBundle(ctx context.Context, bundle *funcdomain.SourceBundle) (io.ReadCloser, error) {
+	ret := _m.Called(ctx, bundle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenBundle")
+	}
+
+	var r0 io.ReadCloser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.SourceBundle) (io.ReadCloser, error)); ok {
+		return rf(ctx, bundle)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.SourceBundle) io.ReadCloser); ok {
+		r0 = rf(ctx, bundle)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.SourceBundle) error); ok {
+		r1 = rf(ctx, bundle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionObjectRepository_OpenBundle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenBundle'
+type FunctionObjectRepository_OpenBundle_Call struct {
+	*mock.Call
+}
+
+// OpenBundle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bundle *funcdomain.SourceBundle
+func (_e *FunctionObjectRepository_Expecter) OpenBundle(ctx interface{}, bundle interface{}) *FunctionObjectRepository_OpenBundle_Call {
+	return &FunctionObjectRepository_OpenBundle_Call{Call: _e.mock.On("OpenBundle", ctx, bundle)}
+}
+
+func (_c *FunctionObjectRepository_OpenBundle_Call) Run(run func(ctx context.Context, bundle *funcdomain.SourceBundle)) *FunctionObjectRepository_OpenBundle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.SourceBundle))
+	})
+	return _c
+}
+
+func (_c *FunctionObjectRepository_OpenBundle_Call) Return(_a0 io.ReadCloser, _a1 error) *FunctionObjectRepository_OpenBundle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionObjectRepository_OpenBundle_Call) RunAndReturn(run func(context.Context, *funcdomain.SourceBundle) (io.ReadCloser, error)) *FunctionObjectRepository_OpenBundle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenUploadPart provides a mock function with given fields: ctx, session, part
+func (_m *FunctionObjectRepository) OpenUploadPart(ctx context.Context, session uuid.UUID, part funcdomain.UploadPart) (io.ReadCloser, error) {
+	ret := _m.Called(ctx, session, part)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenUploadPart")
+	}
+
+	var r0 io.ReadCloser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, funcdomain.UploadPart) (io.ReadCloser, error)); ok {
+		return rf(ctx, session, part)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, funcdomain.UploadPart) io.ReadCloser); ok {
+		r0 = rf(ctx, session, part)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, funcdomain.UploadPart) error); ok {
+		r1 = rf(ctx, session, part)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionObjectRepository_OpenUploadPart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenUploadPart'
+type FunctionObjectRepository_OpenUploadPart_Call struct {
+	*mock.Call
+}
+
+// OpenUploadPart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session uuid.UUID
+//   - part funcdomain.UploadPart
+func (_e *FunctionObjectRepository_Expecter) OpenUploadPart(ctx interface{}, session interface{}, part interface{}) *FunctionObjectRepository_OpenUploadPart_Call {
+	return &FunctionObjectRepository_OpenUploadPart_Call{Call: _e.mock.On("OpenUploadPart", ctx, session, part)}
+}
+
+func (_c *FunctionObjectRepository_OpenUploadPart_Call) Run(run func(ctx context.Context, session uuid.UUID, part funcdomain.UploadPart)) *FunctionObjectRepository_OpenUploadPart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(funcdomain.UploadPart))
+	})
+	return _c
+}
+
+func (_c *FunctionObjectRepository_OpenUploadPart_Call) Return(_a0 io.ReadCloser, _a1 error) *FunctionObjectRepository_OpenUploadPart_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionObjectRepository_OpenUploadPart_Call) RunAndReturn(run func(context.Context, uuid.UUID, funcdomain.UploadPart) (io.ReadCloser, error)) *FunctionObjectRepository_OpenUploadPart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveBundle provides a mock function with given fields: ctx, digest, data
+func (_m *FunctionObjectRepository) SaveBundle(ctx context.Context, digest string, data io.ReadCloser) (*funcdomain.SourceBundle, error) {
+	ret := _m.Called(ctx, digest, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveBundle")
+	}
+
+	var r0 *funcdomain.SourceBundle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.ReadCloser) (*funcdomain.SourceBundle, error)); ok {
+		return rf(ctx, digest, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.ReadCloser) *funcdomain.SourceBundle); ok {
+		r0 = rf(ctx, digest, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.SourceBundle)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, io.ReadCloser) error); ok {
+		r1 = rf(ctx, digest, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionObjectRepository_SaveBundle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveBundle'
+type FunctionObjectRepository_SaveBundle_Call struct {
+	*mock.Call
+}
+
+// SaveBundle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - digest string
+//   - data io.ReadCloser
+func (_e *FunctionObjectRepository_Expecter) SaveBundle(ctx interface{}, digest interface{}, data interface{}) *FunctionObjectRepository_SaveBundle_Call {
+	return &FunctionObjectRepository_SaveBundle_Call{Call: _e.mock.On("SaveBundle", ctx, digest, data)}
+}
+
+func (_c *FunctionObjectRepository_SaveBundle_Call) Run(run func(ctx context.Context, digest string, data io.ReadCloser)) *FunctionObjectRepository_SaveBundle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(io.ReadCloser))
+	})
+	return _c
+}
+
+func (_c *FunctionObjectRepository_SaveBundle_Call) Return(_a0 *funcdomain.SourceBundle, _a1 error) *FunctionObjectRepository_SaveBundle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionObjectRepository_SaveBundle_Call) RunAndReturn(run func(context.Context, string, io.ReadCloser) (*funcdomain.SourceBundle, error)) *FunctionObjectRepository_SaveBundle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveUploadPart provides a mock function with given fields: ctx, session, part, data
+func (_m *FunctionObjectRepository) SaveUploadPart(ctx context.Context, session uuid.UUID, part funcdomain.UploadPart, data io.Reader) error {
+	ret := _m.Called(ctx, session, part, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveUploadPart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, funcdomain.UploadPart, io.Reader) error); ok {
+		r0 = rf(ctx, session, part, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FunctionObjectRepository_SaveUploadPart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveUploadPart'
+type FunctionObjectRepository_SaveUploadPart_Call struct {
+	*mock.Call
+}
+
+// SaveUploadPart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session uuid.UUID
+//   - part funcdomain.UploadPart
+//   - data io.Reader
+func (_e *FunctionObjectRepository_Expecter) SaveUploadPart(ctx interface{}, session interface{}, part interface{}, data interface{}) *FunctionObjectRepository_SaveUploadPart_Call {
+	return &FunctionObjectRepository_SaveUploadPart_Call{Call: _e.mock.On("SaveUploadPart", ctx, session, part, data)}
+}
+
+func (_c *FunctionObjectRepository_SaveUploadPart_Call) Run(run func(ctx context.Context, session uuid.UUID, part funcdomain.UploadPart, data io.Reader)) *FunctionObjectRepository_SaveUploadPart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(funcdomain.UploadPart), args[3].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *FunctionObjectRepository_SaveUploadPart_Call) Return(_a0 error) *FunctionObjectRepository_SaveUploadPart_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FunctionObjectRepository_SaveUploadPart_Call) RunAndReturn(run func(context.Context, uuid.UUID, funcdomain.UploadPart, io.Reader) error) *FunctionObjectRepository_SaveUploadPart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFunctionObjectRepository creates a new instance of FunctionObjectRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFunctionObjectRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FunctionObjectRepository {
+	mock := &FunctionObjectRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
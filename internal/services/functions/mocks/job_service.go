@@ -0,0 +1,156 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	jobdomain "github.com/10Narratives/faas/internal/domains/jobs"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// JobService is an autogenerated mock type for the JobService type
+type JobService struct {
+	mock.Mock
+}
+
+type JobService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *JobService) EXPECT() *JobService_Expecter {
+	return &JobService_Expecter{mock: &_m.Mock}
+}
+
+// AddJobTasks provides a mock function with given fields: ctx, args
+func (_m *JobService) AddJobTasks(ctx context.Context, args *jobdomain.AddJobTasksArgs) (*jobdomain.AddJobTasksResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddJobTasks")
+	}
+
+	var r0 *jobdomain.AddJobTasksResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *jobdomain.AddJobTasksArgs) (*jobdomain.AddJobTasksResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *jobdomain.AddJobTasksArgs) *jobdomain.AddJobTasksResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*jobdomain.AddJobTasksResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *jobdomain.AddJobTasksArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobService_AddJobTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddJobTasks'
+type JobService_AddJobTasks_Call struct {
+	*mock.Call
+}
+
+// AddJobTasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *jobdomain.AddJobTasksArgs
+func (_e *JobService_Expecter) AddJobTasks(ctx interface{}, args interface{}) *JobService_AddJobTasks_Call {
+	return &JobService_AddJobTasks_Call{Call: _e.mock.On("AddJobTasks", ctx, args)}
+}
+
+func (_c *JobService_AddJobTasks_Call) Run(run func(ctx context.Context, args *jobdomain.AddJobTasksArgs)) *JobService_AddJobTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*jobdomain.AddJobTasksArgs))
+	})
+	return _c
+}
+
+func (_c *JobService_AddJobTasks_Call) Return(_a0 *jobdomain.AddJobTasksResult, _a1 error) *JobService_AddJobTasks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobService_AddJobTasks_Call) RunAndReturn(run func(context.Context, *jobdomain.AddJobTasksArgs) (*jobdomain.AddJobTasksResult, error)) *JobService_AddJobTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateJob provides a mock function with given fields: ctx, args
+func (_m *JobService) CreateJob(ctx context.Context, args *jobdomain.CreateJobArgs) (*jobdomain.CreateJobResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJob")
+	}
+
+	var r0 *jobdomain.CreateJobResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *jobdomain.CreateJobArgs) (*jobdomain.CreateJobResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *jobdomain.CreateJobArgs) *jobdomain.CreateJobResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*jobdomain.CreateJobResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *jobdomain.CreateJobArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobService_CreateJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJob'
+type JobService_CreateJob_Call struct {
+	*mock.Call
+}
+
+// CreateJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *jobdomain.CreateJobArgs
+func (_e *JobService_Expecter) CreateJob(ctx interface{}, args interface{}) *JobService_CreateJob_Call {
+	return &JobService_CreateJob_Call{Call: _e.mock.On("CreateJob", ctx, args)}
+}
+
+func (_c *JobService_CreateJob_Call) Run(run func(ctx context.Context, args *jobdomain.CreateJobArgs)) *JobService_CreateJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*jobdomain.CreateJobArgs))
+	})
+	return _c
+}
+
+func (_c *JobService_CreateJob_Call) Return(_a0 *jobdomain.CreateJobResult, _a1 error) *JobService_CreateJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobService_CreateJob_Call) RunAndReturn(run func(context.Context, *jobdomain.CreateJobArgs) (*jobdomain.CreateJobResult, error)) *JobService_CreateJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewJobService creates a new instance of JobService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJobService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JobService {
+	mock := &JobService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	secretdomain "github.com/10Narratives/faas/internal/domains/secrets"
+)
+
+// SecretService is an autogenerated mock type for the SecretService type
+type SecretService struct {
+	mock.Mock
+}
+
+type SecretService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SecretService) EXPECT() *SecretService_Expecter {
+	return &SecretService_Expecter{mock: &_m.Mock}
+}
+
+// GetSecret provides a mock function with given fields: ctx, args
+func (_m *SecretService) GetSecret(ctx context.Context, args *secretdomain.GetSecretArgs) (*secretdomain.GetSecretResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSecret")
+	}
+
+	var r0 *secretdomain.GetSecretResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretdomain.GetSecretArgs) (*secretdomain.GetSecretResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secretdomain.GetSecretArgs) *secretdomain.GetSecretResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretdomain.GetSecretResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secretdomain.GetSecretArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretService_GetSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSecret'
+type SecretService_GetSecret_Call struct {
+	*mock.Call
+}
+
+// GetSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *secretdomain.GetSecretArgs
+func (_e *SecretService_Expecter) GetSecret(ctx interface{}, args interface{}) *SecretService_GetSecret_Call {
+	return &SecretService_GetSecret_Call{Call: _e.mock.On("GetSecret", ctx, args)}
+}
+
+func (_c *SecretService_GetSecret_Call) Run(run func(ctx context.Context, args *secretdomain.GetSecretArgs)) *SecretService_GetSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*secretdomain.GetSecretArgs))
+	})
+	return _c
+}
+
+func (_c *SecretService_GetSecret_Call) Return(_a0 *secretdomain.GetSecretResult, _a1 error) *SecretService_GetSecret_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretService_GetSecret_Call) RunAndReturn(run func(context.Context, *secretdomain.GetSecretArgs) (*secretdomain.GetSecretResult, error)) *SecretService_GetSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSecretService creates a new instance of SecretService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSecretService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SecretService {
+	mock := &SecretService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
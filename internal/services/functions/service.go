@@ -2,96 +2,349 @@ package funcsrv
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"maps"
+	"math/rand/v2"
+	"slices"
 	"time"
 
 	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	jobdomain "github.com/10Narratives/faas/internal/domains/jobs"
+	secretdomain "github.com/10Narratives/faas/internal/domains/secrets"
 	taskdomain "github.com/10Narratives/faas/internal/domains/tasks"
+	archiveutils "github.com/10Narratives/faas/pkg/archive"
+	digestutils "github.com/10Narratives/faas/pkg/digest"
+	labelutils "github.com/10Narratives/faas/pkg/labels"
 	"github.com/google/uuid"
 )
 
+//go:generate mockery --name FunctionMetadataRepository --output ./mocks --outpkg mocks --with-expecter --filename function_metadata_repository.go
 type FunctionMetadataRepository interface {
-	CreateFunction(ctx context.Context, fn *funcdomain.Function) error
+	CreateRevision(ctx context.Context, fn *funcdomain.Function) error
+	UpdateFunction(ctx context.Context, name funcdomain.FunctionName, revision uint64, mutate func(fn *funcdomain.Function) error) (*funcdomain.Function, error)
+	UpdateLatest(ctx context.Context, name funcdomain.FunctionName, etag uint64, mutate func(fn *funcdomain.Function) error) (*funcdomain.Function, error)
 	funcdomain.FunctionGetter
-	funcdomain.FunctionDeleter
+	SoftDeleteFunction(ctx context.Context, name funcdomain.FunctionName, deleteTime, purgeTime time.Time) (*funcdomain.Function, error)
+	UndeleteFunction(ctx context.Context, name funcdomain.FunctionName) (*funcdomain.Function, error)
+	BeginPurge(ctx context.Context, name funcdomain.FunctionName, now time.Time) error
+	NextPurgeItem(ctx context.Context, name funcdomain.FunctionName) (*funcdomain.PurgeItem, error)
+	RequeuePurgeItem(ctx context.Context, name funcdomain.FunctionName, item *funcdomain.PurgeItem) error
+	FinishPurge(ctx context.Context, name funcdomain.FunctionName) error
+	ListPurgeableFunctions(ctx context.Context, now time.Time) ([]funcdomain.FunctionName, error)
 	funcdomain.FunctionLister
+	funcdomain.FunctionRevisionLister
+	PutAlias(ctx context.Context, alias *funcdomain.FunctionAlias) error
+	funcdomain.AliasGetter
+	funcdomain.AliasLister
+	funcdomain.AliasDeleter
+	GetUsage(ctx context.Context, namespace string) (uint64, error)
+	AddUsage(ctx context.Context, namespace string, delta int64, quota uint64) error
+	CreateUploadSession(ctx context.Context, session *funcdomain.UploadSession) error
+	GetUploadSession(ctx context.Context, id uuid.UUID) (*funcdomain.UploadSession, error)
+	UpdateUploadSession(ctx context.Context, id uuid.UUID, mutate func(session *funcdomain.UploadSession) error) (*funcdomain.UploadSession, error)
+	DeleteUploadSession(ctx context.Context, id uuid.UUID, etag uint64) error
+	ListUploadSessions(ctx context.Context) ([]*funcdomain.UploadSession, error)
+	CreateBlob(ctx context.Context, namespace string, bundle *funcdomain.SourceBundle) error
+	RefBlob(ctx context.Context, digest, namespace string, verified bool) (*funcdomain.SourceBundle, bool, error)
+	UnrefBlob(ctx context.Context, namespace string, bundle *funcdomain.SourceBundle) (funcdomain.BlobRelease, error)
 }
 
+//go:generate mockery --name FunctionObjectRepository --output ./mocks --outpkg mocks --with-expecter --filename function_object_repository.go
 type FunctionObjectRepository interface {
-	SaveBundle(ctx context.Context, name funcdomain.FunctionName, format funcdomain.UploadFunctionFormat, data io.ReadCloser) (*funcdomain.SourceBundle, error)
+	SaveBundle(ctx context.Context, digest string, data io.ReadCloser) (*funcdomain.SourceBundle, error)
 	OpenBundle(ctx context.Context, bundle *funcdomain.SourceBundle) (io.ReadCloser, error)
 	DeleteBundle(ctx context.Context, bundle *funcdomain.SourceBundle) error
+	SaveUploadPart(ctx context.Context, session uuid.UUID, part funcdomain.UploadPart, data io.Reader) error
+	OpenUploadPart(ctx context.Context, session uuid.UUID, part funcdomain.UploadPart) (io.ReadCloser, error)
+	DeleteUploadPart(ctx context.Context, session uuid.UUID, part funcdomain.UploadPart) error
 }
 
+//go:generate mockery --name TaskService --output ./mocks --outpkg mocks --with-expecter --filename task_service.go
 type TaskService interface {
 	taskdomain.TaskCreator
+	taskdomain.TaskLister
+	taskdomain.TaskCanceler
+	taskdomain.TaskWaiter
+}
+
+//go:generate mockery --name JobService --output ./mocks --outpkg mocks --with-expecter --filename job_service.go
+type JobService interface {
+	jobdomain.JobCreator
+	jobdomain.JobTaskAdder
+}
+
+//go:generate mockery --name BuildPublisher --output ./mocks --outpkg mocks --with-expecter --filename build_publisher.go
+type BuildPublisher interface {
+	funcdomain.BuildPublisher
+}
+
+//go:generate mockery --name SecretService --output ./mocks --outpkg mocks --with-expecter --filename secret_service.go
+type SecretService interface {
+	secretdomain.SecretGetter
+}
+
+type Config struct {
+	// MaxBundleSize bounds a single uploaded bundle; 0 means unlimited.
+	MaxBundleSize uint64
+	// NamespaceQuota bounds the bundle bytes stored per namespace, each
+	// distinct bundle counted once; 0 means unlimited.
+	NamespaceQuota uint64
+	// UploadSessionTTL is how long an upload session survives without new
+	// chunks before it is garbage-collected.
+	UploadSessionTTL time.Duration
+	// FinalizeTimeout bounds a FinalizeUpload; a session left finalizing
+	// for longer can be finalized again.
+	FinalizeTimeout time.Duration
+	// DeleteRetention is how long a deleted function can be undeleted
+	// before it is purged; with 0 it is purged by the next
+	// PurgeDeletedFunctions.
+	DeleteRetention time.Duration
+	// MaxInvokeWait caps how long InvokeFunction waits for a task, whatever
+	// the caller asks for.
+	MaxInvokeWait time.Duration
 }
 
 type Service struct {
+	cfg          Config
 	funcMetaRepo FunctionMetadataRepository
 	funcObjRepo  FunctionObjectRepository
 	taskService  TaskService
+	jobService   JobService
+	buildPub     BuildPublisher
+	secrets      SecretService
 }
 
 func NewService(
+	cfg Config,
 	funcMetaRepo FunctionMetadataRepository,
 	funcObjRepo FunctionObjectRepository,
 	taskService TaskService,
+	jobService JobService,
+	buildPub BuildPublisher,
+	secrets SecretService,
 ) *Service {
+	if cfg.UploadSessionTTL <= 0 {
+		cfg.UploadSessionTTL = 24 * time.Hour
+	}
+	if cfg.FinalizeTimeout <= 0 {
+		cfg.FinalizeTimeout = 15 * time.Minute
+	}
+	if cfg.MaxInvokeWait <= 0 {
+		cfg.MaxInvokeWait = 5 * time.Minute
+	}
+
 	return &Service{
+		cfg:          cfg,
 		funcMetaRepo: funcMetaRepo,
 		funcObjRepo:  funcObjRepo,
 		taskService:  taskService,
+		jobService:   jobService,
+		buildPub:     buildPub,
+		secrets:      secrets,
 	}
 }
 
+// DeleteFunction soft-deletes the function. It stays restorable with
+// UndeleteFunction until the retention window ends and PurgeDeletedFunctions
+// removes it together with its bundles. A function with pending or
+// processing tasks is only deleted with Force, which cancels them.
 func (s *Service) DeleteFunction(ctx context.Context, args *funcdomain.DeleteFunctionArgs) error {
-	if args == nil {
+	if args == nil || args.Name == "" {
 		return funcdomain.ErrInvalidArgument
 	}
 
-	got, err := s.funcMetaRepo.GetFunction(ctx, &funcdomain.GetFunctionArgs{Name: args.Name})
+	active, err := s.activeTasks(ctx, args.Name)
 	if err != nil {
 		return err
 	}
+	if len(active) > 0 && !args.Force {
+		return fmt.Errorf("%w: %d tasks, e.g. %s", funcdomain.ErrFunctionHasTasks, len(active), active[0].Name)
+	}
 
-	if err := s.funcMetaRepo.DeleteFunction(ctx, args); err != nil {
+	now := time.Now().UTC()
+	if _, err := s.funcMetaRepo.SoftDeleteFunction(ctx, args.Name, now, now.Add(s.cfg.DeleteRetention)); err != nil {
 		return err
 	}
+	if !args.Force {
+		return nil
+	}
 
-	if err := s.funcObjRepo.DeleteBundle(ctx, got.Function.Bundle); err != nil {
+	// Listed again: tasks may have been created before the delete landed.
+	active, err = s.activeTasks(ctx, args.Name)
+	if err != nil {
 		return err
 	}
-
+	for _, t := range active {
+		_, err := s.taskService.CancelTask(ctx, &taskdomain.CancelTaskArgs{Name: string(t.Name)})
+		switch {
+		case err == nil,
+			errors.Is(err, taskdomain.ErrNotFound),
+			errors.Is(err, taskdomain.ErrCannotCancelTask),
+			errors.Is(err, taskdomain.ErrTaskAlreadyCompleted):
+			// Finished or removed meanwhile.
+		default:
+			return err
+		}
+	}
 	return nil
 }
 
-func (s *Service) ExecuteFunction(ctx context.Context, args *funcdomain.ExecuteFunctionArgs) (*funcdomain.ExecuteFunctionResult, error) {
-	if args == nil {
-		return nil, funcdomain.ErrInvalidArgument
+// activeTasks returns the pending and processing tasks of a function.
+func (s *Service) activeTasks(ctx context.Context, name funcdomain.FunctionName) ([]*taskdomain.Task, error) {
+	filter, err := taskdomain.ParseTaskFilter(fmt.Sprintf(
+		`function = %q AND state != "succeeded" AND state != "failed" AND state != "canceled"`, name,
+	))
+	if err != nil {
+		return nil, err
 	}
-	if args.Name == "" {
+
+	var (
+		out   []*taskdomain.Task
+		token string
+	)
+	for {
+		res, err := s.taskService.ListTasks(ctx, &taskdomain.ListTasksArgs{
+			PageSize:  1000,
+			PageToken: token,
+			Filter:    filter,
+		})
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, res.Tasks...)
+		if res.NextPageToken == "" {
+			return out, nil
+		}
+		token = res.NextPageToken
+	}
+}
+
+// UndeleteFunction restores a soft-deleted function that has not been
+// purged yet.
+func (s *Service) UndeleteFunction(ctx context.Context, args *funcdomain.UndeleteFunctionArgs) (*funcdomain.UndeleteFunctionResult, error) {
+	if args == nil || args.Name == "" {
 		return nil, funcdomain.ErrInvalidArgument
 	}
 
-	if len(args.Parameters) != 0 {
-		var tmp any
-		if err := json.Unmarshal([]byte(args.Parameters), &tmp); err != nil {
-			return nil, funcdomain.ErrInvalidArgument
+	fn, err := s.funcMetaRepo.UndeleteFunction(ctx, args.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &funcdomain.UndeleteFunctionResult{Function: fn}, nil
+}
+
+// PurgeDeletedFunctions permanently removes the functions whose retention
+// window ended by now and returns how many were purged. Purges interrupted
+// earlier are resumed, so calling it periodically eventually releases
+// everything a deleted function referenced.
+func (s *Service) PurgeDeletedFunctions(ctx context.Context, now time.Time) (int, error) {
+	names, err := s.funcMetaRepo.ListPurgeableFunctions(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		purged int
+		errs   []error
+	)
+	for _, name := range names {
+		err := s.purgeFunction(ctx, name, now)
+		switch {
+		case err == nil:
+			purged++
+		case errors.Is(err, funcdomain.ErrFunctionNotDeleted), errors.Is(err, funcdomain.ErrFunctionNotFound):
+			// Undeleted or purged concurrently.
+		default:
+			errs = append(errs, fmt.Errorf("purge %s: %w", name, err))
 		}
 	}
+	return purged, errors.Join(errs...)
+}
+
+// purgeFunction removes the records of a soft-deleted function, leaving a
+// tombstone that lists its bundles and build artifacts, and releases them
+// one by one. Each step can be retried: an interrupted purge continues from
+// the tombstone.
+func (s *Service) purgeFunction(ctx context.Context, name funcdomain.FunctionName, now time.Time) error {
+	if err := s.funcMetaRepo.BeginPurge(ctx, name, now); err != nil {
+		return err
+	}
 
-	got, err := s.funcMetaRepo.GetFunction(ctx, &funcdomain.GetFunctionArgs{Name: args.Name})
+	ns := name.Namespace()
+	for {
+		item, err := s.funcMetaRepo.NextPurgeItem(ctx, name)
+		if err != nil {
+			return err
+		}
+		if item == nil {
+			break
+		}
+
+		credit, err := s.releasePurgeItem(ctx, ns, item)
+		if err != nil {
+			if rerr := s.funcMetaRepo.RequeuePurgeItem(ctx, name, item); rerr != nil {
+				return errors.Join(err, rerr)
+			}
+			return err
+		}
+		if credit == 0 {
+			continue
+		}
+		if err := s.funcMetaRepo.AddUsage(ctx, ns, -int64(credit), 0); err != nil {
+			// The bundle is released already; only the usage goes back,
+			// so a retry returns it without releasing the bundle twice.
+			if rerr := s.funcMetaRepo.RequeuePurgeItem(ctx, name, &funcdomain.PurgeItem{Credit: credit}); rerr != nil {
+				return errors.Join(err, rerr)
+			}
+			return err
+		}
+	}
+
+	return s.funcMetaRepo.FinishPurge(ctx, name)
+}
+
+// releasePurgeItem drops a bundle reference or deletes a build artifact,
+// and returns the usage to credit to the namespace; credit-only items have
+// nothing to release. Retrying after a failure is safe: only deleting the
+// object can fail once the reference is dropped, and then it was the last
+// one, so the retry finds the bundle unreferenced.
+func (s *Service) releasePurgeItem(ctx context.Context, namespace string, item *funcdomain.PurgeItem) (uint64, error) {
+	switch {
+	case item.Bundle == nil:
+		return item.Credit, nil
+	case item.Artifact:
+		return 0, s.funcObjRepo.DeleteBundle(ctx, item.Bundle)
+	default:
+		return s.releaseBundle(ctx, namespace, item.Bundle)
+	}
+}
+
+func (s *Service) ExecuteFunction(ctx context.Context, args *funcdomain.ExecuteFunctionArgs) (*funcdomain.ExecuteFunctionResult, error) {
+	if args == nil {
+		return nil, funcdomain.ErrInvalidArgument
+	}
+
+	fn, labels, err := s.resolveExecution(ctx, args)
 	if err != nil {
 		return nil, err
 	}
-	if got == nil || got.Function == nil {
-		return nil, funcdomain.ErrFunctionNotFound
+	// Rejected here, bad input never takes an agent slot.
+	if err := fn.ValidateParameters(string(args.Parameters)); err != nil {
+		return nil, err
 	}
 
-	res, err := s.taskService.CreateTask(ctx, &taskdomain.CreateTaskArgs{Function: string(args.Name), Parameters: string(args.Parameters)})
+	// The task is pinned to the resolved revision so later uploads do not
+	// change what it runs.
+	res, err := s.taskService.CreateTask(ctx, &taskdomain.CreateTaskArgs{
+		Function:         string(args.Name),
+		FunctionRevision: fn.Revision,
+		Parameters:       string(args.Parameters),
+		Labels:           labels,
+		Annotations:      args.Annotations,
+		InputFiles:       args.Inputs,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -101,6 +354,237 @@ func (s *Service) ExecuteFunction(ctx context.Context, args *funcdomain.ExecuteF
 	}, nil
 }
 
+// BatchExecuteFunction resolves the revision once and creates a task per
+// item under a new job. Items failing validation are reported and skipped;
+// the batch only stops early when ctx ends, Report fails or the job is
+// canceled.
+func (s *Service) BatchExecuteFunction(ctx context.Context, args *funcdomain.BatchExecuteFunctionArgs) (_ *funcdomain.BatchExecuteFunctionResult, err error) {
+	if args == nil || args.Items == nil || args.Report == nil {
+		return nil, funcdomain.ErrInvalidArgument
+	}
+	if args.FailureThreshold < 0 || args.FailureThreshold > 1 {
+		return nil, jobdomain.ErrInvalidThreshold
+	}
+
+	fn, labels, err := s.resolveExecution(ctx, &args.ExecuteFunctionArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &funcdomain.BatchExecuteFunctionResult{BatchID: uuid.NewString()}
+	if _, err := s.jobService.CreateJob(ctx, &jobdomain.CreateJobArgs{
+		BatchID:          out.BatchID,
+		Function:         string(args.Name),
+		FailureThreshold: args.FailureThreshold,
+	}); err != nil {
+		return nil, err
+	}
+
+	batch := &batchJob{
+		name:        string(jobdomain.JobNameForBatch(out.BatchID)),
+		jobService:  s.jobService,
+		taskService: s.taskService,
+	}
+	// The job is sealed however the batch ends, so it can settle.
+	defer func() {
+		if sealErr := batch.flush(context.WithoutCancel(ctx), true); err == nil {
+			err = sealErr
+		}
+	}()
+
+	for index := uint64(0); ; index++ {
+		item, err := args.Items.Next()
+		if errors.Is(err, io.EOF) {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		res := &funcdomain.BatchItemResult{BatchID: out.BatchID, Index: index}
+		res.Err = fn.ValidateParameters(item.Parameters)
+		if res.Err == nil {
+			var created *taskdomain.CreateTaskResult
+			created, res.Err = s.taskService.CreateTask(ctx, &taskdomain.CreateTaskArgs{
+				Function:         string(args.Name),
+				FunctionRevision: fn.Revision,
+				Parameters:       item.Parameters,
+				Labels:           labels,
+				Annotations:      args.Annotations,
+				BatchID:          out.BatchID,
+			})
+			if res.Err == nil {
+				res.TaskName = created.Name
+				batch.created = append(batch.created, created.Name)
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if res.Err != nil {
+			out.Rejected++
+		} else {
+			out.Created++
+		}
+		if err := args.Report(res); err != nil {
+			return nil, err
+		}
+
+		if len(batch.created) >= jobFlushSize {
+			if err := batch.flush(ctx, false); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// jobFlushSize is how many tasks a batch creates between updates of its
+// job, which is also when it checks whether the job was canceled.
+const jobFlushSize = 100
+
+// batchJob keeps the job of a running batch up to date.
+type batchJob struct {
+	name        string
+	jobService  JobService
+	taskService TaskService
+	// created holds the tasks not yet added to the job.
+	created []string
+}
+
+// flush adds the created tasks to the job. If the job was canceled in the
+// meantime, they are canceled too: CancelJob may have listed the job's
+// tasks before they existed.
+func (b *batchJob) flush(ctx context.Context, seal bool) error {
+	if len(b.created) == 0 && !seal {
+		return nil
+	}
+
+	res, err := b.jobService.AddJobTasks(ctx, &jobdomain.AddJobTasksArgs{
+		Name:  b.name,
+		Count: uint64(len(b.created)),
+		Seal:  seal,
+	})
+	if err != nil {
+		return err
+	}
+	created := b.created
+	b.created = nil
+
+	if res.Job.State != jobdomain.JobStateCanceled {
+		return nil
+	}
+	for _, name := range created {
+		// Already ended tasks are fine; the job counts them either way.
+		_, _ = b.taskService.CancelTask(ctx, &taskdomain.CancelTaskArgs{Name: name})
+	}
+	return jobdomain.ErrJobCanceled
+}
+
+// resolveExecution checks what ExecuteFunction and BatchExecuteFunction
+// share and returns the ready revision to run with the task labels.
+func (s *Service) resolveExecution(ctx context.Context, args *funcdomain.ExecuteFunctionArgs) (*funcdomain.Function, map[string]string, error) {
+	if args.Name == "" {
+		return nil, nil, funcdomain.ErrInvalidArgument
+	}
+
+	if err := funcdomain.ValidateLabels(args.Labels); err != nil {
+		return nil, nil, err
+	}
+	if err := funcdomain.ValidateAnnotations(args.Annotations); err != nil {
+		return nil, nil, err
+	}
+
+	revision := args.Revision
+	if args.Alias != "" {
+		if revision != 0 {
+			return nil, nil, fmt.Errorf("%w: revision and alias are mutually exclusive", funcdomain.ErrInvalidArgument)
+		}
+		alias, err := s.funcMetaRepo.GetAlias(ctx, &funcdomain.GetAliasArgs{Function: args.Name, Name: args.Alias})
+		if err != nil {
+			return nil, nil, err
+		}
+		revision = alias.Alias.Pick(rand.Uint32N(funcdomain.AliasWeightTotal))
+	}
+
+	got, err := s.funcMetaRepo.GetFunction(ctx, &funcdomain.GetFunctionArgs{
+		Name:     args.Name,
+		Revision: revision,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if got == nil || got.Function == nil {
+		return nil, nil, funcdomain.ErrFunctionNotFound
+	}
+	if got.Function.Build == nil {
+		// Revisions uploaded before builds existed get one on their first
+		// execution.
+		if _, err := s.startBuild(ctx, got.Function); err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, fmt.Errorf("%w: revision %d was uploaded before builds and is being built now",
+			funcdomain.ErrFunctionNotReady, got.Function.Revision)
+	}
+	if !got.Function.IsReady() {
+		return nil, nil, funcdomain.ErrFunctionNotReady
+	}
+
+	labels := labelutils.Select(got.Function.Labels, args.InheritLabels)
+	for k, v := range args.Labels {
+		labels[k] = v
+	}
+	if len(labels) == 0 {
+		labels = nil
+	}
+	return got.Function, labels, nil
+}
+
+// InvokeFunction creates a task like ExecuteFunction and waits for it to
+// end. If the wait runs out first, the task keeps running and the result
+// says so; the caller can follow it with GetTask. The wait is measured once
+// the task exists and leaves part of the context deadline, between 50ms and
+// 1s, to return the task instead of failing with DeadlineExceeded. If the
+// context ends anyway, the result carries only the task name.
+func (s *Service) InvokeFunction(ctx context.Context, args *funcdomain.InvokeFunctionArgs) (*funcdomain.InvokeFunctionResult, error) {
+	if args == nil {
+		return nil, funcdomain.ErrInvalidArgument
+	}
+
+	exec, err := s.ExecuteFunction(ctx, &args.ExecuteFunctionArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.taskService.WaitTask(ctx, &taskdomain.WaitTaskArgs{
+		Name:    exec.TaskName,
+		Timeout: s.invokeWait(ctx, time.Now()),
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return &funcdomain.InvokeFunctionResult{Task: &taskdomain.Task{Name: taskdomain.TaskName(exec.TaskName)}}, nil
+		}
+		return nil, fmt.Errorf("wait for %s: %w", exec.TaskName, err)
+	}
+	return &funcdomain.InvokeFunctionResult{Task: res.Task, Done: res.Done}, nil
+}
+
+// invokeWait is the server's limit, or the context deadline less the margin
+// for the response if that is sooner.
+func (s *Service) invokeWait(ctx context.Context, now time.Time) time.Duration {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return s.cfg.MaxInvokeWait
+	}
+	remaining := deadline.Sub(now)
+	margin := min(max(remaining/10, 50*time.Millisecond), time.Second)
+	if remaining <= margin {
+		// Too late to wait at all; just report the task as created.
+		return time.Nanosecond
+	}
+	return min(s.cfg.MaxInvokeWait, remaining-margin)
+}
+
 func (s *Service) GetFunction(ctx context.Context, args *funcdomain.GetFunctionArgs) (*funcdomain.GetFunctionResult, error) {
 	if args == nil {
 		return nil, funcdomain.ErrInvalidArgument
@@ -117,6 +601,180 @@ func (s *Service) GetFunction(ctx context.Context, args *funcdomain.GetFunctionA
 	return res, nil
 }
 
+// storeBundle returns a stored bundle with the declared digest and whether
+// it is new to the namespace. Bundles are deduplicated by content: if the
+// namespace already references the digest, data is left unread; otherwise
+// the upload is stored and verified, and dropped again in favour of an
+// identical bundle stored earlier.
+func (s *Service) storeBundle(ctx context.Context, namespace, declared string, data io.ReadCloser) (*funcdomain.SourceBundle, bool, error) {
+	bundle, first, err := s.funcMetaRepo.RefBlob(ctx, declared, namespace, false)
+	if err == nil {
+		_ = data.Close()
+		return bundle, first, nil
+	}
+	if !errors.Is(err, funcdomain.ErrBlobNotFound) {
+		_ = data.Close()
+		return nil, false, err
+	}
+
+	limited, err := s.limitUpload(ctx, namespace, data)
+	if err != nil {
+		_ = data.Close()
+		return nil, false, err
+	}
+
+	bundle, err = s.funcObjRepo.SaveBundle(ctx, declared, limited)
+	if err != nil {
+		return nil, false, err
+	}
+	if bundle.SHA256 != declared {
+		_ = s.funcObjRepo.DeleteBundle(ctx, bundle)
+		return nil, false, fmt.Errorf("%w: declared %s, received %s", funcdomain.ErrDigestMismatch, declared, bundle.SHA256)
+	}
+
+	err = s.funcMetaRepo.CreateBlob(ctx, namespace, bundle)
+	switch {
+	case err == nil:
+		return bundle, true, nil
+	case errors.Is(err, funcdomain.ErrBlobExists):
+		_ = s.funcObjRepo.DeleteBundle(ctx, bundle)
+		return s.funcMetaRepo.RefBlob(ctx, declared, namespace, true)
+	default:
+		_ = s.funcObjRepo.DeleteBundle(ctx, bundle)
+		return nil, false, err
+	}
+}
+
+// releaseBundle drops a revision's reference to its bundle and deletes the
+// object once nothing references it. It returns the usage to credit: the
+// bundle size once the namespace no longer references it.
+func (s *Service) releaseBundle(ctx context.Context, namespace string, bundle *funcdomain.SourceBundle) (uint64, error) {
+	if bundle == nil {
+		return 0, nil
+	}
+
+	released, err := s.funcMetaRepo.UnrefBlob(ctx, namespace, bundle)
+	if err != nil {
+		return 0, err
+	}
+	if released.Object {
+		if err := s.funcObjRepo.DeleteBundle(ctx, bundle); err != nil {
+			return 0, err
+		}
+	}
+	if !released.Namespace {
+		return 0, nil
+	}
+	return bundle.Size, nil
+}
+
+// chargeBundle counts a bundle new to the namespace against its quota. It
+// is charged before the revision exists so concurrent uploads cannot
+// overshoot the quota together. Over the quota, the reference is dropped
+// again; if another upload took the bundle meanwhile, that one keeps it and
+// it is charged without the quota.
+func (s *Service) chargeBundle(ctx, cleanupCtx context.Context, namespace string, bundle *funcdomain.SourceBundle) error {
+	err := s.funcMetaRepo.AddUsage(ctx, namespace, int64(bundle.Size), s.cfg.NamespaceQuota)
+	if err == nil {
+		return nil
+	}
+
+	credit, rerr := s.releaseBundle(cleanupCtx, namespace, bundle)
+	if rerr == nil && credit == 0 {
+		_ = s.funcMetaRepo.AddUsage(cleanupCtx, namespace, int64(bundle.Size), 0)
+	}
+	return err
+}
+
+// limitUpload caps the upload at the bundle size limit or the space left in
+// the namespace quota, whichever is smaller. Reading past the cap fails, which
+// aborts the object store write before the bundle is stored.
+func (s *Service) limitUpload(ctx context.Context, namespace string, data io.ReadCloser) (io.ReadCloser, error) {
+	limit, exceeded := s.cfg.MaxBundleSize, funcdomain.ErrBundleTooLarge
+
+	if quota := s.cfg.NamespaceQuota; quota > 0 {
+		used, err := s.funcMetaRepo.GetUsage(ctx, namespace)
+		if err != nil {
+			return nil, err
+		}
+		if used >= quota {
+			return nil, funcdomain.ErrQuotaExceeded
+		}
+		if left := quota - used; limit == 0 || left < limit {
+			limit, exceeded = left, funcdomain.ErrQuotaExceeded
+		}
+	}
+
+	if limit == 0 {
+		return data, nil
+	}
+	return &limitedReader{ReadCloser: data, left: limit, err: exceeded}, nil
+}
+
+// limitedReader fails with err once more than left bytes have been read.
+type limitedReader struct {
+	io.ReadCloser
+	left uint64
+	err  error
+}
+
+func (r *limitedReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if uint64(n) > r.left {
+		r.left = 0
+		return 0, r.err
+	}
+	r.left -= uint64(n)
+	return n, err
+}
+
+// GetNamespaceUsage reports the bundle bytes stored by a namespace together
+// with the configured limits.
+func (s *Service) GetNamespaceUsage(ctx context.Context, args *funcdomain.GetNamespaceUsageArgs) (*funcdomain.GetNamespaceUsageResult, error) {
+	if args == nil {
+		return nil, funcdomain.ErrInvalidArgument
+	}
+
+	ns := args.Namespace
+	if ns == "" {
+		ns = funcdomain.DefaultNamespace
+	}
+
+	used, err := s.funcMetaRepo.GetUsage(ctx, ns)
+	if err != nil {
+		return nil, err
+	}
+
+	return &funcdomain.GetNamespaceUsageResult{Usage: &funcdomain.NamespaceUsage{
+		Namespace:      ns,
+		BundleBytes:    used,
+		QuotaBytes:     s.cfg.NamespaceQuota,
+		MaxBundleBytes: s.cfg.MaxBundleSize,
+	}}, nil
+}
+
+// DownloadFunction opens the source bundle of a revision as uploaded.
+func (s *Service) DownloadFunction(ctx context.Context, args *funcdomain.DownloadFunctionArgs) (*funcdomain.DownloadFunctionResult, error) {
+	if args == nil {
+		return nil, funcdomain.ErrInvalidArgument
+	}
+
+	got, err := s.GetFunction(ctx, &funcdomain.GetFunctionArgs{Name: args.Name, Revision: args.Revision})
+	if err != nil {
+		return nil, err
+	}
+	if got.Function.Bundle == nil {
+		return nil, funcdomain.ErrBundleNotFound
+	}
+
+	rc, err := s.funcObjRepo.OpenBundle(ctx, got.Function.Bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	return &funcdomain.DownloadFunctionResult{Function: got.Function, Data: rc}, nil
+}
+
 func (s *Service) ListFunctions(ctx context.Context, args *funcdomain.ListFunctionsArgs) (*funcdomain.ListFunctionsResult, error) {
 	if args == nil {
 		return nil, funcdomain.ErrInvalidArgument
@@ -133,6 +791,76 @@ func (s *Service) ListFunctions(ctx context.Context, args *funcdomain.ListFuncti
 	return s.funcMetaRepo.ListFunctions(ctx, args)
 }
 
+func (s *Service) ListFunctionRevisions(
+	ctx context.Context,
+	args *funcdomain.ListFunctionRevisionsArgs,
+) (*funcdomain.ListFunctionRevisionsResult, error) {
+	if args == nil || args.Name == "" {
+		return nil, funcdomain.ErrInvalidArgument
+	}
+
+	if args.PageSize <= 0 {
+		args.PageSize = 50
+	}
+
+	if args.PageSize > 1000 {
+		args.PageSize = 1000
+	}
+
+	return s.funcMetaRepo.ListFunctionRevisions(ctx, args)
+}
+
+// UpdateAlias points the alias at the given revisions, creating it if
+// needed. Rolling back is updating the alias to the previous revision.
+func (s *Service) UpdateAlias(ctx context.Context, args *funcdomain.UpdateAliasArgs) (*funcdomain.UpdateAliasResult, error) {
+	if args == nil || args.Alias == nil || args.Alias.Function == "" {
+		return nil, funcdomain.ErrInvalidArgument
+	}
+
+	alias := args.Alias
+	if err := alias.Normalize(); err != nil {
+		return nil, err
+	}
+
+	for _, r := range alias.Routes {
+		if _, err := s.funcMetaRepo.GetFunction(ctx, &funcdomain.GetFunctionArgs{
+			Name:     alias.Function,
+			Revision: r.Revision,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	alias.UpdatedAt = time.Now().UTC()
+	if err := s.funcMetaRepo.PutAlias(ctx, alias); err != nil {
+		return nil, err
+	}
+
+	return &funcdomain.UpdateAliasResult{Alias: alias}, nil
+}
+
+func (s *Service) GetAlias(ctx context.Context, args *funcdomain.GetAliasArgs) (*funcdomain.GetAliasResult, error) {
+	if args == nil {
+		return nil, funcdomain.ErrInvalidArgument
+	}
+	return s.funcMetaRepo.GetAlias(ctx, args)
+}
+
+func (s *Service) ListAliases(ctx context.Context, args *funcdomain.ListAliasesArgs) (*funcdomain.ListAliasesResult, error) {
+	if args == nil {
+		return nil, funcdomain.ErrInvalidArgument
+	}
+	return s.funcMetaRepo.ListAliases(ctx, args)
+}
+
+func (s *Service) DeleteAlias(ctx context.Context, args *funcdomain.DeleteAliasArgs) error {
+	if args == nil {
+		return funcdomain.ErrInvalidArgument
+	}
+	return s.funcMetaRepo.DeleteAlias(ctx, args)
+}
+
+// UploadFunction stores the bundle as the next revision of the function.
 func (s *Service) UploadFunction(ctx context.Context, args *funcdomain.UploadFunctionArgs) (*funcdomain.UploadFunctionResult, error) {
 	if args == nil {
 		return nil, funcdomain.ErrInvalidArgument
@@ -146,33 +874,259 @@ func (s *Service) UploadFunction(ctx context.Context, args *funcdomain.UploadFun
 	if !isSupportedFormat(args.Format) {
 		return nil, funcdomain.ErrUnsupportedFormat
 	}
-
-	bundle, err := s.funcObjRepo.SaveBundle(ctx, args.Name, args.Format, args.Data)
+	declared, err := digestutils.NormalizeSHA256(args.SHA256)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %q", funcdomain.ErrInvalidDigest, args.SHA256)
 	}
-	if bundle == nil {
-		return nil, funcdomain.ErrInvalidArgument
+	if err := s.checkSecrets(ctx, args.SecretEnv); err != nil {
+		return nil, err
 	}
 
 	fn := &funcdomain.Function{
 		InternalID:  uuid.New(),
 		Name:        args.Name,
+		Revision:    1,
 		DisplayName: args.DisplayName,
-		UploadedAt:  time.Now().UTC(),
-		Bundle:      bundle,
+		Description: args.Description,
+		Labels:      args.Labels,
+		Annotations: args.Annotations,
+		Timeout:     args.Timeout,
+		MemoryBytes: args.MemoryBytes,
+		Runtime:     args.Runtime,
+		Env:         args.Env,
+		SecretEnv:   args.SecretEnv,
 	}
 
-	if err := s.funcMetaRepo.CreateFunction(ctx, fn); err != nil {
-		if errors.Is(err, funcdomain.ErrFunctionAlreadyExists) {
-			_ = s.funcObjRepo.DeleteBundle(ctx, bundle)
+	latest, err := s.funcMetaRepo.GetFunction(ctx, &funcdomain.GetFunctionArgs{Name: args.Name, ShowDeleted: true})
+	switch {
+	case err == nil && latest.Function.IsDeleted():
+		return nil, funcdomain.ErrFunctionDeleted
+	case err == nil:
+		fn.Revision = latest.Function.Revision + 1
+	case !errors.Is(err, funcdomain.ErrFunctionNotFound):
+		return nil, err
+	}
+
+	ns := args.Name.Namespace()
+	bundle, first, err := s.storeBundle(ctx, ns, declared, args.Data)
+	if err != nil {
+		return nil, err
+	}
+	bundle.Format = args.Format
+
+	// Cleanup must run even when the failure is the caller going away.
+	cleanupCtx := context.WithoutCancel(ctx)
+	if first {
+		if err := s.chargeBundle(ctx, cleanupCtx, ns, bundle); err != nil {
+			return nil, err
+		}
+	}
+	discard := func() {
+		if credit, err := s.releaseBundle(cleanupCtx, ns, bundle); err == nil && credit > 0 {
+			_ = s.funcMetaRepo.AddUsage(cleanupCtx, ns, -int64(credit), 0)
 		}
+	}
+
+	manifest, err := s.readManifest(ctx, args.Name, bundle)
+	if err != nil {
+		discard()
+		return nil, err
+	}
+	fn.ApplyManifest(manifest)
+	if latest != nil {
+		inheritMetadata(fn, latest.Function)
+	}
+	if err := fn.ValidateMetadata(); err != nil {
+		discard()
+		return nil, err
+	}
+
+	fn.UploadedAt = time.Now().UTC()
+	fn.Bundle = bundle
+	fn.Build = funcdomain.NewFunctionBuild()
+
+	if err := s.funcMetaRepo.CreateRevision(ctx, fn); err != nil {
+		discard()
 		return nil, err
 	}
 
+	fn, err = s.publishBuild(ctx, fn)
+	if err != nil {
+		return nil, err
+	}
 	return &funcdomain.UploadFunctionResult{Function: fn}, nil
 }
 
+// startBuild adds a build to a stored revision that has none and schedules
+// it. A build another caller added first is left as is.
+func (s *Service) startBuild(ctx context.Context, fn *funcdomain.Function) (*funcdomain.Function, error) {
+	started := false
+	updated, err := s.funcMetaRepo.UpdateFunction(ctx, fn.Name, fn.Revision, func(f *funcdomain.Function) error {
+		started = f.Build == nil
+		if started {
+			f.Build = funcdomain.NewFunctionBuild()
+		}
+		return nil
+	})
+	if err != nil || !started {
+		return updated, err
+	}
+	return s.publishBuild(ctx, updated)
+}
+
+// publishBuild schedules the build of fn. The function is stored, so a
+// build that cannot be scheduled is reported on it rather than failing the
+// caller.
+func (s *Service) publishBuild(ctx context.Context, fn *funcdomain.Function) (*funcdomain.Function, error) {
+	err := s.buildPub.PublishBuild(ctx, &funcdomain.BuildFunctionMessage{
+		FunctionName: fn.Name,
+		Revision:     fn.Revision,
+		BuildID:      fn.Build.ID,
+	})
+	if err == nil {
+		return fn, nil
+	}
+
+	updated, uerr := s.funcMetaRepo.UpdateFunction(ctx, fn.Name, fn.Revision, func(f *funcdomain.Function) error {
+		f.Build.State = funcdomain.BuildStateFailed
+		f.Build.EndedAt = time.Now().UTC()
+		f.Build.ErrorMessage = "cannot schedule build: " + err.Error()
+		return nil
+	})
+	if uerr != nil {
+		return nil, errors.Join(err, uerr)
+	}
+	return updated, nil
+}
+
+// readManifest parses the manifest at the bundle root and checks it against
+// the bundle contents.
+func (s *Service) readManifest(ctx context.Context, name funcdomain.FunctionName, bundle *funcdomain.SourceBundle) (*funcdomain.Manifest, error) {
+	rc, err := s.funcObjRepo.OpenBundle(ctx, bundle)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	var (
+		data  []byte
+		found bool
+		files = make(map[string]struct{})
+	)
+	err = archiveutils.Walk(rc, string(bundle.ArchiveFormat()), func(path string, r io.Reader) error {
+		files[path] = struct{}{}
+		if path != funcdomain.ManifestFile {
+			return nil
+		}
+		found = true
+		b, err := io.ReadAll(io.LimitReader(r, funcdomain.MaxManifestSize+1))
+		if err != nil {
+			return err
+		}
+		data = b
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: cannot read bundle: %v", funcdomain.ErrInvalidArgument, err)
+	}
+
+	switch {
+	case !found:
+		return nil, &funcdomain.ManifestError{Violations: []funcdomain.FieldViolation{{
+			Description: funcdomain.ManifestFile + " is missing at the bundle root",
+		}}}
+	case len(data) > funcdomain.MaxManifestSize:
+		return nil, &funcdomain.ManifestError{Violations: []funcdomain.FieldViolation{{
+			Description: fmt.Sprintf("%s is larger than %d bytes", funcdomain.ManifestFile, funcdomain.MaxManifestSize),
+		}}}
+	}
+
+	manifest, err := funcdomain.ParseManifest(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := manifest.CheckBundle(name, files); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// inheritMetadata carries descriptive and resource settings over from the
+// previous revision when the upload leaves them unset. Env is always taken
+// from the upload as is. Parameters and output contracts set with
+// UpdateFunction survive uploads whose manifest declares none.
+func inheritMetadata(fn, prev *funcdomain.Function) {
+	if fn.DisplayName == "" {
+		fn.DisplayName = prev.DisplayName
+	}
+	if fn.Description == "" {
+		fn.Description = prev.Description
+	}
+	if fn.Labels == nil {
+		fn.Labels = prev.Labels
+	}
+	if fn.Annotations == nil {
+		fn.Annotations = prev.Annotations
+	}
+	if fn.Timeout == 0 {
+		fn.Timeout = prev.Timeout
+	}
+	if fn.MemoryBytes == 0 {
+		fn.MemoryBytes = prev.MemoryBytes
+	}
+	if fn.Runtime == "" {
+		fn.Runtime = prev.Runtime
+	}
+	if fn.ParametersSchema == nil {
+		fn.ParametersSchema = prev.ParametersSchema
+	}
+	if fn.OutputSchema == nil && fn.OutputContentType == "" {
+		fn.OutputSchema = prev.OutputSchema
+		fn.OutputContentType = prev.OutputContentType
+	}
+}
+
+// UpdateFunction changes metadata of the latest revision. Bundle and build
+// are never touched, so the revision's code stays immutable.
+func (s *Service) UpdateFunction(ctx context.Context, args *funcdomain.UpdateFunctionArgs) (*funcdomain.UpdateFunctionResult, error) {
+	if args == nil || args.Name == "" || args.Function == nil {
+		return nil, funcdomain.ErrInvalidArgument
+	}
+	if slices.Contains(args.Paths, funcdomain.FieldSecretEnv) {
+		if err := s.checkSecrets(ctx, args.Function.SecretEnv); err != nil {
+			return nil, err
+		}
+	}
+
+	fn, err := s.funcMetaRepo.UpdateLatest(ctx, args.Name, args.ETag, func(f *funcdomain.Function) error {
+		if err := f.ApplyUpdate(args.Function, args.Paths); err != nil {
+			return err
+		}
+		return f.ValidateMetadata()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &funcdomain.UpdateFunctionResult{Function: fn}, nil
+}
+
+// checkSecrets reports secret_env entries naming secrets that do not exist,
+// which would otherwise only fail once a task runs.
+func (s *Service) checkSecrets(ctx context.Context, secretEnv map[string]string) error {
+	for _, key := range slices.Sorted(maps.Keys(secretEnv)) {
+		ref := secretEnv[key]
+		_, err := s.secrets.GetSecret(ctx, &secretdomain.GetSecretArgs{Name: secretdomain.SecretName(ref)})
+		switch {
+		case errors.Is(err, secretdomain.ErrSecretNotFound):
+			return fmt.Errorf("%w: %q references secret %q, which does not exist", funcdomain.ErrInvalidEnv, key, ref)
+		case err != nil:
+			return err
+		}
+	}
+	return nil
+}
+
 func isSupportedFormat(f funcdomain.UploadFunctionFormat) bool {
 	switch f {
 	case funcdomain.ZipFormat, funcdomain.TarGZFormat:
@@ -0,0 +1,739 @@
+package funcsrv_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"hash/crc32"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	secretdomain "github.com/10Narratives/faas/internal/domains/secrets"
+	taskdomain "github.com/10Narratives/faas/internal/domains/tasks"
+	funcsrv "github.com/10Narratives/faas/internal/services/functions"
+	"github.com/10Narratives/faas/internal/services/functions/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	fnName    = funcdomain.FunctionName("functions/orders")
+	namespace = funcdomain.DefaultNamespace
+)
+
+type fixture struct {
+	svc     *funcsrv.Service
+	meta    *mocks.FunctionMetadataRepository
+	obj     *mocks.FunctionObjectRepository
+	tasks   *mocks.TaskService
+	pub     *mocks.BuildPublisher
+	secrets *mocks.SecretService
+}
+
+func newFixture(t *testing.T, cfg funcsrv.Config) *fixture {
+	f := &fixture{
+		meta:    mocks.NewFunctionMetadataRepository(t),
+		obj:     mocks.NewFunctionObjectRepository(t),
+		tasks:   mocks.NewTaskService(t),
+		pub:     mocks.NewBuildPublisher(t),
+		secrets: mocks.NewSecretService(t),
+	}
+	f.svc = funcsrv.NewService(cfg, f.meta, f.obj, f.tasks, mocks.NewJobService(t), f.pub, f.secrets)
+	return f
+}
+
+// zipBundle returns a zip archive of files and its hex SHA-256.
+func zipBundle(t *testing.T, files map[string]string) ([]byte, string) {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	sum := sha256.Sum256(buf.Bytes())
+	return buf.Bytes(), hex.EncodeToString(sum[:])
+}
+
+func validBundle(t *testing.T) ([]byte, string) {
+	return zipBundle(t, map[string]string{
+		funcdomain.ManifestFile: "version: 1\nname: orders\nruntime: python3.12\nentrypoint: main.py\n",
+		"main.py":               "print('ok')\n",
+	})
+}
+
+func storedBundle(data []byte, sum string) *funcdomain.SourceBundle {
+	return &funcdomain.SourceBundle{Bucket: "bundles", ObjectKey: sum + ".zip", Size: uint64(len(data)), SHA256: sum}
+}
+
+func uploadArgs(data []byte, sum string) *funcdomain.UploadFunctionArgs {
+	return &funcdomain.UploadFunctionArgs{
+		Name:   fnName,
+		Format: funcdomain.ZipFormat,
+		SHA256: sum,
+		Data:   io.NopCloser(bytes.NewReader(data)),
+	}
+}
+
+func readyFunction(revision uint64) *funcdomain.Function {
+	return &funcdomain.Function{
+		Name:     fnName,
+		Revision: revision,
+		Build: &funcdomain.FunctionBuild{
+			State:    funcdomain.BuildStateReady,
+			Artifact: &funcdomain.SourceBundle{ObjectKey: "artifact.tar.gz"},
+		},
+	}
+}
+
+func (f *fixture) expectOpenBundle(data []byte) {
+	f.obj.EXPECT().OpenBundle(mock.Anything, mock.Anything).
+		RunAndReturn(func(context.Context, *funcdomain.SourceBundle) (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(data)), nil
+		}).
+		Once()
+}
+
+func TestService_UploadFunction(t *testing.T) {
+	ctx := context.Background()
+	data, sum := validBundle(t)
+	size := int64(len(data))
+
+	t.Run("ok: first upload is revision 1 and charges the new bundle", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{})
+		bundle := storedBundle(data, sum)
+
+		f.meta.EXPECT().GetFunction(ctx, &funcdomain.GetFunctionArgs{Name: fnName, ShowDeleted: true}).
+			Return(nil, funcdomain.ErrFunctionNotFound).Once()
+		f.meta.EXPECT().RefBlob(ctx, sum, namespace, false).Return(nil, false, funcdomain.ErrBlobNotFound).Once()
+		f.obj.EXPECT().SaveBundle(ctx, sum, mock.Anything).
+			RunAndReturn(func(_ context.Context, _ string, r io.ReadCloser) (*funcdomain.SourceBundle, error) {
+				got, err := io.ReadAll(r)
+				require.NoError(t, err)
+				require.Equal(t, data, got)
+				return bundle, nil
+			}).
+			Once()
+		f.meta.EXPECT().CreateBlob(ctx, namespace, bundle).Return(nil).Once()
+		f.meta.EXPECT().AddUsage(ctx, namespace, size, uint64(0)).Return(nil).Once()
+		f.expectOpenBundle(data)
+		f.meta.EXPECT().CreateRevision(ctx, mock.MatchedBy(func(fn *funcdomain.Function) bool {
+			return fn.Revision == 1 && fn.Bundle == bundle && fn.Entrypoint == "main.py" && fn.Runtime == "python3.12"
+		})).Return(nil).Once()
+		f.pub.EXPECT().PublishBuild(ctx, mock.MatchedBy(func(m *funcdomain.BuildFunctionMessage) bool {
+			return m.FunctionName == fnName && m.Revision == 1
+		})).Return(nil).Once()
+
+		res, err := f.svc.UploadFunction(ctx, uploadArgs(data, sum))
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), res.Function.Revision)
+		require.Equal(t, funcdomain.ZipFormat, res.Function.Bundle.Format)
+	})
+
+	t.Run("ok: next revision reuses a referenced bundle without charging", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{NamespaceQuota: 1 << 20})
+		bundle := storedBundle(data, sum)
+		latest := readyFunction(3)
+		latest.DisplayName = "Orders"
+
+		f.meta.EXPECT().GetFunction(ctx, mock.Anything).Return(&funcdomain.GetFunctionResult{Function: latest}, nil).Once()
+		f.meta.EXPECT().RefBlob(ctx, sum, namespace, false).Return(bundle, false, nil).Once()
+		f.expectOpenBundle(data)
+		f.meta.EXPECT().CreateRevision(ctx, mock.MatchedBy(func(fn *funcdomain.Function) bool {
+			return fn.Revision == 4 && fn.DisplayName == "Orders"
+		})).Return(nil).Once()
+		f.pub.EXPECT().PublishBuild(ctx, mock.Anything).Return(nil).Once()
+
+		res, err := f.svc.UploadFunction(ctx, uploadArgs(data, sum))
+		require.NoError(t, err)
+		require.Equal(t, uint64(4), res.Function.Revision)
+	})
+
+	t.Run("ok: failed build scheduling is reported on the revision", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{})
+		bundle := storedBundle(data, sum)
+
+		f.meta.EXPECT().GetFunction(ctx, mock.Anything).Return(nil, funcdomain.ErrFunctionNotFound).Once()
+		f.meta.EXPECT().RefBlob(ctx, sum, namespace, false).Return(bundle, false, nil).Once()
+		f.expectOpenBundle(data)
+		f.meta.EXPECT().CreateRevision(ctx, mock.Anything).Return(nil).Once()
+		f.pub.EXPECT().PublishBuild(ctx, mock.Anything).Return(errors.New("nats down")).Once()
+		f.meta.EXPECT().UpdateFunction(ctx, fnName, uint64(1), mock.Anything).
+			RunAndReturn(func(_ context.Context, _ funcdomain.FunctionName, _ uint64, mutate func(*funcdomain.Function) error) (*funcdomain.Function, error) {
+				fn := &funcdomain.Function{Name: fnName, Revision: 1, Build: funcdomain.NewFunctionBuild()}
+				require.NoError(t, mutate(fn))
+				return fn, nil
+			}).
+			Once()
+
+		res, err := f.svc.UploadFunction(ctx, uploadArgs(data, sum))
+		require.NoError(t, err)
+		require.Equal(t, funcdomain.BuildStateFailed, res.Function.Build.State)
+		require.Contains(t, res.Function.Build.ErrorMessage, "nats down")
+	})
+
+	t.Run("error: deleted function", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{})
+		deleted := readyFunction(2)
+		deleted.DeleteTime = time.Now()
+
+		f.meta.EXPECT().GetFunction(ctx, mock.Anything).Return(&funcdomain.GetFunctionResult{Function: deleted}, nil).Once()
+
+		res, err := f.svc.UploadFunction(ctx, uploadArgs(data, sum))
+		require.ErrorIs(t, err, funcdomain.ErrFunctionDeleted)
+		require.Nil(t, res)
+	})
+
+	t.Run("error: missing secret", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{})
+		args := uploadArgs(data, sum)
+		args.SecretEnv = map[string]string{"DB_PASSWORD": "secrets/db"}
+
+		f.secrets.EXPECT().GetSecret(ctx, &secretdomain.GetSecretArgs{Name: "secrets/db"}).
+			Return(nil, secretdomain.ErrSecretNotFound).Once()
+
+		res, err := f.svc.UploadFunction(ctx, args)
+		require.ErrorIs(t, err, funcdomain.ErrInvalidEnv)
+		require.Nil(t, res)
+	})
+
+	t.Run("error: over quota before storing", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{NamespaceQuota: 100})
+
+		f.meta.EXPECT().GetFunction(ctx, mock.Anything).Return(nil, funcdomain.ErrFunctionNotFound).Once()
+		f.meta.EXPECT().RefBlob(ctx, sum, namespace, false).Return(nil, false, funcdomain.ErrBlobNotFound).Once()
+		f.meta.EXPECT().GetUsage(ctx, namespace).Return(100, nil).Once()
+
+		res, err := f.svc.UploadFunction(ctx, uploadArgs(data, sum))
+		require.ErrorIs(t, err, funcdomain.ErrQuotaExceeded)
+		require.Nil(t, res)
+	})
+
+	t.Run("error: over quota rolls back the reference", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{NamespaceQuota: 100})
+		bundle := storedBundle(data, sum)
+
+		f.meta.EXPECT().GetFunction(ctx, mock.Anything).Return(nil, funcdomain.ErrFunctionNotFound).Once()
+		f.meta.EXPECT().RefBlob(ctx, sum, namespace, false).Return(bundle, true, nil).Once()
+		f.meta.EXPECT().AddUsage(ctx, namespace, size, uint64(100)).Return(funcdomain.ErrQuotaExceeded).Once()
+		f.meta.EXPECT().UnrefBlob(mock.Anything, namespace, bundle).
+			Return(funcdomain.BlobRelease{Namespace: true, Object: true}, nil).Once()
+		f.obj.EXPECT().DeleteBundle(mock.Anything, bundle).Return(nil).Once()
+
+		res, err := f.svc.UploadFunction(ctx, uploadArgs(data, sum))
+		require.ErrorIs(t, err, funcdomain.ErrQuotaExceeded)
+		require.Nil(t, res)
+	})
+
+	t.Run("error: over quota while another upload took the bundle", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{NamespaceQuota: 100})
+		bundle := storedBundle(data, sum)
+
+		f.meta.EXPECT().GetFunction(ctx, mock.Anything).Return(nil, funcdomain.ErrFunctionNotFound).Once()
+		f.meta.EXPECT().RefBlob(ctx, sum, namespace, false).Return(bundle, true, nil).Once()
+		f.meta.EXPECT().AddUsage(ctx, namespace, size, uint64(100)).Return(funcdomain.ErrQuotaExceeded).Once()
+		f.meta.EXPECT().UnrefBlob(mock.Anything, namespace, bundle).Return(funcdomain.BlobRelease{}, nil).Once()
+		// The other holder is charged, without the quota.
+		f.meta.EXPECT().AddUsage(mock.Anything, namespace, size, uint64(0)).Return(nil).Once()
+
+		_, err := f.svc.UploadFunction(ctx, uploadArgs(data, sum))
+		require.ErrorIs(t, err, funcdomain.ErrQuotaExceeded)
+	})
+
+	t.Run("error: invalid manifest releases and credits the bundle", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{})
+		noManifest, noManifestSum := zipBundle(t, map[string]string{"main.py": "print('ok')\n"})
+		bundle := storedBundle(noManifest, noManifestSum)
+		size := int64(len(noManifest))
+
+		f.meta.EXPECT().GetFunction(ctx, mock.Anything).Return(nil, funcdomain.ErrFunctionNotFound).Once()
+		f.meta.EXPECT().RefBlob(ctx, noManifestSum, namespace, false).Return(bundle, true, nil).Once()
+		f.meta.EXPECT().AddUsage(ctx, namespace, size, uint64(0)).Return(nil).Once()
+		f.expectOpenBundle(noManifest)
+		f.meta.EXPECT().UnrefBlob(mock.Anything, namespace, bundle).
+			Return(funcdomain.BlobRelease{Namespace: true}, nil).Once()
+		f.meta.EXPECT().AddUsage(mock.Anything, namespace, -size, uint64(0)).Return(nil).Once()
+
+		res, err := f.svc.UploadFunction(ctx, uploadArgs(noManifest, noManifestSum))
+		var me *funcdomain.ManifestError
+		require.ErrorAs(t, err, &me)
+		require.Nil(t, res)
+	})
+
+	t.Run("error: digest mismatch drops the stored object", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{})
+		other := storedBundle(data, "ab"+sum[2:])
+
+		f.meta.EXPECT().GetFunction(ctx, mock.Anything).Return(nil, funcdomain.ErrFunctionNotFound).Once()
+		f.meta.EXPECT().RefBlob(ctx, sum, namespace, false).Return(nil, false, funcdomain.ErrBlobNotFound).Once()
+		f.obj.EXPECT().SaveBundle(ctx, sum, mock.Anything).Return(other, nil).Once()
+		f.obj.EXPECT().DeleteBundle(ctx, other).Return(nil).Once()
+
+		_, err := f.svc.UploadFunction(ctx, uploadArgs(data, sum))
+		require.ErrorIs(t, err, funcdomain.ErrDigestMismatch)
+	})
+
+	t.Run("ok: identical bundle stored concurrently is shared", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{})
+		ours := storedBundle(data, sum)
+		theirs := storedBundle(data, sum)
+		theirs.ObjectKey = "theirs.zip"
+
+		f.meta.EXPECT().GetFunction(ctx, mock.Anything).Return(nil, funcdomain.ErrFunctionNotFound).Once()
+		f.meta.EXPECT().RefBlob(ctx, sum, namespace, false).Return(nil, false, funcdomain.ErrBlobNotFound).Once()
+		f.obj.EXPECT().SaveBundle(ctx, sum, mock.Anything).Return(ours, nil).Once()
+		f.meta.EXPECT().CreateBlob(ctx, namespace, ours).Return(funcdomain.ErrBlobExists).Once()
+		f.obj.EXPECT().DeleteBundle(ctx, ours).Return(nil).Once()
+		f.meta.EXPECT().RefBlob(ctx, sum, namespace, true).Return(theirs, false, nil).Once()
+		f.expectOpenBundle(data)
+		f.meta.EXPECT().CreateRevision(ctx, mock.MatchedBy(func(fn *funcdomain.Function) bool {
+			return fn.Bundle == theirs
+		})).Return(nil).Once()
+		f.pub.EXPECT().PublishBuild(ctx, mock.Anything).Return(nil).Once()
+
+		_, err := f.svc.UploadFunction(ctx, uploadArgs(data, sum))
+		require.NoError(t, err)
+	})
+}
+
+func TestService_PurgeDeletedFunctions(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	bundle := &funcdomain.SourceBundle{ObjectKey: "source.zip", Size: 300}
+	artifact := &funcdomain.SourceBundle{ObjectKey: "artifact.tar.gz", Size: 900}
+
+	expectItems := func(f *fixture, items ...*funcdomain.PurgeItem) {
+		for _, item := range items {
+			f.meta.EXPECT().NextPurgeItem(ctx, fnName).Return(item, nil).Once()
+		}
+	}
+
+	t.Run("ok: releases bundles and artifacts, then the tombstone", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{})
+
+		f.meta.EXPECT().ListPurgeableFunctions(ctx, now).Return([]funcdomain.FunctionName{fnName}, nil).Once()
+		f.meta.EXPECT().BeginPurge(ctx, fnName, now).Return(nil).Once()
+		expectItems(f, &funcdomain.PurgeItem{Bundle: bundle}, &funcdomain.PurgeItem{Bundle: artifact, Artifact: true}, nil)
+		f.meta.EXPECT().UnrefBlob(ctx, namespace, bundle).Return(funcdomain.BlobRelease{Namespace: true, Object: true}, nil).Once()
+		f.obj.EXPECT().DeleteBundle(ctx, bundle).Return(nil).Once()
+		f.meta.EXPECT().AddUsage(ctx, namespace, int64(-300), uint64(0)).Return(nil).Once()
+		f.obj.EXPECT().DeleteBundle(ctx, artifact).Return(nil).Once()
+		f.meta.EXPECT().FinishPurge(ctx, fnName).Return(nil).Once()
+
+		purged, err := f.svc.PurgeDeletedFunctions(ctx, now)
+		require.NoError(t, err)
+		require.Equal(t, 1, purged)
+	})
+
+	t.Run("ok: a bundle shared with another revision is neither deleted nor credited", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{})
+
+		f.meta.EXPECT().ListPurgeableFunctions(ctx, now).Return([]funcdomain.FunctionName{fnName}, nil).Once()
+		f.meta.EXPECT().BeginPurge(ctx, fnName, now).Return(nil).Once()
+		expectItems(f, &funcdomain.PurgeItem{Bundle: bundle}, nil)
+		f.meta.EXPECT().UnrefBlob(ctx, namespace, bundle).Return(funcdomain.BlobRelease{}, nil).Once()
+		f.meta.EXPECT().FinishPurge(ctx, fnName).Return(nil).Once()
+
+		purged, err := f.svc.PurgeDeletedFunctions(ctx, now)
+		require.NoError(t, err)
+		require.Equal(t, 1, purged)
+	})
+
+	t.Run("ok: credit-only items return usage", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{})
+
+		f.meta.EXPECT().ListPurgeableFunctions(ctx, now).Return([]funcdomain.FunctionName{fnName}, nil).Once()
+		f.meta.EXPECT().BeginPurge(ctx, fnName, now).Return(nil).Once()
+		expectItems(f, &funcdomain.PurgeItem{Credit: 300}, nil)
+		f.meta.EXPECT().AddUsage(ctx, namespace, int64(-300), uint64(0)).Return(nil).Once()
+		f.meta.EXPECT().FinishPurge(ctx, fnName).Return(nil).Once()
+
+		purged, err := f.svc.PurgeDeletedFunctions(ctx, now)
+		require.NoError(t, err)
+		require.Equal(t, 1, purged)
+	})
+
+	t.Run("error: an item that cannot be released is requeued", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{})
+		item := &funcdomain.PurgeItem{Bundle: bundle}
+
+		f.meta.EXPECT().ListPurgeableFunctions(ctx, now).Return([]funcdomain.FunctionName{fnName}, nil).Once()
+		f.meta.EXPECT().BeginPurge(ctx, fnName, now).Return(nil).Once()
+		expectItems(f, item)
+		f.meta.EXPECT().UnrefBlob(ctx, namespace, bundle).Return(funcdomain.BlobRelease{Namespace: true, Object: true}, nil).Once()
+		f.obj.EXPECT().DeleteBundle(ctx, bundle).Return(errors.New("s3 unavailable")).Once()
+		f.meta.EXPECT().RequeuePurgeItem(ctx, fnName, item).Return(nil).Once()
+
+		purged, err := f.svc.PurgeDeletedFunctions(ctx, now)
+		require.ErrorContains(t, err, "s3 unavailable")
+		require.Zero(t, purged)
+	})
+
+	t.Run("error: a failed credit requeues only the credit", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{})
+
+		f.meta.EXPECT().ListPurgeableFunctions(ctx, now).Return([]funcdomain.FunctionName{fnName}, nil).Once()
+		f.meta.EXPECT().BeginPurge(ctx, fnName, now).Return(nil).Once()
+		expectItems(f, &funcdomain.PurgeItem{Bundle: bundle})
+		f.meta.EXPECT().UnrefBlob(ctx, namespace, bundle).Return(funcdomain.BlobRelease{Namespace: true}, nil).Once()
+		f.meta.EXPECT().AddUsage(ctx, namespace, int64(-300), uint64(0)).Return(errors.New("kv unavailable")).Once()
+		f.meta.EXPECT().RequeuePurgeItem(ctx, fnName, &funcdomain.PurgeItem{Credit: 300}).Return(nil).Once()
+
+		purged, err := f.svc.PurgeDeletedFunctions(ctx, now)
+		require.ErrorContains(t, err, "kv unavailable")
+		require.Zero(t, purged)
+	})
+
+	t.Run("ok: skips functions undeleted or purged meanwhile", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{})
+		other := funcdomain.FunctionName("functions/billing")
+
+		f.meta.EXPECT().ListPurgeableFunctions(ctx, now).Return([]funcdomain.FunctionName{fnName, other}, nil).Once()
+		f.meta.EXPECT().BeginPurge(ctx, fnName, now).Return(funcdomain.ErrFunctionNotDeleted).Once()
+		f.meta.EXPECT().BeginPurge(ctx, other, now).Return(funcdomain.ErrFunctionNotFound).Once()
+
+		purged, err := f.svc.PurgeDeletedFunctions(ctx, now)
+		require.NoError(t, err)
+		require.Zero(t, purged)
+	})
+}
+
+func TestService_ExecuteFunction(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		routes []funcdomain.AliasRoute
+		want   uint64
+	}{
+		{name: "single route", routes: []funcdomain.AliasRoute{{Revision: 2, Weight: funcdomain.AliasWeightTotal}}, want: 2},
+		{name: "all weight on the canary", routes: []funcdomain.AliasRoute{{Revision: 2}, {Revision: 3, Weight: funcdomain.AliasWeightTotal}}, want: 3},
+	}
+	for _, tt := range tests {
+		t.Run("ok: alias picks "+tt.name, func(t *testing.T) {
+			f := newFixture(t, funcsrv.Config{})
+
+			f.meta.EXPECT().GetAlias(ctx, &funcdomain.GetAliasArgs{Function: fnName, Name: "live"}).
+				Return(&funcdomain.GetAliasResult{Alias: &funcdomain.FunctionAlias{Function: fnName, Name: "live", Routes: tt.routes}}, nil).Once()
+			f.meta.EXPECT().GetFunction(ctx, &funcdomain.GetFunctionArgs{Name: fnName, Revision: tt.want}).
+				Return(&funcdomain.GetFunctionResult{Function: readyFunction(tt.want)}, nil).Once()
+			f.tasks.EXPECT().CreateTask(ctx, mock.MatchedBy(func(args *taskdomain.CreateTaskArgs) bool {
+				return args.Function == string(fnName) && args.FunctionRevision == tt.want
+			})).Return(&taskdomain.CreateTaskResult{Name: "tasks/1"}, nil).Once()
+
+			res, err := f.svc.ExecuteFunction(ctx, &funcdomain.ExecuteFunctionArgs{Name: fnName, Alias: "live"})
+			require.NoError(t, err)
+			require.Equal(t, "tasks/1", res.TaskName)
+		})
+	}
+
+	t.Run("error: revision and alias together", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{})
+
+		_, err := f.svc.ExecuteFunction(ctx, &funcdomain.ExecuteFunctionArgs{Name: fnName, Alias: "live", Revision: 2})
+		require.ErrorIs(t, err, funcdomain.ErrInvalidArgument)
+	})
+
+	t.Run("error: not built yet", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{})
+		building := readyFunction(1)
+		building.Build = funcdomain.NewFunctionBuild()
+
+		f.meta.EXPECT().GetFunction(ctx, &funcdomain.GetFunctionArgs{Name: fnName}).
+			Return(&funcdomain.GetFunctionResult{Function: building}, nil).Once()
+
+		_, err := f.svc.ExecuteFunction(ctx, &funcdomain.ExecuteFunctionArgs{Name: fnName})
+		require.ErrorIs(t, err, funcdomain.ErrFunctionNotReady)
+	})
+
+	t.Run("error: uploaded before builds starts one", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{})
+		legacy := readyFunction(1)
+		legacy.Build = nil
+
+		f.meta.EXPECT().GetFunction(ctx, &funcdomain.GetFunctionArgs{Name: fnName}).
+			Return(&funcdomain.GetFunctionResult{Function: legacy}, nil).Once()
+		var stored funcdomain.Function
+		f.meta.EXPECT().UpdateFunction(ctx, fnName, uint64(1), mock.Anything).
+			RunAndReturn(func(_ context.Context, _ funcdomain.FunctionName, _ uint64, mutate func(*funcdomain.Function) error) (*funcdomain.Function, error) {
+				stored = *legacy
+				require.NoError(t, mutate(&stored))
+				return &stored, nil
+			}).Once()
+		f.pub.EXPECT().PublishBuild(ctx, mock.MatchedBy(func(m *funcdomain.BuildFunctionMessage) bool {
+			return m.FunctionName == fnName && m.Revision == 1 && m.BuildID == stored.Build.ID
+		})).Return(nil).Once()
+
+		_, err := f.svc.ExecuteFunction(ctx, &funcdomain.ExecuteFunctionArgs{Name: fnName})
+		require.ErrorIs(t, err, funcdomain.ErrFunctionNotReady)
+		require.NotNil(t, stored.Build)
+		require.Equal(t, funcdomain.BuildStateBuilding, stored.Build.State)
+	})
+
+	t.Run("error: uploaded before builds and already being built", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{})
+		legacy := readyFunction(1)
+		legacy.Build = nil
+
+		f.meta.EXPECT().GetFunction(ctx, &funcdomain.GetFunctionArgs{Name: fnName}).
+			Return(&funcdomain.GetFunctionResult{Function: legacy}, nil).Once()
+		// Another execution added the build between the read and the update.
+		f.meta.EXPECT().UpdateFunction(ctx, fnName, uint64(1), mock.Anything).
+			RunAndReturn(func(_ context.Context, _ funcdomain.FunctionName, _ uint64, mutate func(*funcdomain.Function) error) (*funcdomain.Function, error) {
+				current := *legacy
+				current.Build = funcdomain.NewFunctionBuild()
+				require.NoError(t, mutate(&current))
+				return &current, nil
+			}).Once()
+
+		_, err := f.svc.ExecuteFunction(ctx, &funcdomain.ExecuteFunctionArgs{Name: fnName})
+		require.ErrorIs(t, err, funcdomain.ErrFunctionNotReady)
+	})
+}
+
+func TestService_InvokeFunction_ReturnsTaskWhenContextEnds(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	f := newFixture(t, funcsrv.Config{})
+
+	f.meta.EXPECT().GetFunction(ctx, mock.Anything).Return(&funcdomain.GetFunctionResult{Function: readyFunction(1)}, nil).Once()
+	f.tasks.EXPECT().CreateTask(ctx, mock.Anything).Return(&taskdomain.CreateTaskResult{Name: "tasks/1"}, nil).Once()
+	f.tasks.EXPECT().WaitTask(ctx, mock.Anything).
+		RunAndReturn(func(ctx context.Context, _ *taskdomain.WaitTaskArgs) (*taskdomain.WaitTaskResult, error) {
+			cancel()
+			return nil, ctx.Err()
+		}).
+		Once()
+
+	res, err := f.svc.InvokeFunction(ctx, &funcdomain.InvokeFunctionArgs{ExecuteFunctionArgs: funcdomain.ExecuteFunctionArgs{Name: fnName}})
+	require.NoError(t, err)
+	require.Equal(t, taskdomain.TaskName("tasks/1"), res.Task.Name)
+	require.False(t, res.Done)
+}
+
+func TestService_WriteUploadChunk(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+	name := funcdomain.NewUploadSessionName(id)
+	chunk := []byte("chunk")
+	checksum := crc32.Checksum(chunk, crc32.MakeTable(crc32.Castagnoli))
+
+	// updateWith runs the mutation against session like the repository does.
+	updateWith := func(session *funcdomain.UploadSession) func(context.Context, uuid.UUID, func(*funcdomain.UploadSession) error) (*funcdomain.UploadSession, error) {
+		return func(_ context.Context, _ uuid.UUID, mutate func(*funcdomain.UploadSession) error) (*funcdomain.UploadSession, error) {
+			if err := mutate(session); err != nil {
+				return nil, err
+			}
+			return session, nil
+		}
+	}
+
+	t.Run("ok: stores the part and advances the offset", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{})
+
+		f.meta.EXPECT().GetUploadSession(ctx, id).Return(&funcdomain.UploadSession{ID: id, CommittedOffset: 5}, nil).Once()
+		f.obj.EXPECT().SaveUploadPart(ctx, id, mock.MatchedBy(func(p funcdomain.UploadPart) bool {
+			return p.Offset == 5 && p.Size == uint64(len(chunk))
+		}), mock.Anything).Return(nil).Once()
+		f.meta.EXPECT().UpdateUploadSession(ctx, id, mock.Anything).
+			RunAndReturn(updateWith(&funcdomain.UploadSession{ID: id, CommittedOffset: 5})).Once()
+
+		res, err := f.svc.WriteUploadChunk(ctx, &funcdomain.WriteUploadChunkArgs{Name: name, Offset: 5, Data: chunk, CRC32C: checksum})
+		require.NoError(t, err)
+		require.Equal(t, uint64(10), res.Session.CommittedOffset)
+		require.Len(t, res.Session.Parts, 1)
+	})
+
+	t.Run("error: checksum mismatch", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{})
+
+		_, err := f.svc.WriteUploadChunk(ctx, &funcdomain.WriteUploadChunkArgs{Name: name, Data: chunk, CRC32C: checksum + 1})
+		require.ErrorIs(t, err, funcdomain.ErrChunkChecksumMismatch)
+	})
+
+	t.Run("error: offset mismatch", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{})
+
+		f.meta.EXPECT().GetUploadSession(ctx, id).Return(&funcdomain.UploadSession{ID: id, CommittedOffset: 5}, nil).Once()
+
+		_, err := f.svc.WriteUploadChunk(ctx, &funcdomain.WriteUploadChunkArgs{Name: name, Offset: 0, Data: chunk, CRC32C: checksum})
+		require.ErrorIs(t, err, funcdomain.ErrUploadOffsetMismatch)
+	})
+
+	t.Run("error: session is finalizing", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{})
+
+		f.meta.EXPECT().GetUploadSession(ctx, id).Return(&funcdomain.UploadSession{ID: id, Finalizing: true}, nil).Once()
+
+		_, err := f.svc.WriteUploadChunk(ctx, &funcdomain.WriteUploadChunkArgs{Name: name, Data: chunk, CRC32C: checksum})
+		require.ErrorIs(t, err, funcdomain.ErrUploadFinalizing)
+	})
+
+	t.Run("error: session is finalized", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{})
+
+		f.meta.EXPECT().GetUploadSession(ctx, id).Return(&funcdomain.UploadSession{ID: id, Revision: 2}, nil).Once()
+
+		_, err := f.svc.WriteUploadChunk(ctx, &funcdomain.WriteUploadChunkArgs{Name: name, Data: chunk, CRC32C: checksum})
+		require.ErrorIs(t, err, funcdomain.ErrUploadFinalized)
+	})
+
+	t.Run("error: a concurrent chunk wins and the part is deleted", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{})
+
+		f.meta.EXPECT().GetUploadSession(ctx, id).Return(&funcdomain.UploadSession{ID: id}, nil).Once()
+		f.obj.EXPECT().SaveUploadPart(ctx, id, mock.Anything, mock.Anything).Return(nil).Once()
+		f.meta.EXPECT().UpdateUploadSession(ctx, id, mock.Anything).
+			RunAndReturn(updateWith(&funcdomain.UploadSession{ID: id, CommittedOffset: uint64(len(chunk))})).Once()
+		f.obj.EXPECT().DeleteUploadPart(ctx, id, mock.Anything).Return(nil).Once()
+
+		_, err := f.svc.WriteUploadChunk(ctx, &funcdomain.WriteUploadChunkArgs{Name: name, Data: chunk, CRC32C: checksum})
+		require.ErrorIs(t, err, funcdomain.ErrUploadOffsetMismatch)
+	})
+}
+
+func TestService_FinalizeUpload(t *testing.T) {
+	ctx := context.Background()
+	data, sum := validBundle(t)
+	id := uuid.New()
+	part := funcdomain.UploadPart{ID: uuid.New(), Size: uint64(len(data))}
+
+	// expectUpdate runs the mutation against session like the repository
+	// does; the reset after a failure runs on a detached context.
+	expectUpdate := func(f *fixture, session *funcdomain.UploadSession) {
+		f.meta.EXPECT().UpdateUploadSession(mock.Anything, id, mock.Anything).
+			RunAndReturn(func(_ context.Context, _ uuid.UUID, mutate func(*funcdomain.UploadSession) error) (*funcdomain.UploadSession, error) {
+				if err := mutate(session); err != nil {
+					return nil, err
+				}
+				return session, nil
+			}).
+			Once()
+	}
+
+	t.Run("ok: the session records the revision and its parts are deleted best-effort", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{})
+		session := &funcdomain.UploadSession{
+			ID: id, Function: fnName, Format: funcdomain.ZipFormat,
+			Size: part.Size, CommittedOffset: part.Size, Parts: []funcdomain.UploadPart{part},
+		}
+
+		expectUpdate(f, session)
+		f.meta.EXPECT().GetFunction(mock.Anything, mock.Anything).Return(nil, funcdomain.ErrFunctionNotFound).Once()
+		f.meta.EXPECT().RefBlob(mock.Anything, sum, namespace, false).Return(storedBundle(data, sum), false, nil).Once()
+		f.expectOpenBundle(data)
+		f.meta.EXPECT().CreateRevision(mock.Anything, mock.Anything).Return(nil).Once()
+		f.pub.EXPECT().PublishBuild(mock.Anything, mock.Anything).Return(nil).Once()
+		expectUpdate(f, session)
+		f.obj.EXPECT().DeleteUploadPart(mock.Anything, id, part).Return(errors.New("object store unavailable")).Once()
+
+		res, err := f.svc.FinalizeUpload(ctx, &funcdomain.FinalizeUploadArgs{Name: funcdomain.NewUploadSessionName(id), SHA256: sum})
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), res.Function.Revision)
+		require.False(t, session.Finalizing)
+		require.Equal(t, uint64(1), session.Revision)
+		require.Empty(t, session.Parts)
+	})
+
+	t.Run("ok: finalizing again returns the recorded revision", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{})
+		fn := readyFunction(3)
+		fn.Bundle = storedBundle(data, sum)
+
+		expectUpdate(f, &funcdomain.UploadSession{ID: id, Function: fnName, Revision: 3})
+		f.meta.EXPECT().GetFunction(ctx, &funcdomain.GetFunctionArgs{Name: fnName, Revision: 3, ShowDeleted: true}).
+			Return(&funcdomain.GetFunctionResult{Function: fn}, nil).Once()
+
+		res, err := f.svc.FinalizeUpload(ctx, &funcdomain.FinalizeUploadArgs{Name: funcdomain.NewUploadSessionName(id), SHA256: sum})
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), res.Function.Revision)
+	})
+
+	t.Run("error: finalizing again with another digest", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{})
+		fn := readyFunction(3)
+		fn.Bundle = storedBundle(data, sum)
+
+		expectUpdate(f, &funcdomain.UploadSession{ID: id, Function: fnName, Revision: 3})
+		f.meta.EXPECT().GetFunction(ctx, mock.Anything).Return(&funcdomain.GetFunctionResult{Function: fn}, nil).Once()
+
+		_, err := f.svc.FinalizeUpload(ctx, &funcdomain.FinalizeUploadArgs{Name: funcdomain.NewUploadSessionName(id), SHA256: strings.Repeat("0", 64)})
+		require.ErrorIs(t, err, funcdomain.ErrDigestMismatch)
+	})
+
+	t.Run("error: another finalize is running", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{FinalizeTimeout: time.Hour})
+
+		expectUpdate(f, &funcdomain.UploadSession{ID: id, Finalizing: true, FinalizeStartedAt: time.Now().Add(-time.Minute)})
+
+		_, err := f.svc.FinalizeUpload(ctx, &funcdomain.FinalizeUploadArgs{Name: funcdomain.NewUploadSessionName(id), SHA256: sum})
+		require.ErrorIs(t, err, funcdomain.ErrUploadFinalizing)
+	})
+
+	t.Run("ok: a finalize past its timeout is started again", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{FinalizeTimeout: time.Minute})
+		started := time.Now().Add(-time.Hour)
+		session := &funcdomain.UploadSession{ID: id, Function: fnName, Format: "rar", Finalizing: true, FinalizeStartedAt: started}
+
+		expectUpdate(f, session)
+		expectUpdate(f, session)
+
+		// The upload itself ran: it rejects the format.
+		_, err := f.svc.FinalizeUpload(ctx, &funcdomain.FinalizeUploadArgs{Name: funcdomain.NewUploadSessionName(id), SHA256: sum})
+		require.ErrorIs(t, err, funcdomain.ErrUnsupportedFormat)
+		require.True(t, session.FinalizeStartedAt.After(started))
+		require.False(t, session.Finalizing)
+	})
+
+	t.Run("error: incomplete upload", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{})
+
+		expectUpdate(f, &funcdomain.UploadSession{ID: id, Size: 10, CommittedOffset: 5})
+
+		_, err := f.svc.FinalizeUpload(ctx, &funcdomain.FinalizeUploadArgs{Name: funcdomain.NewUploadSessionName(id), SHA256: sum})
+		require.ErrorIs(t, err, funcdomain.ErrUploadIncomplete)
+	})
+
+	t.Run("error: a failed upload clears finalizing for a retry", func(t *testing.T) {
+		f := newFixture(t, funcsrv.Config{})
+		session := &funcdomain.UploadSession{ID: id, Function: fnName, Format: "rar"}
+
+		expectUpdate(f, session)
+		expectUpdate(f, session)
+
+		_, err := f.svc.FinalizeUpload(ctx, &funcdomain.FinalizeUploadArgs{Name: funcdomain.NewUploadSessionName(id), SHA256: sum})
+		require.ErrorIs(t, err, funcdomain.ErrUnsupportedFormat)
+		require.False(t, session.Finalizing)
+	})
+}
+
+func TestService_ExpireUploadSessions(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	f := newFixture(t, funcsrv.Config{})
+
+	part := funcdomain.UploadPart{ID: uuid.New(), Size: 5}
+	expired := &funcdomain.UploadSession{ID: uuid.New(), ExpiresAt: now.Add(-time.Minute), Parts: []funcdomain.UploadPart{part}, ETag: 4}
+	fresh := &funcdomain.UploadSession{ID: uuid.New(), ExpiresAt: now.Add(time.Minute), ETag: 5}
+	written := &funcdomain.UploadSession{ID: uuid.New(), ExpiresAt: now.Add(-time.Minute), ETag: 9}
+
+	f.meta.EXPECT().ListUploadSessions(ctx).Return([]*funcdomain.UploadSession{expired, fresh, written}, nil).Once()
+	f.meta.EXPECT().DeleteUploadSession(ctx, expired.ID, uint64(4)).Return(nil).Once()
+	f.obj.EXPECT().DeleteUploadPart(ctx, expired.ID, part).Return(nil).Once()
+	// A chunk arrived after the listing; the session stays.
+	f.meta.EXPECT().DeleteUploadSession(ctx, written.ID, uint64(9)).Return(funcdomain.ErrUploadModified).Once()
+
+	removed, err := f.svc.ExpireUploadSessions(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, 1, removed)
+}
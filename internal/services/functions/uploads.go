@@ -0,0 +1,331 @@
+package funcsrv
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"time"
+
+	digestutils "github.com/10Narratives/faas/pkg/digest"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	"github.com/google/uuid"
+)
+
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+func (s *Service) StartUpload(ctx context.Context, args *funcdomain.StartUploadArgs) (*funcdomain.UploadSessionResult, error) {
+	if args == nil || args.Upload == nil || args.Upload.Name == "" {
+		return nil, funcdomain.ErrInvalidArgument
+	}
+	up := args.Upload
+	if !isSupportedFormat(up.Format) {
+		return nil, funcdomain.ErrUnsupportedFormat
+	}
+	if s.cfg.MaxBundleSize > 0 && args.Size > s.cfg.MaxBundleSize {
+		return nil, funcdomain.ErrBundleTooLarge
+	}
+	if err := s.checkSecrets(ctx, up.SecretEnv); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	session := &funcdomain.UploadSession{
+		ID:          uuid.New(),
+		Function:    up.Name,
+		DisplayName: up.DisplayName,
+		Description: up.Description,
+		Labels:      up.Labels,
+		Annotations: up.Annotations,
+		Timeout:     up.Timeout,
+		MemoryBytes: up.MemoryBytes,
+		Runtime:     up.Runtime,
+		Format:      up.Format,
+		Env:         up.Env,
+		SecretEnv:   up.SecretEnv,
+		Size:        args.Size,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		ExpiresAt:   now.Add(s.cfg.UploadSessionTTL),
+	}
+	if err := s.funcMetaRepo.CreateUploadSession(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return &funcdomain.UploadSessionResult{Session: session}, nil
+}
+
+// WriteUploadChunk stores a chunk and advances the committed offset. Chunks
+// must arrive in order; after a reconnect the client continues from the
+// offset reported by GetUploadSession.
+func (s *Service) WriteUploadChunk(ctx context.Context, args *funcdomain.WriteUploadChunkArgs) (*funcdomain.UploadSessionResult, error) {
+	if args == nil {
+		return nil, funcdomain.ErrInvalidArgument
+	}
+	_, id, err := funcdomain.ParseUploadSessionName(string(args.Name))
+	if err != nil {
+		return nil, err
+	}
+	if got := crc32.Checksum(args.Data, castagnoli); got != args.CRC32C {
+		return nil, fmt.Errorf("%w: declared %08x, received %08x", funcdomain.ErrChunkChecksumMismatch, args.CRC32C, got)
+	}
+
+	session, err := s.funcMetaRepo.GetUploadSession(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkChunk(session, args); err != nil {
+		return nil, err
+	}
+	if len(args.Data) == 0 {
+		return &funcdomain.UploadSessionResult{Session: session}, nil
+	}
+
+	part := funcdomain.UploadPart{ID: uuid.New(), Offset: args.Offset, Size: uint64(len(args.Data))}
+	if err := s.funcObjRepo.SaveUploadPart(ctx, id, part, bytes.NewReader(args.Data)); err != nil {
+		return nil, err
+	}
+
+	session, err = s.funcMetaRepo.UpdateUploadSession(ctx, id, func(session *funcdomain.UploadSession) error {
+		if err := s.checkChunk(session, args); err != nil {
+			return err
+		}
+		now := time.Now().UTC()
+		session.Parts = append(session.Parts, part)
+		session.CommittedOffset += part.Size
+		session.UpdatedAt = now
+		session.ExpiresAt = now.Add(s.cfg.UploadSessionTTL)
+		return nil
+	})
+	if err != nil {
+		_ = s.funcObjRepo.DeleteUploadPart(ctx, id, part)
+		return nil, err
+	}
+
+	return &funcdomain.UploadSessionResult{Session: session}, nil
+}
+
+func (s *Service) checkChunk(session *funcdomain.UploadSession, args *funcdomain.WriteUploadChunkArgs) error {
+	if session.Revision != 0 {
+		return funcdomain.ErrUploadFinalized
+	}
+	if session.Finalizing {
+		return funcdomain.ErrUploadFinalizing
+	}
+	if args.Offset != session.CommittedOffset {
+		return fmt.Errorf("%w: offset %d, committed %d", funcdomain.ErrUploadOffsetMismatch, args.Offset, session.CommittedOffset)
+	}
+
+	end := args.Offset + uint64(len(args.Data))
+	if s.cfg.MaxBundleSize > 0 && end > s.cfg.MaxBundleSize {
+		return funcdomain.ErrBundleTooLarge
+	}
+	if session.Size > 0 && end > session.Size {
+		return fmt.Errorf("%w: chunk ends at %d, past the declared size %d", funcdomain.ErrInvalidArgument, end, session.Size)
+	}
+	return nil
+}
+
+func (s *Service) GetUploadSession(ctx context.Context, args *funcdomain.GetUploadSessionArgs) (*funcdomain.UploadSessionResult, error) {
+	if args == nil {
+		return nil, funcdomain.ErrInvalidArgument
+	}
+	_, id, err := funcdomain.ParseUploadSessionName(string(args.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.funcMetaRepo.GetUploadSession(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &funcdomain.UploadSessionResult{Session: session}, nil
+}
+
+// FinalizeUpload runs a regular upload over the stored parts. The session
+// then records the revision, without its parts, until it expires, so a
+// client that lost the response gets the same revision by finalizing
+// again. On failure the session is kept so the client can fix the problem,
+// e.g. resend a corrupted tail, and retry.
+func (s *Service) FinalizeUpload(ctx context.Context, args *funcdomain.FinalizeUploadArgs) (*funcdomain.UploadFunctionResult, error) {
+	if args == nil {
+		return nil, funcdomain.ErrInvalidArgument
+	}
+	_, id, err := funcdomain.ParseUploadSessionName(string(args.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	session, err := s.funcMetaRepo.UpdateUploadSession(ctx, id, func(session *funcdomain.UploadSession) error {
+		if session.Revision != 0 {
+			return nil
+		}
+		if session.Finalizing && now.Sub(session.FinalizeStartedAt) < s.cfg.FinalizeTimeout {
+			return funcdomain.ErrUploadFinalizing
+		}
+		if session.Size > 0 && session.CommittedOffset != session.Size {
+			return fmt.Errorf("%w: committed %d of %d bytes", funcdomain.ErrUploadIncomplete, session.CommittedOffset, session.Size)
+		}
+		// Expiry restarts so the session outlives the upload it turns into.
+		session.Finalizing = true
+		session.FinalizeStartedAt = now
+		session.ExpiresAt = now.Add(s.cfg.UploadSessionTTL)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if session.Revision != 0 {
+		return s.finalizedUpload(ctx, session, args.SHA256)
+	}
+
+	// Bounded, so that the session is not finalized again while this
+	// upload may still create a revision.
+	uploadCtx, cancel := context.WithTimeout(ctx, s.cfg.FinalizeTimeout)
+	defer cancel()
+
+	data := &partsReader{ctx: uploadCtx, objects: s.funcObjRepo, session: id, parts: session.Parts}
+	res, err := s.UploadFunction(uploadCtx, session.UploadArgs(args.SHA256, data))
+	if err != nil {
+		_, _ = s.funcMetaRepo.UpdateUploadSession(context.WithoutCancel(ctx), id, func(session *funcdomain.UploadSession) error {
+			session.Finalizing = false
+			return nil
+		})
+		return nil, err
+	}
+
+	// The revision exists, so the upload succeeded whatever happens to the
+	// session. Parts left behind by a failure here expire with it.
+	cleanupCtx := context.WithoutCancel(ctx)
+	parts := session.Parts
+	_, err = s.funcMetaRepo.UpdateUploadSession(cleanupCtx, id, func(session *funcdomain.UploadSession) error {
+		session.Finalizing = false
+		session.Revision = res.Function.Revision
+		session.Parts = nil
+		return nil
+	})
+	if err == nil {
+		_ = s.deleteParts(cleanupCtx, id, parts)
+	}
+	return res, nil
+}
+
+// finalizedUpload returns the revision a session was finalized into, if the
+// digest declared this time matches the one it was uploaded with.
+func (s *Service) finalizedUpload(ctx context.Context, session *funcdomain.UploadSession, sha256 string) (*funcdomain.UploadFunctionResult, error) {
+	got, err := s.funcMetaRepo.GetFunction(ctx, &funcdomain.GetFunctionArgs{
+		Name:        session.Function,
+		Revision:    session.Revision,
+		ShowDeleted: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	declared, err := digestutils.NormalizeSHA256(sha256)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", funcdomain.ErrInvalidDigest, sha256)
+	}
+	if got.Function.Bundle == nil || got.Function.Bundle.SHA256 != declared {
+		return nil, fmt.Errorf("%w: session %s was finalized with another bundle", funcdomain.ErrDigestMismatch, session.ID)
+	}
+	return &funcdomain.UploadFunctionResult{Function: got.Function}, nil
+}
+
+// ExpireUploadSessions removes sessions that received no chunks within the
+// session TTL, together with their parts. A session written since it was
+// listed, e.g. by a late chunk, is kept.
+func (s *Service) ExpireUploadSessions(ctx context.Context, now time.Time) (int, error) {
+	sessions, err := s.funcMetaRepo.ListUploadSessions(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		removed int
+		errs    []error
+	)
+	for _, session := range sessions {
+		if now.Before(session.ExpiresAt) {
+			continue
+		}
+		err := s.discardUpload(ctx, session, session.ETag)
+		switch {
+		case err == nil:
+			removed++
+		case errors.Is(err, funcdomain.ErrUploadModified):
+		default:
+			errs = append(errs, fmt.Errorf("expire upload %s: %w", session.ID, err))
+		}
+	}
+	return removed, errors.Join(errs...)
+}
+
+// discardUpload removes the session record, only at etag unless it is 0,
+// and then its parts. Deleting the record first means no one can add parts
+// afterwards; parts left behind by a failure are garbage-collected.
+func (s *Service) discardUpload(ctx context.Context, session *funcdomain.UploadSession, etag uint64) error {
+	if err := s.funcMetaRepo.DeleteUploadSession(ctx, session.ID, etag); err != nil {
+		return err
+	}
+	return s.deleteParts(ctx, session.ID, session.Parts)
+}
+
+func (s *Service) deleteParts(ctx context.Context, id uuid.UUID, parts []funcdomain.UploadPart) error {
+	var errs []error
+	for _, part := range parts {
+		if err := s.funcObjRepo.DeleteUploadPart(ctx, id, part); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// partsReader reads the parts of a session back to back, opening each one
+// only when the previous is exhausted.
+type partsReader struct {
+	ctx     context.Context
+	objects FunctionObjectRepository
+	session uuid.UUID
+	parts   []funcdomain.UploadPart
+	cur     io.ReadCloser
+}
+
+func (r *partsReader) Read(p []byte) (int, error) {
+	for {
+		if r.cur == nil {
+			if len(r.parts) == 0 {
+				return 0, io.EOF
+			}
+			rc, err := r.objects.OpenUploadPart(r.ctx, r.session, r.parts[0])
+			if err != nil {
+				return 0, err
+			}
+			r.cur, r.parts = rc, r.parts[1:]
+		}
+
+		n, err := r.cur.Read(p)
+		if errors.Is(err, io.EOF) {
+			_ = r.cur.Close()
+			r.cur = nil
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+		return n, err
+	}
+}
+
+func (r *partsReader) Close() error {
+	if r.cur == nil {
+		return nil
+	}
+	err := r.cur.Close()
+	r.cur = nil
+	return err
+}
@@ -0,0 +1,147 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	gcdomain "github.com/10Narratives/faas/internal/domains/gc"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// FunctionMetadataScanner is an autogenerated mock type for the FunctionMetadataScanner type
+type FunctionMetadataScanner struct {
+	mock.Mock
+}
+
+type FunctionMetadataScanner_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FunctionMetadataScanner) EXPECT() *FunctionMetadataScanner_Expecter {
+	return &FunctionMetadataScanner_Expecter{mock: &_m.Mock}
+}
+
+// DeleteRecord provides a mock function with given fields: ctx, key, revision
+func (_m *FunctionMetadataScanner) DeleteRecord(ctx context.Context, key string, revision uint64) error {
+	ret := _m.Called(ctx, key, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRecord")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64) error); ok {
+		r0 = rf(ctx, key, revision)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FunctionMetadataScanner_DeleteRecord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRecord'
+type FunctionMetadataScanner_DeleteRecord_Call struct {
+	*mock.Call
+}
+
+// DeleteRecord is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - revision uint64
+func (_e *FunctionMetadataScanner_Expecter) DeleteRecord(ctx interface{}, key interface{}, revision interface{}) *FunctionMetadataScanner_DeleteRecord_Call {
+	return &FunctionMetadataScanner_DeleteRecord_Call{Call: _e.mock.On("DeleteRecord", ctx, key, revision)}
+}
+
+func (_c *FunctionMetadataScanner_DeleteRecord_Call) Run(run func(ctx context.Context, key string, revision uint64)) *FunctionMetadataScanner_DeleteRecord_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataScanner_DeleteRecord_Call) Return(_a0 error) *FunctionMetadataScanner_DeleteRecord_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FunctionMetadataScanner_DeleteRecord_Call) RunAndReturn(run func(context.Context, string, uint64) error) *FunctionMetadataScanner_DeleteRecord_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ScanGarbage provides a mock function with given fields: ctx, cutoff
+func (_m *FunctionMetadataScanner) ScanGarbage(ctx context.Context, cutoff time.Time) (*gcdomain.MetadataScan, error) {
+	ret := _m.Called(ctx, cutoff)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScanGarbage")
+	}
+
+	var r0 *gcdomain.MetadataScan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (*gcdomain.MetadataScan, error)); ok {
+		return rf(ctx, cutoff)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) *gcdomain.MetadataScan); ok {
+		r0 = rf(ctx, cutoff)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gcdomain.MetadataScan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, cutoff)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionMetadataScanner_ScanGarbage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScanGarbage'
+type FunctionMetadataScanner_ScanGarbage_Call struct {
+	*mock.Call
+}
+
+// ScanGarbage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cutoff time.Time
+func (_e *FunctionMetadataScanner_Expecter) ScanGarbage(ctx interface{}, cutoff interface{}) *FunctionMetadataScanner_ScanGarbage_Call {
+	return &FunctionMetadataScanner_ScanGarbage_Call{Call: _e.mock.On("ScanGarbage", ctx, cutoff)}
+}
+
+func (_c *FunctionMetadataScanner_ScanGarbage_Call) Run(run func(ctx context.Context, cutoff time.Time)) *FunctionMetadataScanner_ScanGarbage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *FunctionMetadataScanner_ScanGarbage_Call) Return(_a0 *gcdomain.MetadataScan, _a1 error) *FunctionMetadataScanner_ScanGarbage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionMetadataScanner_ScanGarbage_Call) RunAndReturn(run func(context.Context, time.Time) (*gcdomain.MetadataScan, error)) *FunctionMetadataScanner_ScanGarbage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFunctionMetadataScanner creates a new instance of FunctionMetadataScanner. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFunctionMetadataScanner(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FunctionMetadataScanner {
+	mock := &FunctionMetadataScanner{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
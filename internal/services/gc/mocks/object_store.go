@@ -0,0 +1,143 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	gcdomain "github.com/10Narratives/faas/internal/domains/gc"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ObjectStore is an autogenerated mock type for the ObjectStore type
+type ObjectStore struct {
+	mock.Mock
+}
+
+type ObjectStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ObjectStore) EXPECT() *ObjectStore_Expecter {
+	return &ObjectStore_Expecter{mock: &_m.Mock}
+}
+
+// DeleteObject provides a mock function with given fields: ctx, key
+func (_m *ObjectStore) DeleteObject(ctx context.Context, key string) error {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteObject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ObjectStore_DeleteObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteObject'
+type ObjectStore_DeleteObject_Call struct {
+	*mock.Call
+}
+
+// DeleteObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *ObjectStore_Expecter) DeleteObject(ctx interface{}, key interface{}) *ObjectStore_DeleteObject_Call {
+	return &ObjectStore_DeleteObject_Call{Call: _e.mock.On("DeleteObject", ctx, key)}
+}
+
+func (_c *ObjectStore_DeleteObject_Call) Run(run func(ctx context.Context, key string)) *ObjectStore_DeleteObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ObjectStore_DeleteObject_Call) Return(_a0 error) *ObjectStore_DeleteObject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ObjectStore_DeleteObject_Call) RunAndReturn(run func(context.Context, string) error) *ObjectStore_DeleteObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListObjects provides a mock function with given fields: ctx
+func (_m *ObjectStore) ListObjects(ctx context.Context) ([]gcdomain.Object, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListObjects")
+	}
+
+	var r0 []gcdomain.Object
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]gcdomain.Object, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []gcdomain.Object); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]gcdomain.Object)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ObjectStore_ListObjects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListObjects'
+type ObjectStore_ListObjects_Call struct {
+	*mock.Call
+}
+
+// ListObjects is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ObjectStore_Expecter) ListObjects(ctx interface{}) *ObjectStore_ListObjects_Call {
+	return &ObjectStore_ListObjects_Call{Call: _e.mock.On("ListObjects", ctx)}
+}
+
+func (_c *ObjectStore_ListObjects_Call) Run(run func(ctx context.Context)) *ObjectStore_ListObjects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ObjectStore_ListObjects_Call) Return(_a0 []gcdomain.Object, _a1 error) *ObjectStore_ListObjects_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ObjectStore_ListObjects_Call) RunAndReturn(run func(context.Context) ([]gcdomain.Object, error)) *ObjectStore_ListObjects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewObjectStore creates a new instance of ObjectStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewObjectStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ObjectStore {
+	mock := &ObjectStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
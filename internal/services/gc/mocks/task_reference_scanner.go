@@ -0,0 +1,94 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TaskReferenceScanner is an autogenerated mock type for the TaskReferenceScanner type
+type TaskReferenceScanner struct {
+	mock.Mock
+}
+
+type TaskReferenceScanner_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TaskReferenceScanner) EXPECT() *TaskReferenceScanner_Expecter {
+	return &TaskReferenceScanner_Expecter{mock: &_m.Mock}
+}
+
+// ReferencedObjects provides a mock function with given fields: ctx
+func (_m *TaskReferenceScanner) ReferencedObjects(ctx context.Context) (map[string]bool, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReferencedObjects")
+	}
+
+	var r0 map[string]bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]bool, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]bool); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TaskReferenceScanner_ReferencedObjects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReferencedObjects'
+type TaskReferenceScanner_ReferencedObjects_Call struct {
+	*mock.Call
+}
+
+// ReferencedObjects is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *TaskReferenceScanner_Expecter) ReferencedObjects(ctx interface{}) *TaskReferenceScanner_ReferencedObjects_Call {
+	return &TaskReferenceScanner_ReferencedObjects_Call{Call: _e.mock.On("ReferencedObjects", ctx)}
+}
+
+func (_c *TaskReferenceScanner_ReferencedObjects_Call) Run(run func(ctx context.Context)) *TaskReferenceScanner_ReferencedObjects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *TaskReferenceScanner_ReferencedObjects_Call) Return(_a0 map[string]bool, _a1 error) *TaskReferenceScanner_ReferencedObjects_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TaskReferenceScanner_ReferencedObjects_Call) RunAndReturn(run func(context.Context) (map[string]bool, error)) *TaskReferenceScanner_ReferencedObjects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTaskReferenceScanner creates a new instance of TaskReferenceScanner. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTaskReferenceScanner(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TaskReferenceScanner {
+	mock := &TaskReferenceScanner{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,170 @@
+package gcsrv
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	gcdomain "github.com/10Narratives/faas/internal/domains/gc"
+)
+
+//go:generate mockery --name FunctionMetadataScanner --output ./mocks --outpkg mocks --with-expecter --filename function_metadata_scanner.go
+type FunctionMetadataScanner interface {
+	ScanGarbage(ctx context.Context, cutoff time.Time) (*gcdomain.MetadataScan, error)
+	DeleteRecord(ctx context.Context, key string, revision uint64) error
+}
+
+//go:generate mockery --name TaskReferenceScanner --output ./mocks --outpkg mocks --with-expecter --filename task_reference_scanner.go
+type TaskReferenceScanner interface {
+	ReferencedObjects(ctx context.Context) (map[string]bool, error)
+}
+
+//go:generate mockery --name ObjectStore --output ./mocks --outpkg mocks --with-expecter --filename object_store.go
+type ObjectStore interface {
+	ListObjects(ctx context.Context) ([]gcdomain.Object, error)
+	DeleteObject(ctx context.Context, key string) error
+}
+
+type Config struct {
+	// MinAge is used when a collection does not ask for one; objects and
+	// records written within it are never collected.
+	MinAge time.Duration
+}
+
+type Service struct {
+	cfg         Config
+	funcMeta    FunctionMetadataScanner
+	funcObjects ObjectStore
+	tasks       TaskReferenceScanner
+	taskObjects ObjectStore
+}
+
+func NewService(
+	cfg Config,
+	funcMeta FunctionMetadataScanner,
+	funcObjects ObjectStore,
+	tasks TaskReferenceScanner,
+	taskObjects ObjectStore,
+) *Service {
+	if cfg.MinAge < gcdomain.MinMinAge {
+		cfg.MinAge = time.Hour
+	}
+
+	return &Service{
+		cfg:         cfg,
+		funcMeta:    funcMeta,
+		funcObjects: funcObjects,
+		tasks:       tasks,
+		taskObjects: taskObjects,
+	}
+}
+
+// CollectGarbage finds objects no record references and records that point
+// at nothing, in both object stores and the functions bucket. With Delete
+// they are removed, records first so that the objects they still pin are
+// collected by the next run.
+//
+// The references are read before the objects are listed, so an object
+// written in between is at most MinAge old and survives.
+func (s *Service) CollectGarbage(ctx context.Context, args *gcdomain.CollectGarbageArgs) (*gcdomain.CollectGarbageResult, error) {
+	if args == nil {
+		return nil, gcdomain.ErrInvalidArgument
+	}
+	minAge := args.MinAge
+	switch {
+	case minAge == 0:
+		minAge = s.cfg.MinAge
+	case minAge < gcdomain.MinMinAge:
+		return nil, fmt.Errorf("%w: min_age must be at least %s", gcdomain.ErrInvalidArgument, gcdomain.MinMinAge)
+	}
+	cutoff := time.Now().Add(-minAge)
+
+	scan, err := s.funcMeta.ScanGarbage(ctx, cutoff)
+	if err != nil {
+		return nil, fmt.Errorf("scan function records: %w", err)
+	}
+	taskRefs, err := s.tasks.ReferencedObjects(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("scan task records: %w", err)
+	}
+
+	funcOrphans, err := unreferenced(ctx, s.funcObjects, gcdomain.StoreFunctionObjects, scan.Referenced, cutoff)
+	if err != nil {
+		return nil, fmt.Errorf("list function objects: %w", err)
+	}
+	taskOrphans, err := unreferenced(ctx, s.taskObjects, gcdomain.StoreTaskObjects, taskRefs, cutoff)
+	if err != nil {
+		return nil, fmt.Errorf("list task objects: %w", err)
+	}
+
+	res := &gcdomain.CollectGarbageResult{}
+	res.Orphans = append(res.Orphans, scan.Dangling...)
+	res.Orphans = append(res.Orphans, funcOrphans...)
+	res.Orphans = append(res.Orphans, taskOrphans...)
+	if !args.Delete {
+		return res, nil
+	}
+
+	for _, o := range res.Orphans {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		var err error
+		switch o.Store {
+		case gcdomain.StoreFunctionRecords:
+			err = s.funcMeta.DeleteRecord(ctx, o.Key, o.Revision)
+		case gcdomain.StoreFunctionObjects:
+			err = s.funcObjects.DeleteObject(ctx, o.Key)
+		case gcdomain.StoreTaskObjects:
+			err = s.taskObjects.DeleteObject(ctx, o.Key)
+		}
+		if err != nil {
+			o.Error = err.Error()
+			res.Failed++
+			continue
+		}
+		o.Deleted = true
+		res.Deleted++
+	}
+	return res, nil
+}
+
+func unreferenced(ctx context.Context, store ObjectStore, name string, refs map[string]bool, cutoff time.Time) ([]*gcdomain.Orphan, error) {
+	objects, err := store.ListObjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*gcdomain.Orphan
+	for _, obj := range objects {
+		if refs[obj.Key] || !obj.ModTime.Before(cutoff) {
+			continue
+		}
+		out = append(out, &gcdomain.Orphan{
+			Kind:      objectKind(name, obj.Key),
+			Store:     name,
+			Key:       obj.Key,
+			Size:      obj.Size,
+			Reason:    "not referenced",
+			UpdatedAt: obj.ModTime,
+		})
+	}
+	return out, nil
+}
+
+func objectKind(store, key string) string {
+	if store == gcdomain.StoreTaskObjects {
+		return gcdomain.KindTaskFile
+	}
+	switch {
+	case strings.HasPrefix(key, "bundles/"):
+		return gcdomain.KindBundle
+	case strings.HasPrefix(key, "artifacts/"):
+		return gcdomain.KindArtifact
+	case strings.HasPrefix(key, "uploads/"):
+		return gcdomain.KindUploadPart
+	}
+	return gcdomain.KindObject
+}
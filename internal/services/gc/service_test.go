@@ -0,0 +1,110 @@
+package gcsrv_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	gcdomain "github.com/10Narratives/faas/internal/domains/gc"
+	gcsrv "github.com/10Narratives/faas/internal/services/gc"
+	"github.com/10Narratives/faas/internal/services/gc/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fixture struct {
+	meta        *mocks.FunctionMetadataScanner
+	funcObjects *mocks.ObjectStore
+	tasks       *mocks.TaskReferenceScanner
+	taskObjects *mocks.ObjectStore
+	svc         *gcsrv.Service
+}
+
+func newFixture(t *testing.T) *fixture {
+	f := &fixture{
+		meta:        mocks.NewFunctionMetadataScanner(t),
+		funcObjects: mocks.NewObjectStore(t),
+		tasks:       mocks.NewTaskReferenceScanner(t),
+		taskObjects: mocks.NewObjectStore(t),
+	}
+	f.svc = gcsrv.NewService(gcsrv.Config{MinAge: time.Hour}, f.meta, f.funcObjects, f.tasks, f.taskObjects)
+	return f
+}
+
+func (f *fixture) expectScan(ctx context.Context) {
+	old := time.Now().Add(-2 * time.Hour)
+
+	f.meta.EXPECT().ScanGarbage(ctx, mock.Anything).Return(&gcdomain.MetadataScan{
+		Referenced: map[string]bool{"bundles/sha256/aa/1": true},
+		Dangling: []*gcdomain.Orphan{{
+			Kind: gcdomain.KindAliasRecord, Store: gcdomain.StoreFunctionRecords,
+			Key: "alias.Zm9v.prod", Revision: 7,
+		}},
+	}, nil).Once()
+	f.tasks.EXPECT().ReferencedObjects(ctx).Return(map[string]bool{"t1/inputs/a": true}, nil).Once()
+	f.funcObjects.EXPECT().ListObjects(ctx).Return([]gcdomain.Object{
+		{Key: "bundles/sha256/aa/1", ModTime: old},
+		{Key: "bundles/sha256/bb/2", Size: 10, ModTime: old},
+		{Key: "artifacts/x", ModTime: time.Now()},
+	}, nil).Once()
+	f.taskObjects.EXPECT().ListObjects(ctx).Return([]gcdomain.Object{
+		{Key: "t1/inputs/a", ModTime: old},
+		{Key: "t2/outputs/b", ModTime: old},
+	}, nil).Once()
+}
+
+func TestCollectGarbage_DryRun(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	f.expectScan(ctx)
+
+	res, err := f.svc.CollectGarbage(ctx, &gcdomain.CollectGarbageArgs{})
+	require.NoError(t, err)
+	require.Zero(t, res.Deleted)
+
+	var keys []string
+	for _, o := range res.Orphans {
+		keys = append(keys, o.Kind+" "+o.Key)
+		require.False(t, o.Deleted)
+	}
+	require.Equal(t, []string{
+		"alias_record alias.Zm9v.prod",
+		"bundle bundles/sha256/bb/2",
+		"task_file t2/outputs/b",
+	}, keys)
+}
+
+func TestCollectGarbage_Delete(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	f.expectScan(ctx)
+
+	f.meta.EXPECT().DeleteRecord(ctx, "alias.Zm9v.prod", uint64(7)).Return(gcdomain.ErrModified).Once()
+	f.funcObjects.EXPECT().DeleteObject(ctx, "bundles/sha256/bb/2").Return(nil).Once()
+	f.taskObjects.EXPECT().DeleteObject(ctx, "t2/outputs/b").Return(nil).Once()
+
+	res, err := f.svc.CollectGarbage(ctx, &gcdomain.CollectGarbageArgs{Delete: true})
+	require.NoError(t, err)
+	require.Equal(t, 2, res.Deleted)
+	require.Equal(t, 1, res.Failed)
+	require.False(t, res.Orphans[0].Deleted)
+	require.Equal(t, gcdomain.ErrModified.Error(), res.Orphans[0].Error)
+	require.True(t, res.Orphans[1].Deleted)
+}
+
+func TestCollectGarbage_MinAgeTooSmall(t *testing.T) {
+	f := newFixture(t)
+
+	_, err := f.svc.CollectGarbage(context.Background(), &gcdomain.CollectGarbageArgs{MinAge: time.Minute})
+	require.ErrorIs(t, err, gcdomain.ErrInvalidArgument)
+}
+
+func TestCollectGarbage_ScanFailsBeforeListing(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	f.meta.EXPECT().ScanGarbage(ctx, mock.Anything).Return(nil, errors.New("boom")).Once()
+
+	_, err := f.svc.CollectGarbage(ctx, &gcdomain.CollectGarbageArgs{Delete: true})
+	require.Error(t, err)
+}
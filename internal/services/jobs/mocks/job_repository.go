@@ -0,0 +1,439 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	jobdomain "github.com/10Narratives/faas/internal/domains/jobs"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// JobRepository is an autogenerated mock type for the JobRepository type
+type JobRepository struct {
+	mock.Mock
+}
+
+type JobRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *JobRepository) EXPECT() *JobRepository_Expecter {
+	return &JobRepository_Expecter{mock: &_m.Mock}
+}
+
+// AddJobTasks provides a mock function with given fields: ctx, args
+func (_m *JobRepository) AddJobTasks(ctx context.Context, args *jobdomain.AddJobTasksArgs) (*jobdomain.AddJobTasksResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddJobTasks")
+	}
+
+	var r0 *jobdomain.AddJobTasksResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *jobdomain.AddJobTasksArgs) (*jobdomain.AddJobTasksResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *jobdomain.AddJobTasksArgs) *jobdomain.AddJobTasksResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*jobdomain.AddJobTasksResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *jobdomain.AddJobTasksArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobRepository_AddJobTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddJobTasks'
+type JobRepository_AddJobTasks_Call struct {
+	*mock.Call
+}
+
+// AddJobTasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *jobdomain.AddJobTasksArgs
+func (_e *JobRepository_Expecter) AddJobTasks(ctx interface{}, args interface{}) *JobRepository_AddJobTasks_Call {
+	return &JobRepository_AddJobTasks_Call{Call: _e.mock.On("AddJobTasks", ctx, args)}
+}
+
+func (_c *JobRepository_AddJobTasks_Call) Run(run func(ctx context.Context, args *jobdomain.AddJobTasksArgs)) *JobRepository_AddJobTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*jobdomain.AddJobTasksArgs))
+	})
+	return _c
+}
+
+func (_c *JobRepository_AddJobTasks_Call) Return(_a0 *jobdomain.AddJobTasksResult, _a1 error) *JobRepository_AddJobTasks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobRepository_AddJobTasks_Call) RunAndReturn(run func(context.Context, *jobdomain.AddJobTasksArgs) (*jobdomain.AddJobTasksResult, error)) *JobRepository_AddJobTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelJob provides a mock function with given fields: ctx, args
+func (_m *JobRepository) CancelJob(ctx context.Context, args *jobdomain.CancelJobArgs) (*jobdomain.CancelJobResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelJob")
+	}
+
+	var r0 *jobdomain.CancelJobResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *jobdomain.CancelJobArgs) (*jobdomain.CancelJobResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *jobdomain.CancelJobArgs) *jobdomain.CancelJobResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*jobdomain.CancelJobResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *jobdomain.CancelJobArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobRepository_CancelJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelJob'
+type JobRepository_CancelJob_Call struct {
+	*mock.Call
+}
+
+// CancelJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *jobdomain.CancelJobArgs
+func (_e *JobRepository_Expecter) CancelJob(ctx interface{}, args interface{}) *JobRepository_CancelJob_Call {
+	return &JobRepository_CancelJob_Call{Call: _e.mock.On("CancelJob", ctx, args)}
+}
+
+func (_c *JobRepository_CancelJob_Call) Run(run func(ctx context.Context, args *jobdomain.CancelJobArgs)) *JobRepository_CancelJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*jobdomain.CancelJobArgs))
+	})
+	return _c
+}
+
+func (_c *JobRepository_CancelJob_Call) Return(_a0 *jobdomain.CancelJobResult, _a1 error) *JobRepository_CancelJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobRepository_CancelJob_Call) RunAndReturn(run func(context.Context, *jobdomain.CancelJobArgs) (*jobdomain.CancelJobResult, error)) *JobRepository_CancelJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateJob provides a mock function with given fields: ctx, args
+func (_m *JobRepository) CreateJob(ctx context.Context, args *jobdomain.CreateJobArgs) (*jobdomain.CreateJobResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJob")
+	}
+
+	var r0 *jobdomain.CreateJobResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *jobdomain.CreateJobArgs) (*jobdomain.CreateJobResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *jobdomain.CreateJobArgs) *jobdomain.CreateJobResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*jobdomain.CreateJobResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *jobdomain.CreateJobArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobRepository_CreateJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJob'
+type JobRepository_CreateJob_Call struct {
+	*mock.Call
+}
+
+// CreateJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *jobdomain.CreateJobArgs
+func (_e *JobRepository_Expecter) CreateJob(ctx interface{}, args interface{}) *JobRepository_CreateJob_Call {
+	return &JobRepository_CreateJob_Call{Call: _e.mock.On("CreateJob", ctx, args)}
+}
+
+func (_c *JobRepository_CreateJob_Call) Run(run func(ctx context.Context, args *jobdomain.CreateJobArgs)) *JobRepository_CreateJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*jobdomain.CreateJobArgs))
+	})
+	return _c
+}
+
+func (_c *JobRepository_CreateJob_Call) Return(_a0 *jobdomain.CreateJobResult, _a1 error) *JobRepository_CreateJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobRepository_CreateJob_Call) RunAndReturn(run func(context.Context, *jobdomain.CreateJobArgs) (*jobdomain.CreateJobResult, error)) *JobRepository_CreateJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJob provides a mock function with given fields: ctx, args
+func (_m *JobRepository) GetJob(ctx context.Context, args *jobdomain.GetJobArgs) (*jobdomain.GetJobResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJob")
+	}
+
+	var r0 *jobdomain.GetJobResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *jobdomain.GetJobArgs) (*jobdomain.GetJobResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *jobdomain.GetJobArgs) *jobdomain.GetJobResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*jobdomain.GetJobResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *jobdomain.GetJobArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobRepository_GetJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJob'
+type JobRepository_GetJob_Call struct {
+	*mock.Call
+}
+
+// GetJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *jobdomain.GetJobArgs
+func (_e *JobRepository_Expecter) GetJob(ctx interface{}, args interface{}) *JobRepository_GetJob_Call {
+	return &JobRepository_GetJob_Call{Call: _e.mock.On("GetJob", ctx, args)}
+}
+
+func (_c *JobRepository_GetJob_Call) Run(run func(ctx context.Context, args *jobdomain.GetJobArgs)) *JobRepository_GetJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*jobdomain.GetJobArgs))
+	})
+	return _c
+}
+
+func (_c *JobRepository_GetJob_Call) Return(_a0 *jobdomain.GetJobResult, _a1 error) *JobRepository_GetJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobRepository_GetJob_Call) RunAndReturn(run func(context.Context, *jobdomain.GetJobArgs) (*jobdomain.GetJobResult, error)) *JobRepository_GetJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListJobs provides a mock function with given fields: ctx, args
+func (_m *JobRepository) ListJobs(ctx context.Context, args *jobdomain.ListJobsArgs) (*jobdomain.ListJobsResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListJobs")
+	}
+
+	var r0 *jobdomain.ListJobsResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *jobdomain.ListJobsArgs) (*jobdomain.ListJobsResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *jobdomain.ListJobsArgs) *jobdomain.ListJobsResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*jobdomain.ListJobsResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *jobdomain.ListJobsArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobRepository_ListJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListJobs'
+type JobRepository_ListJobs_Call struct {
+	*mock.Call
+}
+
+// ListJobs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *jobdomain.ListJobsArgs
+func (_e *JobRepository_Expecter) ListJobs(ctx interface{}, args interface{}) *JobRepository_ListJobs_Call {
+	return &JobRepository_ListJobs_Call{Call: _e.mock.On("ListJobs", ctx, args)}
+}
+
+func (_c *JobRepository_ListJobs_Call) Run(run func(ctx context.Context, args *jobdomain.ListJobsArgs)) *JobRepository_ListJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*jobdomain.ListJobsArgs))
+	})
+	return _c
+}
+
+func (_c *JobRepository_ListJobs_Call) Return(_a0 *jobdomain.ListJobsResult, _a1 error) *JobRepository_ListJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobRepository_ListJobs_Call) RunAndReturn(run func(context.Context, *jobdomain.ListJobsArgs) (*jobdomain.ListJobsResult, error)) *JobRepository_ListJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReconcileJob provides a mock function with given fields: ctx, args
+func (_m *JobRepository) ReconcileJob(ctx context.Context, args *jobdomain.ReconcileJobArgs) (*jobdomain.ReconcileJobResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReconcileJob")
+	}
+
+	var r0 *jobdomain.ReconcileJobResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *jobdomain.ReconcileJobArgs) (*jobdomain.ReconcileJobResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *jobdomain.ReconcileJobArgs) *jobdomain.ReconcileJobResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*jobdomain.ReconcileJobResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *jobdomain.ReconcileJobArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobRepository_ReconcileJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReconcileJob'
+type JobRepository_ReconcileJob_Call struct {
+	*mock.Call
+}
+
+// ReconcileJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *jobdomain.ReconcileJobArgs
+func (_e *JobRepository_Expecter) ReconcileJob(ctx interface{}, args interface{}) *JobRepository_ReconcileJob_Call {
+	return &JobRepository_ReconcileJob_Call{Call: _e.mock.On("ReconcileJob", ctx, args)}
+}
+
+func (_c *JobRepository_ReconcileJob_Call) Run(run func(ctx context.Context, args *jobdomain.ReconcileJobArgs)) *JobRepository_ReconcileJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*jobdomain.ReconcileJobArgs))
+	})
+	return _c
+}
+
+func (_c *JobRepository_ReconcileJob_Call) Return(_a0 *jobdomain.ReconcileJobResult, _a1 error) *JobRepository_ReconcileJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobRepository_ReconcileJob_Call) RunAndReturn(run func(context.Context, *jobdomain.ReconcileJobArgs) (*jobdomain.ReconcileJobResult, error)) *JobRepository_ReconcileJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchJob provides a mock function with given fields: ctx, args
+func (_m *JobRepository) WatchJob(ctx context.Context, args *jobdomain.WatchJobArgs) error {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *jobdomain.WatchJobArgs) error); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// JobRepository_WatchJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchJob'
+type JobRepository_WatchJob_Call struct {
+	*mock.Call
+}
+
+// WatchJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *jobdomain.WatchJobArgs
+func (_e *JobRepository_Expecter) WatchJob(ctx interface{}, args interface{}) *JobRepository_WatchJob_Call {
+	return &JobRepository_WatchJob_Call{Call: _e.mock.On("WatchJob", ctx, args)}
+}
+
+func (_c *JobRepository_WatchJob_Call) Run(run func(ctx context.Context, args *jobdomain.WatchJobArgs)) *JobRepository_WatchJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*jobdomain.WatchJobArgs))
+	})
+	return _c
+}
+
+func (_c *JobRepository_WatchJob_Call) Return(_a0 error) *JobRepository_WatchJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JobRepository_WatchJob_Call) RunAndReturn(run func(context.Context, *jobdomain.WatchJobArgs) error) *JobRepository_WatchJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewJobRepository creates a new instance of JobRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJobRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JobRepository {
+	mock := &JobRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
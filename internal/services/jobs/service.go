@@ -0,0 +1,208 @@
+package jobsrv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	jobdomain "github.com/10Narratives/faas/internal/domains/jobs"
+	taskdomain "github.com/10Narratives/faas/internal/domains/tasks"
+)
+
+// cancelPageSize is how many tasks CancelJob looks at per page.
+const cancelPageSize = 500
+
+// reconcilePageSize is how many jobs and tasks ReconcileJobs reads per page.
+const reconcilePageSize = 500
+
+//go:generate mockery --name JobRepository --output ./mocks --outpkg mocks --with-expecter --filename job_repository.go
+type JobRepository interface {
+	jobdomain.JobCreator
+	jobdomain.JobTaskAdder
+	jobdomain.JobGetter
+	jobdomain.JobLister
+	jobdomain.JobWatcher
+	jobdomain.JobCanceler
+	jobdomain.JobReconciler
+}
+
+//go:generate mockery --name TaskService --output ./mocks --outpkg mocks --with-expecter --filename task_service.go
+type TaskService interface {
+	taskdomain.TaskLister
+	taskdomain.TaskCanceler
+}
+
+type Service struct {
+	jobRepo     JobRepository
+	taskService TaskService
+}
+
+func NewService(jobRepo JobRepository, taskService TaskService) *Service {
+	return &Service{jobRepo: jobRepo, taskService: taskService}
+}
+
+func (s *Service) CreateJob(ctx context.Context, args *jobdomain.CreateJobArgs) (*jobdomain.CreateJobResult, error) {
+	return s.jobRepo.CreateJob(ctx, args)
+}
+
+func (s *Service) AddJobTasks(ctx context.Context, args *jobdomain.AddJobTasksArgs) (*jobdomain.AddJobTasksResult, error) {
+	return s.jobRepo.AddJobTasks(ctx, args)
+}
+
+func (s *Service) GetJob(ctx context.Context, args *jobdomain.GetJobArgs) (*jobdomain.GetJobResult, error) {
+	if args == nil {
+		return nil, jobdomain.ErrInvalidParameters
+	}
+	if _, err := jobdomain.ParseJobName(args.Name); err != nil {
+		return nil, err
+	}
+	return s.jobRepo.GetJob(ctx, args)
+}
+
+func (s *Service) ListJobs(ctx context.Context, args *jobdomain.ListJobsArgs) (*jobdomain.ListJobsResult, error) {
+	return s.jobRepo.ListJobs(ctx, args)
+}
+
+func (s *Service) WatchJob(ctx context.Context, args *jobdomain.WatchJobArgs) error {
+	if args == nil || args.Send == nil {
+		return jobdomain.ErrInvalidParameters
+	}
+	if _, err := jobdomain.ParseJobName(args.Name); err != nil {
+		return err
+	}
+	return s.jobRepo.WatchJob(ctx, args)
+}
+
+// ReconcileJobs recounts the ended tasks of every job that is not done and
+// repairs the counters that drifted from them, e.g. because a task's
+// RecordJobTask was lost, settling the job when that ends it. It returns
+// how many jobs were repaired; one written while its tasks were counted is
+// left for the next run.
+func (s *Service) ReconcileJobs(ctx context.Context) (int, error) {
+	// Jobs are read before tasks: a task ending in between is either
+	// counted or recorded after the job was read, which fails the repair.
+	jobs := make(map[string]*jobdomain.Job)
+	token := ""
+	for {
+		page, err := s.jobRepo.ListJobs(ctx, &jobdomain.ListJobsArgs{PageSize: reconcilePageSize, PageToken: token})
+		if err != nil {
+			return 0, err
+		}
+		for _, j := range page.Jobs {
+			if !j.Done() {
+				jobs[j.Name.BatchID()] = j
+			}
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		token = page.NextPageToken
+	}
+	if len(jobs) == 0 {
+		return 0, nil
+	}
+
+	counted := make(map[string]jobdomain.JobCounts, len(jobs))
+	token = ""
+	for {
+		page, err := s.taskService.ListTasks(ctx, &taskdomain.ListTasksArgs{PageSize: reconcilePageSize, PageToken: token})
+		if err != nil {
+			return 0, err
+		}
+		for _, t := range page.Tasks {
+			if _, ok := jobs[t.BatchID]; !ok || !t.State.IsTerminal() {
+				continue
+			}
+			c := counted[t.BatchID]
+			c.Add(t.State)
+			counted[t.BatchID] = c
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		token = page.NextPageToken
+	}
+
+	var (
+		repaired int
+		errs     []error
+	)
+	for batchID, j := range jobs {
+		seen := j.Counts()
+		if counted[batchID] == seen {
+			continue
+		}
+		_, err := s.jobRepo.ReconcileJob(ctx, &jobdomain.ReconcileJobArgs{
+			Name:    string(j.Name),
+			Seen:    seen,
+			Counted: counted[batchID],
+		})
+		switch {
+		case err == nil:
+			repaired++
+		case errors.Is(err, jobdomain.ErrJobModified), errors.Is(err, jobdomain.ErrNotFound):
+		default:
+			errs = append(errs, fmt.Errorf("reconcile %s: %w", j.Name, err))
+		}
+	}
+	return repaired, errors.Join(errs...)
+}
+
+// CancelJob marks the job canceled, then cancels its pending and processing
+// tasks. A batch still creating tasks notices the mark and cancels what it
+// created since it last looked.
+func (s *Service) CancelJob(ctx context.Context, args *jobdomain.CancelJobArgs) (*jobdomain.CancelJobResult, error) {
+	if args == nil {
+		return nil, jobdomain.ErrInvalidParameters
+	}
+	name, err := jobdomain.ParseJobName(args.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.jobRepo.CancelJob(ctx, args); err != nil {
+		return nil, err
+	}
+
+	filter, err := taskdomain.ParseTaskFilter(fmt.Sprintf("batch_id = %q", name.BatchID()))
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	token := ""
+	for {
+		page, err := s.taskService.ListTasks(ctx, &taskdomain.ListTasksArgs{
+			PageSize:  cancelPageSize,
+			PageToken: token,
+			Filter:    filter,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range page.Tasks {
+			if t.State.IsTerminal() {
+				continue
+			}
+			_, err := s.taskService.CancelTask(ctx, &taskdomain.CancelTaskArgs{Name: string(t.Name)})
+			// The task may have ended since it was listed.
+			if err != nil && !errors.Is(err, taskdomain.ErrTaskAlreadyCompleted) && !errors.Is(err, taskdomain.ErrCannotCancelTask) {
+				errs = append(errs, fmt.Errorf("cancel %s: %w", t.Name, err))
+			}
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		token = page.NextPageToken
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	// Read again for the counters the cancellations updated.
+	got, err := s.jobRepo.GetJob(ctx, &jobdomain.GetJobArgs{Name: args.Name})
+	if err != nil {
+		return nil, err
+	}
+	return &jobdomain.CancelJobResult{Job: got.Job}, nil
+}
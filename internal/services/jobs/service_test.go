@@ -0,0 +1,169 @@
+package jobsrv_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	jobdomain "github.com/10Narratives/faas/internal/domains/jobs"
+	taskdomain "github.com/10Narratives/faas/internal/domains/tasks"
+	jobsrv "github.com/10Narratives/faas/internal/services/jobs"
+	"github.com/10Narratives/faas/internal/services/jobs/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_CancelJob_CancelsActiveTasks(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewJobRepository(t)
+	tasks := mocks.NewTaskService(t)
+	svc := jobsrv.NewService(repo, tasks)
+
+	args := &jobdomain.CancelJobArgs{Name: "jobs/b1"}
+	repo.EXPECT().CancelJob(ctx, args).
+		Return(&jobdomain.CancelJobResult{Job: &jobdomain.Job{Name: "jobs/b1", State: jobdomain.JobStateCanceled}}, nil).
+		Once()
+
+	byBatch := mock.MatchedBy(func(a *taskdomain.ListTasksArgs) bool {
+		return a.Filter.Match(&taskdomain.Task{BatchID: "b1"}) && !a.Filter.Match(&taskdomain.Task{BatchID: "b2"})
+	})
+	tasks.EXPECT().ListTasks(ctx, byBatch).
+		Return(&taskdomain.ListTaskResult{
+			Tasks: []*taskdomain.Task{
+				{Name: "tasks/1", State: taskdomain.TaskStatePending},
+				{Name: "tasks/2", State: taskdomain.TaskStateSucceeded},
+			},
+			NextPageToken: "tasks/2",
+		}, nil).
+		Once()
+	tasks.EXPECT().ListTasks(ctx, mock.MatchedBy(func(a *taskdomain.ListTasksArgs) bool { return a.PageToken == "tasks/2" })).
+		Return(&taskdomain.ListTaskResult{
+			Tasks: []*taskdomain.Task{{Name: "tasks/3", State: taskdomain.TaskStateProcessing}},
+		}, nil).
+		Once()
+
+	tasks.EXPECT().CancelTask(ctx, &taskdomain.CancelTaskArgs{Name: "tasks/1"}).
+		Return(&taskdomain.CancelTaskResult{}, nil).Once()
+	// Ended between listing and canceling.
+	tasks.EXPECT().CancelTask(ctx, &taskdomain.CancelTaskArgs{Name: "tasks/3"}).
+		Return(nil, taskdomain.ErrTaskAlreadyCompleted).Once()
+
+	final := &jobdomain.Job{Name: "jobs/b1", State: jobdomain.JobStateCanceled, Total: 3, Succeeded: 2, Canceled: 1}
+	repo.EXPECT().GetJob(ctx, &jobdomain.GetJobArgs{Name: "jobs/b1"}).
+		Return(&jobdomain.GetJobResult{Job: final}, nil).Once()
+
+	res, err := svc.CancelJob(ctx, args)
+	require.NoError(t, err)
+	require.Equal(t, final, res.Job)
+}
+
+func TestService_CancelJob_AlreadyEnded(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewJobRepository(t)
+	svc := jobsrv.NewService(repo, mocks.NewTaskService(t))
+
+	repo.EXPECT().CancelJob(ctx, mock.Anything).Return(nil, jobdomain.ErrJobAlreadyEnded).Once()
+
+	_, err := svc.CancelJob(ctx, &jobdomain.CancelJobArgs{Name: "jobs/b1"})
+	require.ErrorIs(t, err, jobdomain.ErrJobAlreadyEnded)
+}
+
+func TestService_CancelJob_ReportsFailedCancels(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewJobRepository(t)
+	tasks := mocks.NewTaskService(t)
+	svc := jobsrv.NewService(repo, tasks)
+
+	repo.EXPECT().CancelJob(ctx, mock.Anything).
+		Return(&jobdomain.CancelJobResult{Job: &jobdomain.Job{Name: "jobs/b1"}}, nil).Once()
+	tasks.EXPECT().ListTasks(ctx, mock.Anything).
+		Return(&taskdomain.ListTaskResult{
+			Tasks: []*taskdomain.Task{
+				{Name: "tasks/1", State: taskdomain.TaskStatePending},
+				{Name: "tasks/2", State: taskdomain.TaskStatePending},
+			},
+		}, nil).Once()
+	wantErr := errors.New("kv unavailable")
+	tasks.EXPECT().CancelTask(ctx, &taskdomain.CancelTaskArgs{Name: "tasks/1"}).Return(nil, wantErr).Once()
+	// One failure does not stop the others.
+	tasks.EXPECT().CancelTask(ctx, &taskdomain.CancelTaskArgs{Name: "tasks/2"}).
+		Return(&taskdomain.CancelTaskResult{}, nil).Once()
+
+	_, err := svc.CancelJob(ctx, &jobdomain.CancelJobArgs{Name: "jobs/b1"})
+	require.ErrorIs(t, err, wantErr)
+}
+
+func TestService_GetJob_InvalidName(t *testing.T) {
+	svc := jobsrv.NewService(mocks.NewJobRepository(t), mocks.NewTaskService(t))
+
+	_, err := svc.GetJob(context.Background(), &jobdomain.GetJobArgs{Name: "tasks/1"})
+	require.ErrorIs(t, err, jobdomain.ErrInvalidName)
+}
+
+func TestService_ReconcileJobs(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewJobRepository(t)
+	tasks := mocks.NewTaskService(t)
+	svc := jobsrv.NewService(repo, tasks)
+
+	// b1 lost the count of its failed task and would stay running forever.
+	lost := &jobdomain.Job{Name: "jobs/b1", State: jobdomain.JobStateRunning, Sealed: true, Total: 2, Succeeded: 1}
+	// b2 is counted right; b3 was written while its tasks were counted.
+	exact := &jobdomain.Job{Name: "jobs/b2", State: jobdomain.JobStateRunning, Sealed: true, Total: 2, Succeeded: 1}
+	racing := &jobdomain.Job{Name: "jobs/b3", State: jobdomain.JobStateRunning, Sealed: true, Total: 1}
+	done := &jobdomain.Job{Name: "jobs/b4", State: jobdomain.JobStateSucceeded, Sealed: true, Total: 1, Succeeded: 1}
+
+	repo.EXPECT().ListJobs(ctx, &jobdomain.ListJobsArgs{PageSize: 500}).
+		Return(&jobdomain.ListJobsResult{Jobs: []*jobdomain.Job{lost, exact}, NextPageToken: "jobs/b2"}, nil).Once()
+	repo.EXPECT().ListJobs(ctx, &jobdomain.ListJobsArgs{PageSize: 500, PageToken: "jobs/b2"}).
+		Return(&jobdomain.ListJobsResult{Jobs: []*jobdomain.Job{racing, done}}, nil).Once()
+	tasks.EXPECT().ListTasks(ctx, &taskdomain.ListTasksArgs{PageSize: 500}).
+		Return(&taskdomain.ListTaskResult{
+			Tasks: []*taskdomain.Task{
+				{Name: "tasks/1", BatchID: "b1", State: taskdomain.TaskStateSucceeded},
+				{Name: "tasks/2", BatchID: "b1", State: taskdomain.TaskStateFailed},
+				{Name: "tasks/3", BatchID: "b2", State: taskdomain.TaskStateSucceeded},
+				{Name: "tasks/4", BatchID: "b2", State: taskdomain.TaskStateProcessing},
+			},
+			NextPageToken: "tasks/4",
+		}, nil).Once()
+	tasks.EXPECT().ListTasks(ctx, &taskdomain.ListTasksArgs{PageSize: 500, PageToken: "tasks/4"}).
+		Return(&taskdomain.ListTaskResult{
+			Tasks: []*taskdomain.Task{
+				{Name: "tasks/5", BatchID: "b3", State: taskdomain.TaskStateCanceled},
+				{Name: "tasks/6", BatchID: "b4", State: taskdomain.TaskStateSucceeded},
+				{Name: "tasks/7", State: taskdomain.TaskStateFailed},
+			},
+		}, nil).Once()
+
+	repo.EXPECT().ReconcileJob(ctx, &jobdomain.ReconcileJobArgs{
+		Name:    "jobs/b1",
+		Seen:    jobdomain.JobCounts{Succeeded: 1},
+		Counted: jobdomain.JobCounts{Succeeded: 1, Failed: 1},
+	}).Return(&jobdomain.ReconcileJobResult{}, nil).Once()
+	repo.EXPECT().ReconcileJob(ctx, &jobdomain.ReconcileJobArgs{
+		Name:    "jobs/b3",
+		Counted: jobdomain.JobCounts{Canceled: 1},
+	}).Return(nil, jobdomain.ErrJobModified).Once()
+
+	repaired, err := svc.ReconcileJobs(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, repaired)
+}
+
+func TestService_ReconcileJobs_ReportsFailures(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewJobRepository(t)
+	tasks := mocks.NewTaskService(t)
+	svc := jobsrv.NewService(repo, tasks)
+
+	wantErr := errors.New("kv unavailable")
+	repo.EXPECT().ListJobs(ctx, mock.Anything).
+		Return(&jobdomain.ListJobsResult{Jobs: []*jobdomain.Job{{Name: "jobs/b1", State: jobdomain.JobStateRunning}}}, nil).Once()
+	tasks.EXPECT().ListTasks(ctx, mock.Anything).
+		Return(&taskdomain.ListTaskResult{Tasks: []*taskdomain.Task{{Name: "tasks/1", BatchID: "b1", State: taskdomain.TaskStateFailed}}}, nil).Once()
+	repo.EXPECT().ReconcileJob(ctx, mock.Anything).Return(nil, wantErr).Once()
+
+	_, err := svc.ReconcileJobs(ctx)
+	require.ErrorIs(t, err, wantErr)
+}
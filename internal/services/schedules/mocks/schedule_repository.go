@@ -0,0 +1,309 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	scheddomain "github.com/10Narratives/faas/internal/domains/schedules"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ScheduleRepository is an autogenerated mock type for the ScheduleRepository type
+type ScheduleRepository struct {
+	mock.Mock
+}
+
+type ScheduleRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ScheduleRepository) EXPECT() *ScheduleRepository_Expecter {
+	return &ScheduleRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateSchedule provides a mock function with given fields: ctx, s
+func (_m *ScheduleRepository) CreateSchedule(ctx context.Context, s *scheddomain.Schedule) error {
+	ret := _m.Called(ctx, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSchedule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *scheddomain.Schedule) error); ok {
+		r0 = rf(ctx, s)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ScheduleRepository_CreateSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSchedule'
+type ScheduleRepository_CreateSchedule_Call struct {
+	*mock.Call
+}
+
+// CreateSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - s *scheddomain.Schedule
+func (_e *ScheduleRepository_Expecter) CreateSchedule(ctx interface{}, s interface{}) *ScheduleRepository_CreateSchedule_Call {
+	return &ScheduleRepository_CreateSchedule_Call{Call: _e.mock.On("CreateSchedule", ctx, s)}
+}
+
+func (_c *ScheduleRepository_CreateSchedule_Call) Run(run func(ctx context.Context, s *scheddomain.Schedule)) *ScheduleRepository_CreateSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*scheddomain.Schedule))
+	})
+	return _c
+}
+
+func (_c *ScheduleRepository_CreateSchedule_Call) Return(_a0 error) *ScheduleRepository_CreateSchedule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ScheduleRepository_CreateSchedule_Call) RunAndReturn(run func(context.Context, *scheddomain.Schedule) error) *ScheduleRepository_CreateSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSchedule provides a mock function with given fields: ctx, name
+func (_m *ScheduleRepository) DeleteSchedule(ctx context.Context, name scheddomain.ScheduleName) error {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSchedule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, scheddomain.ScheduleName) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ScheduleRepository_DeleteSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSchedule'
+type ScheduleRepository_DeleteSchedule_Call struct {
+	*mock.Call
+}
+
+// DeleteSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name scheddomain.ScheduleName
+func (_e *ScheduleRepository_Expecter) DeleteSchedule(ctx interface{}, name interface{}) *ScheduleRepository_DeleteSchedule_Call {
+	return &ScheduleRepository_DeleteSchedule_Call{Call: _e.mock.On("DeleteSchedule", ctx, name)}
+}
+
+func (_c *ScheduleRepository_DeleteSchedule_Call) Run(run func(ctx context.Context, name scheddomain.ScheduleName)) *ScheduleRepository_DeleteSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(scheddomain.ScheduleName))
+	})
+	return _c
+}
+
+func (_c *ScheduleRepository_DeleteSchedule_Call) Return(_a0 error) *ScheduleRepository_DeleteSchedule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ScheduleRepository_DeleteSchedule_Call) RunAndReturn(run func(context.Context, scheddomain.ScheduleName) error) *ScheduleRepository_DeleteSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSchedule provides a mock function with given fields: ctx, name
+func (_m *ScheduleRepository) GetSchedule(ctx context.Context, name scheddomain.ScheduleName) (*scheddomain.Schedule, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSchedule")
+	}
+
+	var r0 *scheddomain.Schedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, scheddomain.ScheduleName) (*scheddomain.Schedule, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, scheddomain.ScheduleName) *scheddomain.Schedule); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*scheddomain.Schedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, scheddomain.ScheduleName) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScheduleRepository_GetSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSchedule'
+type ScheduleRepository_GetSchedule_Call struct {
+	*mock.Call
+}
+
+// GetSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name scheddomain.ScheduleName
+func (_e *ScheduleRepository_Expecter) GetSchedule(ctx interface{}, name interface{}) *ScheduleRepository_GetSchedule_Call {
+	return &ScheduleRepository_GetSchedule_Call{Call: _e.mock.On("GetSchedule", ctx, name)}
+}
+
+func (_c *ScheduleRepository_GetSchedule_Call) Run(run func(ctx context.Context, name scheddomain.ScheduleName)) *ScheduleRepository_GetSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(scheddomain.ScheduleName))
+	})
+	return _c
+}
+
+func (_c *ScheduleRepository_GetSchedule_Call) Return(_a0 *scheddomain.Schedule, _a1 error) *ScheduleRepository_GetSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ScheduleRepository_GetSchedule_Call) RunAndReturn(run func(context.Context, scheddomain.ScheduleName) (*scheddomain.Schedule, error)) *ScheduleRepository_GetSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSchedules provides a mock function with given fields: ctx, args
+func (_m *ScheduleRepository) ListSchedules(ctx context.Context, args *scheddomain.ListSchedulesArgs) (*scheddomain.ListSchedulesResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSchedules")
+	}
+
+	var r0 *scheddomain.ListSchedulesResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *scheddomain.ListSchedulesArgs) (*scheddomain.ListSchedulesResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *scheddomain.ListSchedulesArgs) *scheddomain.ListSchedulesResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*scheddomain.ListSchedulesResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *scheddomain.ListSchedulesArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScheduleRepository_ListSchedules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSchedules'
+type ScheduleRepository_ListSchedules_Call struct {
+	*mock.Call
+}
+
+// ListSchedules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *scheddomain.ListSchedulesArgs
+func (_e *ScheduleRepository_Expecter) ListSchedules(ctx interface{}, args interface{}) *ScheduleRepository_ListSchedules_Call {
+	return &ScheduleRepository_ListSchedules_Call{Call: _e.mock.On("ListSchedules", ctx, args)}
+}
+
+func (_c *ScheduleRepository_ListSchedules_Call) Run(run func(ctx context.Context, args *scheddomain.ListSchedulesArgs)) *ScheduleRepository_ListSchedules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*scheddomain.ListSchedulesArgs))
+	})
+	return _c
+}
+
+func (_c *ScheduleRepository_ListSchedules_Call) Return(_a0 *scheddomain.ListSchedulesResult, _a1 error) *ScheduleRepository_ListSchedules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ScheduleRepository_ListSchedules_Call) RunAndReturn(run func(context.Context, *scheddomain.ListSchedulesArgs) (*scheddomain.ListSchedulesResult, error)) *ScheduleRepository_ListSchedules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSchedule provides a mock function with given fields: ctx, name, mutate
+func (_m *ScheduleRepository) UpdateSchedule(ctx context.Context, name scheddomain.ScheduleName, mutate func(*scheddomain.Schedule) error) (*scheddomain.Schedule, error) {
+	ret := _m.Called(ctx, name, mutate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSchedule")
+	}
+
+	var r0 *scheddomain.Schedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, scheddomain.ScheduleName, func(*scheddomain.Schedule) error) (*scheddomain.Schedule, error)); ok {
+		return rf(ctx, name, mutate)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, scheddomain.ScheduleName, func(*scheddomain.Schedule) error) *scheddomain.Schedule); ok {
+		r0 = rf(ctx, name, mutate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*scheddomain.Schedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, scheddomain.ScheduleName, func(*scheddomain.Schedule) error) error); ok {
+		r1 = rf(ctx, name, mutate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScheduleRepository_UpdateSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSchedule'
+type ScheduleRepository_UpdateSchedule_Call struct {
+	*mock.Call
+}
+
+// UpdateSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name scheddomain.ScheduleName
+//   - mutate func(*scheddomain.Schedule) error
+func (_e *ScheduleRepository_Expecter) UpdateSchedule(ctx interface{}, name interface{}, mutate interface{}) *ScheduleRepository_UpdateSchedule_Call {
+	return &ScheduleRepository_UpdateSchedule_Call{Call: _e.mock.On("UpdateSchedule", ctx, name, mutate)}
+}
+
+func (_c *ScheduleRepository_UpdateSchedule_Call) Run(run func(ctx context.Context, name scheddomain.ScheduleName, mutate func(*scheddomain.Schedule) error)) *ScheduleRepository_UpdateSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(scheddomain.ScheduleName), args[2].(func(*scheddomain.Schedule) error))
+	})
+	return _c
+}
+
+func (_c *ScheduleRepository_UpdateSchedule_Call) Return(_a0 *scheddomain.Schedule, _a1 error) *ScheduleRepository_UpdateSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ScheduleRepository_UpdateSchedule_Call) RunAndReturn(run func(context.Context, scheddomain.ScheduleName, func(*scheddomain.Schedule) error) (*scheddomain.Schedule, error)) *ScheduleRepository_UpdateSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewScheduleRepository creates a new instance of ScheduleRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewScheduleRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ScheduleRepository {
+	mock := &ScheduleRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,323 @@
+package schedsrv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	scheddomain "github.com/10Narratives/faas/internal/domains/schedules"
+)
+
+// Annotations set on the tasks a schedule creates.
+const (
+	AnnotationSchedule      = "faas/schedule"
+	AnnotationScheduledTime = "faas/scheduled-time"
+)
+
+// listPageSize is how many schedules RunDueSchedules looks at per page.
+const listPageSize = 500
+
+// errNothingDue aborts a claim that found no slot to take.
+var errNothingDue = errors.New("nothing due")
+
+type Config struct {
+	// MissedRunGrace is how late a slot may fire before it counts as
+	// missed. It must exceed the scheduler's tick interval.
+	MissedRunGrace time.Duration
+	// MaxRunsPerTick bounds the runs of one schedule per RunDueSchedules,
+	// so catching up after a long outage does not flood the queue.
+	MaxRunsPerTick int
+}
+
+//go:generate mockery --name ScheduleRepository --output ./mocks --outpkg mocks --with-expecter --filename schedule_repository.go
+type ScheduleRepository interface {
+	CreateSchedule(ctx context.Context, s *scheddomain.Schedule) error
+	GetSchedule(ctx context.Context, name scheddomain.ScheduleName) (*scheddomain.Schedule, error)
+	UpdateSchedule(ctx context.Context, name scheddomain.ScheduleName, mutate func(s *scheddomain.Schedule) error) (*scheddomain.Schedule, error)
+	DeleteSchedule(ctx context.Context, name scheddomain.ScheduleName) error
+	ListSchedules(ctx context.Context, args *scheddomain.ListSchedulesArgs) (*scheddomain.ListSchedulesResult, error)
+}
+
+//go:generate mockery --name FunctionService --output ./mocks --outpkg mocks --with-expecter --filename function_service.go
+type FunctionService interface {
+	funcdomain.FunctionExecutor
+}
+
+type Service struct {
+	cfg         Config
+	repo        ScheduleRepository
+	funcService FunctionService
+}
+
+func NewService(cfg Config, repo ScheduleRepository, funcService FunctionService) *Service {
+	if cfg.MissedRunGrace <= 0 {
+		cfg.MissedRunGrace = time.Minute
+	}
+	if cfg.MaxRunsPerTick <= 0 {
+		cfg.MaxRunsPerTick = 100
+	}
+	return &Service{cfg: cfg, repo: repo, funcService: funcService}
+}
+
+func (s *Service) CreateSchedule(ctx context.Context, args *scheddomain.CreateScheduleArgs) (*scheddomain.CreateScheduleResult, error) {
+	if args == nil || args.Schedule == nil {
+		return nil, scheddomain.ErrInvalidParameters
+	}
+
+	now := time.Now().UTC()
+	in := args.Schedule
+	sched := &scheddomain.Schedule{
+		Name:            in.Name,
+		Cron:            in.Cron,
+		TimeZone:        in.TimeZone,
+		Function:        in.Function,
+		Parameters:      in.Parameters,
+		MissedRunPolicy: in.MissedRunPolicy,
+		Paused:          in.Paused,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+	if sched.MissedRunPolicy == scheddomain.MissedRunPolicyUnspecified {
+		sched.MissedRunPolicy = scheddomain.MissedRunSkip
+	}
+	if err := sched.Validate(now); err != nil {
+		return nil, err
+	}
+	if err := reschedule(sched, now); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.CreateSchedule(ctx, sched); err != nil {
+		return nil, err
+	}
+	return &scheddomain.CreateScheduleResult{Schedule: sched}, nil
+}
+
+func (s *Service) GetSchedule(ctx context.Context, args *scheddomain.GetScheduleArgs) (*scheddomain.GetScheduleResult, error) {
+	if args == nil {
+		return nil, scheddomain.ErrInvalidParameters
+	}
+	name, err := scheddomain.ParseScheduleName(args.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	sched, err := s.repo.GetSchedule(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return &scheddomain.GetScheduleResult{Schedule: sched}, nil
+}
+
+func (s *Service) ListSchedules(ctx context.Context, args *scheddomain.ListSchedulesArgs) (*scheddomain.ListSchedulesResult, error) {
+	return s.repo.ListSchedules(ctx, args)
+}
+
+// UpdateSchedule recomputes the next run when the cron expression or the
+// time zone changes.
+func (s *Service) UpdateSchedule(ctx context.Context, args *scheddomain.UpdateScheduleArgs) (*scheddomain.UpdateScheduleResult, error) {
+	if args == nil || args.Schedule == nil {
+		return nil, scheddomain.ErrInvalidParameters
+	}
+	name, err := scheddomain.ParseScheduleName(args.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.UpdateSchedule(ctx, name, func(cur *scheddomain.Schedule) error {
+		now := time.Now().UTC()
+		cron, tz := cur.Cron, cur.TimeZone
+		if err := cur.ApplyUpdate(args.Schedule, args.Paths); err != nil {
+			return err
+		}
+		if err := cur.Validate(now); err != nil {
+			return err
+		}
+		if cur.Cron != cron || cur.TimeZone != tz {
+			if err := reschedule(cur, now); err != nil {
+				return err
+			}
+		}
+		cur.UpdatedAt = now
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &scheddomain.UpdateScheduleResult{Schedule: updated}, nil
+}
+
+func (s *Service) PauseSchedule(ctx context.Context, args *scheddomain.PauseScheduleArgs) (*scheddomain.PauseScheduleResult, error) {
+	if args == nil {
+		return nil, scheddomain.ErrInvalidParameters
+	}
+	name, err := scheddomain.ParseScheduleName(args.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.UpdateSchedule(ctx, name, func(cur *scheddomain.Schedule) error {
+		if !cur.Paused {
+			cur.Paused = true
+			cur.NextRunTime = time.Time{}
+			cur.UpdatedAt = time.Now().UTC()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &scheddomain.PauseScheduleResult{Schedule: updated}, nil
+}
+
+func (s *Service) ResumeSchedule(ctx context.Context, args *scheddomain.ResumeScheduleArgs) (*scheddomain.ResumeScheduleResult, error) {
+	if args == nil {
+		return nil, scheddomain.ErrInvalidParameters
+	}
+	name, err := scheddomain.ParseScheduleName(args.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.UpdateSchedule(ctx, name, func(cur *scheddomain.Schedule) error {
+		if !cur.Paused {
+			return nil
+		}
+		now := time.Now().UTC()
+		cur.Paused = false
+		cur.UpdatedAt = now
+		return reschedule(cur, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &scheddomain.ResumeScheduleResult{Schedule: updated}, nil
+}
+
+func (s *Service) DeleteSchedule(ctx context.Context, args *scheddomain.DeleteScheduleArgs) error {
+	if args == nil {
+		return scheddomain.ErrInvalidParameters
+	}
+	name, err := scheddomain.ParseScheduleName(args.Name)
+	if err != nil {
+		return err
+	}
+	return s.repo.DeleteSchedule(ctx, name)
+}
+
+// RunDueSchedules fires the slots due at now. Each schedule's slots are
+// claimed by a conditional write moving its next run time before any task
+// is created, so a slot fires at most once even if two schedulers overlap;
+// a scheduler dying in between loses the claimed runs.
+func (s *Service) RunDueSchedules(ctx context.Context, now time.Time) (*scheddomain.RunDueSchedulesResult, error) {
+	res := &scheddomain.RunDueSchedulesResult{}
+
+	var errs []error
+	token := ""
+	for {
+		page, err := s.repo.ListSchedules(ctx, &scheddomain.ListSchedulesArgs{PageSize: listPageSize, PageToken: token})
+		if err != nil {
+			return res, err
+		}
+		for _, sched := range page.Schedules {
+			if sched.Paused || sched.NextRunTime.IsZero() || sched.NextRunTime.After(now) {
+				continue
+			}
+			if err := s.runDue(ctx, sched.Name, now, res); err != nil {
+				errs = append(errs, fmt.Errorf("run %s: %w", sched.Name, err))
+			}
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		token = page.NextPageToken
+	}
+	return res, errors.Join(errs...)
+}
+
+func (s *Service) runDue(ctx context.Context, name scheddomain.ScheduleName, now time.Time, res *scheddomain.RunDueSchedulesResult) error {
+	var runs []time.Time
+	claimed, err := s.repo.UpdateSchedule(ctx, name, func(cur *scheddomain.Schedule) error {
+		var (
+			next time.Time
+			err  error
+		)
+		runs, next, err = cur.Plan(now, s.cfg.MissedRunGrace, s.cfg.MaxRunsPerTick)
+		if err != nil {
+			return err
+		}
+		if len(runs) == 0 && next.Equal(cur.NextRunTime) {
+			return errNothingDue
+		}
+		cur.NextRunTime = next.UTC()
+		if len(runs) > 0 {
+			cur.LastRunTime = runs[len(runs)-1].UTC()
+		}
+		return nil
+	})
+	if errors.Is(err, errNothingDue) || errors.Is(err, scheddomain.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(runs) == 0 {
+		return nil
+	}
+
+	fnName, alias, err := funcdomain.ParseFunctionRef(claimed.Function)
+	if err != nil {
+		return err
+	}
+
+	var lastTask, lastErr string
+	for _, slot := range runs {
+		res.Runs++
+		out, err := s.funcService.ExecuteFunction(ctx, &funcdomain.ExecuteFunctionArgs{
+			Name:       fnName,
+			Alias:      alias,
+			Parameters: claimed.Parameters,
+			Annotations: map[string]string{
+				AnnotationSchedule:      string(name),
+				AnnotationScheduledTime: slot.UTC().Format(time.RFC3339),
+			},
+		})
+		if err != nil {
+			res.Failed++
+			lastTask, lastErr = "", err.Error()
+			continue
+		}
+		lastTask, lastErr = out.TaskName, ""
+	}
+
+	last := runs[len(runs)-1].UTC()
+	_, err = s.repo.UpdateSchedule(ctx, name, func(cur *scheddomain.Schedule) error {
+		// A later run has claimed the record since.
+		if !cur.LastRunTime.Equal(last) {
+			return errNothingDue
+		}
+		cur.LastTask, cur.LastError = lastTask, lastErr
+		return nil
+	})
+	if errors.Is(err, errNothingDue) || errors.Is(err, scheddomain.ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+// reschedule sets the next run of an active schedule to its first slot
+// after now.
+func reschedule(sched *scheddomain.Schedule, now time.Time) error {
+	if sched.Paused {
+		sched.NextRunTime = time.Time{}
+		return nil
+	}
+	spec, err := sched.Spec()
+	if err != nil {
+		return err
+	}
+	sched.NextRunTime = spec.Next(now).UTC()
+	return nil
+}
@@ -0,0 +1,215 @@
+package schedsrv_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	scheddomain "github.com/10Narratives/faas/internal/domains/schedules"
+	schedsrv "github.com/10Narratives/faas/internal/services/schedules"
+	"github.com/10Narratives/faas/internal/services/schedules/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// storeSchedule backs UpdateSchedule with a single in-memory record.
+func storeSchedule(repo *mocks.ScheduleRepository, stored *scheddomain.Schedule) {
+	repo.EXPECT().UpdateSchedule(mock.Anything, stored.Name, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ scheddomain.ScheduleName, mutate func(*scheddomain.Schedule) error) (*scheddomain.Schedule, error) {
+			cur := *stored
+			if err := mutate(&cur); err != nil {
+				return nil, err
+			}
+			*stored = cur
+			return &cur, nil
+		})
+}
+
+func TestService_RunDueSchedules_MissedRunPolicy(t *testing.T) {
+	at := func(h, m int) time.Time { return time.Date(2026, 3, 2, h, m, 0, 0, time.UTC) }
+	now := at(13, 0).Add(30 * time.Second)
+
+	tests := []struct {
+		name   string
+		policy scheddomain.MissedRunPolicy
+		want   []string
+	}{
+		{"skip", scheddomain.MissedRunSkip, []string{"2026-03-02T13:00:00Z"}},
+		{"run once", scheddomain.MissedRunOnce, []string{"2026-03-02T10:00:00Z", "2026-03-02T13:00:00Z"}},
+		{"catch up", scheddomain.MissedRunCatchUp, []string{
+			"2026-03-02T10:00:00Z", "2026-03-02T11:00:00Z", "2026-03-02T12:00:00Z", "2026-03-02T13:00:00Z",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := mocks.NewScheduleRepository(t)
+			funcs := mocks.NewFunctionService(t)
+			svc := schedsrv.NewService(schedsrv.Config{MissedRunGrace: time.Minute}, repo, funcs)
+
+			stored := &scheddomain.Schedule{
+				Name:            "schedules/hourly",
+				Cron:            "0 * * * *",
+				Function:        "functions/report@prod",
+				Parameters:      `{"full":true}`,
+				MissedRunPolicy: tt.policy,
+				NextRunTime:     at(10, 0),
+			}
+			repo.EXPECT().ListSchedules(ctx, mock.Anything).
+				Return(&scheddomain.ListSchedulesResult{Schedules: []*scheddomain.Schedule{stored}}, nil).Once()
+			storeSchedule(repo, stored)
+
+			var got []string
+			funcs.EXPECT().ExecuteFunction(ctx, mock.Anything).
+				RunAndReturn(func(_ context.Context, args *funcdomain.ExecuteFunctionArgs) (*funcdomain.ExecuteFunctionResult, error) {
+					require.Equal(t, funcdomain.FunctionName("functions/report"), args.Name)
+					require.Equal(t, "prod", args.Alias)
+					require.Equal(t, `{"full":true}`, args.Parameters)
+					require.Equal(t, "schedules/hourly", args.Annotations[schedsrv.AnnotationSchedule])
+					got = append(got, args.Annotations[schedsrv.AnnotationScheduledTime])
+					return &funcdomain.ExecuteFunctionResult{TaskName: fmt.Sprintf("tasks/t%d", len(got))}, nil
+				})
+
+			res, err := svc.RunDueSchedules(ctx, now)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, len(tt.want), res.Runs)
+			require.Zero(t, res.Failed)
+
+			require.True(t, stored.NextRunTime.Equal(at(14, 0)))
+			require.True(t, stored.LastRunTime.Equal(at(13, 0)))
+			require.Equal(t, fmt.Sprintf("tasks/t%d", len(tt.want)), stored.LastTask)
+		})
+	}
+}
+
+func TestService_RunDueSchedules_CatchUpIsBounded(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewScheduleRepository(t)
+	funcs := mocks.NewFunctionService(t)
+	svc := schedsrv.NewService(schedsrv.Config{MissedRunGrace: time.Minute, MaxRunsPerTick: 2}, repo, funcs)
+
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	stored := &scheddomain.Schedule{
+		Name:            "schedules/minutely",
+		Cron:            "* * * * *",
+		Function:        "functions/ping",
+		MissedRunPolicy: scheddomain.MissedRunCatchUp,
+		NextRunTime:     start,
+	}
+	repo.EXPECT().ListSchedules(ctx, mock.Anything).
+		Return(&scheddomain.ListSchedulesResult{Schedules: []*scheddomain.Schedule{stored}}, nil).Once()
+	storeSchedule(repo, stored)
+	funcs.EXPECT().ExecuteFunction(ctx, mock.Anything).
+		Return(&funcdomain.ExecuteFunctionResult{TaskName: "tasks/t"}, nil).Times(2)
+
+	res, err := svc.RunDueSchedules(ctx, start.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 2, res.Runs)
+	// The rest is left for the next tick.
+	require.True(t, stored.NextRunTime.Equal(start.Add(2*time.Minute)))
+}
+
+func TestService_RunDueSchedules_RecordsExecuteError(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewScheduleRepository(t)
+	funcs := mocks.NewFunctionService(t)
+	svc := schedsrv.NewService(schedsrv.Config{}, repo, funcs)
+
+	now := time.Date(2026, 3, 2, 9, 0, 5, 0, time.UTC)
+	stored := &scheddomain.Schedule{
+		Name:            "schedules/daily",
+		Cron:            "@daily",
+		TimeZone:        "Europe/Berlin",
+		Function:        "functions/gone",
+		MissedRunPolicy: scheddomain.MissedRunSkip,
+		LastTask:        "tasks/old",
+		// Midnight in Berlin.
+		NextRunTime: time.Date(2026, 3, 1, 23, 0, 0, 0, time.UTC),
+	}
+	repo.EXPECT().ListSchedules(ctx, mock.Anything).
+		Return(&scheddomain.ListSchedulesResult{Schedules: []*scheddomain.Schedule{stored}}, nil).Once()
+	storeSchedule(repo, stored)
+
+	// Only slots within the grace period run under the skip policy.
+	res, err := svc.RunDueSchedules(ctx, now)
+	require.NoError(t, err)
+	require.Zero(t, res.Runs)
+	require.True(t, stored.NextRunTime.Equal(time.Date(2026, 3, 2, 23, 0, 0, 0, time.UTC)))
+
+	repo.EXPECT().ListSchedules(ctx, mock.Anything).
+		Return(&scheddomain.ListSchedulesResult{Schedules: []*scheddomain.Schedule{stored}}, nil).Once()
+	funcs.EXPECT().ExecuteFunction(ctx, mock.Anything).Return(nil, funcdomain.ErrFunctionNotFound).Once()
+
+	res, err = svc.RunDueSchedules(ctx, stored.NextRunTime.Add(10*time.Second))
+	require.NoError(t, err)
+	require.Equal(t, 1, res.Runs)
+	require.Equal(t, 1, res.Failed)
+	require.Empty(t, stored.LastTask)
+	require.Equal(t, funcdomain.ErrFunctionNotFound.Error(), stored.LastError)
+}
+
+func TestService_RunDueSchedules_SkipsPausedAndClaimed(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewScheduleRepository(t)
+	funcs := mocks.NewFunctionService(t)
+	svc := schedsrv.NewService(schedsrv.Config{}, repo, funcs)
+
+	now := time.Date(2026, 3, 2, 9, 0, 5, 0, time.UTC)
+	repo.EXPECT().ListSchedules(ctx, mock.Anything).
+		Return(&scheddomain.ListSchedulesResult{Schedules: []*scheddomain.Schedule{
+			{Name: "schedules/paused", Cron: "* * * * *", Function: "functions/f", Paused: true},
+			{Name: "schedules/claimed", Cron: "* * * * *", Function: "functions/f", NextRunTime: now.Add(-5 * time.Second)},
+		}}, nil).Once()
+
+	// Another scheduler moved the next run on in the meantime.
+	claimed := &scheddomain.Schedule{
+		Name: "schedules/claimed", Cron: "* * * * *", Function: "functions/f",
+		MissedRunPolicy: scheddomain.MissedRunSkip, NextRunTime: now.Add(55 * time.Second),
+	}
+	storeSchedule(repo, claimed)
+
+	res, err := svc.RunDueSchedules(ctx, now)
+	require.NoError(t, err)
+	require.Zero(t, res.Runs)
+}
+
+func TestService_CreateSchedule(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewScheduleRepository(t)
+	svc := schedsrv.NewService(schedsrv.Config{}, repo, mocks.NewFunctionService(t))
+
+	repo.EXPECT().CreateSchedule(ctx, mock.Anything).Return(nil).Once()
+
+	before := time.Now()
+	res, err := svc.CreateSchedule(ctx, &scheddomain.CreateScheduleArgs{Schedule: &scheddomain.Schedule{
+		Name:     "schedules/nightly",
+		Cron:     "30 2 * * *",
+		TimeZone: "America/New_York",
+		Function: "functions/backup",
+	}})
+	require.NoError(t, err)
+	require.Equal(t, scheddomain.MissedRunSkip, res.Schedule.MissedRunPolicy)
+	require.True(t, res.Schedule.NextRunTime.After(before))
+
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	next := res.Schedule.NextRunTime.In(loc)
+	require.Equal(t, 2, next.Hour())
+	require.Equal(t, 30, next.Minute())
+
+	for _, bad := range []*scheddomain.Schedule{
+		{Name: "schedules/x", Cron: "61 * * * *", Function: "functions/f"},
+		{Name: "schedules/x", Cron: "0 0 30 2 *", Function: "functions/f"},
+		{Name: "schedules/x", Cron: "@hourly", TimeZone: "Mars/Olympus", Function: "functions/f"},
+		{Name: "schedules/x", Cron: "@hourly", Function: "f"},
+		{Name: "schedules/x", Cron: "@hourly", Function: "functions/f", Parameters: "{"},
+		{Name: "x", Cron: "@hourly", Function: "functions/f"},
+	} {
+		_, err := svc.CreateSchedule(ctx, &scheddomain.CreateScheduleArgs{Schedule: bad})
+		require.Error(t, err, "%+v", bad)
+	}
+}
@@ -0,0 +1,65 @@
+package secretsrv
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	secretdomain "github.com/10Narratives/faas/internal/domains/secrets"
+)
+
+// Cipher seals secret values with AES-256-GCM. The secret name is bound as
+// additional data, so a ciphertext copied under another key won't open.
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+func NewCipher(masterKey []byte) (*Cipher, error) {
+	if len(masterKey) != 32 {
+		return nil, fmt.Errorf("master key must be 32 bytes, got %d", len(masterKey))
+	}
+
+	block, err := aes.NewCipher(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Cipher{aead: aead}, nil
+}
+
+// NewCipherFromBase64 decodes a standard base64 master key as found in configs.
+func NewCipherFromBase64(masterKey string) (*Cipher, error) {
+	if masterKey == "" {
+		return nil, errors.New("master key is empty")
+	}
+	key, err := base64.StdEncoding.DecodeString(masterKey)
+	if err != nil {
+		return nil, fmt.Errorf("decode master key: %w", err)
+	}
+	return NewCipher(key)
+}
+
+func (c *Cipher) Seal(name secretdomain.SecretName, plaintext []byte) (nonce, ciphertext []byte, err error) {
+	nonce = make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, nil, err
+	}
+	return nonce, c.aead.Seal(nil, nonce, plaintext, []byte(name)), nil
+}
+
+func (c *Cipher) Open(name secretdomain.SecretName, nonce, ciphertext []byte) ([]byte, error) {
+	if len(nonce) != c.aead.NonceSize() {
+		return nil, secretdomain.ErrDecryptionFailed
+	}
+	plaintext, err := c.aead.Open(nil, nonce, ciphertext, []byte(name))
+	if err != nil {
+		return nil, secretdomain.ErrDecryptionFailed
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FunctionLister is an autogenerated mock type for the FunctionLister type
+type FunctionLister struct {
+	mock.Mock
+}
+
+type FunctionLister_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FunctionLister) EXPECT() *FunctionLister_Expecter {
+	return &FunctionLister_Expecter{mock: &_m.Mock}
+}
+
+// ListFunctions provides a mock function with given fields: ctx, args
+func (_m *FunctionLister) ListFunctions(ctx context.Context, args *funcdomain.ListFunctionsArgs) (*funcdomain.ListFunctionsResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFunctions")
+	}
+
+	var r0 *funcdomain.ListFunctionsResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.ListFunctionsArgs) (*funcdomain.ListFunctionsResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.ListFunctionsArgs) *funcdomain.ListFunctionsResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.ListFunctionsResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.ListFunctionsArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionLister_ListFunctions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFunctions'
+type FunctionLister_ListFunctions_Call struct {
+	*mock.Call
+}
+
+// ListFunctions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *funcdomain.ListFunctionsArgs
+func (_e *FunctionLister_Expecter) ListFunctions(ctx interface{}, args interface{}) *FunctionLister_ListFunctions_Call {
+	return &FunctionLister_ListFunctions_Call{Call: _e.mock.On("ListFunctions", ctx, args)}
+}
+
+func (_c *FunctionLister_ListFunctions_Call) Run(run func(ctx context.Context, args *funcdomain.ListFunctionsArgs)) *FunctionLister_ListFunctions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.ListFunctionsArgs))
+	})
+	return _c
+}
+
+func (_c *FunctionLister_ListFunctions_Call) Return(_a0 *funcdomain.ListFunctionsResult, _a1 error) *FunctionLister_ListFunctions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionLister_ListFunctions_Call) RunAndReturn(run func(context.Context, *funcdomain.ListFunctionsArgs) (*funcdomain.ListFunctionsResult, error)) *FunctionLister_ListFunctions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFunctionLister creates a new instance of FunctionLister. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFunctionLister(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FunctionLister {
+	mock := &FunctionLister{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
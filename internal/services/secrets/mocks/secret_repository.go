@@ -0,0 +1,296 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	secretdomain "github.com/10Narratives/faas/internal/domains/secrets"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SecretRepository is an autogenerated mock type for the SecretRepository type
+type SecretRepository struct {
+	mock.Mock
+}
+
+type SecretRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SecretRepository) EXPECT() *SecretRepository_Expecter {
+	return &SecretRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateSecret provides a mock function with given fields: ctx, s
+func (_m *SecretRepository) CreateSecret(ctx context.Context, s *secretdomain.EncryptedSecret) error {
+	ret := _m.Called(ctx, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSecret")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretdomain.EncryptedSecret) error); ok {
+		r0 = rf(ctx, s)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SecretRepository_CreateSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSecret'
+type SecretRepository_CreateSecret_Call struct {
+	*mock.Call
+}
+
+// CreateSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - s *secretdomain.EncryptedSecret
+func (_e *SecretRepository_Expecter) CreateSecret(ctx interface{}, s interface{}) *SecretRepository_CreateSecret_Call {
+	return &SecretRepository_CreateSecret_Call{Call: _e.mock.On("CreateSecret", ctx, s)}
+}
+
+func (_c *SecretRepository_CreateSecret_Call) Run(run func(ctx context.Context, s *secretdomain.EncryptedSecret)) *SecretRepository_CreateSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*secretdomain.EncryptedSecret))
+	})
+	return _c
+}
+
+func (_c *SecretRepository_CreateSecret_Call) Return(_a0 error) *SecretRepository_CreateSecret_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SecretRepository_CreateSecret_Call) RunAndReturn(run func(context.Context, *secretdomain.EncryptedSecret) error) *SecretRepository_CreateSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSecret provides a mock function with given fields: ctx, args
+func (_m *SecretRepository) DeleteSecret(ctx context.Context, args *secretdomain.DeleteSecretArgs) error {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSecret")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretdomain.DeleteSecretArgs) error); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SecretRepository_DeleteSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSecret'
+type SecretRepository_DeleteSecret_Call struct {
+	*mock.Call
+}
+
+// DeleteSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *secretdomain.DeleteSecretArgs
+func (_e *SecretRepository_Expecter) DeleteSecret(ctx interface{}, args interface{}) *SecretRepository_DeleteSecret_Call {
+	return &SecretRepository_DeleteSecret_Call{Call: _e.mock.On("DeleteSecret", ctx, args)}
+}
+
+func (_c *SecretRepository_DeleteSecret_Call) Run(run func(ctx context.Context, args *secretdomain.DeleteSecretArgs)) *SecretRepository_DeleteSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*secretdomain.DeleteSecretArgs))
+	})
+	return _c
+}
+
+func (_c *SecretRepository_DeleteSecret_Call) Return(_a0 error) *SecretRepository_DeleteSecret_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SecretRepository_DeleteSecret_Call) RunAndReturn(run func(context.Context, *secretdomain.DeleteSecretArgs) error) *SecretRepository_DeleteSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSecret provides a mock function with given fields: ctx, name
+func (_m *SecretRepository) GetSecret(ctx context.Context, name secretdomain.SecretName) (*secretdomain.EncryptedSecret, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSecret")
+	}
+
+	var r0 *secretdomain.EncryptedSecret
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, secretdomain.SecretName) (*secretdomain.EncryptedSecret, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, secretdomain.SecretName) *secretdomain.EncryptedSecret); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretdomain.EncryptedSecret)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, secretdomain.SecretName) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretRepository_GetSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSecret'
+type SecretRepository_GetSecret_Call struct {
+	*mock.Call
+}
+
+// GetSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name secretdomain.SecretName
+func (_e *SecretRepository_Expecter) GetSecret(ctx interface{}, name interface{}) *SecretRepository_GetSecret_Call {
+	return &SecretRepository_GetSecret_Call{Call: _e.mock.On("GetSecret", ctx, name)}
+}
+
+func (_c *SecretRepository_GetSecret_Call) Run(run func(ctx context.Context, name secretdomain.SecretName)) *SecretRepository_GetSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(secretdomain.SecretName))
+	})
+	return _c
+}
+
+func (_c *SecretRepository_GetSecret_Call) Return(_a0 *secretdomain.EncryptedSecret, _a1 error) *SecretRepository_GetSecret_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretRepository_GetSecret_Call) RunAndReturn(run func(context.Context, secretdomain.SecretName) (*secretdomain.EncryptedSecret, error)) *SecretRepository_GetSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSecrets provides a mock function with given fields: ctx, args
+func (_m *SecretRepository) ListSecrets(ctx context.Context, args *secretdomain.ListSecretsArgs) (*secretdomain.ListSecretsResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSecrets")
+	}
+
+	var r0 *secretdomain.ListSecretsResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretdomain.ListSecretsArgs) (*secretdomain.ListSecretsResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secretdomain.ListSecretsArgs) *secretdomain.ListSecretsResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretdomain.ListSecretsResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secretdomain.ListSecretsArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretRepository_ListSecrets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSecrets'
+type SecretRepository_ListSecrets_Call struct {
+	*mock.Call
+}
+
+// ListSecrets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *secretdomain.ListSecretsArgs
+func (_e *SecretRepository_Expecter) ListSecrets(ctx interface{}, args interface{}) *SecretRepository_ListSecrets_Call {
+	return &SecretRepository_ListSecrets_Call{Call: _e.mock.On("ListSecrets", ctx, args)}
+}
+
+func (_c *SecretRepository_ListSecrets_Call) Run(run func(ctx context.Context, args *secretdomain.ListSecretsArgs)) *SecretRepository_ListSecrets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*secretdomain.ListSecretsArgs))
+	})
+	return _c
+}
+
+func (_c *SecretRepository_ListSecrets_Call) Return(_a0 *secretdomain.ListSecretsResult, _a1 error) *SecretRepository_ListSecrets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretRepository_ListSecrets_Call) RunAndReturn(run func(context.Context, *secretdomain.ListSecretsArgs) (*secretdomain.ListSecretsResult, error)) *SecretRepository_ListSecrets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSecret provides a mock function with given fields: ctx, s
+func (_m *SecretRepository) UpdateSecret(ctx context.Context, s *secretdomain.EncryptedSecret) error {
+	ret := _m.Called(ctx, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSecret")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretdomain.EncryptedSecret) error); ok {
+		r0 = rf(ctx, s)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SecretRepository_UpdateSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSecret'
+type SecretRepository_UpdateSecret_Call struct {
+	*mock.Call
+}
+
+// UpdateSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - s *secretdomain.EncryptedSecret
+func (_e *SecretRepository_Expecter) UpdateSecret(ctx interface{}, s interface{}) *SecretRepository_UpdateSecret_Call {
+	return &SecretRepository_UpdateSecret_Call{Call: _e.mock.On("UpdateSecret", ctx, s)}
+}
+
+func (_c *SecretRepository_UpdateSecret_Call) Run(run func(ctx context.Context, s *secretdomain.EncryptedSecret)) *SecretRepository_UpdateSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*secretdomain.EncryptedSecret))
+	})
+	return _c
+}
+
+func (_c *SecretRepository_UpdateSecret_Call) Return(_a0 error) *SecretRepository_UpdateSecret_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SecretRepository_UpdateSecret_Call) RunAndReturn(run func(context.Context, *secretdomain.EncryptedSecret) error) *SecretRepository_UpdateSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSecretRepository creates a new instance of SecretRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSecretRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SecretRepository {
+	mock := &SecretRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,209 @@
+package secretsrv
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	secretdomain "github.com/10Narratives/faas/internal/domains/secrets"
+)
+
+//go:generate mockery --name SecretRepository --output ./mocks --outpkg mocks --with-expecter --filename secret_repository.go
+type SecretRepository interface {
+	CreateSecret(ctx context.Context, s *secretdomain.EncryptedSecret) error
+	GetSecret(ctx context.Context, name secretdomain.SecretName) (*secretdomain.EncryptedSecret, error)
+	UpdateSecret(ctx context.Context, s *secretdomain.EncryptedSecret) error
+	secretdomain.SecretDeleter
+	secretdomain.SecretLister
+}
+
+//go:generate mockery --name FunctionLister --output ./mocks --outpkg mocks --with-expecter --filename function_lister.go
+type FunctionLister interface {
+	funcdomain.FunctionLister
+}
+
+type Service struct {
+	secretRepo SecretRepository
+	funcLister FunctionLister
+	cipher     *Cipher
+}
+
+func NewService(secretRepo SecretRepository, funcLister FunctionLister, cipher *Cipher) *Service {
+	return &Service{
+		secretRepo: secretRepo,
+		funcLister: funcLister,
+		cipher:     cipher,
+	}
+}
+
+func (s *Service) CreateSecret(ctx context.Context, args *secretdomain.CreateSecretArgs) (*secretdomain.CreateSecretResult, error) {
+	if args == nil || args.Name == "" {
+		return nil, secretdomain.ErrInvalidArgument
+	}
+	if err := validateValue(args.Value); err != nil {
+		return nil, err
+	}
+
+	nonce, ciphertext, err := s.cipher.Seal(args.Name, args.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	es := &secretdomain.EncryptedSecret{
+		Secret: secretdomain.Secret{
+			Name:      args.Name,
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		Nonce:      nonce,
+		Ciphertext: ciphertext,
+	}
+
+	if err := s.secretRepo.CreateSecret(ctx, es); err != nil {
+		return nil, err
+	}
+
+	return &secretdomain.CreateSecretResult{Secret: &es.Secret}, nil
+}
+
+func (s *Service) GetSecret(ctx context.Context, args *secretdomain.GetSecretArgs) (*secretdomain.GetSecretResult, error) {
+	if args == nil || args.Name == "" {
+		return nil, secretdomain.ErrInvalidArgument
+	}
+
+	es, err := s.secretRepo.GetSecret(ctx, args.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &secretdomain.GetSecretResult{Secret: &es.Secret}, nil
+}
+
+func (s *Service) ListSecrets(ctx context.Context, args *secretdomain.ListSecretsArgs) (*secretdomain.ListSecretsResult, error) {
+	if args == nil {
+		return nil, secretdomain.ErrInvalidArgument
+	}
+	return s.secretRepo.ListSecrets(ctx, args)
+}
+
+func (s *Service) UpdateSecret(ctx context.Context, args *secretdomain.UpdateSecretArgs) (*secretdomain.UpdateSecretResult, error) {
+	if args == nil || args.Name == "" {
+		return nil, secretdomain.ErrInvalidArgument
+	}
+	if err := validateValue(args.Value); err != nil {
+		return nil, err
+	}
+
+	es, err := s.secretRepo.GetSecret(ctx, args.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, ciphertext, err := s.cipher.Seal(args.Name, args.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	es.Nonce = nonce
+	es.Ciphertext = ciphertext
+	es.UpdatedAt = time.Now().UTC()
+
+	if err := s.secretRepo.UpdateSecret(ctx, es); err != nil {
+		return nil, err
+	}
+
+	return &secretdomain.UpdateSecretResult{Secret: &es.Secret}, nil
+}
+
+// DeleteSecret refuses to delete a secret that the latest revision of a
+// function, deleted ones included, still references in secret_env.
+func (s *Service) DeleteSecret(ctx context.Context, args *secretdomain.DeleteSecretArgs) error {
+	if args == nil || args.Name == "" {
+		return secretdomain.ErrInvalidArgument
+	}
+
+	users, err := s.referencingFunctions(ctx, args.Name)
+	if err != nil {
+		return err
+	}
+	if len(users) > 0 {
+		return fmt.Errorf("%w: referenced by %s", secretdomain.ErrSecretInUse, strings.Join(users, ", "))
+	}
+
+	return s.secretRepo.DeleteSecret(ctx, args)
+}
+
+// referencingFunctions returns up to maxReportedUsers functions whose
+// latest revision references the secret.
+func (s *Service) referencingFunctions(ctx context.Context, name secretdomain.SecretName) ([]string, error) {
+	const maxReportedUsers = 5
+
+	var (
+		users []string
+		token string
+	)
+	for {
+		res, err := s.funcLister.ListFunctions(ctx, &funcdomain.ListFunctionsArgs{
+			PageSize:    1000,
+			PageToken:   token,
+			ShowDeleted: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, fn := range res.Functions {
+			for _, ref := range fn.SecretEnv {
+				if ref == string(name) {
+					users = append(users, string(fn.Name))
+					break
+				}
+			}
+			if len(users) == maxReportedUsers {
+				return users, nil
+			}
+		}
+		if res.NextPageToken == "" {
+			return users, nil
+		}
+		token = res.NextPageToken
+	}
+}
+
+func (s *Service) ResolveSecrets(ctx context.Context, args *secretdomain.ResolveSecretsArgs) (*secretdomain.ResolveSecretsResult, error) {
+	if args == nil {
+		return nil, secretdomain.ErrInvalidArgument
+	}
+
+	values := make(map[secretdomain.SecretName][]byte, len(args.Names))
+	for _, name := range args.Names {
+		if _, ok := values[name]; ok {
+			continue
+		}
+
+		es, err := s.secretRepo.GetSecret(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+
+		plaintext, err := s.cipher.Open(name, es.Nonce, es.Ciphertext)
+		if err != nil {
+			return nil, err
+		}
+		values[name] = plaintext
+	}
+
+	return &secretdomain.ResolveSecretsResult{Values: values}, nil
+}
+
+func validateValue(v []byte) error {
+	if len(v) == 0 {
+		return secretdomain.ErrEmptyValue
+	}
+	if len(v) > secretdomain.MaxValueSize {
+		return secretdomain.ErrValueTooLarge
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package secretsrv_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	secretdomain "github.com/10Narratives/faas/internal/domains/secrets"
+	secretsrv "github.com/10Narratives/faas/internal/services/secrets"
+	"github.com/10Narratives/faas/internal/services/secrets/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newCipher(t *testing.T) *secretsrv.Cipher {
+	t.Helper()
+	c, err := secretsrv.NewCipher(bytes.Repeat([]byte{7}, 32))
+	require.NoError(t, err)
+	return c
+}
+
+func TestNewCipher_RejectsShortKey(t *testing.T) {
+	_, err := secretsrv.NewCipher([]byte("short"))
+	require.Error(t, err)
+}
+
+func TestService_CreateSecret(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("error: empty value", func(t *testing.T) {
+		repo := mocks.NewSecretRepository(t)
+		svc := secretsrv.NewService(repo, mocks.NewFunctionLister(t), newCipher(t))
+
+		res, err := svc.CreateSecret(ctx, &secretdomain.CreateSecretArgs{Name: "secrets/db"})
+		require.ErrorIs(t, err, secretdomain.ErrEmptyValue)
+		require.Nil(t, res)
+	})
+
+	t.Run("ok: stores ciphertext, never plaintext", func(t *testing.T) {
+		repo := mocks.NewSecretRepository(t)
+		svc := secretsrv.NewService(repo, mocks.NewFunctionLister(t), newCipher(t))
+
+		plaintext := []byte("hunter2-very-secret")
+
+		repo.EXPECT().
+			CreateSecret(ctx, mock.MatchedBy(func(s *secretdomain.EncryptedSecret) bool {
+				return s.Name == "secrets/db" &&
+					len(s.Nonce) > 0 &&
+					len(s.Ciphertext) > 0 &&
+					!bytes.Contains(s.Ciphertext, plaintext)
+			})).
+			Return(nil).
+			Once()
+
+		res, err := svc.CreateSecret(ctx, &secretdomain.CreateSecretArgs{Name: "secrets/db", Value: plaintext})
+		require.NoError(t, err)
+		require.Equal(t, secretdomain.SecretName("secrets/db"), res.Secret.Name)
+		require.False(t, res.Secret.CreatedAt.IsZero())
+	})
+}
+
+func TestService_ResolveSecrets(t *testing.T) {
+	ctx := context.Background()
+	cipher := newCipher(t)
+
+	nonce, ciphertext, err := cipher.Seal("secrets/db", []byte("s3cr3t"))
+	require.NoError(t, err)
+
+	stored := &secretdomain.EncryptedSecret{
+		Secret:     secretdomain.Secret{Name: "secrets/db"},
+		Nonce:      nonce,
+		Ciphertext: ciphertext,
+	}
+
+	t.Run("ok: decrypts each secret once", func(t *testing.T) {
+		repo := mocks.NewSecretRepository(t)
+		svc := secretsrv.NewService(repo, mocks.NewFunctionLister(t), cipher)
+
+		repo.EXPECT().GetSecret(ctx, secretdomain.SecretName("secrets/db")).Return(stored, nil).Once()
+
+		res, err := svc.ResolveSecrets(ctx, &secretdomain.ResolveSecretsArgs{
+			Names: []secretdomain.SecretName{"secrets/db", "secrets/db"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []byte("s3cr3t"), res.Values["secrets/db"])
+	})
+
+	t.Run("error: ciphertext bound to another name", func(t *testing.T) {
+		repo := mocks.NewSecretRepository(t)
+		svc := secretsrv.NewService(repo, mocks.NewFunctionLister(t), cipher)
+
+		repo.EXPECT().GetSecret(ctx, secretdomain.SecretName("secrets/other")).Return(stored, nil).Once()
+
+		res, err := svc.ResolveSecrets(ctx, &secretdomain.ResolveSecretsArgs{
+			Names: []secretdomain.SecretName{"secrets/other"},
+		})
+		require.ErrorIs(t, err, secretdomain.ErrDecryptionFailed)
+		require.Nil(t, res)
+	})
+
+	t.Run("error: missing secret", func(t *testing.T) {
+		repo := mocks.NewSecretRepository(t)
+		svc := secretsrv.NewService(repo, mocks.NewFunctionLister(t), cipher)
+
+		repo.EXPECT().
+			GetSecret(ctx, secretdomain.SecretName("secrets/missing")).
+			Return((*secretdomain.EncryptedSecret)(nil), secretdomain.ErrSecretNotFound).
+			Once()
+
+		_, err := svc.ResolveSecrets(ctx, &secretdomain.ResolveSecretsArgs{
+			Names: []secretdomain.SecretName{"secrets/missing"},
+		})
+		require.ErrorIs(t, err, secretdomain.ErrSecretNotFound)
+	})
+}
+
+func TestService_DeleteSecret(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("error: referenced by a function", func(t *testing.T) {
+		repo := mocks.NewSecretRepository(t)
+		funcs := mocks.NewFunctionLister(t)
+		svc := secretsrv.NewService(repo, funcs, newCipher(t))
+
+		funcs.EXPECT().
+			ListFunctions(ctx, mock.MatchedBy(func(a *funcdomain.ListFunctionsArgs) bool { return a.ShowDeleted && a.PageToken == "" })).
+			Return(&funcdomain.ListFunctionsResult{
+				Functions: []*funcdomain.Function{
+					{Name: "functions/api", SecretEnv: map[string]string{"TOKEN": "secrets/api"}},
+				},
+				NextPageToken: "next",
+			}, nil).Once()
+		funcs.EXPECT().
+			ListFunctions(ctx, mock.MatchedBy(func(a *funcdomain.ListFunctionsArgs) bool { return a.PageToken == "next" })).
+			Return(&funcdomain.ListFunctionsResult{
+				Functions: []*funcdomain.Function{
+					{Name: "functions/report", SecretEnv: map[string]string{"DB_PASSWORD": "secrets/db"}},
+				},
+			}, nil).Once()
+
+		err := svc.DeleteSecret(ctx, &secretdomain.DeleteSecretArgs{Name: "secrets/db"})
+		require.ErrorIs(t, err, secretdomain.ErrSecretInUse)
+		require.ErrorContains(t, err, "functions/report")
+	})
+
+	t.Run("ok: unreferenced", func(t *testing.T) {
+		repo := mocks.NewSecretRepository(t)
+		funcs := mocks.NewFunctionLister(t)
+		svc := secretsrv.NewService(repo, funcs, newCipher(t))
+
+		funcs.EXPECT().ListFunctions(ctx, mock.Anything).
+			Return(&funcdomain.ListFunctionsResult{
+				Functions: []*funcdomain.Function{{Name: "functions/api", Env: map[string]string{"DB": "secrets/db"}}},
+			}, nil).Once()
+		repo.EXPECT().DeleteSecret(ctx, &secretdomain.DeleteSecretArgs{Name: "secrets/db"}).Return(nil).Once()
+
+		require.NoError(t, svc.DeleteSecret(ctx, &secretdomain.DeleteSecretArgs{Name: "secrets/db"}))
+	})
+}
@@ -0,0 +1,84 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	jobdomain "github.com/10Narratives/faas/internal/domains/jobs"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// JobTaskRecorder is an autogenerated mock type for the JobTaskRecorder type
+type JobTaskRecorder struct {
+	mock.Mock
+}
+
+type JobTaskRecorder_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *JobTaskRecorder) EXPECT() *JobTaskRecorder_Expecter {
+	return &JobTaskRecorder_Expecter{mock: &_m.Mock}
+}
+
+// RecordJobTask provides a mock function with given fields: ctx, args
+func (_m *JobTaskRecorder) RecordJobTask(ctx context.Context, args *jobdomain.RecordJobTaskArgs) error {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordJobTask")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *jobdomain.RecordJobTaskArgs) error); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// JobTaskRecorder_RecordJobTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordJobTask'
+type JobTaskRecorder_RecordJobTask_Call struct {
+	*mock.Call
+}
+
+// RecordJobTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *jobdomain.RecordJobTaskArgs
+func (_e *JobTaskRecorder_Expecter) RecordJobTask(ctx interface{}, args interface{}) *JobTaskRecorder_RecordJobTask_Call {
+	return &JobTaskRecorder_RecordJobTask_Call{Call: _e.mock.On("RecordJobTask", ctx, args)}
+}
+
+func (_c *JobTaskRecorder_RecordJobTask_Call) Run(run func(ctx context.Context, args *jobdomain.RecordJobTaskArgs)) *JobTaskRecorder_RecordJobTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*jobdomain.RecordJobTaskArgs))
+	})
+	return _c
+}
+
+func (_c *JobTaskRecorder_RecordJobTask_Call) Return(_a0 error) *JobTaskRecorder_RecordJobTask_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JobTaskRecorder_RecordJobTask_Call) RunAndReturn(run func(context.Context, *jobdomain.RecordJobTaskArgs) error) *JobTaskRecorder_RecordJobTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewJobTaskRecorder creates a new instance of JobTaskRecorder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJobTaskRecorder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JobTaskRecorder {
+	mock := &JobTaskRecorder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
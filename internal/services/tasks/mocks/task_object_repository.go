@@ -0,0 +1,204 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+
+	taskdomain "github.com/10Narratives/faas/internal/domains/tasks"
+)
+
+// TaskObjectRepository is an autogenerated mock type for the TaskObjectRepository type
+type TaskObjectRepository struct {
+	mock.Mock
+}
+
+type TaskObjectRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TaskObjectRepository) EXPECT() *TaskObjectRepository_Expecter {
+	return &TaskObjectRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteTaskArtifact provides a mock function with given fields: ctx, artifact
+func (_m *TaskObjectRepository) DeleteTaskArtifact(ctx context.Context, artifact *taskdomain.TaskArtifact) error {
+	ret := _m.Called(ctx, artifact)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTaskArtifact")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *taskdomain.TaskArtifact) error); ok {
+		r0 = rf(ctx, artifact)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TaskObjectRepository_DeleteTaskArtifact_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTaskArtifact'
+type TaskObjectRepository_DeleteTaskArtifact_Call struct {
+	*mock.Call
+}
+
+// DeleteTaskArtifact is a helper method to define mock.On call
+//   - ctx context.Context
+//   - artifact *taskdomain.TaskArtifact
+func (_e *TaskObjectRepository_Expecter) DeleteTaskArtifact(ctx interface{}, artifact interface{}) *TaskObjectRepository_DeleteTaskArtifact_Call {
+	return &TaskObjectRepository_DeleteTaskArtifact_Call{Call: _e.mock.On("DeleteTaskArtifact", ctx, artifact)}
+}
+
+func (_c *TaskObjectRepository_DeleteTaskArtifact_Call) Run(run func(ctx context.Context, artifact *taskdomain.TaskArtifact)) *TaskObjectRepository_DeleteTaskArtifact_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*taskdomain.TaskArtifact))
+	})
+	return _c
+}
+
+func (_c *TaskObjectRepository_DeleteTaskArtifact_Call) Return(_a0 error) *TaskObjectRepository_DeleteTaskArtifact_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TaskObjectRepository_DeleteTaskArtifact_Call) RunAndReturn(run func(context.Context, *taskdomain.TaskArtifact) error) *TaskObjectRepository_DeleteTaskArtifact_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenTaskArtifact provides a mock function with given fields: ctx, artifact
+func (_m *TaskObjectRepository) OpenTaskArtifact(ctx context.Context, artifact *taskdomain.TaskArtifact) (io.ReadCloser, error) {
+	ret := _m.Called(ctx, artifact)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenTaskArtifact")
+	}
+
+	var r0 io.ReadCloser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *taskdomain.TaskArtifact) (io.ReadCloser, error)); ok {
+		return rf(ctx, artifact)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *taskdomain.TaskArtifact) io.ReadCloser); ok {
+		r0 = rf(ctx, artifact)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *taskdomain.TaskArtifact) error); ok {
+		r1 = rf(ctx, artifact)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TaskObjectRepository_OpenTaskArtifact_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenTaskArtifact'
+type TaskObjectRepository_OpenTaskArtifact_Call struct {
+	*mock.Call
+}
+
+// OpenTaskArtifact is a helper method to define mock.On call
+//   - ctx context.Context
+//   - artifact *taskdomain.TaskArtifact
+func (_e *TaskObjectRepository_Expecter) OpenTaskArtifact(ctx interface{}, artifact interface{}) *TaskObjectRepository_OpenTaskArtifact_Call {
+	return &TaskObjectRepository_OpenTaskArtifact_Call{Call: _e.mock.On("OpenTaskArtifact", ctx, artifact)}
+}
+
+func (_c *TaskObjectRepository_OpenTaskArtifact_Call) Run(run func(ctx context.Context, artifact *taskdomain.TaskArtifact)) *TaskObjectRepository_OpenTaskArtifact_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*taskdomain.TaskArtifact))
+	})
+	return _c
+}
+
+func (_c *TaskObjectRepository_OpenTaskArtifact_Call) Return(_a0 io.ReadCloser, _a1 error) *TaskObjectRepository_OpenTaskArtifact_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TaskObjectRepository_OpenTaskArtifact_Call) RunAndReturn(run func(context.Context, *taskdomain.TaskArtifact) (io.ReadCloser, error)) *TaskObjectRepository_OpenTaskArtifact_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveTaskInput provides a mock function with given fields: ctx, args
+func (_m *TaskObjectRepository) SaveTaskInput(ctx context.Context, args *taskdomain.SaveTaskArtifactArgs) (*taskdomain.SaveTaskArtifactResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveTaskInput")
+	}
+
+	var r0 *taskdomain.SaveTaskArtifactResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *taskdomain.SaveTaskArtifactArgs) (*taskdomain.SaveTaskArtifactResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *taskdomain.SaveTaskArtifactArgs) *taskdomain.SaveTaskArtifactResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*taskdomain.SaveTaskArtifactResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *taskdomain.SaveTaskArtifactArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TaskObjectRepository_SaveTaskInput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveTaskInput'
+type TaskObjectRepository_SaveTaskInput_Call struct {
+	*mock.Call
+}
+
+// SaveTaskInput is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *taskdomain.SaveTaskArtifactArgs
+func (_e *TaskObjectRepository_Expecter) SaveTaskInput(ctx interface{}, args interface{}) *TaskObjectRepository_SaveTaskInput_Call {
+	return &TaskObjectRepository_SaveTaskInput_Call{Call: _e.mock.On("SaveTaskInput", ctx, args)}
+}
+
+func (_c *TaskObjectRepository_SaveTaskInput_Call) Run(run func(ctx context.Context, args *taskdomain.SaveTaskArtifactArgs)) *TaskObjectRepository_SaveTaskInput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*taskdomain.SaveTaskArtifactArgs))
+	})
+	return _c
+}
+
+func (_c *TaskObjectRepository_SaveTaskInput_Call) Return(_a0 *taskdomain.SaveTaskArtifactResult, _a1 error) *TaskObjectRepository_SaveTaskInput_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TaskObjectRepository_SaveTaskInput_Call) RunAndReturn(run func(context.Context, *taskdomain.SaveTaskArtifactArgs) (*taskdomain.SaveTaskArtifactResult, error)) *TaskObjectRepository_SaveTaskInput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTaskObjectRepository creates a new instance of TaskObjectRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTaskObjectRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TaskObjectRepository {
+	mock := &TaskObjectRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -305,6 +305,124 @@ func (_c *TaskRepository_ListTasks_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// UpdateTask provides a mock function with given fields: ctx, args
+func (_m *TaskRepository) UpdateTask(ctx context.Context, args *taskdomain.UpdateTaskArgs) (*taskdomain.UpdateTaskResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTask")
+	}
+
+	var r0 *taskdomain.UpdateTaskResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *taskdomain.UpdateTaskArgs) (*taskdomain.UpdateTaskResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *taskdomain.UpdateTaskArgs) *taskdomain.UpdateTaskResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*taskdomain.UpdateTaskResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *taskdomain.UpdateTaskArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TaskRepository_UpdateTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTask'
+type TaskRepository_UpdateTask_Call struct {
+	*mock.Call
+}
+
+// UpdateTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *taskdomain.UpdateTaskArgs
+func (_e *TaskRepository_Expecter) UpdateTask(ctx interface{}, args interface{}) *TaskRepository_UpdateTask_Call {
+	return &TaskRepository_UpdateTask_Call{Call: _e.mock.On("UpdateTask", ctx, args)}
+}
+
+func (_c *TaskRepository_UpdateTask_Call) Run(run func(ctx context.Context, args *taskdomain.UpdateTaskArgs)) *TaskRepository_UpdateTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*taskdomain.UpdateTaskArgs))
+	})
+	return _c
+}
+
+func (_c *TaskRepository_UpdateTask_Call) Return(_a0 *taskdomain.UpdateTaskResult, _a1 error) *TaskRepository_UpdateTask_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TaskRepository_UpdateTask_Call) RunAndReturn(run func(context.Context, *taskdomain.UpdateTaskArgs) (*taskdomain.UpdateTaskResult, error)) *TaskRepository_UpdateTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WaitTask provides a mock function with given fields: ctx, args
+func (_m *TaskRepository) WaitTask(ctx context.Context, args *taskdomain.WaitTaskArgs) (*taskdomain.WaitTaskResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WaitTask")
+	}
+
+	var r0 *taskdomain.WaitTaskResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *taskdomain.WaitTaskArgs) (*taskdomain.WaitTaskResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *taskdomain.WaitTaskArgs) *taskdomain.WaitTaskResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*taskdomain.WaitTaskResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *taskdomain.WaitTaskArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TaskRepository_WaitTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WaitTask'
+type TaskRepository_WaitTask_Call struct {
+	*mock.Call
+}
+
+// WaitTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *taskdomain.WaitTaskArgs
+func (_e *TaskRepository_Expecter) WaitTask(ctx interface{}, args interface{}) *TaskRepository_WaitTask_Call {
+	return &TaskRepository_WaitTask_Call{Call: _e.mock.On("WaitTask", ctx, args)}
+}
+
+func (_c *TaskRepository_WaitTask_Call) Run(run func(ctx context.Context, args *taskdomain.WaitTaskArgs)) *TaskRepository_WaitTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*taskdomain.WaitTaskArgs))
+	})
+	return _c
+}
+
+func (_c *TaskRepository_WaitTask_Call) Return(_a0 *taskdomain.WaitTaskResult, _a1 error) *TaskRepository_WaitTask_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TaskRepository_WaitTask_Call) RunAndReturn(run func(context.Context, *taskdomain.WaitTaskArgs) (*taskdomain.WaitTaskResult, error)) *TaskRepository_WaitTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewTaskRepository creates a new instance of TaskRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewTaskRepository(t interface {
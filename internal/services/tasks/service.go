@@ -2,8 +2,15 @@ package tasksrv
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
 
+	jobdomain "github.com/10Narratives/faas/internal/domains/jobs"
 	taskdomain "github.com/10Narratives/faas/internal/domains/tasks"
+	digestutils "github.com/10Narratives/faas/pkg/digest"
+	"github.com/google/uuid"
 )
 
 //go:generate mockery --name TaskRepository --output ./mocks --outpkg mocks --with-expecter --filename task_repository.go
@@ -13,6 +20,8 @@ type TaskRepository interface {
 	taskdomain.TaskLister
 	taskdomain.TaskDeleter
 	taskdomain.TaskCanceler
+	taskdomain.TaskUpdater
+	taskdomain.TaskWaiter
 }
 
 //go:generate mockery --name TaskPublisher --output ./mocks --outpkg mocks --with-expecter --filename task_publisher.go
@@ -20,18 +29,39 @@ type TaskPublisher interface {
 	taskdomain.TaskPublisher
 }
 
+//go:generate mockery --name TaskObjectRepository --output ./mocks --outpkg mocks --with-expecter --filename task_object_repository.go
+type TaskObjectRepository interface {
+	SaveTaskInput(ctx context.Context, args *taskdomain.SaveTaskArtifactArgs) (*taskdomain.SaveTaskArtifactResult, error)
+	OpenTaskArtifact(ctx context.Context, artifact *taskdomain.TaskArtifact) (io.ReadCloser, error)
+	DeleteTaskArtifact(ctx context.Context, artifact *taskdomain.TaskArtifact) error
+}
+
+//go:generate mockery --name JobTaskRecorder --output ./mocks --outpkg mocks --with-expecter --filename job_task_recorder.go
+type JobTaskRecorder interface {
+	jobdomain.JobTaskRecorder
+}
+
+// expirePageSize is the page size ExpireTasks lists tasks with.
+const expirePageSize = 500
+
 type Service struct {
-	taskRepo TaskRepository
-	taskPub  TaskPublisher
+	taskRepo    TaskRepository
+	taskPub     TaskPublisher
+	taskObjRepo TaskObjectRepository
+	jobs        JobTaskRecorder
 }
 
 func NewService(
 	taskRepo TaskRepository,
 	taskPub TaskPublisher,
+	taskObjRepo TaskObjectRepository,
+	jobs JobTaskRecorder,
 ) *Service {
 	return &Service{
-		taskRepo: taskRepo,
-		taskPub:  taskPub,
+		taskRepo:    taskRepo,
+		taskPub:     taskPub,
+		taskObjRepo: taskObjRepo,
+		jobs:        jobs,
 	}
 }
 
@@ -54,25 +84,130 @@ func (s *Service) CancelTask(ctx context.Context, args *taskdomain.CancelTaskArg
 	_ = s.taskPub.PublishCancel(ctx, &taskdomain.CancelTaskMessage{
 		TaskName: res.Task.Name,
 	})
+	// Like the cancel message, this is best effort: the task is canceled
+	// either way, and the job reconciler repairs a lost count.
+	if res.Task.BatchID != "" {
+		_ = s.jobs.RecordJobTask(ctx, &jobdomain.RecordJobTaskArgs{
+			Name:  string(jobdomain.JobNameForBatch(res.Task.BatchID)),
+			State: taskdomain.TaskStateCanceled,
+		})
+	}
 
 	return res, nil
 }
 
 func (s *Service) DeleteTask(ctx context.Context, args *taskdomain.DeleteTaskArgs) error {
-	return s.taskRepo.DeleteTask(ctx, args)
+	if args == nil {
+		return taskdomain.ErrInvalidName
+	}
+
+	got, err := s.taskRepo.GetTask(ctx, &taskdomain.GetTaskArgs{Name: args.Name})
+	if err != nil {
+		return err
+	}
+
+	if err := s.taskRepo.DeleteTask(ctx, args); err != nil {
+		return err
+	}
+
+	// Stored files are unreachable once the task is gone, so cleanup is best
+	// effort.
+	if got != nil && got.Task != nil {
+		s.deleteFiles(ctx, got.Task.Inputs)
+		if got.Task.Result != nil {
+			s.deleteFiles(ctx, got.Task.Result.Artifacts)
+		}
+	}
+	return nil
+}
+
+// ExpireTasks deletes the tasks that ended before endedBefore, together
+// with their input and artifact files; the result object goes to the
+// garbage collector once nothing references it. Pending and processing
+// tasks are never expired.
+func (s *Service) ExpireTasks(ctx context.Context, endedBefore time.Time) (int, error) {
+	// Listing completes before anything is deleted so deletions cannot
+	// shift the pages.
+	var (
+		expired []string
+		token   string
+	)
+	for {
+		page, err := s.taskRepo.ListTasks(ctx, &taskdomain.ListTasksArgs{PageSize: expirePageSize, PageToken: token})
+		if err != nil {
+			return 0, err
+		}
+		for _, t := range page.Tasks {
+			if t.State.IsTerminal() && !t.EndedAt.IsZero() && t.EndedAt.Before(endedBefore) {
+				expired = append(expired, string(t.Name))
+			}
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		token = page.NextPageToken
+	}
+
+	var (
+		removed int
+		errs    []error
+	)
+	for _, name := range expired {
+		err := s.DeleteTask(ctx, &taskdomain.DeleteTaskArgs{Name: name})
+		switch {
+		case err == nil:
+			removed++
+		case errors.Is(err, taskdomain.ErrNotFound):
+			// Deleted concurrently.
+		default:
+			errs = append(errs, fmt.Errorf("expire %s: %w", name, err))
+		}
+	}
+	return removed, errors.Join(errs...)
+}
+
+func (s *Service) deleteFiles(ctx context.Context, files []taskdomain.TaskArtifact) {
+	for i := range files {
+		_ = s.taskObjRepo.DeleteTaskArtifact(ctx, &files[i])
+	}
 }
 
 func (s *Service) ListTasks(ctx context.Context, args *taskdomain.ListTasksArgs) (*taskdomain.ListTaskResult, error) {
 	return s.taskRepo.ListTasks(ctx, args)
 }
 
+func (s *Service) UpdateTask(ctx context.Context, args *taskdomain.UpdateTaskArgs) (*taskdomain.UpdateTaskResult, error) {
+	if args == nil {
+		return nil, taskdomain.ErrInvalidName
+	}
+	// Validate before touching the store; the repository applies the
+	// update again to the current record.
+	if err := (&taskdomain.Task{}).ApplyUpdate(args); err != nil {
+		return nil, err
+	}
+	return s.taskRepo.UpdateTask(ctx, args)
+}
+
 func (s *Service) GetTask(ctx context.Context, args *taskdomain.GetTaskArgs) (*taskdomain.GetTaskResult, error) {
 	return s.taskRepo.GetTask(ctx, args)
 }
 
+func (s *Service) WaitTask(ctx context.Context, args *taskdomain.WaitTaskArgs) (*taskdomain.WaitTaskResult, error) {
+	return s.taskRepo.WaitTask(ctx, args)
+}
+
 func (s *Service) CreateTask(ctx context.Context, args *taskdomain.CreateTaskArgs) (*taskdomain.CreateTaskResult, error) {
+	if args != nil && args.InputFiles != nil {
+		if err := s.storeInputs(ctx, args); err != nil {
+			return nil, err
+		}
+	}
+
 	res, err := s.taskRepo.CreateTask(ctx, args)
 	if err != nil {
+		if args != nil {
+			s.deleteFiles(ctx, args.Inputs)
+		}
 		return nil, err
 	}
 	if res == nil || res.Name == "" {
@@ -85,3 +220,121 @@ func (s *Service) CreateTask(ctx context.Context, args *taskdomain.CreateTaskArg
 
 	return res, nil
 }
+
+func (s *Service) ListTaskArtifacts(ctx context.Context, args *taskdomain.ListTaskArtifactsArgs) (*taskdomain.ListTaskArtifactsResult, error) {
+	if args == nil {
+		return nil, taskdomain.ErrInvalidName
+	}
+	if _, err := taskdomain.ParseTaskName(args.Name); err != nil {
+		return nil, err
+	}
+
+	got, err := s.taskRepo.GetTask(ctx, &taskdomain.GetTaskArgs{Name: args.Name})
+	if err != nil {
+		return nil, err
+	}
+	if got == nil || got.Task == nil {
+		return nil, taskdomain.ErrNotFound
+	}
+
+	out := &taskdomain.ListTaskArtifactsResult{}
+	if got.Task.Result != nil {
+		out.Artifacts = got.Task.Result.Artifacts
+	}
+	return out, nil
+}
+
+func (s *Service) DownloadTaskArtifact(ctx context.Context, args *taskdomain.DownloadTaskArtifactArgs) (*taskdomain.DownloadTaskArtifactResult, error) {
+	if args == nil {
+		return nil, taskdomain.ErrInvalidName
+	}
+	if _, err := taskdomain.ParseTaskName(args.Name); err != nil {
+		return nil, err
+	}
+	if err := taskdomain.ValidateArtifactName(args.Artifact); err != nil {
+		return nil, err
+	}
+
+	got, err := s.taskRepo.GetTask(ctx, &taskdomain.GetTaskArgs{Name: args.Name})
+	if err != nil {
+		return nil, err
+	}
+	if got == nil || got.Task == nil {
+		return nil, taskdomain.ErrNotFound
+	}
+
+	artifact, ok := got.Task.Result.Artifact(args.Artifact)
+	if !ok {
+		return nil, taskdomain.ErrArtifactNotFound
+	}
+
+	rc, err := s.taskObjRepo.OpenTaskArtifact(ctx, artifact)
+	if err != nil {
+		return nil, err
+	}
+
+	return &taskdomain.DownloadTaskArtifactResult{Artifact: artifact, Data: rc}, nil
+}
+
+// storeInputs consumes args.InputFiles before the task record exists, so the
+// task ID is chosen here. On failure the inputs stored so far are removed.
+func (s *Service) storeInputs(ctx context.Context, args *taskdomain.CreateTaskArgs) error {
+	args.ID = uuid.New()
+	task := taskdomain.TaskName("tasks/" + args.ID.String())
+
+	seen := make(map[string]struct{})
+	for {
+		in, err := args.InputFiles.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err == nil {
+			err = s.storeInput(ctx, task, in, seen, args)
+		}
+		if err != nil {
+			s.deleteFiles(ctx, args.Inputs)
+			args.Inputs = nil
+			return err
+		}
+	}
+}
+
+func (s *Service) storeInput(
+	ctx context.Context,
+	task taskdomain.TaskName,
+	in *taskdomain.TaskInput,
+	seen map[string]struct{},
+	args *taskdomain.CreateTaskArgs,
+) error {
+	if err := taskdomain.ValidateArtifactName(in.Name); err != nil {
+		return err
+	}
+	if _, dup := seen[in.Name]; dup {
+		return fmt.Errorf("%w: %q", taskdomain.ErrDuplicateInput, in.Name)
+	}
+	seen[in.Name] = struct{}{}
+
+	var declared string
+	if in.SHA256 != "" {
+		d, err := digestutils.NormalizeSHA256(in.SHA256)
+		if err != nil {
+			return errors.Join(taskdomain.ErrInvalidParameters, err)
+		}
+		declared = d
+	}
+
+	res, err := s.taskObjRepo.SaveTaskInput(ctx, &taskdomain.SaveTaskArtifactArgs{
+		Task: task,
+		Name: in.Name,
+		Data: in.Data,
+	})
+	if err != nil {
+		return err
+	}
+	args.Inputs = append(args.Inputs, *res.Artifact)
+
+	if declared != "" && res.Artifact.SHA256 != declared {
+		return fmt.Errorf("%w: %s: declared %s, received %s", taskdomain.ErrInputDigestMismatch, in.Name, declared, res.Artifact.SHA256)
+	}
+	return nil
+}
@@ -2,13 +2,20 @@ package tasksrv_test
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"io"
+	"strings"
 	"testing"
+	"time"
 
+	jobdomain "github.com/10Narratives/faas/internal/domains/jobs"
 	taskdomain "github.com/10Narratives/faas/internal/domains/tasks"
 	tasksrv "github.com/10Narratives/faas/internal/services/tasks"
 	"github.com/10Narratives/faas/internal/services/tasks/mocks"
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -19,7 +26,7 @@ func TestService_CreateTask(t *testing.T) {
 		repo := mocks.NewTaskRepository(t)
 		pub := mocks.NewTaskPublisher(t)
 
-		svc := tasksrv.NewService(repo, pub)
+		svc := tasksrv.NewService(repo, pub, mocks.NewTaskObjectRepository(t), mocks.NewJobTaskRecorder(t))
 
 		args := &taskdomain.CreateTaskArgs{Function: "fn", Parameters: "{}"}
 		wantErr := errors.New("repo fail")
@@ -35,7 +42,7 @@ func TestService_CreateTask(t *testing.T) {
 		repo := mocks.NewTaskRepository(t)
 		pub := mocks.NewTaskPublisher(t)
 
-		svc := tasksrv.NewService(repo, pub)
+		svc := tasksrv.NewService(repo, pub, mocks.NewTaskObjectRepository(t), mocks.NewJobTaskRecorder(t))
 
 		args := &taskdomain.CreateTaskArgs{Function: "fn", Parameters: "{}"}
 
@@ -50,7 +57,7 @@ func TestService_CreateTask(t *testing.T) {
 		repo := mocks.NewTaskRepository(t)
 		pub := mocks.NewTaskPublisher(t)
 
-		svc := tasksrv.NewService(repo, pub)
+		svc := tasksrv.NewService(repo, pub, mocks.NewTaskObjectRepository(t), mocks.NewJobTaskRecorder(t))
 
 		args := &taskdomain.CreateTaskArgs{Function: "fn", Parameters: "{}"}
 
@@ -65,7 +72,7 @@ func TestService_CreateTask(t *testing.T) {
 		repo := mocks.NewTaskRepository(t)
 		pub := mocks.NewTaskPublisher(t)
 
-		svc := tasksrv.NewService(repo, pub)
+		svc := tasksrv.NewService(repo, pub, mocks.NewTaskObjectRepository(t), mocks.NewJobTaskRecorder(t))
 
 		args := &taskdomain.CreateTaskArgs{Function: "fn", Parameters: "{}"}
 		repoRes := &taskdomain.CreateTaskResult{Name: "tasks/123"}
@@ -89,7 +96,7 @@ func TestService_CancelTask(t *testing.T) {
 		repo := mocks.NewTaskRepository(t)
 		pub := mocks.NewTaskPublisher(t)
 
-		svc := tasksrv.NewService(repo, pub)
+		svc := tasksrv.NewService(repo, pub, mocks.NewTaskObjectRepository(t), mocks.NewJobTaskRecorder(t))
 
 		res, err := svc.CancelTask(ctx, nil)
 		require.ErrorIs(t, err, taskdomain.ErrInvalidName)
@@ -100,7 +107,7 @@ func TestService_CancelTask(t *testing.T) {
 		repo := mocks.NewTaskRepository(t)
 		pub := mocks.NewTaskPublisher(t)
 
-		svc := tasksrv.NewService(repo, pub)
+		svc := tasksrv.NewService(repo, pub, mocks.NewTaskObjectRepository(t), mocks.NewJobTaskRecorder(t))
 
 		res, err := svc.CancelTask(ctx, &taskdomain.CancelTaskArgs{Name: ""})
 		require.ErrorIs(t, err, taskdomain.ErrInvalidName)
@@ -111,7 +118,7 @@ func TestService_CancelTask(t *testing.T) {
 		repo := mocks.NewTaskRepository(t)
 		pub := mocks.NewTaskPublisher(t)
 
-		svc := tasksrv.NewService(repo, pub)
+		svc := tasksrv.NewService(repo, pub, mocks.NewTaskObjectRepository(t), mocks.NewJobTaskRecorder(t))
 
 		res, err := svc.CancelTask(ctx, &taskdomain.CancelTaskArgs{Name: "bad/123"})
 		require.ErrorIs(t, err, taskdomain.ErrInvalidName)
@@ -122,7 +129,7 @@ func TestService_CancelTask(t *testing.T) {
 		repo := mocks.NewTaskRepository(t)
 		pub := mocks.NewTaskPublisher(t)
 
-		svc := tasksrv.NewService(repo, pub)
+		svc := tasksrv.NewService(repo, pub, mocks.NewTaskObjectRepository(t), mocks.NewJobTaskRecorder(t))
 
 		args := &taskdomain.CancelTaskArgs{Name: "tasks/123"}
 		wantErr := errors.New("repo fail")
@@ -138,7 +145,7 @@ func TestService_CancelTask(t *testing.T) {
 		repo := mocks.NewTaskRepository(t)
 		pub := mocks.NewTaskPublisher(t)
 
-		svc := tasksrv.NewService(repo, pub)
+		svc := tasksrv.NewService(repo, pub, mocks.NewTaskObjectRepository(t), mocks.NewJobTaskRecorder(t))
 
 		args := &taskdomain.CancelTaskArgs{Name: "tasks/123"}
 
@@ -153,7 +160,7 @@ func TestService_CancelTask(t *testing.T) {
 		repo := mocks.NewTaskRepository(t)
 		pub := mocks.NewTaskPublisher(t)
 
-		svc := tasksrv.NewService(repo, pub)
+		svc := tasksrv.NewService(repo, pub, mocks.NewTaskObjectRepository(t), mocks.NewJobTaskRecorder(t))
 
 		args := &taskdomain.CancelTaskArgs{Name: "tasks/123"}
 
@@ -168,7 +175,7 @@ func TestService_CancelTask(t *testing.T) {
 		repo := mocks.NewTaskRepository(t)
 		pub := mocks.NewTaskPublisher(t)
 
-		svc := tasksrv.NewService(repo, pub)
+		svc := tasksrv.NewService(repo, pub, mocks.NewTaskObjectRepository(t), mocks.NewJobTaskRecorder(t))
 
 		args := &taskdomain.CancelTaskArgs{Name: "tasks/123"}
 
@@ -188,4 +195,239 @@ func TestService_CancelTask(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, repoRes, res)
 	})
+
+	t.Run("ok: batch task counted on its job", func(t *testing.T) {
+		repo := mocks.NewTaskRepository(t)
+		pub := mocks.NewTaskPublisher(t)
+		jobs := mocks.NewJobTaskRecorder(t)
+
+		svc := tasksrv.NewService(repo, pub, mocks.NewTaskObjectRepository(t), jobs)
+
+		args := &taskdomain.CancelTaskArgs{Name: "tasks/123"}
+		task := &taskdomain.Task{Name: "tasks/123", BatchID: "b1", State: taskdomain.TaskStateCanceled}
+
+		repo.EXPECT().CancelTask(ctx, args).Return(&taskdomain.CancelTaskResult{Task: task}, nil).Once()
+		pub.EXPECT().PublishCancel(ctx, mock.Anything).Return(nil).Once()
+		jobs.EXPECT().
+			RecordJobTask(ctx, &jobdomain.RecordJobTaskArgs{Name: "jobs/b1", State: taskdomain.TaskStateCanceled}).
+			Return(nil).
+			Once()
+
+		_, err := svc.CancelTask(ctx, args)
+		require.NoError(t, err)
+	})
+
+	t.Run("ok: a failed job count does not fail the cancel", func(t *testing.T) {
+		repo := mocks.NewTaskRepository(t)
+		pub := mocks.NewTaskPublisher(t)
+		jobs := mocks.NewJobTaskRecorder(t)
+
+		svc := tasksrv.NewService(repo, pub, mocks.NewTaskObjectRepository(t), jobs)
+
+		args := &taskdomain.CancelTaskArgs{Name: "tasks/123"}
+		task := &taskdomain.Task{Name: "tasks/123", BatchID: "b1", State: taskdomain.TaskStateCanceled}
+
+		repo.EXPECT().CancelTask(ctx, args).Return(&taskdomain.CancelTaskResult{Task: task}, nil).Once()
+		pub.EXPECT().PublishCancel(ctx, mock.Anything).Return(nil).Once()
+		jobs.EXPECT().RecordJobTask(ctx, mock.Anything).Return(errors.New("kv unavailable")).Once()
+
+		res, err := svc.CancelTask(ctx, args)
+		require.NoError(t, err)
+		require.Equal(t, task, res.Task)
+	})
+}
+
+type sliceInputs struct {
+	inputs []*taskdomain.TaskInput
+}
+
+func (s *sliceInputs) Next() (*taskdomain.TaskInput, error) {
+	if len(s.inputs) == 0 {
+		return nil, io.EOF
+	}
+	in := s.inputs[0]
+	s.inputs = s.inputs[1:]
+	return in, nil
+}
+
+func TestService_CreateTask_WithInputs(t *testing.T) {
+	ctx := context.Background()
+
+	saveInput := func(args *taskdomain.SaveTaskArtifactArgs) (*taskdomain.SaveTaskArtifactResult, error) {
+		b, err := io.ReadAll(args.Data)
+		if err != nil {
+			return nil, err
+		}
+		sum := sha256.Sum256(b)
+		return &taskdomain.SaveTaskArtifactResult{Artifact: &taskdomain.TaskArtifact{
+			Name:      args.Name,
+			ObjectKey: strings.TrimPrefix(string(args.Task), "tasks/") + "/inputs/" + args.Name,
+			Size:      uint64(len(b)),
+			SHA256:    hex.EncodeToString(sum[:]),
+		}}, nil
+	}
+
+	t.Run("ok: inputs stored under the preset task id", func(t *testing.T) {
+		repo := mocks.NewTaskRepository(t)
+		pub := mocks.NewTaskPublisher(t)
+		objects := mocks.NewTaskObjectRepository(t)
+
+		svc := tasksrv.NewService(repo, pub, objects, mocks.NewJobTaskRecorder(t))
+
+		args := &taskdomain.CreateTaskArgs{
+			Function: "functions/fn",
+			InputFiles: &sliceInputs{inputs: []*taskdomain.TaskInput{
+				{Name: "a.csv", Data: strings.NewReader("1,2")},
+				{Name: "dir/b.bin", Data: strings.NewReader("xyz")},
+			}},
+		}
+
+		objects.EXPECT().SaveTaskInput(ctx, mock.Anything).
+			RunAndReturn(func(_ context.Context, a *taskdomain.SaveTaskArtifactArgs) (*taskdomain.SaveTaskArtifactResult, error) {
+				return saveInput(a)
+			}).Twice()
+		repo.EXPECT().CreateTask(ctx, args).
+			RunAndReturn(func(_ context.Context, a *taskdomain.CreateTaskArgs) (*taskdomain.CreateTaskResult, error) {
+				return &taskdomain.CreateTaskResult{Name: "tasks/" + a.ID.String()}, nil
+			}).Once()
+		pub.EXPECT().PublishExecute(ctx, mock.Anything).Return(nil).Once()
+
+		res, err := svc.CreateTask(ctx, args)
+		require.NoError(t, err)
+		require.Equal(t, "tasks/"+args.ID.String(), res.Name)
+		require.Len(t, args.Inputs, 2)
+		require.Equal(t, args.ID.String()+"/inputs/dir/b.bin", args.Inputs[1].ObjectKey)
+		require.Equal(t, uint64(3), args.Inputs[1].Size)
+	})
+
+	t.Run("error: digest mismatch removes stored inputs", func(t *testing.T) {
+		repo := mocks.NewTaskRepository(t)
+		pub := mocks.NewTaskPublisher(t)
+		objects := mocks.NewTaskObjectRepository(t)
+
+		svc := tasksrv.NewService(repo, pub, objects, mocks.NewJobTaskRecorder(t))
+
+		args := &taskdomain.CreateTaskArgs{
+			Function: "functions/fn",
+			InputFiles: &sliceInputs{inputs: []*taskdomain.TaskInput{
+				{Name: "ok.txt", Data: strings.NewReader("ok")},
+				{Name: "bad.txt", SHA256: strings.Repeat("0", 64), Data: strings.NewReader("bad")},
+			}},
+		}
+
+		objects.EXPECT().SaveTaskInput(ctx, mock.Anything).
+			RunAndReturn(func(_ context.Context, a *taskdomain.SaveTaskArtifactArgs) (*taskdomain.SaveTaskArtifactResult, error) {
+				return saveInput(a)
+			}).Twice()
+		objects.EXPECT().DeleteTaskArtifact(ctx, mock.Anything).Return(nil).Twice()
+
+		res, err := svc.CreateTask(ctx, args)
+		require.ErrorIs(t, err, taskdomain.ErrInputDigestMismatch)
+		require.Nil(t, res)
+	})
+
+	t.Run("error: duplicate input name", func(t *testing.T) {
+		repo := mocks.NewTaskRepository(t)
+		pub := mocks.NewTaskPublisher(t)
+		objects := mocks.NewTaskObjectRepository(t)
+
+		svc := tasksrv.NewService(repo, pub, objects, mocks.NewJobTaskRecorder(t))
+
+		args := &taskdomain.CreateTaskArgs{
+			Function: "functions/fn",
+			InputFiles: &sliceInputs{inputs: []*taskdomain.TaskInput{
+				{Name: "a.txt", Data: strings.NewReader("1")},
+				{Name: "a.txt", Data: strings.NewReader("2")},
+			}},
+		}
+
+		objects.EXPECT().SaveTaskInput(ctx, mock.Anything).
+			RunAndReturn(func(_ context.Context, a *taskdomain.SaveTaskArtifactArgs) (*taskdomain.SaveTaskArtifactResult, error) {
+				return saveInput(a)
+			}).Once()
+		objects.EXPECT().DeleteTaskArtifact(ctx, mock.Anything).Return(nil).Once()
+
+		_, err := svc.CreateTask(ctx, args)
+		require.ErrorIs(t, err, taskdomain.ErrDuplicateInput)
+	})
+}
+
+func TestService_UpdateTask(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("error: invalid labels are rejected before the repo", func(t *testing.T) {
+		repo := mocks.NewTaskRepository(t)
+		svc := tasksrv.NewService(repo, mocks.NewTaskPublisher(t), mocks.NewTaskObjectRepository(t), mocks.NewJobTaskRecorder(t))
+
+		res, err := svc.UpdateTask(ctx, &taskdomain.UpdateTaskArgs{
+			Name:   "tasks/1",
+			Labels: map[string]string{"Env": "dev"},
+			Paths:  []string{taskdomain.FieldLabels},
+		})
+		require.ErrorIs(t, err, taskdomain.ErrInvalidMetadata)
+		require.Nil(t, res)
+	})
+
+	t.Run("error: unknown mask path", func(t *testing.T) {
+		repo := mocks.NewTaskRepository(t)
+		svc := tasksrv.NewService(repo, mocks.NewTaskPublisher(t), mocks.NewTaskObjectRepository(t), mocks.NewJobTaskRecorder(t))
+
+		_, err := svc.UpdateTask(ctx, &taskdomain.UpdateTaskArgs{Name: "tasks/1", Paths: []string{"state"}})
+		require.ErrorIs(t, err, taskdomain.ErrInvalidUpdateMask)
+	})
+
+	t.Run("ok: delegates to repo", func(t *testing.T) {
+		repo := mocks.NewTaskRepository(t)
+		svc := tasksrv.NewService(repo, mocks.NewTaskPublisher(t), mocks.NewTaskObjectRepository(t), mocks.NewJobTaskRecorder(t))
+
+		args := &taskdomain.UpdateTaskArgs{
+			Name:        "tasks/1",
+			Annotations: map[string]string{"example.com/ticket": "OPS-1"},
+			Paths:       []string{taskdomain.FieldAnnotations},
+		}
+		want := &taskdomain.UpdateTaskResult{Task: &taskdomain.Task{Name: "tasks/1", Annotations: args.Annotations}}
+		repo.EXPECT().UpdateTask(ctx, args).Return(want, nil).Once()
+
+		res, err := svc.UpdateTask(ctx, args)
+		require.NoError(t, err)
+		require.Equal(t, want, res)
+	})
+}
+
+func TestService_ExpireTasks(t *testing.T) {
+	ctx := context.Background()
+	cutoff := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	input := taskdomain.TaskArtifact{Name: "data.csv", ObjectKey: "1/inputs/data.csv"}
+	artifact := taskdomain.TaskArtifact{Name: "report.pdf", ObjectKey: "1/artifacts/report.pdf"}
+	old := &taskdomain.Task{
+		Name:    "tasks/1",
+		State:   taskdomain.TaskStateSucceeded,
+		EndedAt: cutoff.Add(-time.Hour),
+		Inputs:  []taskdomain.TaskArtifact{input},
+		Result:  &taskdomain.TaskResult{Artifacts: []taskdomain.TaskArtifact{artifact}},
+	}
+	recent := &taskdomain.Task{Name: "tasks/2", State: taskdomain.TaskStateFailed, EndedAt: cutoff.Add(time.Hour)}
+	running := &taskdomain.Task{Name: "tasks/3", State: taskdomain.TaskStateProcessing}
+	gone := &taskdomain.Task{Name: "tasks/4", State: taskdomain.TaskStateCanceled, EndedAt: cutoff.Add(-time.Hour)}
+
+	repo := mocks.NewTaskRepository(t)
+	objects := mocks.NewTaskObjectRepository(t)
+	svc := tasksrv.NewService(repo, mocks.NewTaskPublisher(t), objects, mocks.NewJobTaskRecorder(t))
+
+	repo.EXPECT().ListTasks(ctx, mock.MatchedBy(func(a *taskdomain.ListTasksArgs) bool { return a.PageToken == "" })).
+		Return(&taskdomain.ListTaskResult{Tasks: []*taskdomain.Task{old, recent}, NextPageToken: "next"}, nil).Once()
+	repo.EXPECT().ListTasks(ctx, mock.MatchedBy(func(a *taskdomain.ListTasksArgs) bool { return a.PageToken == "next" })).
+		Return(&taskdomain.ListTaskResult{Tasks: []*taskdomain.Task{running, gone}}, nil).Once()
+
+	repo.EXPECT().GetTask(ctx, &taskdomain.GetTaskArgs{Name: "tasks/1"}).Return(&taskdomain.GetTaskResult{Task: old}, nil).Once()
+	repo.EXPECT().DeleteTask(ctx, &taskdomain.DeleteTaskArgs{Name: "tasks/1"}).Return(nil).Once()
+	objects.EXPECT().DeleteTaskArtifact(ctx, &input).Return(nil).Once()
+	objects.EXPECT().DeleteTaskArtifact(ctx, &artifact).Return(nil).Once()
+	// Deleted by someone else since the listing.
+	repo.EXPECT().GetTask(ctx, &taskdomain.GetTaskArgs{Name: "tasks/4"}).Return(nil, taskdomain.ErrNotFound).Once()
+
+	removed, err := svc.ExpireTasks(ctx, cutoff)
+	require.NoError(t, err)
+	require.Equal(t, 1, removed)
 }
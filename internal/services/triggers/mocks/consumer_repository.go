@@ -0,0 +1,131 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	triggerdomain "github.com/10Narratives/faas/internal/domains/triggers"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ConsumerRepository is an autogenerated mock type for the ConsumerRepository type
+type ConsumerRepository struct {
+	mock.Mock
+}
+
+type ConsumerRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ConsumerRepository) EXPECT() *ConsumerRepository_Expecter {
+	return &ConsumerRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteConsumer provides a mock function with given fields: ctx, t
+func (_m *ConsumerRepository) DeleteConsumer(ctx context.Context, t *triggerdomain.Trigger) error {
+	ret := _m.Called(ctx, t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteConsumer")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *triggerdomain.Trigger) error); ok {
+		r0 = rf(ctx, t)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ConsumerRepository_DeleteConsumer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteConsumer'
+type ConsumerRepository_DeleteConsumer_Call struct {
+	*mock.Call
+}
+
+// DeleteConsumer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - t *triggerdomain.Trigger
+func (_e *ConsumerRepository_Expecter) DeleteConsumer(ctx interface{}, t interface{}) *ConsumerRepository_DeleteConsumer_Call {
+	return &ConsumerRepository_DeleteConsumer_Call{Call: _e.mock.On("DeleteConsumer", ctx, t)}
+}
+
+func (_c *ConsumerRepository_DeleteConsumer_Call) Run(run func(ctx context.Context, t *triggerdomain.Trigger)) *ConsumerRepository_DeleteConsumer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*triggerdomain.Trigger))
+	})
+	return _c
+}
+
+func (_c *ConsumerRepository_DeleteConsumer_Call) Return(_a0 error) *ConsumerRepository_DeleteConsumer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ConsumerRepository_DeleteConsumer_Call) RunAndReturn(run func(context.Context, *triggerdomain.Trigger) error) *ConsumerRepository_DeleteConsumer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnsureConsumer provides a mock function with given fields: ctx, t
+func (_m *ConsumerRepository) EnsureConsumer(ctx context.Context, t *triggerdomain.Trigger) error {
+	ret := _m.Called(ctx, t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnsureConsumer")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *triggerdomain.Trigger) error); ok {
+		r0 = rf(ctx, t)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ConsumerRepository_EnsureConsumer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnsureConsumer'
+type ConsumerRepository_EnsureConsumer_Call struct {
+	*mock.Call
+}
+
+// EnsureConsumer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - t *triggerdomain.Trigger
+func (_e *ConsumerRepository_Expecter) EnsureConsumer(ctx interface{}, t interface{}) *ConsumerRepository_EnsureConsumer_Call {
+	return &ConsumerRepository_EnsureConsumer_Call{Call: _e.mock.On("EnsureConsumer", ctx, t)}
+}
+
+func (_c *ConsumerRepository_EnsureConsumer_Call) Run(run func(ctx context.Context, t *triggerdomain.Trigger)) *ConsumerRepository_EnsureConsumer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*triggerdomain.Trigger))
+	})
+	return _c
+}
+
+func (_c *ConsumerRepository_EnsureConsumer_Call) Return(_a0 error) *ConsumerRepository_EnsureConsumer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ConsumerRepository_EnsureConsumer_Call) RunAndReturn(run func(context.Context, *triggerdomain.Trigger) error) *ConsumerRepository_EnsureConsumer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewConsumerRepository creates a new instance of ConsumerRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewConsumerRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ConsumerRepository {
+	mock := &ConsumerRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
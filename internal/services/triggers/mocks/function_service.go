@@ -0,0 +1,155 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FunctionService is an autogenerated mock type for the FunctionService type
+type FunctionService struct {
+	mock.Mock
+}
+
+type FunctionService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FunctionService) EXPECT() *FunctionService_Expecter {
+	return &FunctionService_Expecter{mock: &_m.Mock}
+}
+
+// ExecuteFunction provides a mock function with given fields: ctx, args
+func (_m *FunctionService) ExecuteFunction(ctx context.Context, args *funcdomain.ExecuteFunctionArgs) (*funcdomain.ExecuteFunctionResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExecuteFunction")
+	}
+
+	var r0 *funcdomain.ExecuteFunctionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.ExecuteFunctionArgs) (*funcdomain.ExecuteFunctionResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.ExecuteFunctionArgs) *funcdomain.ExecuteFunctionResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.ExecuteFunctionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.ExecuteFunctionArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionService_ExecuteFunction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExecuteFunction'
+type FunctionService_ExecuteFunction_Call struct {
+	*mock.Call
+}
+
+// ExecuteFunction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *funcdomain.ExecuteFunctionArgs
+func (_e *FunctionService_Expecter) ExecuteFunction(ctx interface{}, args interface{}) *FunctionService_ExecuteFunction_Call {
+	return &FunctionService_ExecuteFunction_Call{Call: _e.mock.On("ExecuteFunction", ctx, args)}
+}
+
+func (_c *FunctionService_ExecuteFunction_Call) Run(run func(ctx context.Context, args *funcdomain.ExecuteFunctionArgs)) *FunctionService_ExecuteFunction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.ExecuteFunctionArgs))
+	})
+	return _c
+}
+
+func (_c *FunctionService_ExecuteFunction_Call) Return(_a0 *funcdomain.ExecuteFunctionResult, _a1 error) *FunctionService_ExecuteFunction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionService_ExecuteFunction_Call) RunAndReturn(run func(context.Context, *funcdomain.ExecuteFunctionArgs) (*funcdomain.ExecuteFunctionResult, error)) *FunctionService_ExecuteFunction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFunction provides a mock function with given fields: ctx, args
+func (_m *FunctionService) GetFunction(ctx context.Context, args *funcdomain.GetFunctionArgs) (*funcdomain.GetFunctionResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFunction")
+	}
+
+	var r0 *funcdomain.GetFunctionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.GetFunctionArgs) (*funcdomain.GetFunctionResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.GetFunctionArgs) *funcdomain.GetFunctionResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.GetFunctionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.GetFunctionArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionService_GetFunction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFunction'
+type FunctionService_GetFunction_Call struct {
+	*mock.Call
+}
+
+// GetFunction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *funcdomain.GetFunctionArgs
+func (_e *FunctionService_Expecter) GetFunction(ctx interface{}, args interface{}) *FunctionService_GetFunction_Call {
+	return &FunctionService_GetFunction_Call{Call: _e.mock.On("GetFunction", ctx, args)}
+}
+
+func (_c *FunctionService_GetFunction_Call) Run(run func(ctx context.Context, args *funcdomain.GetFunctionArgs)) *FunctionService_GetFunction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.GetFunctionArgs))
+	})
+	return _c
+}
+
+func (_c *FunctionService_GetFunction_Call) Return(_a0 *funcdomain.GetFunctionResult, _a1 error) *FunctionService_GetFunction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionService_GetFunction_Call) RunAndReturn(run func(context.Context, *funcdomain.GetFunctionArgs) (*funcdomain.GetFunctionResult, error)) *FunctionService_GetFunction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFunctionService creates a new instance of FunctionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFunctionService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FunctionService {
+	mock := &FunctionService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
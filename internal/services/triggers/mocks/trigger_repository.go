@@ -0,0 +1,309 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	triggerdomain "github.com/10Narratives/faas/internal/domains/triggers"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TriggerRepository is an autogenerated mock type for the TriggerRepository type
+type TriggerRepository struct {
+	mock.Mock
+}
+
+type TriggerRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TriggerRepository) EXPECT() *TriggerRepository_Expecter {
+	return &TriggerRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateTrigger provides a mock function with given fields: ctx, t
+func (_m *TriggerRepository) CreateTrigger(ctx context.Context, t *triggerdomain.Trigger) error {
+	ret := _m.Called(ctx, t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTrigger")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *triggerdomain.Trigger) error); ok {
+		r0 = rf(ctx, t)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TriggerRepository_CreateTrigger_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTrigger'
+type TriggerRepository_CreateTrigger_Call struct {
+	*mock.Call
+}
+
+// CreateTrigger is a helper method to define mock.On call
+//   - ctx context.Context
+//   - t *triggerdomain.Trigger
+func (_e *TriggerRepository_Expecter) CreateTrigger(ctx interface{}, t interface{}) *TriggerRepository_CreateTrigger_Call {
+	return &TriggerRepository_CreateTrigger_Call{Call: _e.mock.On("CreateTrigger", ctx, t)}
+}
+
+func (_c *TriggerRepository_CreateTrigger_Call) Run(run func(ctx context.Context, t *triggerdomain.Trigger)) *TriggerRepository_CreateTrigger_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*triggerdomain.Trigger))
+	})
+	return _c
+}
+
+func (_c *TriggerRepository_CreateTrigger_Call) Return(_a0 error) *TriggerRepository_CreateTrigger_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TriggerRepository_CreateTrigger_Call) RunAndReturn(run func(context.Context, *triggerdomain.Trigger) error) *TriggerRepository_CreateTrigger_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTrigger provides a mock function with given fields: ctx, name
+func (_m *TriggerRepository) DeleteTrigger(ctx context.Context, name triggerdomain.TriggerName) error {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTrigger")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, triggerdomain.TriggerName) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TriggerRepository_DeleteTrigger_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTrigger'
+type TriggerRepository_DeleteTrigger_Call struct {
+	*mock.Call
+}
+
+// DeleteTrigger is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name triggerdomain.TriggerName
+func (_e *TriggerRepository_Expecter) DeleteTrigger(ctx interface{}, name interface{}) *TriggerRepository_DeleteTrigger_Call {
+	return &TriggerRepository_DeleteTrigger_Call{Call: _e.mock.On("DeleteTrigger", ctx, name)}
+}
+
+func (_c *TriggerRepository_DeleteTrigger_Call) Run(run func(ctx context.Context, name triggerdomain.TriggerName)) *TriggerRepository_DeleteTrigger_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(triggerdomain.TriggerName))
+	})
+	return _c
+}
+
+func (_c *TriggerRepository_DeleteTrigger_Call) Return(_a0 error) *TriggerRepository_DeleteTrigger_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TriggerRepository_DeleteTrigger_Call) RunAndReturn(run func(context.Context, triggerdomain.TriggerName) error) *TriggerRepository_DeleteTrigger_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTrigger provides a mock function with given fields: ctx, name
+func (_m *TriggerRepository) GetTrigger(ctx context.Context, name triggerdomain.TriggerName) (*triggerdomain.Trigger, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrigger")
+	}
+
+	var r0 *triggerdomain.Trigger
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, triggerdomain.TriggerName) (*triggerdomain.Trigger, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, triggerdomain.TriggerName) *triggerdomain.Trigger); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*triggerdomain.Trigger)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, triggerdomain.TriggerName) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TriggerRepository_GetTrigger_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrigger'
+type TriggerRepository_GetTrigger_Call struct {
+	*mock.Call
+}
+
+// GetTrigger is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name triggerdomain.TriggerName
+func (_e *TriggerRepository_Expecter) GetTrigger(ctx interface{}, name interface{}) *TriggerRepository_GetTrigger_Call {
+	return &TriggerRepository_GetTrigger_Call{Call: _e.mock.On("GetTrigger", ctx, name)}
+}
+
+func (_c *TriggerRepository_GetTrigger_Call) Run(run func(ctx context.Context, name triggerdomain.TriggerName)) *TriggerRepository_GetTrigger_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(triggerdomain.TriggerName))
+	})
+	return _c
+}
+
+func (_c *TriggerRepository_GetTrigger_Call) Return(_a0 *triggerdomain.Trigger, _a1 error) *TriggerRepository_GetTrigger_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TriggerRepository_GetTrigger_Call) RunAndReturn(run func(context.Context, triggerdomain.TriggerName) (*triggerdomain.Trigger, error)) *TriggerRepository_GetTrigger_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTriggers provides a mock function with given fields: ctx, args
+func (_m *TriggerRepository) ListTriggers(ctx context.Context, args *triggerdomain.ListTriggersArgs) (*triggerdomain.ListTriggersResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTriggers")
+	}
+
+	var r0 *triggerdomain.ListTriggersResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *triggerdomain.ListTriggersArgs) (*triggerdomain.ListTriggersResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *triggerdomain.ListTriggersArgs) *triggerdomain.ListTriggersResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*triggerdomain.ListTriggersResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *triggerdomain.ListTriggersArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TriggerRepository_ListTriggers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTriggers'
+type TriggerRepository_ListTriggers_Call struct {
+	*mock.Call
+}
+
+// ListTriggers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *triggerdomain.ListTriggersArgs
+func (_e *TriggerRepository_Expecter) ListTriggers(ctx interface{}, args interface{}) *TriggerRepository_ListTriggers_Call {
+	return &TriggerRepository_ListTriggers_Call{Call: _e.mock.On("ListTriggers", ctx, args)}
+}
+
+func (_c *TriggerRepository_ListTriggers_Call) Run(run func(ctx context.Context, args *triggerdomain.ListTriggersArgs)) *TriggerRepository_ListTriggers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*triggerdomain.ListTriggersArgs))
+	})
+	return _c
+}
+
+func (_c *TriggerRepository_ListTriggers_Call) Return(_a0 *triggerdomain.ListTriggersResult, _a1 error) *TriggerRepository_ListTriggers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TriggerRepository_ListTriggers_Call) RunAndReturn(run func(context.Context, *triggerdomain.ListTriggersArgs) (*triggerdomain.ListTriggersResult, error)) *TriggerRepository_ListTriggers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTrigger provides a mock function with given fields: ctx, name, mutate
+func (_m *TriggerRepository) UpdateTrigger(ctx context.Context, name triggerdomain.TriggerName, mutate func(*triggerdomain.Trigger) error) (*triggerdomain.Trigger, error) {
+	ret := _m.Called(ctx, name, mutate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTrigger")
+	}
+
+	var r0 *triggerdomain.Trigger
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, triggerdomain.TriggerName, func(*triggerdomain.Trigger) error) (*triggerdomain.Trigger, error)); ok {
+		return rf(ctx, name, mutate)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, triggerdomain.TriggerName, func(*triggerdomain.Trigger) error) *triggerdomain.Trigger); ok {
+		r0 = rf(ctx, name, mutate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*triggerdomain.Trigger)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, triggerdomain.TriggerName, func(*triggerdomain.Trigger) error) error); ok {
+		r1 = rf(ctx, name, mutate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TriggerRepository_UpdateTrigger_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTrigger'
+type TriggerRepository_UpdateTrigger_Call struct {
+	*mock.Call
+}
+
+// UpdateTrigger is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name triggerdomain.TriggerName
+//   - mutate func(*triggerdomain.Trigger) error
+func (_e *TriggerRepository_Expecter) UpdateTrigger(ctx interface{}, name interface{}, mutate interface{}) *TriggerRepository_UpdateTrigger_Call {
+	return &TriggerRepository_UpdateTrigger_Call{Call: _e.mock.On("UpdateTrigger", ctx, name, mutate)}
+}
+
+func (_c *TriggerRepository_UpdateTrigger_Call) Run(run func(ctx context.Context, name triggerdomain.TriggerName, mutate func(*triggerdomain.Trigger) error)) *TriggerRepository_UpdateTrigger_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(triggerdomain.TriggerName), args[2].(func(*triggerdomain.Trigger) error))
+	})
+	return _c
+}
+
+func (_c *TriggerRepository_UpdateTrigger_Call) Return(_a0 *triggerdomain.Trigger, _a1 error) *TriggerRepository_UpdateTrigger_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TriggerRepository_UpdateTrigger_Call) RunAndReturn(run func(context.Context, triggerdomain.TriggerName, func(*triggerdomain.Trigger) error) (*triggerdomain.Trigger, error)) *TriggerRepository_UpdateTrigger_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTriggerRepository creates a new instance of TriggerRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTriggerRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TriggerRepository {
+	mock := &TriggerRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
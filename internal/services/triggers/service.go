@@ -0,0 +1,300 @@
+package triggersrv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	triggerdomain "github.com/10Narratives/faas/internal/domains/triggers"
+	labelutils "github.com/10Narratives/faas/pkg/labels"
+)
+
+// Annotations set on the tasks a trigger creates. Message headers are
+// copied under AnnotationHeaderPrefix, multiple values joined by ",".
+const (
+	AnnotationTrigger        = "faas/trigger"
+	AnnotationSubject        = "faas/subject"
+	AnnotationStreamSequence = "faas/stream-sequence"
+	AnnotationHeaderPrefix   = "faas/header/"
+)
+
+//go:generate mockery --name TriggerRepository --output ./mocks --outpkg mocks --with-expecter --filename trigger_repository.go
+type TriggerRepository interface {
+	CreateTrigger(ctx context.Context, t *triggerdomain.Trigger) error
+	GetTrigger(ctx context.Context, name triggerdomain.TriggerName) (*triggerdomain.Trigger, error)
+	UpdateTrigger(ctx context.Context, name triggerdomain.TriggerName, mutate func(t *triggerdomain.Trigger) error) (*triggerdomain.Trigger, error)
+	DeleteTrigger(ctx context.Context, name triggerdomain.TriggerName) error
+	ListTriggers(ctx context.Context, args *triggerdomain.ListTriggersArgs) (*triggerdomain.ListTriggersResult, error)
+}
+
+//go:generate mockery --name ConsumerRepository --output ./mocks --outpkg mocks --with-expecter --filename consumer_repository.go
+type ConsumerRepository interface {
+	EnsureConsumer(ctx context.Context, t *triggerdomain.Trigger) error
+	DeleteConsumer(ctx context.Context, t *triggerdomain.Trigger) error
+}
+
+//go:generate mockery --name FunctionService --output ./mocks --outpkg mocks --with-expecter --filename function_service.go
+type FunctionService interface {
+	funcdomain.FunctionGetter
+	funcdomain.FunctionExecutor
+}
+
+type Service struct {
+	repo        TriggerRepository
+	consumers   ConsumerRepository
+	funcService FunctionService
+}
+
+func NewService(repo TriggerRepository, consumers ConsumerRepository, funcService FunctionService) *Service {
+	return &Service{repo: repo, consumers: consumers, funcService: funcService}
+}
+
+// CreateTrigger stores the trigger, then sets up the consumer of a stream
+// trigger; the trigger is removed again if that fails.
+func (s *Service) CreateTrigger(ctx context.Context, args *triggerdomain.CreateTriggerArgs) (*triggerdomain.CreateTriggerResult, error) {
+	if args == nil || args.Trigger == nil {
+		return nil, triggerdomain.ErrInvalidParameters
+	}
+
+	now := time.Now().UTC()
+	in := args.Trigger
+	t := &triggerdomain.Trigger{
+		Name:        in.Name,
+		Function:    in.Function,
+		Subject:     in.Subject,
+		Stream:      in.Stream,
+		Consumer:    in.Consumer,
+		Filter:      in.Filter,
+		Concurrency: in.Concurrency,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if t.Concurrency == 0 {
+		t.Concurrency = 1
+	}
+	if err := s.check(ctx, t); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.CreateTrigger(ctx, t); err != nil {
+		return nil, err
+	}
+	if t.Stream != "" {
+		if err := s.consumers.EnsureConsumer(ctx, t); err != nil {
+			if delErr := s.repo.DeleteTrigger(context.WithoutCancel(ctx), t.Name); delErr != nil {
+				return nil, errors.Join(err, fmt.Errorf("remove trigger: %w", delErr))
+			}
+			return nil, err
+		}
+	}
+	return &triggerdomain.CreateTriggerResult{Trigger: t}, nil
+}
+
+func (s *Service) GetTrigger(ctx context.Context, args *triggerdomain.GetTriggerArgs) (*triggerdomain.GetTriggerResult, error) {
+	if args == nil {
+		return nil, triggerdomain.ErrInvalidParameters
+	}
+	name, err := triggerdomain.ParseTriggerName(args.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := s.repo.GetTrigger(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return &triggerdomain.GetTriggerResult{Trigger: t}, nil
+}
+
+func (s *Service) ListTriggers(ctx context.Context, args *triggerdomain.ListTriggersArgs) (*triggerdomain.ListTriggersResult, error) {
+	return s.repo.ListTriggers(ctx, args)
+}
+
+// UpdateTrigger sets up the consumer of the updated trigger before storing
+// it, and removes a managed consumer the trigger no longer reads through.
+func (s *Service) UpdateTrigger(ctx context.Context, args *triggerdomain.UpdateTriggerArgs) (*triggerdomain.UpdateTriggerResult, error) {
+	if args == nil || args.Trigger == nil {
+		return nil, triggerdomain.ErrInvalidParameters
+	}
+	name, err := triggerdomain.ParseTriggerName(args.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	cur, err := s.repo.GetTrigger(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	next := *cur
+	if err := next.ApplyUpdate(args.Trigger, args.Paths); err != nil {
+		return nil, err
+	}
+	if err := s.check(ctx, &next); err != nil {
+		return nil, err
+	}
+	if next.Stream != "" {
+		if err := s.consumers.EnsureConsumer(ctx, &next); err != nil {
+			return nil, err
+		}
+	}
+
+	var prev triggerdomain.Trigger
+	updated, err := s.repo.UpdateTrigger(ctx, name, func(t *triggerdomain.Trigger) error {
+		prev = *t
+		if err := t.ApplyUpdate(args.Trigger, args.Paths); err != nil {
+			return err
+		}
+		if err := t.Validate(); err != nil {
+			return err
+		}
+		t.UpdatedAt = time.Now().UTC()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if old := prev.ManagedConsumer(); old != "" && (prev.Stream != updated.Stream || updated.ManagedConsumer() != old) {
+		if err := s.consumers.DeleteConsumer(ctx, &prev); err != nil {
+			return nil, fmt.Errorf("remove consumer %s: %w", old, err)
+		}
+	}
+	return &triggerdomain.UpdateTriggerResult{Trigger: updated}, nil
+}
+
+// DeleteTrigger also removes the trigger's managed consumer.
+func (s *Service) DeleteTrigger(ctx context.Context, args *triggerdomain.DeleteTriggerArgs) error {
+	if args == nil {
+		return triggerdomain.ErrInvalidParameters
+	}
+	name, err := triggerdomain.ParseTriggerName(args.Name)
+	if err != nil {
+		return err
+	}
+
+	t, err := s.repo.GetTrigger(ctx, name)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.DeleteTrigger(ctx, name); err != nil {
+		return err
+	}
+	if err := s.consumers.DeleteConsumer(ctx, t); err != nil {
+		return fmt.Errorf("remove consumer %s: %w", t.ManagedConsumer(), err)
+	}
+	return nil
+}
+
+// FireTrigger creates a task with the message payload as parameters and
+// its subject, stream sequence and headers as annotations. Errors that
+// would recur on redelivery are reported as ErrMessageRejected.
+func (s *Service) FireTrigger(ctx context.Context, args *triggerdomain.FireTriggerArgs) (*triggerdomain.FireTriggerResult, error) {
+	if args == nil || args.Trigger == nil || args.Message == nil {
+		return nil, triggerdomain.ErrInvalidParameters
+	}
+	t, msg := args.Trigger, args.Message
+
+	filter, err := triggerdomain.ParseMessageFilter(t.Filter)
+	if err != nil {
+		return nil, err
+	}
+	if !filter.Match(msg) {
+		return &triggerdomain.FireTriggerResult{Filtered: true}, nil
+	}
+
+	fnName, alias, err := funcdomain.ParseFunctionRef(t.Function)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", triggerdomain.ErrMessageRejected, err)
+	}
+
+	out, err := s.funcService.ExecuteFunction(ctx, &funcdomain.ExecuteFunctionArgs{
+		Name:        fnName,
+		Alias:       alias,
+		Parameters:  string(msg.Data),
+		Annotations: messageAnnotations(t, msg),
+	})
+	if err != nil {
+		if isPermanent(err) {
+			return nil, fmt.Errorf("%w: %v", triggerdomain.ErrMessageRejected, err)
+		}
+		return nil, err
+	}
+	return &triggerdomain.FireTriggerResult{TaskName: out.TaskName}, nil
+}
+
+// check validates the trigger and that its function exists.
+func (s *Service) check(ctx context.Context, t *triggerdomain.Trigger) error {
+	if err := t.Validate(); err != nil {
+		return err
+	}
+
+	fnName, _, err := funcdomain.ParseFunctionRef(t.Function)
+	if err != nil {
+		return fmt.Errorf("%w: %v", triggerdomain.ErrInvalidFunction, err)
+	}
+	if _, err := s.funcService.GetFunction(ctx, &funcdomain.GetFunctionArgs{Name: fnName}); err != nil {
+		if errors.Is(err, funcdomain.ErrFunctionNotFound) {
+			return fmt.Errorf("%w: %v", triggerdomain.ErrInvalidFunction, err)
+		}
+		return err
+	}
+	return nil
+}
+
+// messageAnnotations describes the message on its task. Headers whose key
+// is not a valid annotation key, or that would exceed the annotation size
+// limit, are left out.
+func messageAnnotations(t *triggerdomain.Trigger, msg *triggerdomain.Message) map[string]string {
+	out := map[string]string{
+		AnnotationTrigger: string(t.Name),
+		AnnotationSubject: msg.Subject,
+	}
+	if msg.Sequence > 0 {
+		out[AnnotationStreamSequence] = strconv.FormatUint(msg.Sequence, 10)
+	}
+
+	size := 0
+	for k, v := range out {
+		size += len(k) + len(v)
+	}
+
+	keys := make([]string, 0, len(msg.Headers))
+	for k := range msg.Headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		key := AnnotationHeaderPrefix + k
+		value := strings.Join(msg.Headers[k], ",")
+		if size+len(key)+len(value) > labelutils.MaxAnnotationsSize {
+			continue
+		}
+		if labelutils.ValidateAnnotations(map[string]string{key: value}) != nil {
+			continue
+		}
+		out[key] = value
+		size += len(key) + len(value)
+	}
+	return out
+}
+
+// isPermanent reports execute errors that redelivering the message cannot
+// fix. A missing or deleted function counts: the trigger outlives it, and
+// its messages would otherwise be redelivered forever.
+func isPermanent(err error) bool {
+	return errors.Is(err, funcdomain.ErrFunctionNotFound) ||
+		errors.Is(err, funcdomain.ErrFunctionDeleted) ||
+		errors.Is(err, funcdomain.ErrAliasNotFound) ||
+		errors.Is(err, funcdomain.ErrRevisionNotFound) ||
+		errors.Is(err, funcdomain.ErrInvalidParameters) ||
+		errors.Is(err, funcdomain.ErrInvalidMetadata) ||
+		errors.Is(err, funcdomain.ErrInvalidArgument) ||
+		errors.Is(err, funcdomain.ErrInvalidName) ||
+		errors.Is(err, funcdomain.ErrInvalidAlias)
+}
@@ -0,0 +1,138 @@
+package triggersrv_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	triggerdomain "github.com/10Narratives/faas/internal/domains/triggers"
+	triggersrv "github.com/10Narratives/faas/internal/services/triggers"
+	"github.com/10Narratives/faas/internal/services/triggers/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newService(t *testing.T) (*triggersrv.Service, *mocks.TriggerRepository, *mocks.ConsumerRepository, *mocks.FunctionService) {
+	repo := mocks.NewTriggerRepository(t)
+	consumers := mocks.NewConsumerRepository(t)
+	funcs := mocks.NewFunctionService(t)
+	return triggersrv.NewService(repo, consumers, funcs), repo, consumers, funcs
+}
+
+func TestService_FireTrigger_CreatesTask(t *testing.T) {
+	ctx := context.Background()
+	svc, _, _, funcs := newService(t)
+
+	funcs.EXPECT().ExecuteFunction(ctx, mock.Anything).
+		RunAndReturn(func(_ context.Context, args *funcdomain.ExecuteFunctionArgs) (*funcdomain.ExecuteFunctionResult, error) {
+			require.Equal(t, funcdomain.FunctionName("functions/orders"), args.Name)
+			require.Equal(t, "prod", args.Alias)
+			require.Equal(t, `{"id":7}`, args.Parameters)
+			require.Equal(t, map[string]string{
+				triggersrv.AnnotationTrigger:        "triggers/orders",
+				triggersrv.AnnotationSubject:        "orders.eu.created",
+				triggersrv.AnnotationStreamSequence: "42",
+				"faas/header/Type":                  "created",
+				"faas/header/Tag":                   "a,b",
+			}, args.Annotations)
+			return &funcdomain.ExecuteFunctionResult{TaskName: "tasks/t1"}, nil
+		}).
+		Once()
+
+	res, err := svc.FireTrigger(ctx, &triggerdomain.FireTriggerArgs{
+		Trigger: &triggerdomain.Trigger{Name: "triggers/orders", Function: "functions/orders@prod", Stream: "ORDERS"},
+		Message: &triggerdomain.Message{
+			Subject: "orders.eu.created",
+			Headers: map[string][]string{
+				"Type":     {"created"},
+				"Tag":      {"a", "b"},
+				"Bad Key!": {"dropped"},
+			},
+			Data:     []byte(`{"id":7}`),
+			Sequence: 42,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "tasks/t1", res.TaskName)
+	require.False(t, res.Filtered)
+}
+
+func TestService_FireTrigger_Filtered(t *testing.T) {
+	svc, _, _, _ := newService(t)
+
+	res, err := svc.FireTrigger(context.Background(), &triggerdomain.FireTriggerArgs{
+		Trigger: &triggerdomain.Trigger{
+			Name:     "triggers/orders",
+			Function: "functions/orders",
+			Subject:  "orders.>",
+			Filter:   `subject = "orders.eu.*" AND headers.Type = "created"`,
+		},
+		Message: &triggerdomain.Message{
+			Subject: "orders.us.created",
+			Headers: map[string][]string{"Type": {"created"}},
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, res.Filtered)
+	require.Empty(t, res.TaskName)
+}
+
+func TestService_FireTrigger_RejectsInvalidPayload(t *testing.T) {
+	ctx := context.Background()
+	svc, _, _, funcs := newService(t)
+
+	funcs.EXPECT().ExecuteFunction(ctx, mock.Anything).Return(nil, funcdomain.ErrInvalidParameters).Once()
+
+	_, err := svc.FireTrigger(ctx, &triggerdomain.FireTriggerArgs{
+		Trigger: &triggerdomain.Trigger{Name: "triggers/orders", Function: "functions/orders", Subject: "orders.>"},
+		Message: &triggerdomain.Message{Subject: "orders.eu", Data: []byte("not json")},
+	})
+	require.ErrorIs(t, err, triggerdomain.ErrMessageRejected)
+
+	funcs.EXPECT().ExecuteFunction(ctx, mock.Anything).Return(nil, errors.New("kv unavailable")).Once()
+
+	_, err = svc.FireTrigger(ctx, &triggerdomain.FireTriggerArgs{
+		Trigger: &triggerdomain.Trigger{Name: "triggers/orders", Function: "functions/orders", Subject: "orders.>"},
+		Message: &triggerdomain.Message{Subject: "orders.eu", Data: []byte("{}")},
+	})
+	require.Error(t, err)
+	require.NotErrorIs(t, err, triggerdomain.ErrMessageRejected)
+}
+
+func TestService_CreateTrigger_RemovesTriggerWhenConsumerFails(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, consumers, funcs := newService(t)
+
+	funcs.EXPECT().GetFunction(ctx, &funcdomain.GetFunctionArgs{Name: "functions/orders"}).
+		Return(&funcdomain.GetFunctionResult{Function: &funcdomain.Function{Name: "functions/orders"}}, nil).Once()
+	repo.EXPECT().CreateTrigger(ctx, mock.MatchedBy(func(tr *triggerdomain.Trigger) bool {
+		return tr.Name == "triggers/orders" && tr.Concurrency == 1 && !tr.CreatedAt.IsZero()
+	})).Return(nil).Once()
+	consumers.EXPECT().EnsureConsumer(ctx, mock.Anything).Return(triggerdomain.ErrStreamNotFound).Once()
+	repo.EXPECT().DeleteTrigger(mock.Anything, triggerdomain.TriggerName("triggers/orders")).Return(nil).Once()
+
+	_, err := svc.CreateTrigger(ctx, &triggerdomain.CreateTriggerArgs{Trigger: &triggerdomain.Trigger{
+		Name:     "triggers/orders",
+		Function: "functions/orders",
+		Stream:   "ORDERS",
+	}})
+	require.ErrorIs(t, err, triggerdomain.ErrStreamNotFound)
+}
+
+func TestService_FireTrigger_RejectsMissingFunction(t *testing.T) {
+	for _, fnErr := range []error{funcdomain.ErrFunctionNotFound, funcdomain.ErrFunctionDeleted, funcdomain.ErrAliasNotFound} {
+		t.Run(fnErr.Error(), func(t *testing.T) {
+			ctx := context.Background()
+			svc, _, _, funcs := newService(t)
+
+			funcs.EXPECT().ExecuteFunction(ctx, mock.Anything).Return(nil, fnErr).Once()
+
+			_, err := svc.FireTrigger(ctx, &triggerdomain.FireTriggerArgs{
+				Trigger: &triggerdomain.Trigger{Name: "triggers/orders", Function: "functions/orders@prod", Subject: "orders.>"},
+				Message: &triggerdomain.Message{Subject: "orders.eu", Data: []byte("{}")},
+			})
+			require.ErrorIs(t, err, triggerdomain.ErrMessageRejected)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package adminapi
+
+import (
+	"context"
+	"errors"
+
+	grpcsrv "github.com/10Narratives/faas/internal/app/components/grpc/server"
+	gcdomain "github.com/10Narratives/faas/internal/domains/gc"
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+//go:generate mockery --name AdminService --output ./mocks --outpkg mocks --with-expecter --filename admin_service.go
+type AdminService interface {
+	gcdomain.GarbageCollector
+}
+
+type Server struct {
+	faaspb.UnimplementedAdminServer
+	adminService AdminService
+}
+
+func NewServer(adminService AdminService) *Server {
+	return &Server{adminService: adminService}
+}
+
+func NewRegistration(adminService AdminService) grpcsrv.ServiceRegistration {
+	return func(s *grpc.Server) {
+		faaspb.RegisterAdminServer(s, NewServer(adminService))
+	}
+}
+
+func (s *Server) CollectGarbage(ctx context.Context, req *faaspb.CollectGarbageRequest) (*faaspb.CollectGarbageResponse, error) {
+	args := &gcdomain.CollectGarbageArgs{Delete: req.GetDelete()}
+	if req.GetMinAge() != nil {
+		args.MinAge = req.GetMinAge().AsDuration()
+		if args.MinAge <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "min_age must be positive")
+		}
+	}
+
+	res, err := s.adminService.CollectGarbage(ctx, args)
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	if res == nil {
+		return nil, status.Error(codes.Internal, "missing result")
+	}
+
+	out := &faaspb.CollectGarbageResponse{
+		Orphans: make([]*faaspb.Orphan, 0, len(res.Orphans)),
+		Deleted: int32(res.Deleted),
+		Failed:  int32(res.Failed),
+	}
+	for _, o := range res.Orphans {
+		out.Orphans = append(out.Orphans, &faaspb.Orphan{
+			Kind:      o.Kind,
+			Store:     o.Store,
+			Key:       o.Key,
+			Size:      o.Size,
+			UpdatedAt: timestamppb.New(o.UpdatedAt),
+			Reason:    o.Reason,
+			Deleted:   o.Deleted,
+			Error:     o.Error,
+		})
+	}
+	return out, nil
+}
+
+func toStatusErr(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, "request canceled")
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return status.Error(codes.DeadlineExceeded, "deadline exceeded")
+	}
+
+	switch {
+	case errors.Is(err, gcdomain.ErrInvalidArgument):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
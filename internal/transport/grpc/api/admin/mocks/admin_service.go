@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	gcdomain "github.com/10Narratives/faas/internal/domains/gc"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AdminService is an autogenerated mock type for the AdminService type
+type AdminService struct {
+	mock.Mock
+}
+
+type AdminService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AdminService) EXPECT() *AdminService_Expecter {
+	return &AdminService_Expecter{mock: &_m.Mock}
+}
+
+// CollectGarbage provides a mock function with given fields: ctx, args
+func (_m *AdminService) CollectGarbage(ctx context.Context, args *gcdomain.CollectGarbageArgs) (*gcdomain.CollectGarbageResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CollectGarbage")
+	}
+
+	var r0 *gcdomain.CollectGarbageResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gcdomain.CollectGarbageArgs) (*gcdomain.CollectGarbageResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gcdomain.CollectGarbageArgs) *gcdomain.CollectGarbageResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gcdomain.CollectGarbageResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gcdomain.CollectGarbageArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdminService_CollectGarbage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CollectGarbage'
+type AdminService_CollectGarbage_Call struct {
+	*mock.Call
+}
+
+// CollectGarbage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *gcdomain.CollectGarbageArgs
+func (_e *AdminService_Expecter) CollectGarbage(ctx interface{}, args interface{}) *AdminService_CollectGarbage_Call {
+	return &AdminService_CollectGarbage_Call{Call: _e.mock.On("CollectGarbage", ctx, args)}
+}
+
+func (_c *AdminService_CollectGarbage_Call) Run(run func(ctx context.Context, args *gcdomain.CollectGarbageArgs)) *AdminService_CollectGarbage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*gcdomain.CollectGarbageArgs))
+	})
+	return _c
+}
+
+func (_c *AdminService_CollectGarbage_Call) Return(_a0 *gcdomain.CollectGarbageResult, _a1 error) *AdminService_CollectGarbage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AdminService_CollectGarbage_Call) RunAndReturn(run func(context.Context, *gcdomain.CollectGarbageArgs) (*gcdomain.CollectGarbageResult, error)) *AdminService_CollectGarbage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAdminService creates a new instance of AdminService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAdminService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AdminService {
+	mock := &AdminService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -2,27 +2,50 @@ package funcapi
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"io"
+	"strconv"
+	"time"
 
 	grpcsrv "github.com/10Narratives/faas/internal/app/components/grpc/server"
 	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	jobdomain "github.com/10Narratives/faas/internal/domains/jobs"
+	taskdomain "github.com/10Narratives/faas/internal/domains/tasks"
+	taskapi "github.com/10Narratives/faas/internal/transport/grpc/api/tasks"
 	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// downloadChunkSize keeps each message well below the default 4 MiB limit.
+const downloadChunkSize = 256 << 10
+
 //go:generate mockery --name FunctionService --output ./mocks --outpkg mocks --with-expecter --filename function_service.go
 type FunctionService interface {
 	funcdomain.FunctionUploader
 	funcdomain.FunctionExecutor
+	funcdomain.FunctionInvoker
+	funcdomain.FunctionBatchExecutor
 	funcdomain.FunctionGetter
 	funcdomain.FunctionLister
+	funcdomain.FunctionRevisionLister
+	funcdomain.FunctionUpdater
 	funcdomain.FunctionDeleter
+	funcdomain.FunctionUndeleter
+	funcdomain.FunctionDownloader
+	funcdomain.UsageGetter
+	funcdomain.ResumableUploader
+	funcdomain.AliasUpdater
+	funcdomain.AliasGetter
+	funcdomain.AliasLister
+	funcdomain.AliasDeleter
 }
 
 type Server struct {
@@ -56,17 +79,13 @@ func (s *Server) UploadFunction(stream grpc.ClientStreamingServer[faaspb.UploadF
 		return status.Error(codes.InvalidArgument, "first message must be upload_function_metadata")
 	}
 
-	name, err := funcdomain.ParseFunctionName(meta.FunctionName)
-	if err != nil {
-		return toStatusErr(err)
-	}
-
-	format, err := pbToDomainUploadFormat(meta.Format)
+	args, err := pbToDomainUploadArgs(meta)
 	if err != nil {
 		return toStatusErr(err)
 	}
 
 	pr, pw := io.Pipe()
+	args.Data = pr
 
 	type uploadResult struct {
 		res *funcdomain.UploadFunctionResult
@@ -75,11 +94,7 @@ func (s *Server) UploadFunction(stream grpc.ClientStreamingServer[faaspb.UploadF
 	done := make(chan uploadResult, 1)
 
 	go func() {
-		res, uerr := s.functionService.UploadFunction(ctx, &funcdomain.UploadFunctionArgs{
-			Name:   name,
-			Format: format,
-			Data:   pr,
-		})
+		res, uerr := s.functionService.UploadFunction(ctx, args)
 		_ = pr.Close()
 		done <- uploadResult{res: res, err: uerr}
 	}()
@@ -112,7 +127,16 @@ func (s *Server) UploadFunction(stream grpc.ClientStreamingServer[faaspb.UploadF
 		if len(chunk.Data) > 0 {
 			if _, werr := pw.Write(chunk.Data); werr != nil {
 				_ = pw.CloseWithError(werr)
-				<-done
+				// The service stops reading once it has decided: it rejected
+				// the upload, e.g. past the size limit, or it already stores
+				// a bundle with the declared digest.
+				ur := <-done
+				if ur.err != nil {
+					return toStatusErr(ur.err)
+				}
+				if ur.res != nil && ur.res.Function != nil {
+					return stream.SendAndClose(domainToPBFunction(ur.res.Function))
+				}
 				return toStatusErr(werr)
 			}
 		}
@@ -129,19 +153,147 @@ func (s *Server) UploadFunction(stream grpc.ClientStreamingServer[faaspb.UploadF
 	return stream.SendAndClose(domainToPBFunction(ur.res.Function))
 }
 
-func (s *Server) ExecuteFunction(ctx context.Context, req *faaspb.ExecuteFunctionRequest) (*faaspb.ExecuteFunctionResponse, error) {
-	name, err := funcdomain.ParseFunctionName(req.GetName())
+func pbToDomainUploadArgs(meta *faaspb.UploadFunctionMetadata) (*funcdomain.UploadFunctionArgs, error) {
+	name, err := funcdomain.ParseFunctionName(meta.GetFunctionName())
+	if err != nil {
+		return nil, err
+	}
+
+	format, err := pbToDomainUploadFormat(meta.GetFormat())
+	if err != nil {
+		return nil, err
+	}
+
+	return &funcdomain.UploadFunctionArgs{
+		Name:        name,
+		DisplayName: meta.GetDisplayName(),
+		Description: meta.GetDescription(),
+		Labels:      meta.GetLabels(),
+		Annotations: meta.GetAnnotations(),
+		Timeout:     meta.GetTimeout().AsDuration(),
+		MemoryBytes: meta.GetMemoryBytes(),
+		Runtime:     meta.GetRuntime(),
+		Format:      format,
+		Env:         meta.GetEnv(),
+		SecretEnv:   meta.GetSecretEnv(),
+		SHA256:      meta.GetSha256(),
+	}, nil
+}
+
+func (s *Server) StartUpload(ctx context.Context, req *faaspb.StartUploadRequest) (*faaspb.UploadSession, error) {
+	if req.GetMetadata() == nil {
+		return nil, status.Error(codes.InvalidArgument, "metadata is required")
+	}
+
+	args, err := pbToDomainUploadArgs(req.GetMetadata())
 	if err != nil {
 		return nil, toStatusErr(err)
 	}
 
-	res, err := s.functionService.ExecuteFunction(ctx, &funcdomain.ExecuteFunctionArgs{
-		Name:       name,
-		Parameters: req.GetParameters(),
+	res, err := s.functionService.StartUpload(ctx, &funcdomain.StartUploadArgs{Upload: args, Size: req.GetSize()})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	if res == nil || res.Session == nil {
+		return nil, status.Error(codes.Internal, "empty result")
+	}
+
+	return domainToPBUploadSession(res.Session), nil
+}
+
+func (s *Server) UploadChunks(stream grpc.ClientStreamingServer[faaspb.UploadChunkRequest, faaspb.UploadSession]) error {
+	ctx := stream.Context()
+
+	var (
+		name    string
+		session *funcdomain.UploadSession
+	)
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return toStatusErr(err)
+		}
+
+		if name == "" {
+			name = req.GetName()
+		} else if req.GetName() != name {
+			return status.Error(codes.InvalidArgument, "all chunks must belong to the same upload session")
+		}
+
+		res, err := s.functionService.WriteUploadChunk(ctx, &funcdomain.WriteUploadChunkArgs{
+			Name:   funcdomain.UploadSessionName(req.GetName()),
+			Offset: req.GetOffset(),
+			Data:   req.GetData(),
+			CRC32C: req.GetCrc32C(),
+		})
+		if err != nil {
+			return toStatusErr(err)
+		}
+		if res == nil || res.Session == nil {
+			return status.Error(codes.Internal, "empty result")
+		}
+		session = res.Session
+	}
+
+	if session == nil {
+		return status.Error(codes.InvalidArgument, "no chunks received")
+	}
+	return stream.SendAndClose(domainToPBUploadSession(session))
+}
+
+func (s *Server) GetUploadSession(ctx context.Context, req *faaspb.GetUploadSessionRequest) (*faaspb.UploadSession, error) {
+	res, err := s.functionService.GetUploadSession(ctx, &funcdomain.GetUploadSessionArgs{
+		Name: funcdomain.UploadSessionName(req.GetName()),
+	})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	if res == nil || res.Session == nil {
+		return nil, status.Error(codes.Internal, "empty result")
+	}
+
+	return domainToPBUploadSession(res.Session), nil
+}
+
+func (s *Server) FinalizeUpload(ctx context.Context, req *faaspb.FinalizeUploadRequest) (*faaspb.Function, error) {
+	res, err := s.functionService.FinalizeUpload(ctx, &funcdomain.FinalizeUploadArgs{
+		Name:   funcdomain.UploadSessionName(req.GetName()),
+		SHA256: req.GetSha256(),
 	})
 	if err != nil {
 		return nil, toStatusErr(err)
 	}
+	if res == nil || res.Function == nil {
+		return nil, status.Error(codes.Internal, "upload finished without function result")
+	}
+
+	return domainToPBFunction(res.Function), nil
+}
+
+func domainToPBUploadSession(s *funcdomain.UploadSession) *faaspb.UploadSession {
+	return &faaspb.UploadSession{
+		Name:            string(s.Name()),
+		FunctionName:    string(s.Function),
+		Size:            s.Size,
+		CommittedOffset: s.CommittedOffset,
+		CreateTime:      timestamppb.New(s.CreatedAt),
+		ExpireTime:      timestamppb.New(s.ExpiresAt),
+	}
+}
+
+func (s *Server) ExecuteFunction(ctx context.Context, req *faaspb.ExecuteFunctionRequest) (*faaspb.ExecuteFunctionResponse, error) {
+	name, alias, err := funcdomain.ParseFunctionRef(req.GetName())
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	res, err := s.functionService.ExecuteFunction(ctx, pbToDomainExecuteArgs(req, name, alias))
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
 	if res == nil {
 		return nil, status.Error(codes.Internal, "missing execute function result")
 	}
@@ -156,6 +308,116 @@ func (s *Server) ExecuteFunction(ctx context.Context, req *faaspb.ExecuteFunctio
 	}, nil
 }
 
+func (s *Server) ExecuteFunctionWithInputs(stream grpc.ClientStreamingServer[faaspb.ExecuteFunctionWithInputsRequest, faaspb.ExecuteFunctionResponse]) error {
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return status.Error(codes.InvalidArgument, "missing execute request")
+	}
+	if err != nil {
+		return toStatusErr(err)
+	}
+
+	req := first.GetExecute()
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "first message must be execute")
+	}
+
+	name, alias, err := funcdomain.ParseFunctionRef(req.GetName())
+	if err != nil {
+		return toStatusErr(err)
+	}
+
+	// Inputs are read from the stream as the service consumes them, so
+	// nothing is buffered in memory.
+	args := pbToDomainExecuteArgs(req, name, alias)
+	args.Inputs = newStreamInputs(stream)
+	res, err := s.functionService.ExecuteFunction(stream.Context(), args)
+	if err != nil {
+		return toStatusErr(err)
+	}
+	if res == nil || res.TaskName == "" {
+		return status.Error(codes.Internal, "missing execute function result")
+	}
+
+	return stream.SendAndClose(&faaspb.ExecuteFunctionResponse{Name: res.TaskName})
+}
+
+func (s *Server) InvokeFunction(ctx context.Context, req *faaspb.InvokeFunctionRequest) (*faaspb.InvokeFunctionResponse, error) {
+	exec := req.GetExecute()
+	if exec == nil {
+		return nil, status.Error(codes.InvalidArgument, "execute is required")
+	}
+	name, alias, err := funcdomain.ParseFunctionRef(exec.GetName())
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	res, err := s.functionService.InvokeFunction(ctx, &funcdomain.InvokeFunctionArgs{
+		ExecuteFunctionArgs: *pbToDomainExecuteArgs(exec, name, alias),
+	})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	if res == nil || res.Task == nil {
+		return nil, status.Error(codes.Internal, "missing invoke function result")
+	}
+
+	return &faaspb.InvokeFunctionResponse{
+		Task: taskapi.ToPBTask(res.Task),
+		Done: res.Done,
+	}, nil
+}
+
+func (s *Server) BatchExecuteFunction(stream grpc.BidiStreamingServer[faaspb.BatchExecuteFunctionRequest, faaspb.BatchExecuteFunctionResponse]) error {
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return status.Error(codes.InvalidArgument, "missing execute request")
+	}
+	if err != nil {
+		return toStatusErr(err)
+	}
+
+	req := first.GetExecute()
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "first message must be execute")
+	}
+
+	name, alias, err := funcdomain.ParseFunctionRef(req.GetName())
+	if err != nil {
+		return toStatusErr(err)
+	}
+
+	_, err = s.functionService.BatchExecuteFunction(stream.Context(), &funcdomain.BatchExecuteFunctionArgs{
+		ExecuteFunctionArgs: *pbToDomainExecuteArgs(req, name, alias),
+		FailureThreshold:    first.GetFailureThreshold(),
+		Items:               &streamItems{stream: stream},
+		Report: func(res *funcdomain.BatchItemResult) error {
+			out := &faaspb.BatchExecuteFunctionResponse{
+				BatchId: res.BatchID,
+				Index:   res.Index,
+				Task:    res.TaskName,
+			}
+			if res.Err != nil {
+				out.Error = status.Convert(toStatusErr(res.Err)).Proto()
+			}
+			return stream.Send(out)
+		},
+	})
+	return toStatusErr(err)
+}
+
+func pbToDomainExecuteArgs(req *faaspb.ExecuteFunctionRequest, name funcdomain.FunctionName, alias string) *funcdomain.ExecuteFunctionArgs {
+	return &funcdomain.ExecuteFunctionArgs{
+		Name:          name,
+		Revision:      req.GetRevision(),
+		Alias:         alias,
+		Parameters:    req.GetParameters(),
+		Labels:        req.GetLabels(),
+		Annotations:   req.GetAnnotations(),
+		InheritLabels: req.GetInheritLabels(),
+	}
+}
+
 func (s *Server) GetFunction(ctx context.Context, req *faaspb.GetFunctionRequest) (*faaspb.Function, error) {
 	name, err := funcdomain.ParseFunctionName(req.GetName())
 	if err != nil {
@@ -174,9 +436,21 @@ func (s *Server) GetFunction(ctx context.Context, req *faaspb.GetFunctionRequest
 }
 
 func (s *Server) ListFunctions(ctx context.Context, req *faaspb.ListFunctionsRequest) (*faaspb.ListFunctionsResponse, error) {
+	filter, err := funcdomain.ParseFunctionFilter(req.GetFilter())
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	order, err := funcdomain.ParseFunctionOrder(req.GetOrderBy())
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+
 	res, err := s.functionService.ListFunctions(ctx, &funcdomain.ListFunctionsArgs{
-		PageSize:  req.GetPageSize(),
-		PageToken: req.GetPageToken(),
+		PageSize:    req.GetPageSize(),
+		PageToken:   req.GetPageToken(),
+		Filter:      filter,
+		OrderBy:     order,
+		ShowDeleted: req.GetShowDeleted(),
 	})
 	if err != nil {
 		return nil, toStatusErr(err)
@@ -197,13 +471,279 @@ func (s *Server) ListFunctions(ctx context.Context, req *faaspb.ListFunctionsReq
 	return out, nil
 }
 
+func (s *Server) ListFunctionRevisions(ctx context.Context, req *faaspb.ListFunctionRevisionsRequest) (*faaspb.ListFunctionRevisionsResponse, error) {
+	name, err := funcdomain.ParseFunctionName(req.GetName())
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	res, err := s.functionService.ListFunctionRevisions(ctx, &funcdomain.ListFunctionRevisionsArgs{
+		Name:      name,
+		PageSize:  req.GetPageSize(),
+		PageToken: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	out := &faaspb.ListFunctionRevisionsResponse{
+		Revisions:     make([]*faaspb.Function, 0, len(res.Revisions)),
+		NextPageToken: res.NextPageToken,
+	}
+
+	for _, f := range res.Revisions {
+		if f == nil {
+			continue
+		}
+		out.Revisions = append(out.Revisions, domainToPBFunction(f))
+	}
+
+	return out, nil
+}
+
+func (s *Server) GetFunctionRevision(ctx context.Context, req *faaspb.GetFunctionRevisionRequest) (*faaspb.Function, error) {
+	name, err := funcdomain.ParseFunctionName(req.GetName())
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	if req.GetRevision() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "revision is required")
+	}
+
+	res, err := s.functionService.GetFunction(ctx, &funcdomain.GetFunctionArgs{
+		Name:     name,
+		Revision: req.GetRevision(),
+	})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	if res == nil || res.Function == nil {
+		return nil, status.Error(codes.Internal, "missing function in result")
+	}
+
+	return domainToPBFunction(res.Function), nil
+}
+
+func (s *Server) UpdateFunction(ctx context.Context, req *faaspb.UpdateFunctionRequest) (*faaspb.Function, error) {
+	pb := req.GetFunction()
+	if pb == nil {
+		return nil, status.Error(codes.InvalidArgument, "function is required")
+	}
+
+	name, err := funcdomain.ParseFunctionName(pb.GetName())
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	var etag uint64
+	if pb.GetEtag() != "" {
+		if etag, err = strconv.ParseUint(pb.GetEtag(), 10, 64); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid etag")
+		}
+	}
+
+	res, err := s.functionService.UpdateFunction(ctx, &funcdomain.UpdateFunctionArgs{
+		Name: name,
+		Function: &funcdomain.Function{
+			DisplayName: pb.GetDisplayName(),
+			Description: pb.GetDescription(),
+			Labels:      pb.GetLabels(),
+			Annotations: pb.GetAnnotations(),
+			Timeout:     pb.GetTimeout().AsDuration(),
+			MemoryBytes: pb.GetMemoryBytes(),
+			Runtime:     pb.GetRuntime(),
+			Env:         pb.GetEnv(),
+			SecretEnv:   pb.GetSecretEnv(),
+
+			ParametersSchema:  json.RawMessage(pb.GetParametersSchema()),
+			OutputSchema:      json.RawMessage(pb.GetOutputSchema()),
+			OutputContentType: pb.GetOutputContentType(),
+		},
+		Paths: req.GetUpdateMask().GetPaths(),
+		ETag:  etag,
+	})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	if res == nil || res.Function == nil {
+		return nil, status.Error(codes.Internal, "missing function in result")
+	}
+
+	return domainToPBFunction(res.Function), nil
+}
+
 func (s *Server) DeleteFunction(ctx context.Context, req *faaspb.DeleteFunctionRequest) (*emptypb.Empty, error) {
 	name, err := funcdomain.ParseFunctionName(req.GetName())
 	if err != nil {
 		return nil, toStatusErr(err)
 	}
 
-	if err := s.functionService.DeleteFunction(ctx, &funcdomain.DeleteFunctionArgs{Name: name}); err != nil {
+	if err := s.functionService.DeleteFunction(ctx, &funcdomain.DeleteFunctionArgs{
+		Name:  name,
+		Force: req.GetForce(),
+	}); err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) UndeleteFunction(ctx context.Context, req *faaspb.UndeleteFunctionRequest) (*faaspb.Function, error) {
+	name, err := funcdomain.ParseFunctionName(req.GetName())
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	res, err := s.functionService.UndeleteFunction(ctx, &funcdomain.UndeleteFunctionArgs{Name: name})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	return domainToPBFunction(res.Function), nil
+}
+
+func (s *Server) DownloadFunction(req *faaspb.DownloadFunctionRequest, stream grpc.ServerStreamingServer[faaspb.DownloadFunctionResponse]) error {
+	name, err := funcdomain.ParseFunctionName(req.GetName())
+	if err != nil {
+		return toStatusErr(err)
+	}
+
+	res, err := s.functionService.DownloadFunction(stream.Context(), &funcdomain.DownloadFunctionArgs{
+		Name:     name,
+		Revision: req.GetRevision(),
+	})
+	if err != nil {
+		return toStatusErr(err)
+	}
+	if res == nil || res.Function == nil || res.Data == nil {
+		return status.Error(codes.Internal, "empty result")
+	}
+	defer res.Data.Close()
+
+	if err := stream.Send(&faaspb.DownloadFunctionResponse{
+		Payload: &faaspb.DownloadFunctionResponse_Function{Function: domainToPBFunction(res.Function)},
+	}); err != nil {
+		return err
+	}
+
+	buf := make([]byte, downloadChunkSize)
+	for {
+		n, readErr := res.Data.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&faaspb.DownloadFunctionResponse{
+				Payload: &faaspb.DownloadFunctionResponse_Data{Data: buf[:n]},
+			}); err != nil {
+				return err
+			}
+		}
+		if readErr == io.EOF {
+			return nil
+		}
+		if readErr != nil {
+			return status.Error(codes.Internal, readErr.Error())
+		}
+	}
+}
+
+func (s *Server) GetNamespaceUsage(ctx context.Context, req *faaspb.GetNamespaceUsageRequest) (*faaspb.NamespaceUsage, error) {
+	res, err := s.functionService.GetNamespaceUsage(ctx, &funcdomain.GetNamespaceUsageArgs{Namespace: req.GetNamespace()})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	if res == nil || res.Usage == nil {
+		return nil, status.Error(codes.Internal, "empty result")
+	}
+
+	return &faaspb.NamespaceUsage{
+		Namespace:      res.Usage.Namespace,
+		BundleBytes:    res.Usage.BundleBytes,
+		QuotaBytes:     res.Usage.QuotaBytes,
+		MaxBundleBytes: res.Usage.MaxBundleBytes,
+	}, nil
+}
+
+func (s *Server) UpdateAlias(ctx context.Context, req *faaspb.UpdateAliasRequest) (*faaspb.FunctionAlias, error) {
+	pb := req.GetAlias()
+	if pb == nil {
+		return nil, status.Error(codes.InvalidArgument, "alias is required")
+	}
+
+	name, err := funcdomain.ParseFunctionName(pb.GetFunction())
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	alias := &funcdomain.FunctionAlias{
+		Function: name,
+		Name:     pb.GetName(),
+		Routes:   make([]funcdomain.AliasRoute, 0, len(pb.GetRoutes())),
+	}
+	for _, r := range pb.GetRoutes() {
+		alias.Routes = append(alias.Routes, funcdomain.AliasRoute{
+			Revision: r.GetRevision(),
+			Weight:   r.GetWeight(),
+		})
+	}
+
+	res, err := s.functionService.UpdateAlias(ctx, &funcdomain.UpdateAliasArgs{Alias: alias})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	if res == nil || res.Alias == nil {
+		return nil, status.Error(codes.Internal, "missing alias in result")
+	}
+
+	return domainToPBAlias(res.Alias), nil
+}
+
+func (s *Server) GetAlias(ctx context.Context, req *faaspb.GetAliasRequest) (*faaspb.FunctionAlias, error) {
+	name, err := funcdomain.ParseFunctionName(req.GetFunction())
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	res, err := s.functionService.GetAlias(ctx, &funcdomain.GetAliasArgs{Function: name, Name: req.GetName()})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	if res == nil || res.Alias == nil {
+		return nil, status.Error(codes.Internal, "missing alias in result")
+	}
+
+	return domainToPBAlias(res.Alias), nil
+}
+
+func (s *Server) ListAliases(ctx context.Context, req *faaspb.ListAliasesRequest) (*faaspb.ListAliasesResponse, error) {
+	name, err := funcdomain.ParseFunctionName(req.GetFunction())
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	res, err := s.functionService.ListAliases(ctx, &funcdomain.ListAliasesArgs{Function: name})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	out := &faaspb.ListAliasesResponse{
+		Aliases: make([]*faaspb.FunctionAlias, 0, len(res.Aliases)),
+	}
+	for _, a := range res.Aliases {
+		if a == nil {
+			continue
+		}
+		out.Aliases = append(out.Aliases, domainToPBAlias(a))
+	}
+
+	return out, nil
+}
+
+func (s *Server) DeleteAlias(ctx context.Context, req *faaspb.DeleteAliasRequest) (*emptypb.Empty, error) {
+	name, err := funcdomain.ParseFunctionName(req.GetFunction())
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	if err := s.functionService.DeleteAlias(ctx, &funcdomain.DeleteAliasArgs{Function: name, Name: req.GetName()}); err != nil {
 		return nil, toStatusErr(err)
 	}
 
@@ -224,6 +764,7 @@ func pbToDomainUploadFormat(f faaspb.UploadFunctionMetadata_Format) (funcdomain.
 func domainToPBFunction(f *funcdomain.Function) *faaspb.Function {
 	pb := &faaspb.Function{
 		Name:        string(f.Name),
+		Revision:    f.Revision,
 		DisplayName: f.DisplayName,
 		UploadedAt:  timestamppb.New(f.UploadedAt),
 		SourceBundle: &faaspb.SourceBundle{
@@ -231,11 +772,99 @@ func domainToPBFunction(f *funcdomain.Function) *faaspb.Function {
 			ObjectKey: f.Bundle.ObjectKey,
 			Size:      f.Bundle.Size,
 			Sha256:    f.Bundle.SHA256,
+			Format:    string(f.Bundle.ArchiveFormat()),
 		},
+		Env:               f.Env,
+		SecretEnv:         f.SecretEnv,
+		Description:       f.Description,
+		Labels:            f.Labels,
+		Annotations:       f.Annotations,
+		MemoryBytes:       f.MemoryBytes,
+		Runtime:           f.Runtime,
+		Entrypoint:        f.Entrypoint,
+		Handler:           f.Handler,
+		ParametersSchema:  string(f.ParametersSchema),
+		OutputSchema:      string(f.OutputSchema),
+		OutputContentType: f.OutputType(),
+		State:             faaspb.FunctionState_FUNCTION_STATE_ACTIVE,
+		DeleteTime:        toPBTimestampOrNil(f.DeleteTime),
+		PurgeTime:         toPBTimestampOrNil(f.PurgeTime),
+	}
+	if f.IsDeleted() {
+		pb.State = faaspb.FunctionState_FUNCTION_STATE_DELETED
+	}
+	if f.Retry != nil {
+		pb.RetryPolicy = &faaspb.RetryPolicy{
+			MaxAttempts: int32(f.Retry.MaxAttempts),
+			Backoff:     durationpb.New(f.Retry.Backoff),
+		}
+	}
+	if f.ETag != 0 {
+		pb.Etag = strconv.FormatUint(f.ETag, 10)
+	}
+	if f.Timeout > 0 {
+		pb.Timeout = durationpb.New(f.Timeout)
+	}
+	if f.Build != nil {
+		pb.Build = domainToPBBuild(f.Build)
+	}
+	return pb
+}
+
+func domainToPBAlias(a *funcdomain.FunctionAlias) *faaspb.FunctionAlias {
+	pb := &faaspb.FunctionAlias{
+		Function:  string(a.Function),
+		Name:      a.Name,
+		Routes:    make([]*faaspb.AliasRoute, 0, len(a.Routes)),
+		UpdatedAt: toPBTimestampOrNil(a.UpdatedAt),
+	}
+	for _, r := range a.Routes {
+		pb.Routes = append(pb.Routes, &faaspb.AliasRoute{Revision: r.Revision, Weight: r.Weight})
+	}
+	return pb
+}
+
+func domainToPBBuild(b *funcdomain.FunctionBuild) *faaspb.FunctionBuild {
+	pb := &faaspb.FunctionBuild{
+		Id:           b.ID.String(),
+		State:        domainToPBBuildState(b.State),
+		StartedAt:    toPBTimestampOrNil(b.StartedAt),
+		EndedAt:      toPBTimestampOrNil(b.EndedAt),
+		Log:          b.Log,
+		ErrorMessage: b.ErrorMessage,
+	}
+	if a := b.Artifact; a != nil {
+		pb.Artifact = &faaspb.SourceBundle{
+			Bucket:    a.Bucket,
+			ObjectKey: a.ObjectKey,
+			Size:      a.Size,
+			Sha256:    a.SHA256,
+			Format:    string(a.ArchiveFormat()),
+		}
 	}
 	return pb
 }
 
+func domainToPBBuildState(s funcdomain.BuildState) faaspb.BuildState {
+	switch s {
+	case funcdomain.BuildStateBuilding:
+		return faaspb.BuildState_BUILD_STATE_BUILDING
+	case funcdomain.BuildStateReady:
+		return faaspb.BuildState_BUILD_STATE_READY
+	case funcdomain.BuildStateFailed:
+		return faaspb.BuildState_BUILD_STATE_BUILD_FAILED
+	default:
+		return faaspb.BuildState_BUILD_STATE_UNSPECIFIED
+	}
+}
+
+func toPBTimestampOrNil(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
 func toStatusErr(err error) error {
 	if err == nil {
 		return nil
@@ -248,17 +877,81 @@ func toStatusErr(err error) error {
 		return status.Error(codes.DeadlineExceeded, "deadline exceeded")
 	}
 
+	var me *funcdomain.ManifestError
+	if errors.As(err, &me) {
+		return badRequestErr(me.Error(), me.Violations)
+	}
+	var pe *funcdomain.ParametersError
+	if errors.As(err, &pe) {
+		return badRequestErr(pe.Error(), pe.Violations)
+	}
+
 	switch {
-	case errors.Is(err, funcdomain.ErrFunctionNotFound):
+	case errors.Is(err, funcdomain.ErrFunctionNotFound),
+		errors.Is(err, funcdomain.ErrRevisionNotFound),
+		errors.Is(err, funcdomain.ErrBundleNotFound),
+		errors.Is(err, funcdomain.ErrUploadNotFound),
+		errors.Is(err, funcdomain.ErrAliasNotFound):
 		return status.Error(codes.NotFound, err.Error())
 	case errors.Is(err, funcdomain.ErrFunctionAlreadyExists):
 		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, funcdomain.ErrRevisionConflict),
+		errors.Is(err, funcdomain.ErrETagMismatch):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, funcdomain.ErrUploadOffsetMismatch),
+		errors.Is(err, funcdomain.ErrUploadFinalizing),
+		errors.Is(err, jobdomain.ErrJobCanceled):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, funcdomain.ErrFunctionNotReady),
+		errors.Is(err, funcdomain.ErrFunctionDeleted),
+		errors.Is(err, funcdomain.ErrFunctionNotDeleted),
+		errors.Is(err, funcdomain.ErrFunctionHasTasks),
+		errors.Is(err, funcdomain.ErrUploadIncomplete),
+		errors.Is(err, funcdomain.ErrUploadFinalized):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, funcdomain.ErrBundleTooLarge),
+		errors.Is(err, funcdomain.ErrQuotaExceeded):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, funcdomain.ErrDigestMismatch),
+		errors.Is(err, funcdomain.ErrChunkChecksumMismatch),
+		errors.Is(err, taskdomain.ErrInputDigestMismatch):
+		return status.Error(codes.DataLoss, err.Error())
 	case errors.Is(err, funcdomain.ErrInvalidArgument),
 		errors.Is(err, funcdomain.ErrInvalidName),
 		errors.Is(err, funcdomain.ErrInvalidPageToken),
-		errors.Is(err, funcdomain.ErrUnsupportedFormat):
+		errors.Is(err, funcdomain.ErrInvalidFilter),
+		errors.Is(err, funcdomain.ErrInvalidOrderBy),
+		errors.Is(err, funcdomain.ErrUnsupportedFormat),
+		errors.Is(err, funcdomain.ErrInvalidEnv),
+		errors.Is(err, funcdomain.ErrInvalidDigest),
+		errors.Is(err, funcdomain.ErrInvalidAlias),
+		errors.Is(err, funcdomain.ErrInvalidMetadata),
+		errors.Is(err, funcdomain.ErrInvalidUploadSession),
+		errors.Is(err, funcdomain.ErrInvalidParameters),
+		errors.Is(err, taskdomain.ErrInvalidParameters),
+		errors.Is(err, taskdomain.ErrInvalidArtifactName),
+		errors.Is(err, taskdomain.ErrDuplicateInput),
+		errors.Is(err, jobdomain.ErrInvalidThreshold):
 		return status.Error(codes.InvalidArgument, err.Error())
 	default:
 		return status.Error(codes.Internal, err.Error())
 	}
 }
+
+// badRequestErr reports every invalid manifest or parameters field as a
+// BadRequest field violation.
+func badRequestErr(msg string, violations []funcdomain.FieldViolation) error {
+	br := &errdetails.BadRequest{}
+	for _, v := range violations {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+
+	st, err := status.New(codes.InvalidArgument, msg).WithDetails(br)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, msg)
+	}
+	return st.Err()
+}
@@ -2,7 +2,9 @@ package funcapi_test
 
 import (
 	"context"
+	"encoding/json"
 	"io"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,13 +13,18 @@ import (
 	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
 
 	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	taskdomain "github.com/10Narratives/faas/internal/domains/tasks"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 // ---- fake stream for UploadFunction ----
@@ -299,6 +306,34 @@ func TestGetFunction_NilFunction_Internal(t *testing.T) {
 	require.Contains(t, st.Message(), "missing function in result")
 }
 
+func TestGetFunction_MapsArtifactFormat(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	fn := &funcdomain.Function{
+		InternalID: uuid.New(),
+		Name:       funcdomain.FunctionName("functions/a"),
+		Revision:   1,
+		UploadedAt: time.Now(),
+		Bundle:     &funcdomain.SourceBundle{Bucket: "b", ObjectKey: "bundles/a.zip", Format: funcdomain.ZipFormat},
+		Build: &funcdomain.FunctionBuild{
+			ID:       uuid.New(),
+			State:    funcdomain.BuildStateReady,
+			Artifact: &funcdomain.SourceBundle{Bucket: "b", ObjectKey: "artifacts/a.tar.gz", SHA256: "abc"},
+		},
+	}
+
+	svc.EXPECT().
+		GetFunction(mock.Anything, &funcdomain.GetFunctionArgs{Name: "functions/a"}).
+		Return(&funcdomain.GetFunctionResult{Function: fn}, nil).
+		Once()
+
+	resp, err := s.GetFunction(context.Background(), &faaspb.GetFunctionRequest{Name: "functions/a"})
+	require.NoError(t, err)
+	require.Equal(t, string(funcdomain.ZipFormat), resp.GetSourceBundle().GetFormat())
+	require.Equal(t, string(funcdomain.TarGZFormat), resp.GetBuild().GetArtifact().GetFormat())
+}
+
 func TestListFunctions_SkipsNil(t *testing.T) {
 	svc := mocks.NewFunctionService(t)
 	s := funcapi.NewServer(svc)
@@ -329,6 +364,198 @@ func TestListFunctions_SkipsNil(t *testing.T) {
 	require.Equal(t, "functions/a", resp.GetFunctions()[0].GetName())
 }
 
+func TestListFunctions_ParsesFilterAndOrder(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	img := &funcdomain.Function{
+		Name:       "functions/img-resize",
+		Runtime:    "python3.12",
+		Labels:     map[string]string{"team": "images"},
+		UploadedAt: day.Add(time.Hour),
+		Bundle:     &funcdomain.SourceBundle{},
+	}
+	older := &funcdomain.Function{
+		Name:       "functions/img-crop",
+		Runtime:    "python3.12",
+		Labels:     map[string]string{"team": "images"},
+		UploadedAt: day.Add(-time.Hour),
+	}
+	other := &funcdomain.Function{
+		Name:       "functions/img-thumb",
+		Runtime:    "go1.22",
+		Labels:     map[string]string{"team": "images"},
+		UploadedAt: day.Add(time.Hour),
+	}
+
+	svc.EXPECT().
+		ListFunctions(mock.Anything, mock.MatchedBy(func(args *funcdomain.ListFunctionsArgs) bool {
+			return args.Filter.Match(img) &&
+				!args.Filter.Match(older) &&
+				!args.Filter.Match(other) &&
+				args.OrderBy.String() == "uploaded_at desc, name" &&
+				args.OrderBy.Compare(img, older) < 0
+		})).
+		Return(&funcdomain.ListFunctionsResult{Functions: []*funcdomain.Function{img}}, nil).
+		Once()
+
+	resp, err := s.ListFunctions(context.Background(), &faaspb.ListFunctionsRequest{
+		Filter:  `labels.team = "images" AND name = "img-*" runtime = python3.12 AND uploaded_at >= "2024-03-01T00:00:00Z" NOT labels:deprecated`,
+		OrderBy: "uploaded_at DESC,name",
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetFunctions(), 1)
+}
+
+func TestListFunctions_InvalidQuery_InvalidArgument(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  string
+		orderBy string
+	}{
+		{name: "unknown field", filter: `owner = "me"`},
+		{name: "or", filter: `runtime = go OR runtime = python3.12`},
+		{name: "bad timestamp", filter: `uploaded_at > "yesterday"`},
+		{name: "range on name", filter: `name > "a"`},
+		{name: "dangling and", filter: `runtime = go AND`},
+		{name: "unterminated", filter: `labels.team = "images`},
+		{name: "unknown order field", orderBy: "size"},
+		{name: "bad direction", orderBy: "name up"},
+		{name: "repeated order field", orderBy: "name, name desc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewFunctionService(t)
+			s := funcapi.NewServer(svc)
+
+			_, err := s.ListFunctions(context.Background(), &faaspb.ListFunctionsRequest{
+				Filter:  tt.filter,
+				OrderBy: tt.orderBy,
+			})
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestGetFunctionRevision_PassesRevision(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	fn := &funcdomain.Function{
+		InternalID: uuid.New(),
+		Name:       funcdomain.FunctionName("functions/a"),
+		Revision:   2,
+		UploadedAt: time.Now(),
+		Bundle:     &funcdomain.SourceBundle{Bucket: "b", ObjectKey: "bundles/a/2.zip"},
+	}
+
+	svc.EXPECT().
+		GetFunction(mock.Anything, &funcdomain.GetFunctionArgs{Name: "functions/a", Revision: 2}).
+		Return(&funcdomain.GetFunctionResult{Function: fn}, nil).
+		Once()
+
+	resp, err := s.GetFunctionRevision(context.Background(), &faaspb.GetFunctionRevisionRequest{
+		Name:     "functions/a",
+		Revision: 2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), resp.GetRevision())
+}
+
+func TestGetFunctionRevision_NotFound(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	svc.EXPECT().
+		GetFunction(mock.Anything, mock.Anything).
+		Return(nil, funcdomain.ErrRevisionNotFound).
+		Once()
+
+	_, err := s.GetFunctionRevision(context.Background(), &faaspb.GetFunctionRevisionRequest{
+		Name:     "functions/a",
+		Revision: 7,
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGetFunctionRevision_MissingRevision(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	_, err := s.GetFunctionRevision(context.Background(), &faaspb.GetFunctionRevisionRequest{Name: "functions/a"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUploadFunction_RevisionConflict_Aborted(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	svc.EXPECT().
+		UploadFunction(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, args *funcdomain.UploadFunctionArgs) (*funcdomain.UploadFunctionResult, error) {
+			_, _ = io.Copy(io.Discard, args.Data)
+			return nil, funcdomain.ErrRevisionConflict
+		}).
+		Once()
+
+	stream := &fakeUploadStream{
+		ctx: context.Background(),
+		reqs: []*faaspb.UploadFunctionRequest{
+			{Payload: &faaspb.UploadFunctionRequest_UploadFunctionMetadata{
+				UploadFunctionMetadata: &faaspb.UploadFunctionMetadata{
+					FunctionName: "functions/a",
+					Format:       faaspb.UploadFunctionMetadata_FORMAT_ZIP,
+				},
+			}},
+		},
+	}
+
+	err := s.UploadFunction(stream)
+	require.Equal(t, codes.Aborted, status.Code(err))
+	require.False(t, stream.sendCalled)
+}
+
+func TestUploadFunction_InvalidManifest_FieldViolations(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	svc.EXPECT().
+		UploadFunction(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, args *funcdomain.UploadFunctionArgs) (*funcdomain.UploadFunctionResult, error) {
+			_, _ = io.Copy(io.Discard, args.Data)
+			return nil, &funcdomain.ManifestError{Violations: []funcdomain.FieldViolation{
+				{Field: "runtime", Description: "is required"},
+				{Field: "entrypoint", Description: `file "main.py" is not in the bundle`},
+			}}
+		}).
+		Once()
+
+	stream := &fakeUploadStream{
+		ctx: context.Background(),
+		reqs: []*faaspb.UploadFunctionRequest{
+			{Payload: &faaspb.UploadFunctionRequest_UploadFunctionMetadata{
+				UploadFunctionMetadata: &faaspb.UploadFunctionMetadata{
+					FunctionName: "functions/a",
+					Format:       faaspb.UploadFunctionMetadata_FORMAT_ZIP,
+				},
+			}},
+		},
+	}
+
+	err := s.UploadFunction(stream)
+	st := status.Convert(err)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+
+	br, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, br.GetFieldViolations(), 2)
+	require.Equal(t, "runtime", br.GetFieldViolations()[0].GetField())
+	require.Equal(t, "entrypoint", br.GetFieldViolations()[1].GetField())
+}
+
 func TestDeleteFunction_NotFound(t *testing.T) {
 	svc := mocks.NewFunctionService(t)
 	s := funcapi.NewServer(svc)
@@ -343,3 +570,792 @@ func TestDeleteFunction_NotFound(t *testing.T) {
 
 	require.Equal(t, codes.NotFound, st.Code())
 }
+
+func TestDeleteFunction_HasTasks_FailedPrecondition(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	svc.EXPECT().
+		DeleteFunction(mock.Anything, &funcdomain.DeleteFunctionArgs{Name: "functions/img"}).
+		Return(funcdomain.ErrFunctionHasTasks).
+		Once()
+
+	_, err := s.DeleteFunction(context.Background(), &faaspb.DeleteFunctionRequest{Name: "functions/img"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestDeleteFunction_PassesForce(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	svc.EXPECT().
+		DeleteFunction(mock.Anything, &funcdomain.DeleteFunctionArgs{Name: "functions/img", Force: true}).
+		Return(nil).
+		Once()
+
+	_, err := s.DeleteFunction(context.Background(), &faaspb.DeleteFunctionRequest{Name: "functions/img", Force: true})
+	require.NoError(t, err)
+}
+
+func TestUndeleteFunction_ReportsDeletedState(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	svc.EXPECT().
+		UndeleteFunction(mock.Anything, &funcdomain.UndeleteFunctionArgs{Name: "functions/img"}).
+		Return(&funcdomain.UndeleteFunctionResult{Function: &funcdomain.Function{
+			Name:     "functions/img",
+			Revision: 3,
+			Bundle:   &funcdomain.SourceBundle{},
+		}}, nil).
+		Once()
+
+	fn, err := s.UndeleteFunction(context.Background(), &faaspb.UndeleteFunctionRequest{Name: "functions/img"})
+	require.NoError(t, err)
+	require.Equal(t, faaspb.FunctionState_FUNCTION_STATE_ACTIVE, fn.GetState())
+	require.Nil(t, fn.GetDeleteTime())
+}
+
+func TestUndeleteFunction_NotDeleted_FailedPrecondition(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	svc.EXPECT().
+		UndeleteFunction(mock.Anything, mock.Anything).
+		Return(nil, funcdomain.ErrFunctionNotDeleted).
+		Once()
+
+	_, err := s.UndeleteFunction(context.Background(), &faaspb.UndeleteFunctionRequest{Name: "functions/img"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestListFunctions_ShowDeleted(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	deleted := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	svc.EXPECT().
+		ListFunctions(mock.Anything, mock.MatchedBy(func(args *funcdomain.ListFunctionsArgs) bool {
+			return args.ShowDeleted
+		})).
+		Return(&funcdomain.ListFunctionsResult{Functions: []*funcdomain.Function{{
+			Name:       "functions/img",
+			Bundle:     &funcdomain.SourceBundle{},
+			DeleteTime: deleted,
+			PurgeTime:  deleted.Add(7 * 24 * time.Hour),
+		}}}, nil).
+		Once()
+
+	resp, err := s.ListFunctions(context.Background(), &faaspb.ListFunctionsRequest{ShowDeleted: true})
+	require.NoError(t, err)
+	require.Len(t, resp.GetFunctions(), 1)
+
+	fn := resp.GetFunctions()[0]
+	require.Equal(t, faaspb.FunctionState_FUNCTION_STATE_DELETED, fn.GetState())
+	require.Equal(t, deleted, fn.GetDeleteTime().AsTime())
+	require.Equal(t, deleted.Add(7*24*time.Hour), fn.GetPurgeTime().AsTime())
+}
+
+func TestUploadFunction_DigestMismatch_DataLoss(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	declared := "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+	svc.EXPECT().
+		UploadFunction(mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, args *funcdomain.UploadFunctionArgs) {
+			require.Equal(t, declared, args.SHA256)
+			_, _ = io.ReadAll(args.Data)
+		}).
+		Return((*funcdomain.UploadFunctionResult)(nil), funcdomain.ErrDigestMismatch).
+		Once()
+
+	stream := &fakeUploadStream{
+		ctx: context.Background(),
+		reqs: []*faaspb.UploadFunctionRequest{
+			{
+				Payload: &faaspb.UploadFunctionRequest_UploadFunctionMetadata{
+					UploadFunctionMetadata: &faaspb.UploadFunctionMetadata{
+						FunctionName: "functions/foo",
+						Format:       faaspb.UploadFunctionMetadata_FORMAT_ZIP,
+						Sha256:       declared,
+					},
+				},
+			},
+			{
+				Payload: &faaspb.UploadFunctionRequest_UploadFunctionData{
+					UploadFunctionData: &faaspb.UploadFunctionData{Data: []byte("x")},
+				},
+			},
+		},
+	}
+
+	err := s.UploadFunction(stream)
+	st := status.Convert(err)
+
+	require.Equal(t, codes.DataLoss, st.Code())
+	require.False(t, stream.sendCalled)
+}
+
+// ---- fake stream for ExecuteFunctionWithInputs ----
+
+type fakeExecuteStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	reqs []*faaspb.ExecuteFunctionWithInputsRequest
+	sent *faaspb.ExecuteFunctionResponse
+}
+
+func (s *fakeExecuteStream) Context() context.Context { return s.ctx }
+
+func (s *fakeExecuteStream) Recv() (*faaspb.ExecuteFunctionWithInputsRequest, error) {
+	if len(s.reqs) == 0 {
+		return nil, io.EOF
+	}
+	r := s.reqs[0]
+	s.reqs = s.reqs[1:]
+	return r, nil
+}
+
+func (s *fakeExecuteStream) SendAndClose(res *faaspb.ExecuteFunctionResponse) error {
+	s.sent = res
+	return nil
+}
+
+func inputHeader(name string) *faaspb.ExecuteFunctionWithInputsRequest {
+	return &faaspb.ExecuteFunctionWithInputsRequest{
+		Payload: &faaspb.ExecuteFunctionWithInputsRequest_InputHeader{InputHeader: &faaspb.TaskInputHeader{Name: name}},
+	}
+}
+
+func inputData(b string) *faaspb.ExecuteFunctionWithInputsRequest {
+	return &faaspb.ExecuteFunctionWithInputsRequest{
+		Payload: &faaspb.ExecuteFunctionWithInputsRequest_InputData{InputData: &faaspb.TaskInputData{Data: []byte(b)}},
+	}
+}
+
+func TestExecuteFunction_PassesLabels(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	svc.EXPECT().
+		ExecuteFunction(mock.Anything, &funcdomain.ExecuteFunctionArgs{
+			Name:          "functions/foo",
+			Alias:         "prod",
+			Parameters:    "{}",
+			Labels:        map[string]string{"env": "dev"},
+			Annotations:   map[string]string{"ticket": "OPS-1"},
+			InheritLabels: []string{"team"},
+		}).
+		Return(&funcdomain.ExecuteFunctionResult{TaskName: "tasks/1"}, nil).
+		Once()
+
+	resp, err := s.ExecuteFunction(context.Background(), &faaspb.ExecuteFunctionRequest{
+		Name:          "functions/foo@prod",
+		Parameters:    "{}",
+		Labels:        map[string]string{"env": "dev"},
+		Annotations:   map[string]string{"ticket": "OPS-1"},
+		InheritLabels: []string{"team"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "tasks/1", resp.GetName())
+}
+
+func TestExecuteFunction_SchemaViolations_FieldViolations(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	fn := &funcdomain.Function{ParametersSchema: json.RawMessage(`{
+		"type": "object",
+		"required": ["name"],
+		"properties": {"items": {"type": "array", "items": {"type": "integer"}}}
+	}`)}
+	svc.EXPECT().
+		ExecuteFunction(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, args *funcdomain.ExecuteFunctionArgs) (*funcdomain.ExecuteFunctionResult, error) {
+			return nil, fn.ValidateParameters(args.Parameters)
+		}).
+		Once()
+
+	_, err := s.ExecuteFunction(context.Background(), &faaspb.ExecuteFunctionRequest{
+		Name:       "functions/foo",
+		Parameters: `{"items": [1, "two"]}`,
+	})
+	st := status.Convert(err)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+
+	br, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	var fields []string
+	for _, v := range br.GetFieldViolations() {
+		fields = append(fields, v.GetField())
+	}
+	require.ElementsMatch(t, []string{"parameters", "parameters.items[1]"}, fields)
+}
+
+func TestExecuteFunctionWithInputs_StreamsInputsToDomain(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	svc.EXPECT().
+		ExecuteFunction(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, args *funcdomain.ExecuteFunctionArgs) (*funcdomain.ExecuteFunctionResult, error) {
+			require.Equal(t, funcdomain.FunctionName("functions/foo"), args.Name)
+			require.Equal(t, `{"a":1}`, args.Parameters)
+
+			got := map[string]string{}
+			for {
+				in, err := args.Inputs.Next()
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+				if in.Name == "skipped.bin" {
+					continue // unread data must be skipped by Next
+				}
+				b, err := io.ReadAll(in.Data)
+				require.NoError(t, err)
+				got[in.Name] = string(b)
+			}
+			require.Equal(t, map[string]string{"a.txt": "hello world", "empty": ""}, got)
+			return &funcdomain.ExecuteFunctionResult{TaskName: "tasks/1"}, nil
+		}).
+		Once()
+
+	stream := &fakeExecuteStream{
+		ctx: context.Background(),
+		reqs: []*faaspb.ExecuteFunctionWithInputsRequest{
+			{Payload: &faaspb.ExecuteFunctionWithInputsRequest_Execute{
+				Execute: &faaspb.ExecuteFunctionRequest{Name: "functions/foo", Parameters: `{"a":1}`},
+			}},
+			inputHeader("a.txt"), inputData("hello "), inputData("world"),
+			inputHeader("skipped.bin"), inputData("zzz"),
+			inputHeader("empty"),
+		},
+	}
+
+	err := s.ExecuteFunctionWithInputs(stream)
+	require.NoError(t, err)
+	require.Equal(t, "tasks/1", stream.sent.GetName())
+}
+
+func TestExecuteFunctionWithInputs_DataBeforeHeader(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	svc.EXPECT().
+		ExecuteFunction(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, args *funcdomain.ExecuteFunctionArgs) (*funcdomain.ExecuteFunctionResult, error) {
+			_, err := args.Inputs.Next()
+			return nil, err
+		}).
+		Once()
+
+	stream := &fakeExecuteStream{
+		ctx: context.Background(),
+		reqs: []*faaspb.ExecuteFunctionWithInputsRequest{
+			{Payload: &faaspb.ExecuteFunctionWithInputsRequest_Execute{
+				Execute: &faaspb.ExecuteFunctionRequest{Name: "functions/foo"},
+			}},
+			inputData("orphan"),
+		},
+	}
+
+	err := s.ExecuteFunctionWithInputs(stream)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Nil(t, stream.sent)
+}
+
+// ---- fake stream for BatchExecuteFunction ----
+
+type fakeBatchStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	reqs []*faaspb.BatchExecuteFunctionRequest
+	sent []*faaspb.BatchExecuteFunctionResponse
+}
+
+func (s *fakeBatchStream) Context() context.Context { return s.ctx }
+
+func (s *fakeBatchStream) Recv() (*faaspb.BatchExecuteFunctionRequest, error) {
+	if len(s.reqs) == 0 {
+		return nil, io.EOF
+	}
+	r := s.reqs[0]
+	s.reqs = s.reqs[1:]
+	return r, nil
+}
+
+func (s *fakeBatchStream) Send(res *faaspb.BatchExecuteFunctionResponse) error {
+	s.sent = append(s.sent, res)
+	return nil
+}
+
+func batchItem(params string) *faaspb.BatchExecuteFunctionRequest {
+	return &faaspb.BatchExecuteFunctionRequest{
+		Payload: &faaspb.BatchExecuteFunctionRequest_Item{Item: &faaspb.BatchItem{Parameters: params}},
+	}
+}
+
+func TestBatchExecuteFunction_ReportsEachItem(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	svc.EXPECT().
+		BatchExecuteFunction(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, args *funcdomain.BatchExecuteFunctionArgs) (*funcdomain.BatchExecuteFunctionResult, error) {
+			require.Equal(t, funcdomain.FunctionName("functions/foo"), args.Name)
+			require.Equal(t, "prod", args.Alias)
+
+			for i := uint64(0); ; i++ {
+				item, err := args.Items.Next()
+				if err == io.EOF {
+					return &funcdomain.BatchExecuteFunctionResult{BatchID: "b1"}, nil
+				}
+				require.NoError(t, err)
+
+				res := &funcdomain.BatchItemResult{BatchID: "b1", Index: i}
+				if item.Parameters == "bad" {
+					res.Err = funcdomain.ErrInvalidParameters
+				} else {
+					res.TaskName = "tasks/" + item.Parameters
+				}
+				require.NoError(t, args.Report(res))
+			}
+		}).
+		Once()
+
+	stream := &fakeBatchStream{
+		ctx: context.Background(),
+		reqs: []*faaspb.BatchExecuteFunctionRequest{
+			{Payload: &faaspb.BatchExecuteFunctionRequest_Execute{
+				Execute: &faaspb.ExecuteFunctionRequest{Name: "functions/foo@prod"},
+			}},
+			batchItem("1"), batchItem("bad"), batchItem("3"),
+		},
+	}
+
+	require.NoError(t, s.BatchExecuteFunction(stream))
+	require.Len(t, stream.sent, 3)
+	for i, res := range stream.sent {
+		require.Equal(t, "b1", res.GetBatchId())
+		require.Equal(t, uint64(i), res.GetIndex())
+	}
+	require.Equal(t, "tasks/1", stream.sent[0].GetTask())
+	require.Empty(t, stream.sent[1].GetTask())
+	require.Equal(t, int32(codes.InvalidArgument), stream.sent[1].GetError().GetCode())
+	require.Equal(t, "tasks/3", stream.sent[2].GetTask())
+	require.Nil(t, stream.sent[2].GetError())
+}
+
+func TestBatchExecuteFunction_FirstMessageNotExecute(t *testing.T) {
+	s := funcapi.NewServer(mocks.NewFunctionService(t))
+
+	err := s.BatchExecuteFunction(&fakeBatchStream{
+		ctx:  context.Background(),
+		reqs: []*faaspb.BatchExecuteFunctionRequest{batchItem("1")},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestExecuteFunction_ResolvesAliasRef(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	svc.EXPECT().
+		ExecuteFunction(mock.Anything, &funcdomain.ExecuteFunctionArgs{
+			Name:       "functions/foo",
+			Alias:      "prod",
+			Parameters: "{}",
+		}).
+		Return(&funcdomain.ExecuteFunctionResult{TaskName: "tasks/1"}, nil).
+		Once()
+
+	resp, err := s.ExecuteFunction(context.Background(), &faaspb.ExecuteFunctionRequest{
+		Name:       "functions/foo@prod",
+		Parameters: "{}",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "tasks/1", resp.GetName())
+}
+
+func TestExecuteFunction_InvalidAliasRef(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	_, err := s.ExecuteFunction(context.Background(), &faaspb.ExecuteFunctionRequest{Name: "functions/foo@Prod!"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestInvokeFunction_ReturnsTask(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	svc.EXPECT().
+		InvokeFunction(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, args *funcdomain.InvokeFunctionArgs) (*funcdomain.InvokeFunctionResult, error) {
+			require.Equal(t, funcdomain.FunctionName("functions/foo"), args.Name)
+			require.Equal(t, `{"a":1}`, args.Parameters)
+			return &funcdomain.InvokeFunctionResult{
+				Task: &taskdomain.Task{
+					Name:   "tasks/1",
+					State:  taskdomain.TaskStateSucceeded,
+					Result: &taskdomain.TaskResult{Type: taskdomain.TaskResultInline, InlineResult: []byte(`{"ok":true}`), ContentType: "application/json"},
+				},
+				Done: true,
+			}, nil
+		}).
+		Once()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := s.InvokeFunction(ctx, &faaspb.InvokeFunctionRequest{
+		Execute: &faaspb.ExecuteFunctionRequest{Name: "functions/foo", Parameters: `{"a":1}`},
+	})
+	require.NoError(t, err)
+	require.True(t, resp.GetDone())
+	require.Equal(t, "tasks/1", resp.GetTask().GetName())
+	require.Equal(t, faaspb.TaskState_TASK_STATE_SUCCEEDED, resp.GetTask().GetState())
+	require.Equal(t, []byte(`{"ok":true}`), resp.GetTask().GetResult().GetInlineResult())
+}
+
+func TestInvokeFunction_NotDone(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	svc.EXPECT().
+		InvokeFunction(mock.Anything, mock.Anything).
+		Return(&funcdomain.InvokeFunctionResult{
+			Task: &taskdomain.Task{Name: "tasks/1", State: taskdomain.TaskStateProcessing},
+		}, nil).
+		Once()
+
+	resp, err := s.InvokeFunction(context.Background(), &faaspb.InvokeFunctionRequest{
+		Execute: &faaspb.ExecuteFunctionRequest{Name: "functions/foo"},
+	})
+	require.NoError(t, err)
+	require.False(t, resp.GetDone())
+	require.Equal(t, faaspb.TaskState_TASK_STATE_PROCESSING, resp.GetTask().GetState())
+}
+
+func TestInvokeFunction_MissingExecute(t *testing.T) {
+	s := funcapi.NewServer(mocks.NewFunctionService(t))
+
+	_, err := s.InvokeFunction(context.Background(), &faaspb.InvokeFunctionRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUpdateAlias_MapsRoutes(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	svc.EXPECT().
+		UpdateAlias(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, args *funcdomain.UpdateAliasArgs) (*funcdomain.UpdateAliasResult, error) {
+			require.Equal(t, funcdomain.FunctionName("functions/foo"), args.Alias.Function)
+			require.Equal(t, []funcdomain.AliasRoute{{Revision: 3, Weight: 90}, {Revision: 4, Weight: 10}}, args.Alias.Routes)
+			return &funcdomain.UpdateAliasResult{Alias: args.Alias}, nil
+		}).
+		Once()
+
+	resp, err := s.UpdateAlias(context.Background(), &faaspb.UpdateAliasRequest{
+		Alias: &faaspb.FunctionAlias{
+			Function: "functions/foo",
+			Name:     "prod",
+			Routes: []*faaspb.AliasRoute{
+				{Revision: 3, Weight: 90},
+				{Revision: 4, Weight: 10},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetRoutes(), 2)
+}
+
+func TestUpdateAlias_InvalidRoutes(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	svc.EXPECT().
+		UpdateAlias(mock.Anything, mock.Anything).
+		Return(nil, funcdomain.ErrInvalidAlias).
+		Once()
+
+	_, err := s.UpdateAlias(context.Background(), &faaspb.UpdateAliasRequest{
+		Alias: &faaspb.FunctionAlias{Function: "functions/foo", Name: "prod"},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUpdateFunction_PassesMaskAndETag(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	svc.EXPECT().
+		UpdateFunction(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, args *funcdomain.UpdateFunctionArgs) (*funcdomain.UpdateFunctionResult, error) {
+			require.Equal(t, funcdomain.FunctionName("functions/foo"), args.Name)
+			require.Equal(t, uint64(42), args.ETag)
+			require.Equal(t, []string{"description", "timeout"}, args.Paths)
+			require.Equal(t, 30*time.Second, args.Function.Timeout)
+			return &funcdomain.UpdateFunctionResult{Function: &funcdomain.Function{
+				Name:        args.Name,
+				Description: args.Function.Description,
+				Timeout:     args.Function.Timeout,
+				Bundle:      &funcdomain.SourceBundle{},
+				ETag:        43,
+			}}, nil
+		}).
+		Once()
+
+	resp, err := s.UpdateFunction(context.Background(), &faaspb.UpdateFunctionRequest{
+		Function: &faaspb.Function{
+			Name:        "functions/foo",
+			Etag:        "42",
+			Description: "resizes images",
+			Timeout:     durationpb.New(30 * time.Second),
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description", "timeout"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "43", resp.GetEtag())
+	require.Equal(t, "resizes images", resp.GetDescription())
+}
+
+func TestUpdateFunction_ETagMismatch_Aborted(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	svc.EXPECT().
+		UpdateFunction(mock.Anything, mock.Anything).
+		Return(nil, funcdomain.ErrETagMismatch).
+		Once()
+
+	_, err := s.UpdateFunction(context.Background(), &faaspb.UpdateFunctionRequest{
+		Function:   &faaspb.Function{Name: "functions/foo", Etag: "1"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"labels"}},
+	})
+	require.Equal(t, codes.Aborted, status.Code(err))
+}
+
+// ---- fake stream for DownloadFunction ----
+
+type fakeDownloadStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*faaspb.DownloadFunctionResponse
+}
+
+func (s *fakeDownloadStream) Context() context.Context { return s.ctx }
+
+func (s *fakeDownloadStream) Send(m *faaspb.DownloadFunctionResponse) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+func TestDownloadFunction_MetadataThenData(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	svc.EXPECT().
+		DownloadFunction(mock.Anything, &funcdomain.DownloadFunctionArgs{Name: "functions/foo", Revision: 2}).
+		Return(&funcdomain.DownloadFunctionResult{
+			Function: &funcdomain.Function{
+				Name:     "functions/foo",
+				Revision: 2,
+				Bundle:   &funcdomain.SourceBundle{ObjectKey: "bundles/foo/2.zip", Size: 5, SHA256: "ff"},
+			},
+			Data: io.NopCloser(strings.NewReader("hello")),
+		}, nil).
+		Once()
+
+	stream := &fakeDownloadStream{ctx: context.Background()}
+	err := s.DownloadFunction(&faaspb.DownloadFunctionRequest{Name: "functions/foo", Revision: 2}, stream)
+	require.NoError(t, err)
+	require.Len(t, stream.sent, 2)
+	require.Equal(t, uint64(2), stream.sent[0].GetFunction().GetRevision())
+	require.Equal(t, "ff", stream.sent[0].GetFunction().GetSourceBundle().GetSha256())
+	require.Equal(t, []byte("hello"), stream.sent[1].GetData())
+}
+
+func TestDownloadFunction_BundleNotFound(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	svc.EXPECT().
+		DownloadFunction(mock.Anything, mock.Anything).
+		Return(nil, funcdomain.ErrBundleNotFound).
+		Once()
+
+	err := s.DownloadFunction(&faaspb.DownloadFunctionRequest{Name: "functions/foo"}, &fakeDownloadStream{ctx: context.Background()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestUploadFunction_BundleTooLarge_ResourceExhausted(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	svc.EXPECT().
+		UploadFunction(mock.Anything, mock.Anything).
+		Return(nil, funcdomain.ErrBundleTooLarge).
+		Once()
+
+	data := &faaspb.UploadFunctionRequest{Payload: &faaspb.UploadFunctionRequest_UploadFunctionData{
+		UploadFunctionData: &faaspb.UploadFunctionData{Data: []byte("chunk")},
+	}}
+	stream := &fakeUploadStream{
+		ctx: context.Background(),
+		reqs: []*faaspb.UploadFunctionRequest{
+			{Payload: &faaspb.UploadFunctionRequest_UploadFunctionMetadata{
+				UploadFunctionMetadata: &faaspb.UploadFunctionMetadata{
+					FunctionName: "functions/a",
+					Format:       faaspb.UploadFunctionMetadata_FORMAT_ZIP,
+				},
+			}},
+			data, data, data,
+		},
+	}
+
+	err := s.UploadFunction(stream)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.False(t, stream.sendCalled)
+}
+
+func TestGetNamespaceUsage(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	svc.EXPECT().
+		GetNamespaceUsage(mock.Anything, &funcdomain.GetNamespaceUsageArgs{Namespace: "team-a"}).
+		Return(&funcdomain.GetNamespaceUsageResult{Usage: &funcdomain.NamespaceUsage{
+			Namespace:      "team-a",
+			BundleBytes:    42,
+			QuotaBytes:     1 << 30,
+			MaxBundleBytes: 1 << 20,
+		}}, nil).
+		Once()
+
+	got, err := s.GetNamespaceUsage(context.Background(), &faaspb.GetNamespaceUsageRequest{Namespace: "team-a"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), got.GetBundleBytes())
+	require.Equal(t, uint64(1<<30), got.GetQuotaBytes())
+	require.Equal(t, uint64(1<<20), got.GetMaxBundleBytes())
+}
+
+// ---- fake stream for UploadChunks ----
+
+type fakeChunkStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	reqs []*faaspb.UploadChunkRequest
+	sent *faaspb.UploadSession
+}
+
+func (s *fakeChunkStream) Context() context.Context { return s.ctx }
+
+func (s *fakeChunkStream) Recv() (*faaspb.UploadChunkRequest, error) {
+	if len(s.reqs) == 0 {
+		return nil, io.EOF
+	}
+	r := s.reqs[0]
+	s.reqs = s.reqs[1:]
+	return r, nil
+}
+
+func (s *fakeChunkStream) SendAndClose(res *faaspb.UploadSession) error {
+	s.sent = res
+	return nil
+}
+
+func TestUploadChunks_CommitsEachChunk(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	id := uuid.New()
+	name := "uploads/" + id.String()
+
+	var committed uint64
+	svc.EXPECT().
+		WriteUploadChunk(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, args *funcdomain.WriteUploadChunkArgs) (*funcdomain.UploadSessionResult, error) {
+			require.Equal(t, funcdomain.UploadSessionName(name), args.Name)
+			require.Equal(t, committed, args.Offset)
+			require.Equal(t, uint32(7), args.CRC32C)
+			committed += uint64(len(args.Data))
+			return &funcdomain.UploadSessionResult{Session: &funcdomain.UploadSession{
+				ID:              id,
+				Function:        "functions/foo",
+				CommittedOffset: committed,
+			}}, nil
+		}).
+		Twice()
+
+	stream := &fakeChunkStream{
+		ctx: context.Background(),
+		reqs: []*faaspb.UploadChunkRequest{
+			{Name: name, Offset: 0, Data: []byte("abc"), Crc32C: 7},
+			{Name: name, Offset: 3, Data: []byte("de"), Crc32C: 7},
+		},
+	}
+
+	require.NoError(t, s.UploadChunks(stream))
+	require.Equal(t, name, stream.sent.GetName())
+	require.Equal(t, uint64(5), stream.sent.GetCommittedOffset())
+}
+
+func TestUploadChunks_OffsetMismatch_Aborted(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	svc.EXPECT().
+		WriteUploadChunk(mock.Anything, mock.Anything).
+		Return(nil, funcdomain.ErrUploadOffsetMismatch).
+		Once()
+
+	stream := &fakeChunkStream{
+		ctx: context.Background(),
+		reqs: []*faaspb.UploadChunkRequest{
+			{Name: "uploads/" + uuid.NewString(), Offset: 10, Data: []byte("x")},
+		},
+	}
+
+	err := s.UploadChunks(stream)
+	require.Equal(t, codes.Aborted, status.Code(err))
+	require.Nil(t, stream.sent)
+}
+
+func TestUploadFunction_DeduplicatedBundle_ReturnsWithoutReading(t *testing.T) {
+	svc := mocks.NewFunctionService(t)
+	s := funcapi.NewServer(svc)
+
+	svc.EXPECT().
+		UploadFunction(mock.Anything, mock.Anything).
+		Return(&funcdomain.UploadFunctionResult{Function: &funcdomain.Function{
+			Name:     "functions/a",
+			Revision: 2,
+			Bundle:   &funcdomain.SourceBundle{ObjectKey: "bundles/sha256/ab/1", SHA256: "ab"},
+		}}, nil).
+		Once()
+
+	data := &faaspb.UploadFunctionRequest{Payload: &faaspb.UploadFunctionRequest_UploadFunctionData{
+		UploadFunctionData: &faaspb.UploadFunctionData{Data: []byte("chunk")},
+	}}
+	stream := &fakeUploadStream{
+		ctx: context.Background(),
+		reqs: []*faaspb.UploadFunctionRequest{
+			{Payload: &faaspb.UploadFunctionRequest_UploadFunctionMetadata{
+				UploadFunctionMetadata: &faaspb.UploadFunctionMetadata{
+					FunctionName: "functions/a",
+					Format:       faaspb.UploadFunctionMetadata_FORMAT_ZIP,
+				},
+			}},
+			data, data, data,
+		},
+	}
+
+	require.NoError(t, s.UploadFunction(stream))
+	require.True(t, stream.sendCalled)
+}
@@ -0,0 +1,29 @@
+package funcapi
+
+import (
+	"fmt"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"google.golang.org/grpc"
+)
+
+type batchStream = grpc.BidiStreamingServer[faaspb.BatchExecuteFunctionRequest, faaspb.BatchExecuteFunctionResponse]
+
+// streamItems adapts the request stream to funcdomain.BatchItemIterator.
+// Recv already returns io.EOF once the client closes its side.
+type streamItems struct {
+	stream batchStream
+}
+
+func (s *streamItems) Next() (*funcdomain.BatchItem, error) {
+	msg, err := s.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	item := msg.GetItem()
+	if item == nil {
+		return nil, fmt.Errorf("%w: execute must be sent only once (first message)", funcdomain.ErrInvalidArgument)
+	}
+	return &funcdomain.BatchItem{Parameters: item.GetParameters()}, nil
+}
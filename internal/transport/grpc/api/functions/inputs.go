@@ -0,0 +1,104 @@
+package funcapi
+
+import (
+	"fmt"
+	"io"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	taskdomain "github.com/10Narratives/faas/internal/domains/tasks"
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"google.golang.org/grpc"
+)
+
+type inputStream = grpc.ClientStreamingServer[faaspb.ExecuteFunctionWithInputsRequest, faaspb.ExecuteFunctionResponse]
+
+// streamInputs adapts the request stream to taskdomain.TaskInputIterator.
+// Data of the current input ends at the next header or at the end of the
+// stream; a header read that way is kept for the following Next call.
+type streamInputs struct {
+	stream inputStream
+
+	next    *faaspb.TaskInputHeader
+	current *streamInputData
+	eof     bool
+}
+
+func newStreamInputs(stream inputStream) *streamInputs {
+	return &streamInputs{stream: stream}
+}
+
+func (s *streamInputs) Next() (*taskdomain.TaskInput, error) {
+	if s.current != nil {
+		// Skip whatever the consumer left unread.
+		if _, err := io.Copy(io.Discard, s.current); err != nil {
+			return nil, err
+		}
+		s.current = nil
+	}
+
+	header := s.next
+	s.next = nil
+	if header == nil {
+		if s.eof {
+			return nil, io.EOF
+		}
+		msg, err := s.stream.Recv()
+		if err == io.EOF {
+			s.eof = true
+			return nil, io.EOF
+		}
+		if err != nil {
+			return nil, err
+		}
+		header = msg.GetInputHeader()
+		if header == nil {
+			return nil, fmt.Errorf("%w: expected input_header", funcdomain.ErrInvalidArgument)
+		}
+	}
+
+	s.current = &streamInputData{inputs: s}
+	return &taskdomain.TaskInput{
+		Name:   header.GetName(),
+		SHA256: header.GetSha256(),
+		Data:   s.current,
+	}, nil
+}
+
+type streamInputData struct {
+	inputs *streamInputs
+	buf    []byte
+	done   bool
+}
+
+func (d *streamInputData) Read(p []byte) (int, error) {
+	for len(d.buf) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+
+		msg, err := d.inputs.stream.Recv()
+		if err == io.EOF {
+			d.inputs.eof = true
+			d.done = true
+			return 0, io.EOF
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		switch payload := msg.GetPayload().(type) {
+		case *faaspb.ExecuteFunctionWithInputsRequest_InputData:
+			d.buf = payload.InputData.GetData()
+		case *faaspb.ExecuteFunctionWithInputsRequest_InputHeader:
+			d.inputs.next = payload.InputHeader
+			d.done = true
+			return 0, io.EOF
+		default:
+			return 0, fmt.Errorf("%w: execute must be sent only once (first message)", funcdomain.ErrInvalidArgument)
+		}
+	}
+
+	n := copy(p, d.buf)
+	d.buf = d.buf[n:]
+	return n, nil
+}
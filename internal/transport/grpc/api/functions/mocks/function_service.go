@@ -23,6 +23,112 @@ func (_m *FunctionService) EXPECT() *FunctionService_Expecter {
 	return &FunctionService_Expecter{mock: &_m.Mock}
 }
 
+// BatchExecuteFunction provides a mock function with given fields: ctx, args
+func (_m *FunctionService) BatchExecuteFunction(ctx context.Context, args *funcdomain.BatchExecuteFunctionArgs) (*funcdomain.BatchExecuteFunctionResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchExecuteFunction")
+	}
+
+	var r0 *funcdomain.BatchExecuteFunctionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.BatchExecuteFunctionArgs) (*funcdomain.BatchExecuteFunctionResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.BatchExecuteFunctionArgs) *funcdomain.BatchExecuteFunctionResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.BatchExecuteFunctionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.BatchExecuteFunctionArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionService_BatchExecuteFunction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchExecuteFunction'
+type FunctionService_BatchExecuteFunction_Call struct {
+	*mock.Call
+}
+
+// BatchExecuteFunction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *funcdomain.BatchExecuteFunctionArgs
+func (_e *FunctionService_Expecter) BatchExecuteFunction(ctx interface{}, args interface{}) *FunctionService_BatchExecuteFunction_Call {
+	return &FunctionService_BatchExecuteFunction_Call{Call: _e.mock.On("BatchExecuteFunction", ctx, args)}
+}
+
+func (_c *FunctionService_BatchExecuteFunction_Call) Run(run func(ctx context.Context, args *funcdomain.BatchExecuteFunctionArgs)) *FunctionService_BatchExecuteFunction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.BatchExecuteFunctionArgs))
+	})
+	return _c
+}
+
+func (_c *FunctionService_BatchExecuteFunction_Call) Return(_a0 *funcdomain.BatchExecuteFunctionResult, _a1 error) *FunctionService_BatchExecuteFunction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionService_BatchExecuteFunction_Call) RunAndReturn(run func(context.Context, *funcdomain.BatchExecuteFunctionArgs) (*funcdomain.BatchExecuteFunctionResult, error)) *FunctionService_BatchExecuteFunction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAlias provides a mock function with given fields: ctx, args
+func (_m *FunctionService) DeleteAlias(ctx context.Context, args *funcdomain.DeleteAliasArgs) error {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAlias")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.DeleteAliasArgs) error); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FunctionService_DeleteAlias_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAlias'
+type FunctionService_DeleteAlias_Call struct {
+	*mock.Call
+}
+
+// DeleteAlias is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *funcdomain.DeleteAliasArgs
+func (_e *FunctionService_Expecter) DeleteAlias(ctx interface{}, args interface{}) *FunctionService_DeleteAlias_Call {
+	return &FunctionService_DeleteAlias_Call{Call: _e.mock.On("DeleteAlias", ctx, args)}
+}
+
+func (_c *FunctionService_DeleteAlias_Call) Run(run func(ctx context.Context, args *funcdomain.DeleteAliasArgs)) *FunctionService_DeleteAlias_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.DeleteAliasArgs))
+	})
+	return _c
+}
+
+func (_c *FunctionService_DeleteAlias_Call) Return(_a0 error) *FunctionService_DeleteAlias_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FunctionService_DeleteAlias_Call) RunAndReturn(run func(context.Context, *funcdomain.DeleteAliasArgs) error) *FunctionService_DeleteAlias_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteFunction provides a mock function with given fields: ctx, args
 func (_m *FunctionService) DeleteFunction(ctx context.Context, args *funcdomain.DeleteFunctionArgs) error {
 	ret := _m.Called(ctx, args)
@@ -70,6 +176,65 @@ func (_c *FunctionService_DeleteFunction_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// DownloadFunction provides a mock function with given fields: ctx, args
+func (_m *FunctionService) DownloadFunction(ctx context.Context, args *funcdomain.DownloadFunctionArgs) (*funcdomain.DownloadFunctionResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DownloadFunction")
+	}
+
+	var r0 *funcdomain.DownloadFunctionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.DownloadFunctionArgs) (*funcdomain.DownloadFunctionResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.DownloadFunctionArgs) *funcdomain.DownloadFunctionResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.DownloadFunctionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.DownloadFunctionArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionService_DownloadFunction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadFunction'
+type FunctionService_DownloadFunction_Call struct {
+	*mock.Call
+}
+
+// DownloadFunction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *funcdomain.DownloadFunctionArgs
+func (_e *FunctionService_Expecter) DownloadFunction(ctx interface{}, args interface{}) *FunctionService_DownloadFunction_Call {
+	return &FunctionService_DownloadFunction_Call{Call: _e.mock.On("DownloadFunction", ctx, args)}
+}
+
+func (_c *FunctionService_DownloadFunction_Call) Run(run func(ctx context.Context, args *funcdomain.DownloadFunctionArgs)) *FunctionService_DownloadFunction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.DownloadFunctionArgs))
+	})
+	return _c
+}
+
+func (_c *FunctionService_DownloadFunction_Call) Return(_a0 *funcdomain.DownloadFunctionResult, _a1 error) *FunctionService_DownloadFunction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionService_DownloadFunction_Call) RunAndReturn(run func(context.Context, *funcdomain.DownloadFunctionArgs) (*funcdomain.DownloadFunctionResult, error)) *FunctionService_DownloadFunction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ExecuteFunction provides a mock function with given fields: ctx, args
 func (_m *FunctionService) ExecuteFunction(ctx context.Context, args *funcdomain.ExecuteFunctionArgs) (*funcdomain.ExecuteFunctionResult, error) {
 	ret := _m.Called(ctx, args)
@@ -129,6 +294,124 @@ func (_c *FunctionService_ExecuteFunction_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// FinalizeUpload provides a mock function with given fields: ctx, args
+func (_m *FunctionService) FinalizeUpload(ctx context.Context, args *funcdomain.FinalizeUploadArgs) (*funcdomain.UploadFunctionResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinalizeUpload")
+	}
+
+	var r0 *funcdomain.UploadFunctionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.FinalizeUploadArgs) (*funcdomain.UploadFunctionResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.FinalizeUploadArgs) *funcdomain.UploadFunctionResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.UploadFunctionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.FinalizeUploadArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionService_FinalizeUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinalizeUpload'
+type FunctionService_FinalizeUpload_Call struct {
+	*mock.Call
+}
+
+// FinalizeUpload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *funcdomain.FinalizeUploadArgs
+func (_e *FunctionService_Expecter) FinalizeUpload(ctx interface{}, args interface{}) *FunctionService_FinalizeUpload_Call {
+	return &FunctionService_FinalizeUpload_Call{Call: _e.mock.On("FinalizeUpload", ctx, args)}
+}
+
+func (_c *FunctionService_FinalizeUpload_Call) Run(run func(ctx context.Context, args *funcdomain.FinalizeUploadArgs)) *FunctionService_FinalizeUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.FinalizeUploadArgs))
+	})
+	return _c
+}
+
+func (_c *FunctionService_FinalizeUpload_Call) Return(_a0 *funcdomain.UploadFunctionResult, _a1 error) *FunctionService_FinalizeUpload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionService_FinalizeUpload_Call) RunAndReturn(run func(context.Context, *funcdomain.FinalizeUploadArgs) (*funcdomain.UploadFunctionResult, error)) *FunctionService_FinalizeUpload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAlias provides a mock function with given fields: ctx, args
+func (_m *FunctionService) GetAlias(ctx context.Context, args *funcdomain.GetAliasArgs) (*funcdomain.GetAliasResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAlias")
+	}
+
+	var r0 *funcdomain.GetAliasResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.GetAliasArgs) (*funcdomain.GetAliasResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.GetAliasArgs) *funcdomain.GetAliasResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.GetAliasResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.GetAliasArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionService_GetAlias_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAlias'
+type FunctionService_GetAlias_Call struct {
+	*mock.Call
+}
+
+// GetAlias is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *funcdomain.GetAliasArgs
+func (_e *FunctionService_Expecter) GetAlias(ctx interface{}, args interface{}) *FunctionService_GetAlias_Call {
+	return &FunctionService_GetAlias_Call{Call: _e.mock.On("GetAlias", ctx, args)}
+}
+
+func (_c *FunctionService_GetAlias_Call) Run(run func(ctx context.Context, args *funcdomain.GetAliasArgs)) *FunctionService_GetAlias_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.GetAliasArgs))
+	})
+	return _c
+}
+
+func (_c *FunctionService_GetAlias_Call) Return(_a0 *funcdomain.GetAliasResult, _a1 error) *FunctionService_GetAlias_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionService_GetAlias_Call) RunAndReturn(run func(context.Context, *funcdomain.GetAliasArgs) (*funcdomain.GetAliasResult, error)) *FunctionService_GetAlias_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetFunction provides a mock function with given fields: ctx, args
 func (_m *FunctionService) GetFunction(ctx context.Context, args *funcdomain.GetFunctionArgs) (*funcdomain.GetFunctionResult, error) {
 	ret := _m.Called(ctx, args)
@@ -188,28 +471,28 @@ func (_c *FunctionService_GetFunction_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
-// ListFunctions provides a mock function with given fields: ctx, args
-func (_m *FunctionService) ListFunctions(ctx context.Context, args *funcdomain.ListFunctionsArgs) (*funcdomain.ListFunctionsResult, error) {
+// GetNamespaceUsage provides a mock function with given fields: ctx, args
+func (_m *FunctionService) GetNamespaceUsage(ctx context.Context, args *funcdomain.GetNamespaceUsageArgs) (*funcdomain.GetNamespaceUsageResult, error) {
 	ret := _m.Called(ctx, args)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListFunctions")
+		panic("no return value specified for GetNamespaceUsage")
 	}
 
-	var r0 *funcdomain.ListFunctionsResult
+	var r0 *funcdomain.GetNamespaceUsageResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.ListFunctionsArgs) (*funcdomain.ListFunctionsResult, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.GetNamespaceUsageArgs) (*funcdomain.GetNamespaceUsageResult, error)); ok {
 		return rf(ctx, args)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.ListFunctionsArgs) *funcdomain.ListFunctionsResult); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.GetNamespaceUsageArgs) *funcdomain.GetNamespaceUsageResult); ok {
 		r0 = rf(ctx, args)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*funcdomain.ListFunctionsResult)
+			r0 = ret.Get(0).(*funcdomain.GetNamespaceUsageResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.ListFunctionsArgs) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.GetNamespaceUsageArgs) error); ok {
 		r1 = rf(ctx, args)
 	} else {
 		r1 = ret.Error(1)
@@ -218,57 +501,57 @@ func (_m *FunctionService) ListFunctions(ctx context.Context, args *funcdomain.L
 	return r0, r1
 }
 
-// FunctionService_ListFunctions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFunctions'
-type FunctionService_ListFunctions_Call struct {
+// FunctionService_GetNamespaceUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNamespaceUsage'
+type FunctionService_GetNamespaceUsage_Call struct {
 	*mock.Call
 }
 
-// ListFunctions is a helper method to define mock.On call
+// GetNamespaceUsage is a helper method to define mock.On call
 //   - ctx context.Context
-//   - args *funcdomain.ListFunctionsArgs
-func (_e *FunctionService_Expecter) ListFunctions(ctx interface{}, args interface{}) *FunctionService_ListFunctions_Call {
-	return &FunctionService_ListFunctions_Call{Call: _e.mock.On("ListFunctions", ctx, args)}
+//   - args *funcdomain.GetNamespaceUsageArgs
+func (_e *FunctionService_Expecter) GetNamespaceUsage(ctx interface{}, args interface{}) *FunctionService_GetNamespaceUsage_Call {
+	return &FunctionService_GetNamespaceUsage_Call{Call: _e.mock.On("GetNamespaceUsage", ctx, args)}
 }
 
-func (_c *FunctionService_ListFunctions_Call) Run(run func(ctx context.Context, args *funcdomain.ListFunctionsArgs)) *FunctionService_ListFunctions_Call {
+func (_c *FunctionService_GetNamespaceUsage_Call) Run(run func(ctx context.Context, args *funcdomain.GetNamespaceUsageArgs)) *FunctionService_GetNamespaceUsage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*funcdomain.ListFunctionsArgs))
+		run(args[0].(context.Context), args[1].(*funcdomain.GetNamespaceUsageArgs))
 	})
 	return _c
 }
 
-func (_c *FunctionService_ListFunctions_Call) Return(_a0 *funcdomain.ListFunctionsResult, _a1 error) *FunctionService_ListFunctions_Call {
+func (_c *FunctionService_GetNamespaceUsage_Call) Return(_a0 *funcdomain.GetNamespaceUsageResult, _a1 error) *FunctionService_GetNamespaceUsage_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *FunctionService_ListFunctions_Call) RunAndReturn(run func(context.Context, *funcdomain.ListFunctionsArgs) (*funcdomain.ListFunctionsResult, error)) *FunctionService_ListFunctions_Call {
+func (_c *FunctionService_GetNamespaceUsage_Call) RunAndReturn(run func(context.Context, *funcdomain.GetNamespaceUsageArgs) (*funcdomain.GetNamespaceUsageResult, error)) *FunctionService_GetNamespaceUsage_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UploadFunction provides a mock function with given fields: ctx, args
-func (_m *FunctionService) UploadFunction(ctx context.Context, args *funcdomain.UploadFunctionArgs) (*funcdomain.UploadFunctionResult, error) {
+// GetUploadSession provides a mock function with given fields: ctx, args
+func (_m *FunctionService) GetUploadSession(ctx context.Context, args *funcdomain.GetUploadSessionArgs) (*funcdomain.UploadSessionResult, error) {
 	ret := _m.Called(ctx, args)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UploadFunction")
+		panic("no return value specified for GetUploadSession")
 	}
 
-	var r0 *funcdomain.UploadFunctionResult
+	var r0 *funcdomain.UploadSessionResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.UploadFunctionArgs) (*funcdomain.UploadFunctionResult, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.GetUploadSessionArgs) (*funcdomain.UploadSessionResult, error)); ok {
 		return rf(ctx, args)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.UploadFunctionArgs) *funcdomain.UploadFunctionResult); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.GetUploadSessionArgs) *funcdomain.UploadSessionResult); ok {
 		r0 = rf(ctx, args)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*funcdomain.UploadFunctionResult)
+			r0 = ret.Get(0).(*funcdomain.UploadSessionResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.UploadFunctionArgs) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.GetUploadSessionArgs) error); ok {
 		r1 = rf(ctx, args)
 	} else {
 		r1 = ret.Error(1)
@@ -277,31 +560,621 @@ func (_m *FunctionService) UploadFunction(ctx context.Context, args *funcdomain.
 	return r0, r1
 }
 
-// FunctionService_UploadFunction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadFunction'
-type FunctionService_UploadFunction_Call struct {
+// FunctionService_GetUploadSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUploadSession'
+type FunctionService_GetUploadSession_Call struct {
 	*mock.Call
 }
 
-// UploadFunction is a helper method to define mock.On call
+// GetUploadSession is a helper method to define mock.On call
 //   - ctx context.Context
-//   - args *funcdomain.UploadFunctionArgs
-func (_e *FunctionService_Expecter) UploadFunction(ctx interface{}, args interface{}) *FunctionService_UploadFunction_Call {
-	return &FunctionService_UploadFunction_Call{Call: _e.mock.On("UploadFunction", ctx, args)}
+//   - args *funcdomain.GetUploadSessionArgs
+func (_e *FunctionService_Expecter) GetUploadSession(ctx interface{}, args interface{}) *FunctionService_GetUploadSession_Call {
+	return &FunctionService_GetUploadSession_Call{Call: _e.mock.On("GetUploadSession", ctx, args)}
 }
 
-func (_c *FunctionService_UploadFunction_Call) Run(run func(ctx context.Context, args *funcdomain.UploadFunctionArgs)) *FunctionService_UploadFunction_Call {
+func (_c *FunctionService_GetUploadSession_Call) Run(run func(ctx context.Context, args *funcdomain.GetUploadSessionArgs)) *FunctionService_GetUploadSession_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*funcdomain.UploadFunctionArgs))
+		run(args[0].(context.Context), args[1].(*funcdomain.GetUploadSessionArgs))
 	})
 	return _c
 }
 
-func (_c *FunctionService_UploadFunction_Call) Return(_a0 *funcdomain.UploadFunctionResult, _a1 error) *FunctionService_UploadFunction_Call {
+func (_c *FunctionService_GetUploadSession_Call) Return(_a0 *funcdomain.UploadSessionResult, _a1 error) *FunctionService_GetUploadSession_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *FunctionService_UploadFunction_Call) RunAndReturn(run func(context.Context, *funcdomain.UploadFunctionArgs) (*funcdomain.UploadFunctionResult, error)) *FunctionService_UploadFunction_Call {
+func (_c *FunctionService_GetUploadSession_Call) RunAndReturn(run func(context.Context, *funcdomain.GetUploadSessionArgs) (*funcdomain.UploadSessionResult, error)) *FunctionService_GetUploadSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InvokeFunction provides a mock function with given fields: ctx, args
+func (_m *FunctionService) InvokeFunction(ctx context.Context, args *funcdomain.InvokeFunctionArgs) (*funcdomain.InvokeFunctionResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InvokeFunction")
+	}
+
+	var r0 *funcdomain.InvokeFunctionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.InvokeFunctionArgs) (*funcdomain.InvokeFunctionResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.InvokeFunctionArgs) *funcdomain.InvokeFunctionResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.InvokeFunctionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.InvokeFunctionArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionService_InvokeFunction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvokeFunction'
+type FunctionService_InvokeFunction_Call struct {
+	*mock.Call
+}
+
+// InvokeFunction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *funcdomain.InvokeFunctionArgs
+func (_e *FunctionService_Expecter) InvokeFunction(ctx interface{}, args interface{}) *FunctionService_InvokeFunction_Call {
+	return &FunctionService_InvokeFunction_Call{Call: _e.mock.On("InvokeFunction", ctx, args)}
+}
+
+func (_c *FunctionService_InvokeFunction_Call) Run(run func(ctx context.Context, args *funcdomain.InvokeFunctionArgs)) *FunctionService_InvokeFunction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.InvokeFunctionArgs))
+	})
+	return _c
+}
+
+func (_c *FunctionService_InvokeFunction_Call) Return(_a0 *funcdomain.InvokeFunctionResult, _a1 error) *FunctionService_InvokeFunction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionService_InvokeFunction_Call) RunAndReturn(run func(context.Context, *funcdomain.InvokeFunctionArgs) (*funcdomain.InvokeFunctionResult, error)) *FunctionService_InvokeFunction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAliases provides a mock function with given fields: ctx, args
+func (_m *FunctionService) ListAliases(ctx context.Context, args *funcdomain.ListAliasesArgs) (*funcdomain.ListAliasesResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAliases")
+	}
+
+	var r0 *funcdomain.ListAliasesResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.ListAliasesArgs) (*funcdomain.ListAliasesResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.ListAliasesArgs) *funcdomain.ListAliasesResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.ListAliasesResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.ListAliasesArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionService_ListAliases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAliases'
+type FunctionService_ListAliases_Call struct {
+	*mock.Call
+}
+
+// ListAliases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *funcdomain.ListAliasesArgs
+func (_e *FunctionService_Expecter) ListAliases(ctx interface{}, args interface{}) *FunctionService_ListAliases_Call {
+	return &FunctionService_ListAliases_Call{Call: _e.mock.On("ListAliases", ctx, args)}
+}
+
+func (_c *FunctionService_ListAliases_Call) Run(run func(ctx context.Context, args *funcdomain.ListAliasesArgs)) *FunctionService_ListAliases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.ListAliasesArgs))
+	})
+	return _c
+}
+
+func (_c *FunctionService_ListAliases_Call) Return(_a0 *funcdomain.ListAliasesResult, _a1 error) *FunctionService_ListAliases_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionService_ListAliases_Call) RunAndReturn(run func(context.Context, *funcdomain.ListAliasesArgs) (*funcdomain.ListAliasesResult, error)) *FunctionService_ListAliases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFunctionRevisions provides a mock function with given fields: ctx, args
+func (_m *FunctionService) ListFunctionRevisions(ctx context.Context, args *funcdomain.ListFunctionRevisionsArgs) (*funcdomain.ListFunctionRevisionsResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFunctionRevisions")
+	}
+
+	var r0 *funcdomain.ListFunctionRevisionsResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.ListFunctionRevisionsArgs) (*funcdomain.ListFunctionRevisionsResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.ListFunctionRevisionsArgs) *funcdomain.ListFunctionRevisionsResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.ListFunctionRevisionsResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.ListFunctionRevisionsArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionService_ListFunctionRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFunctionRevisions'
+type FunctionService_ListFunctionRevisions_Call struct {
+	*mock.Call
+}
+
+// ListFunctionRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *funcdomain.ListFunctionRevisionsArgs
+func (_e *FunctionService_Expecter) ListFunctionRevisions(ctx interface{}, args interface{}) *FunctionService_ListFunctionRevisions_Call {
+	return &FunctionService_ListFunctionRevisions_Call{Call: _e.mock.On("ListFunctionRevisions", ctx, args)}
+}
+
+func (_c *FunctionService_ListFunctionRevisions_Call) Run(run func(ctx context.Context, args *funcdomain.ListFunctionRevisionsArgs)) *FunctionService_ListFunctionRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.ListFunctionRevisionsArgs))
+	})
+	return _c
+}
+
+func (_c *FunctionService_ListFunctionRevisions_Call) Return(_a0 *funcdomain.ListFunctionRevisionsResult, _a1 error) *FunctionService_ListFunctionRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionService_ListFunctionRevisions_Call) RunAndReturn(run func(context.Context, *funcdomain.ListFunctionRevisionsArgs) (*funcdomain.ListFunctionRevisionsResult, error)) *FunctionService_ListFunctionRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFunctions provides a mock function with given fields: ctx, args
+func (_m *FunctionService) ListFunctions(ctx context.Context, args *funcdomain.ListFunctionsArgs) (*funcdomain.ListFunctionsResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFunctions")
+	}
+
+	var r0 *funcdomain.ListFunctionsResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.ListFunctionsArgs) (*funcdomain.ListFunctionsResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.ListFunctionsArgs) *funcdomain.ListFunctionsResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.ListFunctionsResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.ListFunctionsArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionService_ListFunctions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFunctions'
+type FunctionService_ListFunctions_Call struct {
+	*mock.Call
+}
+
+// ListFunctions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *funcdomain.ListFunctionsArgs
+func (_e *FunctionService_Expecter) ListFunctions(ctx interface{}, args interface{}) *FunctionService_ListFunctions_Call {
+	return &FunctionService_ListFunctions_Call{Call: _e.mock.On("ListFunctions", ctx, args)}
+}
+
+func (_c *FunctionService_ListFunctions_Call) Run(run func(ctx context.Context, args *funcdomain.ListFunctionsArgs)) *FunctionService_ListFunctions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.ListFunctionsArgs))
+	})
+	return _c
+}
+
+func (_c *FunctionService_ListFunctions_Call) Return(_a0 *funcdomain.ListFunctionsResult, _a1 error) *FunctionService_ListFunctions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionService_ListFunctions_Call) RunAndReturn(run func(context.Context, *funcdomain.ListFunctionsArgs) (*funcdomain.ListFunctionsResult, error)) *FunctionService_ListFunctions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartUpload provides a mock function with given fields: ctx, args
+func (_m *FunctionService) StartUpload(ctx context.Context, args *funcdomain.StartUploadArgs) (*funcdomain.UploadSessionResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartUpload")
+	}
+
+	var r0 *funcdomain.UploadSessionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.StartUploadArgs) (*funcdomain.UploadSessionResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.StartUploadArgs) *funcdomain.UploadSessionResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.UploadSessionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.StartUploadArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionService_StartUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartUpload'
+type FunctionService_StartUpload_Call struct {
+	*mock.Call
+}
+
+// StartUpload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *funcdomain.StartUploadArgs
+func (_e *FunctionService_Expecter) StartUpload(ctx interface{}, args interface{}) *FunctionService_StartUpload_Call {
+	return &FunctionService_StartUpload_Call{Call: _e.mock.On("StartUpload", ctx, args)}
+}
+
+func (_c *FunctionService_StartUpload_Call) Run(run func(ctx context.Context, args *funcdomain.StartUploadArgs)) *FunctionService_StartUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.StartUploadArgs))
+	})
+	return _c
+}
+
+func (_c *FunctionService_StartUpload_Call) Return(_a0 *funcdomain.UploadSessionResult, _a1 error) *FunctionService_StartUpload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionService_StartUpload_Call) RunAndReturn(run func(context.Context, *funcdomain.StartUploadArgs) (*funcdomain.UploadSessionResult, error)) *FunctionService_StartUpload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UndeleteFunction provides a mock function with given fields: ctx, args
+func (_m *FunctionService) UndeleteFunction(ctx context.Context, args *funcdomain.UndeleteFunctionArgs) (*funcdomain.UndeleteFunctionResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UndeleteFunction")
+	}
+
+	var r0 *funcdomain.UndeleteFunctionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.UndeleteFunctionArgs) (*funcdomain.UndeleteFunctionResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.UndeleteFunctionArgs) *funcdomain.UndeleteFunctionResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.UndeleteFunctionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.UndeleteFunctionArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionService_UndeleteFunction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UndeleteFunction'
+type FunctionService_UndeleteFunction_Call struct {
+	*mock.Call
+}
+
+// UndeleteFunction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *funcdomain.UndeleteFunctionArgs
+func (_e *FunctionService_Expecter) UndeleteFunction(ctx interface{}, args interface{}) *FunctionService_UndeleteFunction_Call {
+	return &FunctionService_UndeleteFunction_Call{Call: _e.mock.On("UndeleteFunction", ctx, args)}
+}
+
+func (_c *FunctionService_UndeleteFunction_Call) Run(run func(ctx context.Context, args *funcdomain.UndeleteFunctionArgs)) *FunctionService_UndeleteFunction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.UndeleteFunctionArgs))
+	})
+	return _c
+}
+
+func (_c *FunctionService_UndeleteFunction_Call) Return(_a0 *funcdomain.UndeleteFunctionResult, _a1 error) *FunctionService_UndeleteFunction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionService_UndeleteFunction_Call) RunAndReturn(run func(context.Context, *funcdomain.UndeleteFunctionArgs) (*funcdomain.UndeleteFunctionResult, error)) *FunctionService_UndeleteFunction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAlias provides a mock function with given fields: ctx, args
+func (_m *FunctionService) UpdateAlias(ctx context.Context, args *funcdomain.UpdateAliasArgs) (*funcdomain.UpdateAliasResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAlias")
+	}
+
+	var r0 *funcdomain.UpdateAliasResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.UpdateAliasArgs) (*funcdomain.UpdateAliasResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.UpdateAliasArgs) *funcdomain.UpdateAliasResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.UpdateAliasResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.UpdateAliasArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionService_UpdateAlias_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAlias'
+type FunctionService_UpdateAlias_Call struct {
+	*mock.Call
+}
+
+// UpdateAlias is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *funcdomain.UpdateAliasArgs
+func (_e *FunctionService_Expecter) UpdateAlias(ctx interface{}, args interface{}) *FunctionService_UpdateAlias_Call {
+	return &FunctionService_UpdateAlias_Call{Call: _e.mock.On("UpdateAlias", ctx, args)}
+}
+
+func (_c *FunctionService_UpdateAlias_Call) Run(run func(ctx context.Context, args *funcdomain.UpdateAliasArgs)) *FunctionService_UpdateAlias_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.UpdateAliasArgs))
+	})
+	return _c
+}
+
+func (_c *FunctionService_UpdateAlias_Call) Return(_a0 *funcdomain.UpdateAliasResult, _a1 error) *FunctionService_UpdateAlias_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionService_UpdateAlias_Call) RunAndReturn(run func(context.Context, *funcdomain.UpdateAliasArgs) (*funcdomain.UpdateAliasResult, error)) *FunctionService_UpdateAlias_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateFunction provides a mock function with given fields: ctx, args
+func (_m *FunctionService) UpdateFunction(ctx context.Context, args *funcdomain.UpdateFunctionArgs) (*funcdomain.UpdateFunctionResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFunction")
+	}
+
+	var r0 *funcdomain.UpdateFunctionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.UpdateFunctionArgs) (*funcdomain.UpdateFunctionResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.UpdateFunctionArgs) *funcdomain.UpdateFunctionResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.UpdateFunctionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.UpdateFunctionArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionService_UpdateFunction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFunction'
+type FunctionService_UpdateFunction_Call struct {
+	*mock.Call
+}
+
+// UpdateFunction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *funcdomain.UpdateFunctionArgs
+func (_e *FunctionService_Expecter) UpdateFunction(ctx interface{}, args interface{}) *FunctionService_UpdateFunction_Call {
+	return &FunctionService_UpdateFunction_Call{Call: _e.mock.On("UpdateFunction", ctx, args)}
+}
+
+func (_c *FunctionService_UpdateFunction_Call) Run(run func(ctx context.Context, args *funcdomain.UpdateFunctionArgs)) *FunctionService_UpdateFunction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.UpdateFunctionArgs))
+	})
+	return _c
+}
+
+func (_c *FunctionService_UpdateFunction_Call) Return(_a0 *funcdomain.UpdateFunctionResult, _a1 error) *FunctionService_UpdateFunction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionService_UpdateFunction_Call) RunAndReturn(run func(context.Context, *funcdomain.UpdateFunctionArgs) (*funcdomain.UpdateFunctionResult, error)) *FunctionService_UpdateFunction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UploadFunction provides a mock function with given fields: ctx, args
+func (_m *FunctionService) UploadFunction(ctx context.Context, args *funcdomain.UploadFunctionArgs) (*funcdomain.UploadFunctionResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadFunction")
+	}
+
+	var r0 *funcdomain.UploadFunctionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.UploadFunctionArgs) (*funcdomain.UploadFunctionResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.UploadFunctionArgs) *funcdomain.UploadFunctionResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.UploadFunctionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.UploadFunctionArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionService_UploadFunction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadFunction'
+type FunctionService_UploadFunction_Call struct {
+	*mock.Call
+}
+
+// UploadFunction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *funcdomain.UploadFunctionArgs
+func (_e *FunctionService_Expecter) UploadFunction(ctx interface{}, args interface{}) *FunctionService_UploadFunction_Call {
+	return &FunctionService_UploadFunction_Call{Call: _e.mock.On("UploadFunction", ctx, args)}
+}
+
+func (_c *FunctionService_UploadFunction_Call) Run(run func(ctx context.Context, args *funcdomain.UploadFunctionArgs)) *FunctionService_UploadFunction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.UploadFunctionArgs))
+	})
+	return _c
+}
+
+func (_c *FunctionService_UploadFunction_Call) Return(_a0 *funcdomain.UploadFunctionResult, _a1 error) *FunctionService_UploadFunction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionService_UploadFunction_Call) RunAndReturn(run func(context.Context, *funcdomain.UploadFunctionArgs) (*funcdomain.UploadFunctionResult, error)) *FunctionService_UploadFunction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WriteUploadChunk provides a mock function with given fields: ctx, args
+func (_m *FunctionService) WriteUploadChunk(ctx context.Context, args *funcdomain.WriteUploadChunkArgs) (*funcdomain.UploadSessionResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WriteUploadChunk")
+	}
+
+	var r0 *funcdomain.UploadSessionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.WriteUploadChunkArgs) (*funcdomain.UploadSessionResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *funcdomain.WriteUploadChunkArgs) *funcdomain.UploadSessionResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*funcdomain.UploadSessionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *funcdomain.WriteUploadChunkArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunctionService_WriteUploadChunk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteUploadChunk'
+type FunctionService_WriteUploadChunk_Call struct {
+	*mock.Call
+}
+
+// WriteUploadChunk is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *funcdomain.WriteUploadChunkArgs
+func (_e *FunctionService_Expecter) WriteUploadChunk(ctx interface{}, args interface{}) *FunctionService_WriteUploadChunk_Call {
+	return &FunctionService_WriteUploadChunk_Call{Call: _e.mock.On("WriteUploadChunk", ctx, args)}
+}
+
+func (_c *FunctionService_WriteUploadChunk_Call) Run(run func(ctx context.Context, args *funcdomain.WriteUploadChunkArgs)) *FunctionService_WriteUploadChunk_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*funcdomain.WriteUploadChunkArgs))
+	})
+	return _c
+}
+
+func (_c *FunctionService_WriteUploadChunk_Call) Return(_a0 *funcdomain.UploadSessionResult, _a1 error) *FunctionService_WriteUploadChunk_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FunctionService_WriteUploadChunk_Call) RunAndReturn(run func(context.Context, *funcdomain.WriteUploadChunkArgs) (*funcdomain.UploadSessionResult, error)) *FunctionService_WriteUploadChunk_Call {
 	_c.Call.Return(run)
 	return _c
 }
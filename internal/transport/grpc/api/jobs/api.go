@@ -0,0 +1,173 @@
+package jobapi
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	grpcsrv "github.com/10Narratives/faas/internal/app/components/grpc/server"
+	jobdomain "github.com/10Narratives/faas/internal/domains/jobs"
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+//go:generate mockery --name JobService --output ./mocks --outpkg mocks --with-expecter --filename job_service.go
+type JobService interface {
+	jobdomain.JobGetter
+	jobdomain.JobLister
+	jobdomain.JobWatcher
+	jobdomain.JobCanceler
+}
+
+type Server struct {
+	faaspb.UnimplementedJobsServer
+	jobService JobService
+}
+
+func NewServer(jobService JobService) *Server {
+	return &Server{jobService: jobService}
+}
+
+func NewRegistration(jobService JobService) grpcsrv.ServiceRegistration {
+	return func(s *grpc.Server) {
+		faaspb.RegisterJobsServer(s, NewServer(jobService))
+	}
+}
+
+func (s *Server) GetJob(ctx context.Context, req *faaspb.GetJobRequest) (*faaspb.Job, error) {
+	if _, err := jobdomain.ParseJobName(req.GetName()); err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	res, err := s.jobService.GetJob(ctx, &jobdomain.GetJobArgs{Name: req.GetName()})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	if res == nil || res.Job == nil {
+		return nil, status.Error(codes.Internal, "missing job in result")
+	}
+
+	return toPBJob(res.Job), nil
+}
+
+func (s *Server) ListJobs(ctx context.Context, req *faaspb.ListJobsRequest) (*faaspb.ListJobsResponse, error) {
+	res, err := s.jobService.ListJobs(ctx, &jobdomain.ListJobsArgs{
+		PageSize:  req.GetPageSize(),
+		PageToken: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	if res == nil {
+		return nil, status.Error(codes.Internal, "empty result")
+	}
+
+	out := &faaspb.ListJobsResponse{
+		Jobs:          make([]*faaspb.Job, 0, len(res.Jobs)),
+		NextPageToken: res.NextPageToken,
+	}
+	for _, j := range res.Jobs {
+		if j == nil {
+			continue
+		}
+		out.Jobs = append(out.Jobs, toPBJob(j))
+	}
+	return out, nil
+}
+
+func (s *Server) WatchJob(req *faaspb.WatchJobRequest, stream grpc.ServerStreamingServer[faaspb.Job]) error {
+	if _, err := jobdomain.ParseJobName(req.GetName()); err != nil {
+		return toStatusErr(err)
+	}
+
+	err := s.jobService.WatchJob(stream.Context(), &jobdomain.WatchJobArgs{
+		Name: req.GetName(),
+		Send: func(j *jobdomain.Job) error { return stream.Send(toPBJob(j)) },
+	})
+	return toStatusErr(err)
+}
+
+func (s *Server) CancelJob(ctx context.Context, req *faaspb.CancelJobRequest) (*faaspb.Job, error) {
+	if _, err := jobdomain.ParseJobName(req.GetName()); err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	res, err := s.jobService.CancelJob(ctx, &jobdomain.CancelJobArgs{Name: req.GetName()})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	if res == nil || res.Job == nil {
+		return nil, status.Error(codes.Internal, "missing job in result")
+	}
+
+	return toPBJob(res.Job), nil
+}
+
+func toPBJob(j *jobdomain.Job) *faaspb.Job {
+	return &faaspb.Job{
+		Name:             string(j.Name),
+		Function:         j.Function,
+		State:            toPBState(j.State),
+		CreatedAt:        toPBTimestampOrNil(j.CreatedAt),
+		EndedAt:          toPBTimestampOrNil(j.EndedAt),
+		FailureThreshold: j.FailureThreshold,
+		Sealed:           j.Sealed,
+		Total:            j.Total,
+		Succeeded:        j.Succeeded,
+		Failed:           j.Failed,
+		Canceled:         j.Canceled,
+		Active:           j.Active(),
+	}
+}
+
+func toPBState(s jobdomain.JobState) faaspb.JobState {
+	switch s {
+	case jobdomain.JobStateRunning:
+		return faaspb.JobState_JOB_STATE_RUNNING
+	case jobdomain.JobStateSucceeded:
+		return faaspb.JobState_JOB_STATE_SUCCEEDED
+	case jobdomain.JobStateFailed:
+		return faaspb.JobState_JOB_STATE_FAILED
+	case jobdomain.JobStateCanceled:
+		return faaspb.JobState_JOB_STATE_CANCELED
+	default:
+		return faaspb.JobState_JOB_STATE_UNSPECIFIED
+	}
+}
+
+func toPBTimestampOrNil(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func toStatusErr(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, "request canceled")
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return status.Error(codes.DeadlineExceeded, "deadline exceeded")
+	}
+
+	switch {
+	case errors.Is(err, jobdomain.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, jobdomain.ErrJobAlreadyEnded):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, jobdomain.ErrInvalidName),
+		errors.Is(err, jobdomain.ErrInvalidParameters),
+		errors.Is(err, jobdomain.ErrEmptyPageSize),
+		errors.Is(err, jobdomain.ErrInvalidPageToken):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
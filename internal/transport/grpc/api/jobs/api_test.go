@@ -0,0 +1,91 @@
+package jobapi_test
+
+import (
+	"context"
+	"testing"
+
+	jobdomain "github.com/10Narratives/faas/internal/domains/jobs"
+	jobapi "github.com/10Narratives/faas/internal/transport/grpc/api/jobs"
+	"github.com/10Narratives/faas/internal/transport/grpc/api/jobs/mocks"
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeWatchStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*faaspb.Job
+}
+
+func (s *fakeWatchStream) Context() context.Context { return s.ctx }
+
+func (s *fakeWatchStream) Send(j *faaspb.Job) error {
+	s.sent = append(s.sent, j)
+	return nil
+}
+
+func TestGetJob_MapsCounters(t *testing.T) {
+	svc := mocks.NewJobService(t)
+	s := jobapi.NewServer(svc)
+
+	svc.EXPECT().GetJob(mock.Anything, &jobdomain.GetJobArgs{Name: "jobs/b1"}).
+		Return(&jobdomain.GetJobResult{Job: &jobdomain.Job{
+			Name:      "jobs/b1",
+			Function:  "functions/foo",
+			State:     jobdomain.JobStateRunning,
+			Sealed:    true,
+			Total:     10000,
+			Succeeded: 9812,
+			Failed:    150,
+		}}, nil).
+		Once()
+
+	got, err := s.GetJob(context.Background(), &faaspb.GetJobRequest{Name: "jobs/b1"})
+	require.NoError(t, err)
+	require.Equal(t, faaspb.JobState_JOB_STATE_RUNNING, got.GetState())
+	require.Equal(t, uint64(9812), got.GetSucceeded())
+	require.Equal(t, uint64(150), got.GetFailed())
+	require.Equal(t, uint64(38), got.GetActive())
+	require.Nil(t, got.GetEndedAt())
+}
+
+func TestGetJob_InvalidName(t *testing.T) {
+	s := jobapi.NewServer(mocks.NewJobService(t))
+
+	_, err := s.GetJob(context.Background(), &faaspb.GetJobRequest{Name: "tasks/1"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestWatchJob_StreamsUpdates(t *testing.T) {
+	svc := mocks.NewJobService(t)
+	s := jobapi.NewServer(svc)
+
+	svc.EXPECT().WatchJob(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, args *jobdomain.WatchJobArgs) error {
+			require.Equal(t, "jobs/b1", args.Name)
+			require.NoError(t, args.Send(&jobdomain.Job{Name: "jobs/b1", State: jobdomain.JobStateRunning, Sealed: true, Total: 2}))
+			return args.Send(&jobdomain.Job{Name: "jobs/b1", State: jobdomain.JobStateSucceeded, Sealed: true, Total: 2, Succeeded: 2})
+		}).
+		Once()
+
+	stream := &fakeWatchStream{ctx: context.Background()}
+	require.NoError(t, s.WatchJob(&faaspb.WatchJobRequest{Name: "jobs/b1"}, stream))
+	require.Len(t, stream.sent, 2)
+	require.Equal(t, uint64(2), stream.sent[0].GetActive())
+	require.Equal(t, faaspb.JobState_JOB_STATE_SUCCEEDED, stream.sent[1].GetState())
+}
+
+func TestCancelJob_AlreadyEnded_FailedPrecondition(t *testing.T) {
+	svc := mocks.NewJobService(t)
+	s := jobapi.NewServer(svc)
+
+	svc.EXPECT().CancelJob(mock.Anything, mock.Anything).Return(nil, jobdomain.ErrJobAlreadyEnded).Once()
+
+	_, err := s.CancelJob(context.Background(), &faaspb.CancelJobRequest{Name: "jobs/b1"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
@@ -0,0 +1,262 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	jobdomain "github.com/10Narratives/faas/internal/domains/jobs"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// JobService is an autogenerated mock type for the JobService type
+type JobService struct {
+	mock.Mock
+}
+
+type JobService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *JobService) EXPECT() *JobService_Expecter {
+	return &JobService_Expecter{mock: &_m.Mock}
+}
+
+// CancelJob provides a mock function with given fields: ctx, args
+func (_m *JobService) CancelJob(ctx context.Context, args *jobdomain.CancelJobArgs) (*jobdomain.CancelJobResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelJob")
+	}
+
+	var r0 *jobdomain.CancelJobResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *jobdomain.CancelJobArgs) (*jobdomain.CancelJobResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *jobdomain.CancelJobArgs) *jobdomain.CancelJobResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*jobdomain.CancelJobResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *jobdomain.CancelJobArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobService_CancelJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelJob'
+type JobService_CancelJob_Call struct {
+	*mock.Call
+}
+
+// CancelJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *jobdomain.CancelJobArgs
+func (_e *JobService_Expecter) CancelJob(ctx interface{}, args interface{}) *JobService_CancelJob_Call {
+	return &JobService_CancelJob_Call{Call: _e.mock.On("CancelJob", ctx, args)}
+}
+
+func (_c *JobService_CancelJob_Call) Run(run func(ctx context.Context, args *jobdomain.CancelJobArgs)) *JobService_CancelJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*jobdomain.CancelJobArgs))
+	})
+	return _c
+}
+
+func (_c *JobService_CancelJob_Call) Return(_a0 *jobdomain.CancelJobResult, _a1 error) *JobService_CancelJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobService_CancelJob_Call) RunAndReturn(run func(context.Context, *jobdomain.CancelJobArgs) (*jobdomain.CancelJobResult, error)) *JobService_CancelJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJob provides a mock function with given fields: ctx, args
+func (_m *JobService) GetJob(ctx context.Context, args *jobdomain.GetJobArgs) (*jobdomain.GetJobResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJob")
+	}
+
+	var r0 *jobdomain.GetJobResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *jobdomain.GetJobArgs) (*jobdomain.GetJobResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *jobdomain.GetJobArgs) *jobdomain.GetJobResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*jobdomain.GetJobResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *jobdomain.GetJobArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobService_GetJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJob'
+type JobService_GetJob_Call struct {
+	*mock.Call
+}
+
+// GetJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *jobdomain.GetJobArgs
+func (_e *JobService_Expecter) GetJob(ctx interface{}, args interface{}) *JobService_GetJob_Call {
+	return &JobService_GetJob_Call{Call: _e.mock.On("GetJob", ctx, args)}
+}
+
+func (_c *JobService_GetJob_Call) Run(run func(ctx context.Context, args *jobdomain.GetJobArgs)) *JobService_GetJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*jobdomain.GetJobArgs))
+	})
+	return _c
+}
+
+func (_c *JobService_GetJob_Call) Return(_a0 *jobdomain.GetJobResult, _a1 error) *JobService_GetJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobService_GetJob_Call) RunAndReturn(run func(context.Context, *jobdomain.GetJobArgs) (*jobdomain.GetJobResult, error)) *JobService_GetJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListJobs provides a mock function with given fields: ctx, args
+func (_m *JobService) ListJobs(ctx context.Context, args *jobdomain.ListJobsArgs) (*jobdomain.ListJobsResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListJobs")
+	}
+
+	var r0 *jobdomain.ListJobsResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *jobdomain.ListJobsArgs) (*jobdomain.ListJobsResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *jobdomain.ListJobsArgs) *jobdomain.ListJobsResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*jobdomain.ListJobsResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *jobdomain.ListJobsArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobService_ListJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListJobs'
+type JobService_ListJobs_Call struct {
+	*mock.Call
+}
+
+// ListJobs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *jobdomain.ListJobsArgs
+func (_e *JobService_Expecter) ListJobs(ctx interface{}, args interface{}) *JobService_ListJobs_Call {
+	return &JobService_ListJobs_Call{Call: _e.mock.On("ListJobs", ctx, args)}
+}
+
+func (_c *JobService_ListJobs_Call) Run(run func(ctx context.Context, args *jobdomain.ListJobsArgs)) *JobService_ListJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*jobdomain.ListJobsArgs))
+	})
+	return _c
+}
+
+func (_c *JobService_ListJobs_Call) Return(_a0 *jobdomain.ListJobsResult, _a1 error) *JobService_ListJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobService_ListJobs_Call) RunAndReturn(run func(context.Context, *jobdomain.ListJobsArgs) (*jobdomain.ListJobsResult, error)) *JobService_ListJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchJob provides a mock function with given fields: ctx, args
+func (_m *JobService) WatchJob(ctx context.Context, args *jobdomain.WatchJobArgs) error {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *jobdomain.WatchJobArgs) error); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// JobService_WatchJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchJob'
+type JobService_WatchJob_Call struct {
+	*mock.Call
+}
+
+// WatchJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *jobdomain.WatchJobArgs
+func (_e *JobService_Expecter) WatchJob(ctx interface{}, args interface{}) *JobService_WatchJob_Call {
+	return &JobService_WatchJob_Call{Call: _e.mock.On("WatchJob", ctx, args)}
+}
+
+func (_c *JobService_WatchJob_Call) Run(run func(ctx context.Context, args *jobdomain.WatchJobArgs)) *JobService_WatchJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*jobdomain.WatchJobArgs))
+	})
+	return _c
+}
+
+func (_c *JobService_WatchJob_Call) Return(_a0 error) *JobService_WatchJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JobService_WatchJob_Call) RunAndReturn(run func(context.Context, *jobdomain.WatchJobArgs) error) *JobService_WatchJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewJobService creates a new instance of JobService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJobService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JobService {
+	mock := &JobService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
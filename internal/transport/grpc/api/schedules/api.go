@@ -0,0 +1,268 @@
+package schedapi
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	grpcsrv "github.com/10Narratives/faas/internal/app/components/grpc/server"
+	scheddomain "github.com/10Narratives/faas/internal/domains/schedules"
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+//go:generate mockery --name ScheduleService --output ./mocks --outpkg mocks --with-expecter --filename schedule_service.go
+type ScheduleService interface {
+	scheddomain.ScheduleCreator
+	scheddomain.ScheduleGetter
+	scheddomain.ScheduleLister
+	scheddomain.ScheduleUpdater
+	scheddomain.SchedulePauser
+	scheddomain.ScheduleResumer
+	scheddomain.ScheduleDeleter
+}
+
+type Server struct {
+	faaspb.UnimplementedSchedulesServer
+	scheduleService ScheduleService
+}
+
+func NewServer(scheduleService ScheduleService) *Server {
+	return &Server{scheduleService: scheduleService}
+}
+
+func NewRegistration(scheduleService ScheduleService) grpcsrv.ServiceRegistration {
+	return func(s *grpc.Server) {
+		faaspb.RegisterSchedulesServer(s, NewServer(scheduleService))
+	}
+}
+
+func (s *Server) CreateSchedule(ctx context.Context, req *faaspb.CreateScheduleRequest) (*faaspb.Schedule, error) {
+	pb := req.GetSchedule()
+	if pb == nil {
+		return nil, status.Error(codes.InvalidArgument, "schedule is required")
+	}
+	name, err := scheddomain.ParseScheduleName(pb.GetName())
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	sched := pbToDomainSchedule(pb)
+	sched.Name = name
+	sched.Paused = pb.GetPaused()
+
+	res, err := s.scheduleService.CreateSchedule(ctx, &scheddomain.CreateScheduleArgs{Schedule: sched})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	if res == nil || res.Schedule == nil {
+		return nil, status.Error(codes.Internal, "missing schedule in result")
+	}
+
+	return toPBSchedule(res.Schedule), nil
+}
+
+func (s *Server) GetSchedule(ctx context.Context, req *faaspb.GetScheduleRequest) (*faaspb.Schedule, error) {
+	if _, err := scheddomain.ParseScheduleName(req.GetName()); err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	res, err := s.scheduleService.GetSchedule(ctx, &scheddomain.GetScheduleArgs{Name: req.GetName()})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	if res == nil || res.Schedule == nil {
+		return nil, status.Error(codes.Internal, "missing schedule in result")
+	}
+
+	return toPBSchedule(res.Schedule), nil
+}
+
+func (s *Server) ListSchedules(ctx context.Context, req *faaspb.ListSchedulesRequest) (*faaspb.ListSchedulesResponse, error) {
+	res, err := s.scheduleService.ListSchedules(ctx, &scheddomain.ListSchedulesArgs{
+		PageSize:  req.GetPageSize(),
+		PageToken: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	if res == nil {
+		return nil, status.Error(codes.Internal, "empty result")
+	}
+
+	out := &faaspb.ListSchedulesResponse{
+		Schedules:     make([]*faaspb.Schedule, 0, len(res.Schedules)),
+		NextPageToken: res.NextPageToken,
+	}
+	for _, sched := range res.Schedules {
+		if sched == nil {
+			continue
+		}
+		out.Schedules = append(out.Schedules, toPBSchedule(sched))
+	}
+	return out, nil
+}
+
+func (s *Server) UpdateSchedule(ctx context.Context, req *faaspb.UpdateScheduleRequest) (*faaspb.Schedule, error) {
+	pb := req.GetSchedule()
+	if pb == nil {
+		return nil, status.Error(codes.InvalidArgument, "schedule is required")
+	}
+	if _, err := scheddomain.ParseScheduleName(pb.GetName()); err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	res, err := s.scheduleService.UpdateSchedule(ctx, &scheddomain.UpdateScheduleArgs{
+		Name:     pb.GetName(),
+		Schedule: pbToDomainSchedule(pb),
+		Paths:    req.GetUpdateMask().GetPaths(),
+	})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	if res == nil || res.Schedule == nil {
+		return nil, status.Error(codes.Internal, "missing schedule in result")
+	}
+
+	return toPBSchedule(res.Schedule), nil
+}
+
+func (s *Server) PauseSchedule(ctx context.Context, req *faaspb.PauseScheduleRequest) (*faaspb.Schedule, error) {
+	if _, err := scheddomain.ParseScheduleName(req.GetName()); err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	res, err := s.scheduleService.PauseSchedule(ctx, &scheddomain.PauseScheduleArgs{Name: req.GetName()})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	if res == nil || res.Schedule == nil {
+		return nil, status.Error(codes.Internal, "missing schedule in result")
+	}
+
+	return toPBSchedule(res.Schedule), nil
+}
+
+func (s *Server) ResumeSchedule(ctx context.Context, req *faaspb.ResumeScheduleRequest) (*faaspb.Schedule, error) {
+	if _, err := scheddomain.ParseScheduleName(req.GetName()); err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	res, err := s.scheduleService.ResumeSchedule(ctx, &scheddomain.ResumeScheduleArgs{Name: req.GetName()})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	if res == nil || res.Schedule == nil {
+		return nil, status.Error(codes.Internal, "missing schedule in result")
+	}
+
+	return toPBSchedule(res.Schedule), nil
+}
+
+func (s *Server) DeleteSchedule(ctx context.Context, req *faaspb.DeleteScheduleRequest) (*emptypb.Empty, error) {
+	if _, err := scheddomain.ParseScheduleName(req.GetName()); err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	if err := s.scheduleService.DeleteSchedule(ctx, &scheddomain.DeleteScheduleArgs{Name: req.GetName()}); err != nil {
+		return nil, toStatusErr(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// pbToDomainSchedule copies the user-settable fields.
+func pbToDomainSchedule(pb *faaspb.Schedule) *scheddomain.Schedule {
+	return &scheddomain.Schedule{
+		Cron:            pb.GetCron(),
+		TimeZone:        pb.GetTimeZone(),
+		Function:        pb.GetFunction(),
+		Parameters:      pb.GetParameters(),
+		MissedRunPolicy: toDomainPolicy(pb.GetMissedRunPolicy()),
+	}
+}
+
+func toPBSchedule(s *scheddomain.Schedule) *faaspb.Schedule {
+	return &faaspb.Schedule{
+		Name:            string(s.Name),
+		Cron:            s.Cron,
+		TimeZone:        s.TimeZone,
+		Function:        s.Function,
+		Parameters:      s.Parameters,
+		MissedRunPolicy: toPBPolicy(s.MissedRunPolicy),
+		Paused:          s.Paused,
+		CreatedAt:       toPBTimestampOrNil(s.CreatedAt),
+		UpdatedAt:       toPBTimestampOrNil(s.UpdatedAt),
+		LastRunTime:     toPBTimestampOrNil(s.LastRunTime),
+		LastTask:        s.LastTask,
+		LastError:       s.LastError,
+		NextRunTime:     toPBTimestampOrNil(s.NextRunTime),
+	}
+}
+
+func toDomainPolicy(p faaspb.MissedRunPolicy) scheddomain.MissedRunPolicy {
+	switch p {
+	case faaspb.MissedRunPolicy_MISSED_RUN_POLICY_SKIP:
+		return scheddomain.MissedRunSkip
+	case faaspb.MissedRunPolicy_MISSED_RUN_POLICY_RUN_ONCE:
+		return scheddomain.MissedRunOnce
+	case faaspb.MissedRunPolicy_MISSED_RUN_POLICY_CATCH_UP:
+		return scheddomain.MissedRunCatchUp
+	default:
+		return scheddomain.MissedRunPolicyUnspecified
+	}
+}
+
+func toPBPolicy(p scheddomain.MissedRunPolicy) faaspb.MissedRunPolicy {
+	switch p {
+	case scheddomain.MissedRunSkip:
+		return faaspb.MissedRunPolicy_MISSED_RUN_POLICY_SKIP
+	case scheddomain.MissedRunOnce:
+		return faaspb.MissedRunPolicy_MISSED_RUN_POLICY_RUN_ONCE
+	case scheddomain.MissedRunCatchUp:
+		return faaspb.MissedRunPolicy_MISSED_RUN_POLICY_CATCH_UP
+	default:
+		return faaspb.MissedRunPolicy_MISSED_RUN_POLICY_UNSPECIFIED
+	}
+}
+
+func toPBTimestampOrNil(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func toStatusErr(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, "request canceled")
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return status.Error(codes.DeadlineExceeded, "deadline exceeded")
+	}
+
+	switch {
+	case errors.Is(err, scheddomain.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, scheddomain.ErrAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, scheddomain.ErrInvalidName),
+		errors.Is(err, scheddomain.ErrInvalidCron),
+		errors.Is(err, scheddomain.ErrInvalidTimeZone),
+		errors.Is(err, scheddomain.ErrInvalidFunction),
+		errors.Is(err, scheddomain.ErrInvalidPolicy),
+		errors.Is(err, scheddomain.ErrInvalidUpdateMask),
+		errors.Is(err, scheddomain.ErrInvalidPageToken),
+		errors.Is(err, scheddomain.ErrInvalidParameters):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
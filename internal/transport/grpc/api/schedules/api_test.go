@@ -0,0 +1,95 @@
+package schedapi_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	scheddomain "github.com/10Narratives/faas/internal/domains/schedules"
+	schedapi "github.com/10Narratives/faas/internal/transport/grpc/api/schedules"
+	"github.com/10Narratives/faas/internal/transport/grpc/api/schedules/mocks"
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestCreateSchedule_MapsFields(t *testing.T) {
+	svc := mocks.NewScheduleService(t)
+	s := schedapi.NewServer(svc)
+
+	next := time.Date(2026, 3, 3, 7, 30, 0, 0, time.UTC)
+	svc.EXPECT().CreateSchedule(mock.Anything, mock.MatchedBy(func(a *scheddomain.CreateScheduleArgs) bool {
+		return a.Schedule.Name == "schedules/nightly" &&
+			a.Schedule.Cron == "30 2 * * *" &&
+			a.Schedule.TimeZone == "America/New_York" &&
+			a.Schedule.Function == "functions/backup@prod" &&
+			a.Schedule.MissedRunPolicy == scheddomain.MissedRunCatchUp
+	})).
+		RunAndReturn(func(_ context.Context, a *scheddomain.CreateScheduleArgs) (*scheddomain.CreateScheduleResult, error) {
+			out := *a.Schedule
+			out.NextRunTime = next
+			return &scheddomain.CreateScheduleResult{Schedule: &out}, nil
+		}).
+		Once()
+
+	got, err := s.CreateSchedule(context.Background(), &faaspb.CreateScheduleRequest{Schedule: &faaspb.Schedule{
+		Name:            "schedules/nightly",
+		Cron:            "30 2 * * *",
+		TimeZone:        "America/New_York",
+		Function:        "functions/backup@prod",
+		MissedRunPolicy: faaspb.MissedRunPolicy_MISSED_RUN_POLICY_CATCH_UP,
+	}})
+	require.NoError(t, err)
+	require.Equal(t, faaspb.MissedRunPolicy_MISSED_RUN_POLICY_CATCH_UP, got.GetMissedRunPolicy())
+	require.Equal(t, next, got.GetNextRunTime().AsTime())
+	require.Nil(t, got.GetLastRunTime())
+}
+
+func TestCreateSchedule_InvalidCron(t *testing.T) {
+	svc := mocks.NewScheduleService(t)
+	s := schedapi.NewServer(svc)
+
+	svc.EXPECT().CreateSchedule(mock.Anything, mock.Anything).Return(nil, scheddomain.ErrInvalidCron).Once()
+
+	_, err := s.CreateSchedule(context.Background(), &faaspb.CreateScheduleRequest{Schedule: &faaspb.Schedule{
+		Name: "schedules/x", Cron: "61 * * * *", Function: "functions/f",
+	}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUpdateSchedule_PassesMask(t *testing.T) {
+	svc := mocks.NewScheduleService(t)
+	s := schedapi.NewServer(svc)
+
+	svc.EXPECT().UpdateSchedule(mock.Anything, mock.MatchedBy(func(a *scheddomain.UpdateScheduleArgs) bool {
+		return a.Name == "schedules/nightly" && a.Schedule.Cron == "@hourly" &&
+			len(a.Paths) == 1 && a.Paths[0] == scheddomain.FieldCron
+	})).
+		Return(&scheddomain.UpdateScheduleResult{Schedule: &scheddomain.Schedule{Name: "schedules/nightly", Cron: "@hourly"}}, nil).
+		Once()
+
+	got, err := s.UpdateSchedule(context.Background(), &faaspb.UpdateScheduleRequest{
+		Schedule:   &faaspb.Schedule{Name: "schedules/nightly", Cron: "@hourly"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"cron"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "@hourly", got.GetCron())
+}
+
+func TestPauseSchedule_NotFound(t *testing.T) {
+	svc := mocks.NewScheduleService(t)
+	s := schedapi.NewServer(svc)
+
+	svc.EXPECT().PauseSchedule(mock.Anything, &scheddomain.PauseScheduleArgs{Name: "schedules/gone"}).
+		Return(nil, scheddomain.ErrNotFound).Once()
+
+	_, err := s.PauseSchedule(context.Background(), &faaspb.PauseScheduleRequest{Name: "schedules/gone"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = s.PauseSchedule(context.Background(), &faaspb.PauseScheduleRequest{Name: "jobs/1"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,439 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	scheddomain "github.com/10Narratives/faas/internal/domains/schedules"
+)
+
+// ScheduleService is an autogenerated mock type for the ScheduleService type
+type ScheduleService struct {
+	mock.Mock
+}
+
+type ScheduleService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ScheduleService) EXPECT() *ScheduleService_Expecter {
+	return &ScheduleService_Expecter{mock: &_m.Mock}
+}
+
+// CreateSchedule provides a mock function with given fields: ctx, args
+func (_m *ScheduleService) CreateSchedule(ctx context.Context, args *scheddomain.CreateScheduleArgs) (*scheddomain.CreateScheduleResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSchedule")
+	}
+
+	var r0 *scheddomain.CreateScheduleResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *scheddomain.CreateScheduleArgs) (*scheddomain.CreateScheduleResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *scheddomain.CreateScheduleArgs) *scheddomain.CreateScheduleResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*scheddomain.CreateScheduleResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *scheddomain.CreateScheduleArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScheduleService_CreateSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSchedule'
+type ScheduleService_CreateSchedule_Call struct {
+	*mock.Call
+}
+
+// CreateSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *scheddomain.CreateScheduleArgs
+func (_e *ScheduleService_Expecter) CreateSchedule(ctx interface{}, args interface{}) *ScheduleService_CreateSchedule_Call {
+	return &ScheduleService_CreateSchedule_Call{Call: _e.mock.On("CreateSchedule", ctx, args)}
+}
+
+func (_c *ScheduleService_CreateSchedule_Call) Run(run func(ctx context.Context, args *scheddomain.CreateScheduleArgs)) *ScheduleService_CreateSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*scheddomain.CreateScheduleArgs))
+	})
+	return _c
+}
+
+func (_c *ScheduleService_CreateSchedule_Call) Return(_a0 *scheddomain.CreateScheduleResult, _a1 error) *ScheduleService_CreateSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ScheduleService_CreateSchedule_Call) RunAndReturn(run func(context.Context, *scheddomain.CreateScheduleArgs) (*scheddomain.CreateScheduleResult, error)) *ScheduleService_CreateSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSchedule provides a mock function with given fields: ctx, args
+func (_m *ScheduleService) DeleteSchedule(ctx context.Context, args *scheddomain.DeleteScheduleArgs) error {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSchedule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *scheddomain.DeleteScheduleArgs) error); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ScheduleService_DeleteSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSchedule'
+type ScheduleService_DeleteSchedule_Call struct {
+	*mock.Call
+}
+
+// DeleteSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *scheddomain.DeleteScheduleArgs
+func (_e *ScheduleService_Expecter) DeleteSchedule(ctx interface{}, args interface{}) *ScheduleService_DeleteSchedule_Call {
+	return &ScheduleService_DeleteSchedule_Call{Call: _e.mock.On("DeleteSchedule", ctx, args)}
+}
+
+func (_c *ScheduleService_DeleteSchedule_Call) Run(run func(ctx context.Context, args *scheddomain.DeleteScheduleArgs)) *ScheduleService_DeleteSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*scheddomain.DeleteScheduleArgs))
+	})
+	return _c
+}
+
+func (_c *ScheduleService_DeleteSchedule_Call) Return(_a0 error) *ScheduleService_DeleteSchedule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ScheduleService_DeleteSchedule_Call) RunAndReturn(run func(context.Context, *scheddomain.DeleteScheduleArgs) error) *ScheduleService_DeleteSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSchedule provides a mock function with given fields: ctx, args
+func (_m *ScheduleService) GetSchedule(ctx context.Context, args *scheddomain.GetScheduleArgs) (*scheddomain.GetScheduleResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSchedule")
+	}
+
+	var r0 *scheddomain.GetScheduleResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *scheddomain.GetScheduleArgs) (*scheddomain.GetScheduleResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *scheddomain.GetScheduleArgs) *scheddomain.GetScheduleResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*scheddomain.GetScheduleResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *scheddomain.GetScheduleArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScheduleService_GetSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSchedule'
+type ScheduleService_GetSchedule_Call struct {
+	*mock.Call
+}
+
+// GetSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *scheddomain.GetScheduleArgs
+func (_e *ScheduleService_Expecter) GetSchedule(ctx interface{}, args interface{}) *ScheduleService_GetSchedule_Call {
+	return &ScheduleService_GetSchedule_Call{Call: _e.mock.On("GetSchedule", ctx, args)}
+}
+
+func (_c *ScheduleService_GetSchedule_Call) Run(run func(ctx context.Context, args *scheddomain.GetScheduleArgs)) *ScheduleService_GetSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*scheddomain.GetScheduleArgs))
+	})
+	return _c
+}
+
+func (_c *ScheduleService_GetSchedule_Call) Return(_a0 *scheddomain.GetScheduleResult, _a1 error) *ScheduleService_GetSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ScheduleService_GetSchedule_Call) RunAndReturn(run func(context.Context, *scheddomain.GetScheduleArgs) (*scheddomain.GetScheduleResult, error)) *ScheduleService_GetSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSchedules provides a mock function with given fields: ctx, args
+func (_m *ScheduleService) ListSchedules(ctx context.Context, args *scheddomain.ListSchedulesArgs) (*scheddomain.ListSchedulesResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSchedules")
+	}
+
+	var r0 *scheddomain.ListSchedulesResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *scheddomain.ListSchedulesArgs) (*scheddomain.ListSchedulesResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *scheddomain.ListSchedulesArgs) *scheddomain.ListSchedulesResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*scheddomain.ListSchedulesResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *scheddomain.ListSchedulesArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScheduleService_ListSchedules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSchedules'
+type ScheduleService_ListSchedules_Call struct {
+	*mock.Call
+}
+
+// ListSchedules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *scheddomain.ListSchedulesArgs
+func (_e *ScheduleService_Expecter) ListSchedules(ctx interface{}, args interface{}) *ScheduleService_ListSchedules_Call {
+	return &ScheduleService_ListSchedules_Call{Call: _e.mock.On("ListSchedules", ctx, args)}
+}
+
+func (_c *ScheduleService_ListSchedules_Call) Run(run func(ctx context.Context, args *scheddomain.ListSchedulesArgs)) *ScheduleService_ListSchedules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*scheddomain.ListSchedulesArgs))
+	})
+	return _c
+}
+
+func (_c *ScheduleService_ListSchedules_Call) Return(_a0 *scheddomain.ListSchedulesResult, _a1 error) *ScheduleService_ListSchedules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ScheduleService_ListSchedules_Call) RunAndReturn(run func(context.Context, *scheddomain.ListSchedulesArgs) (*scheddomain.ListSchedulesResult, error)) *ScheduleService_ListSchedules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PauseSchedule provides a mock function with given fields: ctx, args
+func (_m *ScheduleService) PauseSchedule(ctx context.Context, args *scheddomain.PauseScheduleArgs) (*scheddomain.PauseScheduleResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PauseSchedule")
+	}
+
+	var r0 *scheddomain.PauseScheduleResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *scheddomain.PauseScheduleArgs) (*scheddomain.PauseScheduleResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *scheddomain.PauseScheduleArgs) *scheddomain.PauseScheduleResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*scheddomain.PauseScheduleResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *scheddomain.PauseScheduleArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScheduleService_PauseSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseSchedule'
+type ScheduleService_PauseSchedule_Call struct {
+	*mock.Call
+}
+
+// PauseSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *scheddomain.PauseScheduleArgs
+func (_e *ScheduleService_Expecter) PauseSchedule(ctx interface{}, args interface{}) *ScheduleService_PauseSchedule_Call {
+	return &ScheduleService_PauseSchedule_Call{Call: _e.mock.On("PauseSchedule", ctx, args)}
+}
+
+func (_c *ScheduleService_PauseSchedule_Call) Run(run func(ctx context.Context, args *scheddomain.PauseScheduleArgs)) *ScheduleService_PauseSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*scheddomain.PauseScheduleArgs))
+	})
+	return _c
+}
+
+func (_c *ScheduleService_PauseSchedule_Call) Return(_a0 *scheddomain.PauseScheduleResult, _a1 error) *ScheduleService_PauseSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ScheduleService_PauseSchedule_Call) RunAndReturn(run func(context.Context, *scheddomain.PauseScheduleArgs) (*scheddomain.PauseScheduleResult, error)) *ScheduleService_PauseSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResumeSchedule provides a mock function with given fields: ctx, args
+func (_m *ScheduleService) ResumeSchedule(ctx context.Context, args *scheddomain.ResumeScheduleArgs) (*scheddomain.ResumeScheduleResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeSchedule")
+	}
+
+	var r0 *scheddomain.ResumeScheduleResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *scheddomain.ResumeScheduleArgs) (*scheddomain.ResumeScheduleResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *scheddomain.ResumeScheduleArgs) *scheddomain.ResumeScheduleResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*scheddomain.ResumeScheduleResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *scheddomain.ResumeScheduleArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScheduleService_ResumeSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeSchedule'
+type ScheduleService_ResumeSchedule_Call struct {
+	*mock.Call
+}
+
+// ResumeSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *scheddomain.ResumeScheduleArgs
+func (_e *ScheduleService_Expecter) ResumeSchedule(ctx interface{}, args interface{}) *ScheduleService_ResumeSchedule_Call {
+	return &ScheduleService_ResumeSchedule_Call{Call: _e.mock.On("ResumeSchedule", ctx, args)}
+}
+
+func (_c *ScheduleService_ResumeSchedule_Call) Run(run func(ctx context.Context, args *scheddomain.ResumeScheduleArgs)) *ScheduleService_ResumeSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*scheddomain.ResumeScheduleArgs))
+	})
+	return _c
+}
+
+func (_c *ScheduleService_ResumeSchedule_Call) Return(_a0 *scheddomain.ResumeScheduleResult, _a1 error) *ScheduleService_ResumeSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ScheduleService_ResumeSchedule_Call) RunAndReturn(run func(context.Context, *scheddomain.ResumeScheduleArgs) (*scheddomain.ResumeScheduleResult, error)) *ScheduleService_ResumeSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSchedule provides a mock function with given fields: ctx, args
+func (_m *ScheduleService) UpdateSchedule(ctx context.Context, args *scheddomain.UpdateScheduleArgs) (*scheddomain.UpdateScheduleResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSchedule")
+	}
+
+	var r0 *scheddomain.UpdateScheduleResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *scheddomain.UpdateScheduleArgs) (*scheddomain.UpdateScheduleResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *scheddomain.UpdateScheduleArgs) *scheddomain.UpdateScheduleResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*scheddomain.UpdateScheduleResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *scheddomain.UpdateScheduleArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScheduleService_UpdateSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSchedule'
+type ScheduleService_UpdateSchedule_Call struct {
+	*mock.Call
+}
+
+// UpdateSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *scheddomain.UpdateScheduleArgs
+func (_e *ScheduleService_Expecter) UpdateSchedule(ctx interface{}, args interface{}) *ScheduleService_UpdateSchedule_Call {
+	return &ScheduleService_UpdateSchedule_Call{Call: _e.mock.On("UpdateSchedule", ctx, args)}
+}
+
+func (_c *ScheduleService_UpdateSchedule_Call) Run(run func(ctx context.Context, args *scheddomain.UpdateScheduleArgs)) *ScheduleService_UpdateSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*scheddomain.UpdateScheduleArgs))
+	})
+	return _c
+}
+
+func (_c *ScheduleService_UpdateSchedule_Call) Return(_a0 *scheddomain.UpdateScheduleResult, _a1 error) *ScheduleService_UpdateSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ScheduleService_UpdateSchedule_Call) RunAndReturn(run func(context.Context, *scheddomain.UpdateScheduleArgs) (*scheddomain.UpdateScheduleResult, error)) *ScheduleService_UpdateSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewScheduleService creates a new instance of ScheduleService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewScheduleService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ScheduleService {
+	mock := &ScheduleService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
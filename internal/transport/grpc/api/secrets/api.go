@@ -0,0 +1,172 @@
+package secretapi
+
+import (
+	"context"
+	"errors"
+
+	grpcsrv "github.com/10Narratives/faas/internal/app/components/grpc/server"
+	secretdomain "github.com/10Narratives/faas/internal/domains/secrets"
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+//go:generate mockery --name SecretService --output ./mocks --outpkg mocks --with-expecter --filename secret_service.go
+type SecretService interface {
+	secretdomain.SecretCreator
+	secretdomain.SecretGetter
+	secretdomain.SecretLister
+	secretdomain.SecretUpdater
+	secretdomain.SecretDeleter
+}
+
+type Server struct {
+	faaspb.UnimplementedSecretsServer
+	secretService SecretService
+}
+
+func NewServer(secretService SecretService) *Server {
+	return &Server{secretService: secretService}
+}
+
+func NewRegistration(secretService SecretService) grpcsrv.ServiceRegistration {
+	return func(s *grpc.Server) {
+		faaspb.RegisterSecretsServer(s, NewServer(secretService))
+	}
+}
+
+func (s *Server) CreateSecret(ctx context.Context, req *faaspb.CreateSecretRequest) (*faaspb.Secret, error) {
+	name, err := secretdomain.ParseSecretName(req.GetName())
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	res, err := s.secretService.CreateSecret(ctx, &secretdomain.CreateSecretArgs{
+		Name:  name,
+		Value: req.GetValue(),
+	})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	if res == nil || res.Secret == nil {
+		return nil, status.Error(codes.Internal, "missing secret in result")
+	}
+
+	return toPBSecret(res.Secret), nil
+}
+
+func (s *Server) GetSecret(ctx context.Context, req *faaspb.GetSecretRequest) (*faaspb.Secret, error) {
+	name, err := secretdomain.ParseSecretName(req.GetName())
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	res, err := s.secretService.GetSecret(ctx, &secretdomain.GetSecretArgs{Name: name})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	if res == nil || res.Secret == nil {
+		return nil, status.Error(codes.Internal, "missing secret in result")
+	}
+
+	return toPBSecret(res.Secret), nil
+}
+
+func (s *Server) ListSecrets(ctx context.Context, req *faaspb.ListSecretsRequest) (*faaspb.ListSecretsResponse, error) {
+	res, err := s.secretService.ListSecrets(ctx, &secretdomain.ListSecretsArgs{
+		PageSize:  req.GetPageSize(),
+		PageToken: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	out := &faaspb.ListSecretsResponse{
+		Secrets:       make([]*faaspb.Secret, 0, len(res.Secrets)),
+		NextPageToken: res.NextPageToken,
+	}
+	for _, sec := range res.Secrets {
+		if sec == nil {
+			continue
+		}
+		out.Secrets = append(out.Secrets, toPBSecret(sec))
+	}
+
+	return out, nil
+}
+
+func (s *Server) UpdateSecret(ctx context.Context, req *faaspb.UpdateSecretRequest) (*faaspb.Secret, error) {
+	name, err := secretdomain.ParseSecretName(req.GetName())
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	res, err := s.secretService.UpdateSecret(ctx, &secretdomain.UpdateSecretArgs{
+		Name:  name,
+		Value: req.GetValue(),
+	})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	if res == nil || res.Secret == nil {
+		return nil, status.Error(codes.Internal, "missing secret in result")
+	}
+
+	return toPBSecret(res.Secret), nil
+}
+
+func (s *Server) DeleteSecret(ctx context.Context, req *faaspb.DeleteSecretRequest) (*emptypb.Empty, error) {
+	name, err := secretdomain.ParseSecretName(req.GetName())
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	if err := s.secretService.DeleteSecret(ctx, &secretdomain.DeleteSecretArgs{Name: name}); err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func toPBSecret(sec *secretdomain.Secret) *faaspb.Secret {
+	return &faaspb.Secret{
+		Name:      string(sec.Name),
+		CreatedAt: timestamppb.New(sec.CreatedAt),
+		UpdatedAt: timestamppb.New(sec.UpdatedAt),
+	}
+}
+
+func toStatusErr(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, "request canceled")
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return status.Error(codes.DeadlineExceeded, "deadline exceeded")
+	}
+
+	switch {
+	case errors.Is(err, secretdomain.ErrSecretNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, secretdomain.ErrSecretAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, secretdomain.ErrSecretInUse):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, secretdomain.ErrInvalidArgument),
+		errors.Is(err, secretdomain.ErrInvalidName),
+		errors.Is(err, secretdomain.ErrInvalidPageToken),
+		errors.Is(err, secretdomain.ErrEmptyValue),
+		errors.Is(err, secretdomain.ErrValueTooLarge):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		// Never echo storage or crypto errors verbatim: they may carry
+		// details about the stored material.
+		return status.Error(codes.Internal, "internal error")
+	}
+}
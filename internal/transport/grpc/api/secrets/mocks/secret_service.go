@@ -0,0 +1,321 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	secretdomain "github.com/10Narratives/faas/internal/domains/secrets"
+)
+
+// SecretService is an autogenerated mock type for the SecretService type
+type SecretService struct {
+	mock.Mock
+}
+
+type SecretService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SecretService) EXPECT() *SecretService_Expecter {
+	return &SecretService_Expecter{mock: &_m.Mock}
+}
+
+// CreateSecret provides a mock function with given fields: ctx, args
+func (_m *SecretService) CreateSecret(ctx context.Context, args *secretdomain.CreateSecretArgs) (*secretdomain.CreateSecretResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSecret")
+	}
+
+	var r0 *secretdomain.CreateSecretResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretdomain.CreateSecretArgs) (*secretdomain.CreateSecretResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secretdomain.CreateSecretArgs) *secretdomain.CreateSecretResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretdomain.CreateSecretResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secretdomain.CreateSecretArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretService_CreateSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSecret'
+type SecretService_CreateSecret_Call struct {
+	*mock.Call
+}
+
+// CreateSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *secretdomain.CreateSecretArgs
+func (_e *SecretService_Expecter) CreateSecret(ctx interface{}, args interface{}) *SecretService_CreateSecret_Call {
+	return &SecretService_CreateSecret_Call{Call: _e.mock.On("CreateSecret", ctx, args)}
+}
+
+func (_c *SecretService_CreateSecret_Call) Run(run func(ctx context.Context, args *secretdomain.CreateSecretArgs)) *SecretService_CreateSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*secretdomain.CreateSecretArgs))
+	})
+	return _c
+}
+
+func (_c *SecretService_CreateSecret_Call) Return(_a0 *secretdomain.CreateSecretResult, _a1 error) *SecretService_CreateSecret_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretService_CreateSecret_Call) RunAndReturn(run func(context.Context, *secretdomain.CreateSecretArgs) (*secretdomain.CreateSecretResult, error)) *SecretService_CreateSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSecret provides a mock function with given fields: ctx, args
+func (_m *SecretService) DeleteSecret(ctx context.Context, args *secretdomain.DeleteSecretArgs) error {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSecret")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretdomain.DeleteSecretArgs) error); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SecretService_DeleteSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSecret'
+type SecretService_DeleteSecret_Call struct {
+	*mock.Call
+}
+
+// DeleteSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *secretdomain.DeleteSecretArgs
+func (_e *SecretService_Expecter) DeleteSecret(ctx interface{}, args interface{}) *SecretService_DeleteSecret_Call {
+	return &SecretService_DeleteSecret_Call{Call: _e.mock.On("DeleteSecret", ctx, args)}
+}
+
+func (_c *SecretService_DeleteSecret_Call) Run(run func(ctx context.Context, args *secretdomain.DeleteSecretArgs)) *SecretService_DeleteSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*secretdomain.DeleteSecretArgs))
+	})
+	return _c
+}
+
+func (_c *SecretService_DeleteSecret_Call) Return(_a0 error) *SecretService_DeleteSecret_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SecretService_DeleteSecret_Call) RunAndReturn(run func(context.Context, *secretdomain.DeleteSecretArgs) error) *SecretService_DeleteSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSecret provides a mock function with given fields: ctx, args
+func (_m *SecretService) GetSecret(ctx context.Context, args *secretdomain.GetSecretArgs) (*secretdomain.GetSecretResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSecret")
+	}
+
+	var r0 *secretdomain.GetSecretResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretdomain.GetSecretArgs) (*secretdomain.GetSecretResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secretdomain.GetSecretArgs) *secretdomain.GetSecretResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretdomain.GetSecretResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secretdomain.GetSecretArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretService_GetSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSecret'
+type SecretService_GetSecret_Call struct {
+	*mock.Call
+}
+
+// GetSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *secretdomain.GetSecretArgs
+func (_e *SecretService_Expecter) GetSecret(ctx interface{}, args interface{}) *SecretService_GetSecret_Call {
+	return &SecretService_GetSecret_Call{Call: _e.mock.On("GetSecret", ctx, args)}
+}
+
+func (_c *SecretService_GetSecret_Call) Run(run func(ctx context.Context, args *secretdomain.GetSecretArgs)) *SecretService_GetSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*secretdomain.GetSecretArgs))
+	})
+	return _c
+}
+
+func (_c *SecretService_GetSecret_Call) Return(_a0 *secretdomain.GetSecretResult, _a1 error) *SecretService_GetSecret_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretService_GetSecret_Call) RunAndReturn(run func(context.Context, *secretdomain.GetSecretArgs) (*secretdomain.GetSecretResult, error)) *SecretService_GetSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSecrets provides a mock function with given fields: ctx, args
+func (_m *SecretService) ListSecrets(ctx context.Context, args *secretdomain.ListSecretsArgs) (*secretdomain.ListSecretsResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSecrets")
+	}
+
+	var r0 *secretdomain.ListSecretsResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretdomain.ListSecretsArgs) (*secretdomain.ListSecretsResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secretdomain.ListSecretsArgs) *secretdomain.ListSecretsResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretdomain.ListSecretsResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secretdomain.ListSecretsArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretService_ListSecrets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSecrets'
+type SecretService_ListSecrets_Call struct {
+	*mock.Call
+}
+
+// ListSecrets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *secretdomain.ListSecretsArgs
+func (_e *SecretService_Expecter) ListSecrets(ctx interface{}, args interface{}) *SecretService_ListSecrets_Call {
+	return &SecretService_ListSecrets_Call{Call: _e.mock.On("ListSecrets", ctx, args)}
+}
+
+func (_c *SecretService_ListSecrets_Call) Run(run func(ctx context.Context, args *secretdomain.ListSecretsArgs)) *SecretService_ListSecrets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*secretdomain.ListSecretsArgs))
+	})
+	return _c
+}
+
+func (_c *SecretService_ListSecrets_Call) Return(_a0 *secretdomain.ListSecretsResult, _a1 error) *SecretService_ListSecrets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretService_ListSecrets_Call) RunAndReturn(run func(context.Context, *secretdomain.ListSecretsArgs) (*secretdomain.ListSecretsResult, error)) *SecretService_ListSecrets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSecret provides a mock function with given fields: ctx, args
+func (_m *SecretService) UpdateSecret(ctx context.Context, args *secretdomain.UpdateSecretArgs) (*secretdomain.UpdateSecretResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSecret")
+	}
+
+	var r0 *secretdomain.UpdateSecretResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *secretdomain.UpdateSecretArgs) (*secretdomain.UpdateSecretResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *secretdomain.UpdateSecretArgs) *secretdomain.UpdateSecretResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secretdomain.UpdateSecretResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *secretdomain.UpdateSecretArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretService_UpdateSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSecret'
+type SecretService_UpdateSecret_Call struct {
+	*mock.Call
+}
+
+// UpdateSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *secretdomain.UpdateSecretArgs
+func (_e *SecretService_Expecter) UpdateSecret(ctx interface{}, args interface{}) *SecretService_UpdateSecret_Call {
+	return &SecretService_UpdateSecret_Call{Call: _e.mock.On("UpdateSecret", ctx, args)}
+}
+
+func (_c *SecretService_UpdateSecret_Call) Run(run func(ctx context.Context, args *secretdomain.UpdateSecretArgs)) *SecretService_UpdateSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*secretdomain.UpdateSecretArgs))
+	})
+	return _c
+}
+
+func (_c *SecretService_UpdateSecret_Call) Return(_a0 *secretdomain.UpdateSecretResult, _a1 error) *SecretService_UpdateSecret_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretService_UpdateSecret_Call) RunAndReturn(run func(context.Context, *secretdomain.UpdateSecretArgs) (*secretdomain.UpdateSecretResult, error)) *SecretService_UpdateSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSecretService creates a new instance of SecretService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSecretService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SecretService {
+	mock := &SecretService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,6 +3,7 @@ package taskapi
 import (
 	"context"
 	"errors"
+	"io"
 	"time"
 
 	grpcsrv "github.com/10Narratives/faas/internal/app/components/grpc/server"
@@ -21,8 +22,14 @@ type TaskService interface {
 	taskdomain.TaskLister
 	taskdomain.TaskDeleter
 	taskdomain.TaskCanceler
+	taskdomain.TaskUpdater
+	taskdomain.TaskArtifactLister
+	taskdomain.TaskArtifactDownloader
 }
 
+// downloadChunkSize keeps each message well below the default 4 MiB limit.
+const downloadChunkSize = 256 << 10
+
 type Server struct {
 	faaspb.UnimplementedTasksServer
 	taskService TaskService
@@ -54,7 +61,7 @@ func (s *Server) GetTask(ctx context.Context, req *faaspb.GetTaskRequest) (*faas
 		return nil, status.Error(codes.Internal, "empty result")
 	}
 
-	return toPBTask(res.Task), nil
+	return ToPBTask(res.Task), nil
 }
 
 func (s *Server) ListTasks(ctx context.Context, req *faaspb.ListTasksRequest) (*faaspb.ListTasksResponse, error) {
@@ -62,9 +69,15 @@ func (s *Server) ListTasks(ctx context.Context, req *faaspb.ListTasksRequest) (*
 		return nil, status.Error(codes.InvalidArgument, "request is nil")
 	}
 
+	filter, err := taskdomain.ParseTaskFilter(req.GetFilter())
+	if err != nil {
+		return nil, mapDomainErr(err)
+	}
+
 	res, err := s.taskService.ListTasks(ctx, &taskdomain.ListTasksArgs{
 		PageSize:  req.GetPageSize(),
 		PageToken: req.GetPageToken(),
+		Filter:    filter,
 	})
 	if err != nil {
 		return nil, mapDomainErr(err)
@@ -81,12 +94,36 @@ func (s *Server) ListTasks(ctx context.Context, req *faaspb.ListTasksRequest) (*
 		if t == nil {
 			continue
 		}
-		out.Tasks = append(out.Tasks, toPBTask(t))
+		out.Tasks = append(out.Tasks, ToPBTask(t))
 	}
 
 	return out, nil
 }
 
+func (s *Server) UpdateTask(ctx context.Context, req *faaspb.UpdateTaskRequest) (*faaspb.Task, error) {
+	if req == nil || req.GetTask() == nil {
+		return nil, status.Error(codes.InvalidArgument, "task is required")
+	}
+	if _, err := taskdomain.ParseTaskName(req.GetTask().GetName()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := s.taskService.UpdateTask(ctx, &taskdomain.UpdateTaskArgs{
+		Name:        req.GetTask().GetName(),
+		Labels:      req.GetTask().GetLabels(),
+		Annotations: req.GetTask().GetAnnotations(),
+		Paths:       req.GetUpdateMask().GetPaths(),
+	})
+	if err != nil {
+		return nil, mapDomainErr(err)
+	}
+	if res == nil || res.Task == nil {
+		return nil, status.Error(codes.Internal, "empty result")
+	}
+
+	return ToPBTask(res.Task), nil
+}
+
 func (s *Server) DeleteTask(ctx context.Context, req *faaspb.DeleteTaskRequest) (*emptypb.Empty, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request is nil")
@@ -117,12 +154,80 @@ func (s *Server) CancelTask(ctx context.Context, req *faaspb.CancelTaskRequest)
 		return nil, status.Error(codes.Internal, "empty result")
 	}
 
-	return toPBTask(res.Task), nil
+	return ToPBTask(res.Task), nil
+}
+
+func (s *Server) ListTaskArtifacts(ctx context.Context, req *faaspb.ListTaskArtifactsRequest) (*faaspb.ListTaskArtifactsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if _, err := taskdomain.ParseTaskName(req.GetName()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := s.taskService.ListTaskArtifacts(ctx, &taskdomain.ListTaskArtifactsArgs{Name: req.GetName()})
+	if err != nil {
+		return nil, mapDomainErr(err)
+	}
+	if res == nil {
+		return nil, status.Error(codes.Internal, "empty result")
+	}
+
+	return &faaspb.ListTaskArtifactsResponse{Artifacts: toPBArtifacts(res.Artifacts)}, nil
+}
+
+func (s *Server) DownloadTaskArtifact(req *faaspb.DownloadTaskArtifactRequest, stream grpc.ServerStreamingServer[faaspb.DownloadTaskArtifactResponse]) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "request is nil")
+	}
+	if _, err := taskdomain.ParseTaskName(req.GetName()); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := taskdomain.ValidateArtifactName(req.GetArtifact()); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := s.taskService.DownloadTaskArtifact(stream.Context(), &taskdomain.DownloadTaskArtifactArgs{
+		Name:     req.GetName(),
+		Artifact: req.GetArtifact(),
+	})
+	if err != nil {
+		return mapDomainErr(err)
+	}
+	if res == nil || res.Artifact == nil || res.Data == nil {
+		return status.Error(codes.Internal, "empty result")
+	}
+	defer res.Data.Close()
+
+	if err := stream.Send(&faaspb.DownloadTaskArtifactResponse{
+		Payload: &faaspb.DownloadTaskArtifactResponse_Artifact{Artifact: toPBArtifact(res.Artifact)},
+	}); err != nil {
+		return err
+	}
+
+	buf := make([]byte, downloadChunkSize)
+	for {
+		n, readErr := res.Data.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&faaspb.DownloadTaskArtifactResponse{
+				Payload: &faaspb.DownloadTaskArtifactResponse_Data{Data: buf[:n]},
+			}); err != nil {
+				return err
+			}
+		}
+		if readErr == io.EOF {
+			return nil
+		}
+		if readErr != nil {
+			return status.Error(codes.Internal, readErr.Error())
+		}
+	}
 }
 
 func mapDomainErr(err error) error {
 	switch {
-	case errors.Is(err, taskdomain.ErrNotFound):
+	case errors.Is(err, taskdomain.ErrNotFound),
+		errors.Is(err, taskdomain.ErrArtifactNotFound):
 		return status.Error(codes.NotFound, err.Error())
 	case errors.Is(err, taskdomain.ErrAlreadyExists):
 		return status.Error(codes.AlreadyExists, err.Error())
@@ -133,7 +238,11 @@ func mapDomainErr(err error) error {
 		errors.Is(err, taskdomain.ErrEmptyPageSize),
 		errors.Is(err, taskdomain.ErrInvalidPageToken),
 		errors.Is(err, taskdomain.ErrInvalidResult),
-		errors.Is(err, taskdomain.ErrUnknownResultType):
+		errors.Is(err, taskdomain.ErrUnknownResultType),
+		errors.Is(err, taskdomain.ErrInvalidArtifactName),
+		errors.Is(err, taskdomain.ErrInvalidFilter),
+		errors.Is(err, taskdomain.ErrInvalidMetadata),
+		errors.Is(err, taskdomain.ErrInvalidUpdateMask):
 		return status.Error(codes.InvalidArgument, err.Error())
 
 	case errors.Is(err, taskdomain.ErrInvalidState),
@@ -156,15 +265,23 @@ func toPBTimestampOrNil(t time.Time) *timestamppb.Timestamp {
 	return timestamppb.New(t)
 }
 
-func toPBTask(t *taskdomain.Task) *faaspb.Task {
+// ToPBTask converts a task for any API that returns one.
+func ToPBTask(t *taskdomain.Task) *faaspb.Task {
 	out := &faaspb.Task{
-		Name:       string(t.Name),
-		Function:   t.Function,
-		Parameters: t.Parameters,
-		State:      toPBState(t.State),
-		CreatedAt:  toPBTimestampOrNil(t.CreatedAt),
-		StartedAt:  toPBTimestampOrNil(t.StartedAt),
-		EndedAt:    toPBTimestampOrNil(t.EndedAt),
+		Name:             string(t.Name),
+		Function:         t.Function,
+		Parameters:       t.Parameters,
+		State:            toPBState(t.State),
+		CreatedAt:        toPBTimestampOrNil(t.CreatedAt),
+		StartedAt:        toPBTimestampOrNil(t.StartedAt),
+		EndedAt:          toPBTimestampOrNil(t.EndedAt),
+		Inputs:           toPBArtifacts(t.Inputs),
+		FunctionRevision: t.FunctionRevision,
+		Labels:           t.Labels,
+		Annotations:      t.Annotations,
+		BatchId:          t.BatchID,
+		Attempt:          int32(t.Attempt),
+		LastError:        t.LastError,
 	}
 
 	if t.Result != nil {
@@ -191,14 +308,40 @@ func toPBState(s taskdomain.TaskState) faaspb.TaskState {
 }
 
 func toPBTaskResult(tr *taskdomain.TaskResult) *faaspb.TaskResult {
+	var out *faaspb.TaskResult
 	switch tr.Type {
 	case taskdomain.TaskResultInline:
-		return &faaspb.TaskResult{Data: &faaspb.TaskResult_InlineResult{InlineResult: tr.InlineResult}}
+		out = &faaspb.TaskResult{
+			Data:        &faaspb.TaskResult_InlineResult{InlineResult: tr.InlineResult},
+			ContentType: tr.ContentType,
+		}
 	case taskdomain.TaskResultObjectKey:
-		return &faaspb.TaskResult{Data: &faaspb.TaskResult_ObjectKey{ObjectKey: tr.ObjectKey}}
+		out = &faaspb.TaskResult{Data: &faaspb.TaskResult_ObjectKey{ObjectKey: tr.ObjectKey}}
 	case taskdomain.TaskResultError:
-		return &faaspb.TaskResult{Data: &faaspb.TaskResult_ErrorMessage{ErrorMessage: tr.ErrorMessage}}
+		out = &faaspb.TaskResult{Data: &faaspb.TaskResult_ErrorMessage{ErrorMessage: tr.ErrorMessage}}
 	default:
 		return nil
 	}
+	out.Artifacts = toPBArtifacts(tr.Artifacts)
+	return out
+}
+
+func toPBArtifacts(in []taskdomain.TaskArtifact) []*faaspb.TaskArtifact {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]*faaspb.TaskArtifact, 0, len(in))
+	for i := range in {
+		out = append(out, toPBArtifact(&in[i]))
+	}
+	return out
+}
+
+func toPBArtifact(a *taskdomain.TaskArtifact) *faaspb.TaskArtifact {
+	return &faaspb.TaskArtifact{
+		Name:        a.Name,
+		Size:        a.Size,
+		Sha256:      a.SHA256,
+		ContentType: a.ContentType,
+	}
 }
@@ -1,7 +1,9 @@
 package taskapi_test
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"testing"
 	"time"
 
@@ -11,8 +13,10 @@ import (
 	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 func TestServer_GetTask(t *testing.T) {
@@ -390,3 +394,208 @@ func TestServer_CancelTask(t *testing.T) {
 		require.Equal(t, faaspb.TaskState_TASK_STATE_CANCELED, got.GetState())
 	})
 }
+
+type fakeDownloadStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*faaspb.DownloadTaskArtifactResponse
+}
+
+func (s *fakeDownloadStream) Context() context.Context { return s.ctx }
+
+func (s *fakeDownloadStream) Send(m *faaspb.DownloadTaskArtifactResponse) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+func TestServer_ListTaskArtifacts(t *testing.T) {
+	t.Parallel()
+
+	svc := mocks.NewTaskService(t)
+	srv := taskapi.NewServer(svc)
+
+	svc.EXPECT().
+		ListTaskArtifacts(mock.Anything, &taskdomain.ListTaskArtifactsArgs{Name: "tasks/abc"}).
+		Return(&taskdomain.ListTaskArtifactsResult{Artifacts: []taskdomain.TaskArtifact{
+			{Name: "report.csv", ObjectKey: "abc/outputs/report.csv", Size: 8, SHA256: "ff", ContentType: "text/csv"},
+		}}, nil).
+		Once()
+
+	got, err := srv.ListTaskArtifacts(context.Background(), &faaspb.ListTaskArtifactsRequest{Name: "tasks/abc"})
+	require.NoError(t, err)
+	require.Len(t, got.GetArtifacts(), 1)
+	require.Equal(t, "report.csv", got.GetArtifacts()[0].GetName())
+	require.Equal(t, uint64(8), got.GetArtifacts()[0].GetSize())
+	require.Equal(t, "text/csv", got.GetArtifacts()[0].GetContentType())
+}
+
+func TestServer_DownloadTaskArtifact(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid artifact name -> InvalidArgument", func(t *testing.T) {
+		t.Parallel()
+
+		svc := mocks.NewTaskService(t)
+		srv := taskapi.NewServer(svc)
+
+		err := srv.DownloadTaskArtifact(
+			&faaspb.DownloadTaskArtifactRequest{Name: "tasks/abc", Artifact: "../secret"},
+			&fakeDownloadStream{ctx: context.Background()},
+		)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("missing artifact -> NotFound", func(t *testing.T) {
+		t.Parallel()
+
+		svc := mocks.NewTaskService(t)
+		srv := taskapi.NewServer(svc)
+
+		svc.EXPECT().
+			DownloadTaskArtifact(mock.Anything, mock.Anything).
+			Return((*taskdomain.DownloadTaskArtifactResult)(nil), taskdomain.ErrArtifactNotFound).
+			Once()
+
+		err := srv.DownloadTaskArtifact(
+			&faaspb.DownloadTaskArtifactRequest{Name: "tasks/abc", Artifact: "nope.txt"},
+			&fakeDownloadStream{ctx: context.Background()},
+		)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("ok: metadata first, then data", func(t *testing.T) {
+		t.Parallel()
+
+		svc := mocks.NewTaskService(t)
+		srv := taskapi.NewServer(svc)
+
+		svc.EXPECT().
+			DownloadTaskArtifact(mock.Anything, &taskdomain.DownloadTaskArtifactArgs{Name: "tasks/abc", Artifact: "out/a.bin"}).
+			Return(&taskdomain.DownloadTaskArtifactResult{
+				Artifact: &taskdomain.TaskArtifact{Name: "out/a.bin", Size: 5},
+				Data:     io.NopCloser(bytes.NewReader([]byte("hello"))),
+			}, nil).
+			Once()
+
+		stream := &fakeDownloadStream{ctx: context.Background()}
+		err := srv.DownloadTaskArtifact(&faaspb.DownloadTaskArtifactRequest{Name: "tasks/abc", Artifact: "out/a.bin"}, stream)
+		require.NoError(t, err)
+		require.Len(t, stream.sent, 2)
+		require.Equal(t, "out/a.bin", stream.sent[0].GetArtifact().GetName())
+		require.Equal(t, []byte("hello"), stream.sent[1].GetData())
+	})
+}
+
+func TestServer_UpdateTask(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid name -> InvalidArgument", func(t *testing.T) {
+		t.Parallel()
+
+		svc := mocks.NewTaskService(t)
+		srv := taskapi.NewServer(svc)
+
+		got, err := srv.UpdateTask(context.Background(), &faaspb.UpdateTaskRequest{
+			Task: &faaspb.Task{Name: "bad"},
+		})
+		require.Nil(t, got)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("invalid labels -> InvalidArgument", func(t *testing.T) {
+		t.Parallel()
+
+		svc := mocks.NewTaskService(t)
+		srv := taskapi.NewServer(svc)
+
+		svc.EXPECT().
+			UpdateTask(mock.Anything, mock.Anything).
+			Return((*taskdomain.UpdateTaskResult)(nil), taskdomain.ErrInvalidMetadata)
+
+		got, err := srv.UpdateTask(context.Background(), &faaspb.UpdateTaskRequest{
+			Task:       &faaspb.Task{Name: "tasks/1", Labels: map[string]string{"Env": "dev"}},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"labels"}},
+		})
+		require.Nil(t, got)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("ok -> passes mask and returns labels", func(t *testing.T) {
+		t.Parallel()
+
+		svc := mocks.NewTaskService(t)
+		srv := taskapi.NewServer(svc)
+
+		labels := map[string]string{"env": "dev"}
+		svc.EXPECT().
+			UpdateTask(mock.Anything, &taskdomain.UpdateTaskArgs{
+				Name:   "tasks/1",
+				Labels: labels,
+				Paths:  []string{"labels"},
+			}).
+			Return(&taskdomain.UpdateTaskResult{Task: &taskdomain.Task{
+				Name:        "tasks/1",
+				Labels:      labels,
+				Annotations: map[string]string{"ticket": "OPS-1"},
+			}}, nil)
+
+		got, err := srv.UpdateTask(context.Background(), &faaspb.UpdateTaskRequest{
+			Task:       &faaspb.Task{Name: "tasks/1", Labels: labels},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"labels"}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, labels, got.GetLabels())
+		require.Equal(t, "OPS-1", got.GetAnnotations()["ticket"])
+	})
+}
+
+func TestServer_ListTasks_Filter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid filter -> InvalidArgument", func(t *testing.T) {
+		t.Parallel()
+
+		svc := mocks.NewTaskService(t)
+		srv := taskapi.NewServer(svc)
+
+		for _, filter := range []string{`state = sleeping`, `owner = me`, `labels.env > "a"`} {
+			_, err := srv.ListTasks(context.Background(), &faaspb.ListTasksRequest{PageSize: 10, Filter: filter})
+			require.Equal(t, codes.InvalidArgument, status.Code(err), filter)
+		}
+	})
+
+	t.Run("ok -> passes parsed filter", func(t *testing.T) {
+		t.Parallel()
+
+		svc := mocks.NewTaskService(t)
+		srv := taskapi.NewServer(svc)
+
+		dev := &taskdomain.Task{
+			Function: "functions/img-resize",
+			State:    taskdomain.TaskStatePending,
+			Labels:   map[string]string{"env": "dev"},
+		}
+		prod := &taskdomain.Task{
+			Function: "functions/img-resize",
+			State:    taskdomain.TaskStatePending,
+			Labels:   map[string]string{"env": "prod"},
+		}
+		done := &taskdomain.Task{
+			Function: "functions/img-resize",
+			State:    taskdomain.TaskStateSucceeded,
+			Labels:   map[string]string{"env": "dev"},
+		}
+
+		svc.EXPECT().
+			ListTasks(mock.Anything, mock.MatchedBy(func(a *taskdomain.ListTasksArgs) bool {
+				return a.Filter.Match(dev) && !a.Filter.Match(prod) && !a.Filter.Match(done)
+			})).
+			Return(&taskdomain.ListTaskResult{}, nil)
+
+		_, err := srv.ListTasks(context.Background(), &faaspb.ListTasksRequest{
+			PageSize: 10,
+			Filter:   `labels.env = "dev" AND state = pending function = "img-*"`,
+		})
+		require.NoError(t, err)
+	})
+}
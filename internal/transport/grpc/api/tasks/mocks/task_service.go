@@ -129,6 +129,65 @@ func (_c *TaskService_DeleteTask_Call) RunAndReturn(run func(context.Context, *t
 	return _c
 }
 
+// DownloadTaskArtifact provides a mock function with given fields: ctx, args
+func (_m *TaskService) DownloadTaskArtifact(ctx context.Context, args *taskdomain.DownloadTaskArtifactArgs) (*taskdomain.DownloadTaskArtifactResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DownloadTaskArtifact")
+	}
+
+	var r0 *taskdomain.DownloadTaskArtifactResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *taskdomain.DownloadTaskArtifactArgs) (*taskdomain.DownloadTaskArtifactResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *taskdomain.DownloadTaskArtifactArgs) *taskdomain.DownloadTaskArtifactResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*taskdomain.DownloadTaskArtifactResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *taskdomain.DownloadTaskArtifactArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TaskService_DownloadTaskArtifact_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadTaskArtifact'
+type TaskService_DownloadTaskArtifact_Call struct {
+	*mock.Call
+}
+
+// DownloadTaskArtifact is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *taskdomain.DownloadTaskArtifactArgs
+func (_e *TaskService_Expecter) DownloadTaskArtifact(ctx interface{}, args interface{}) *TaskService_DownloadTaskArtifact_Call {
+	return &TaskService_DownloadTaskArtifact_Call{Call: _e.mock.On("DownloadTaskArtifact", ctx, args)}
+}
+
+func (_c *TaskService_DownloadTaskArtifact_Call) Run(run func(ctx context.Context, args *taskdomain.DownloadTaskArtifactArgs)) *TaskService_DownloadTaskArtifact_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*taskdomain.DownloadTaskArtifactArgs))
+	})
+	return _c
+}
+
+func (_c *TaskService_DownloadTaskArtifact_Call) Return(_a0 *taskdomain.DownloadTaskArtifactResult, _a1 error) *TaskService_DownloadTaskArtifact_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TaskService_DownloadTaskArtifact_Call) RunAndReturn(run func(context.Context, *taskdomain.DownloadTaskArtifactArgs) (*taskdomain.DownloadTaskArtifactResult, error)) *TaskService_DownloadTaskArtifact_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTask provides a mock function with given fields: ctx, args
 func (_m *TaskService) GetTask(ctx context.Context, args *taskdomain.GetTaskArgs) (*taskdomain.GetTaskResult, error) {
 	ret := _m.Called(ctx, args)
@@ -188,6 +247,65 @@ func (_c *TaskService_GetTask_Call) RunAndReturn(run func(context.Context, *task
 	return _c
 }
 
+// ListTaskArtifacts provides a mock function with given fields: ctx, args
+func (_m *TaskService) ListTaskArtifacts(ctx context.Context, args *taskdomain.ListTaskArtifactsArgs) (*taskdomain.ListTaskArtifactsResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTaskArtifacts")
+	}
+
+	var r0 *taskdomain.ListTaskArtifactsResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *taskdomain.ListTaskArtifactsArgs) (*taskdomain.ListTaskArtifactsResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *taskdomain.ListTaskArtifactsArgs) *taskdomain.ListTaskArtifactsResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*taskdomain.ListTaskArtifactsResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *taskdomain.ListTaskArtifactsArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TaskService_ListTaskArtifacts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTaskArtifacts'
+type TaskService_ListTaskArtifacts_Call struct {
+	*mock.Call
+}
+
+// ListTaskArtifacts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *taskdomain.ListTaskArtifactsArgs
+func (_e *TaskService_Expecter) ListTaskArtifacts(ctx interface{}, args interface{}) *TaskService_ListTaskArtifacts_Call {
+	return &TaskService_ListTaskArtifacts_Call{Call: _e.mock.On("ListTaskArtifacts", ctx, args)}
+}
+
+func (_c *TaskService_ListTaskArtifacts_Call) Run(run func(ctx context.Context, args *taskdomain.ListTaskArtifactsArgs)) *TaskService_ListTaskArtifacts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*taskdomain.ListTaskArtifactsArgs))
+	})
+	return _c
+}
+
+func (_c *TaskService_ListTaskArtifacts_Call) Return(_a0 *taskdomain.ListTaskArtifactsResult, _a1 error) *TaskService_ListTaskArtifacts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TaskService_ListTaskArtifacts_Call) RunAndReturn(run func(context.Context, *taskdomain.ListTaskArtifactsArgs) (*taskdomain.ListTaskArtifactsResult, error)) *TaskService_ListTaskArtifacts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListTasks provides a mock function with given fields: ctx, args
 func (_m *TaskService) ListTasks(ctx context.Context, args *taskdomain.ListTasksArgs) (*taskdomain.ListTaskResult, error) {
 	ret := _m.Called(ctx, args)
@@ -247,6 +365,65 @@ func (_c *TaskService_ListTasks_Call) RunAndReturn(run func(context.Context, *ta
 	return _c
 }
 
+// UpdateTask provides a mock function with given fields: ctx, args
+func (_m *TaskService) UpdateTask(ctx context.Context, args *taskdomain.UpdateTaskArgs) (*taskdomain.UpdateTaskResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTask")
+	}
+
+	var r0 *taskdomain.UpdateTaskResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *taskdomain.UpdateTaskArgs) (*taskdomain.UpdateTaskResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *taskdomain.UpdateTaskArgs) *taskdomain.UpdateTaskResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*taskdomain.UpdateTaskResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *taskdomain.UpdateTaskArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TaskService_UpdateTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTask'
+type TaskService_UpdateTask_Call struct {
+	*mock.Call
+}
+
+// UpdateTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *taskdomain.UpdateTaskArgs
+func (_e *TaskService_Expecter) UpdateTask(ctx interface{}, args interface{}) *TaskService_UpdateTask_Call {
+	return &TaskService_UpdateTask_Call{Call: _e.mock.On("UpdateTask", ctx, args)}
+}
+
+func (_c *TaskService_UpdateTask_Call) Run(run func(ctx context.Context, args *taskdomain.UpdateTaskArgs)) *TaskService_UpdateTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*taskdomain.UpdateTaskArgs))
+	})
+	return _c
+}
+
+func (_c *TaskService_UpdateTask_Call) Return(_a0 *taskdomain.UpdateTaskResult, _a1 error) *TaskService_UpdateTask_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TaskService_UpdateTask_Call) RunAndReturn(run func(context.Context, *taskdomain.UpdateTaskArgs) (*taskdomain.UpdateTaskResult, error)) *TaskService_UpdateTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewTaskService creates a new instance of TaskService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewTaskService(t interface {
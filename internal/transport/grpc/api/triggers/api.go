@@ -0,0 +1,207 @@
+package triggerapi
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	grpcsrv "github.com/10Narratives/faas/internal/app/components/grpc/server"
+	triggerdomain "github.com/10Narratives/faas/internal/domains/triggers"
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+//go:generate mockery --name TriggerService --output ./mocks --outpkg mocks --with-expecter --filename trigger_service.go
+type TriggerService interface {
+	triggerdomain.TriggerCreator
+	triggerdomain.TriggerGetter
+	triggerdomain.TriggerLister
+	triggerdomain.TriggerUpdater
+	triggerdomain.TriggerDeleter
+}
+
+type Server struct {
+	faaspb.UnimplementedTriggersServer
+	triggerService TriggerService
+}
+
+func NewServer(triggerService TriggerService) *Server {
+	return &Server{triggerService: triggerService}
+}
+
+func NewRegistration(triggerService TriggerService) grpcsrv.ServiceRegistration {
+	return func(s *grpc.Server) {
+		faaspb.RegisterTriggersServer(s, NewServer(triggerService))
+	}
+}
+
+func (s *Server) CreateTrigger(ctx context.Context, req *faaspb.CreateTriggerRequest) (*faaspb.Trigger, error) {
+	pb := req.GetTrigger()
+	if pb == nil {
+		return nil, status.Error(codes.InvalidArgument, "trigger is required")
+	}
+	name, err := triggerdomain.ParseTriggerName(pb.GetName())
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	t := pbToDomainTrigger(pb)
+	t.Name = name
+
+	res, err := s.triggerService.CreateTrigger(ctx, &triggerdomain.CreateTriggerArgs{Trigger: t})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	if res == nil || res.Trigger == nil {
+		return nil, status.Error(codes.Internal, "missing trigger in result")
+	}
+
+	return toPBTrigger(res.Trigger), nil
+}
+
+func (s *Server) GetTrigger(ctx context.Context, req *faaspb.GetTriggerRequest) (*faaspb.Trigger, error) {
+	if _, err := triggerdomain.ParseTriggerName(req.GetName()); err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	res, err := s.triggerService.GetTrigger(ctx, &triggerdomain.GetTriggerArgs{Name: req.GetName()})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	if res == nil || res.Trigger == nil {
+		return nil, status.Error(codes.Internal, "missing trigger in result")
+	}
+
+	return toPBTrigger(res.Trigger), nil
+}
+
+func (s *Server) ListTriggers(ctx context.Context, req *faaspb.ListTriggersRequest) (*faaspb.ListTriggersResponse, error) {
+	res, err := s.triggerService.ListTriggers(ctx, &triggerdomain.ListTriggersArgs{
+		PageSize:  req.GetPageSize(),
+		PageToken: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	if res == nil {
+		return nil, status.Error(codes.Internal, "empty result")
+	}
+
+	out := &faaspb.ListTriggersResponse{
+		Triggers:      make([]*faaspb.Trigger, 0, len(res.Triggers)),
+		NextPageToken: res.NextPageToken,
+	}
+	for _, t := range res.Triggers {
+		if t == nil {
+			continue
+		}
+		out.Triggers = append(out.Triggers, toPBTrigger(t))
+	}
+	return out, nil
+}
+
+func (s *Server) UpdateTrigger(ctx context.Context, req *faaspb.UpdateTriggerRequest) (*faaspb.Trigger, error) {
+	pb := req.GetTrigger()
+	if pb == nil {
+		return nil, status.Error(codes.InvalidArgument, "trigger is required")
+	}
+	if _, err := triggerdomain.ParseTriggerName(pb.GetName()); err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	res, err := s.triggerService.UpdateTrigger(ctx, &triggerdomain.UpdateTriggerArgs{
+		Name:    pb.GetName(),
+		Trigger: pbToDomainTrigger(pb),
+		Paths:   req.GetUpdateMask().GetPaths(),
+	})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	if res == nil || res.Trigger == nil {
+		return nil, status.Error(codes.Internal, "missing trigger in result")
+	}
+
+	return toPBTrigger(res.Trigger), nil
+}
+
+func (s *Server) DeleteTrigger(ctx context.Context, req *faaspb.DeleteTriggerRequest) (*emptypb.Empty, error) {
+	if _, err := triggerdomain.ParseTriggerName(req.GetName()); err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	if err := s.triggerService.DeleteTrigger(ctx, &triggerdomain.DeleteTriggerArgs{Name: req.GetName()}); err != nil {
+		return nil, toStatusErr(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// pbToDomainTrigger copies the user-settable fields.
+func pbToDomainTrigger(pb *faaspb.Trigger) *triggerdomain.Trigger {
+	return &triggerdomain.Trigger{
+		Function:    pb.GetFunction(),
+		Subject:     pb.GetSubject(),
+		Stream:      pb.GetStream(),
+		Consumer:    pb.GetConsumer(),
+		Filter:      pb.GetFilter(),
+		Concurrency: int(pb.GetConcurrency()),
+	}
+}
+
+func toPBTrigger(t *triggerdomain.Trigger) *faaspb.Trigger {
+	return &faaspb.Trigger{
+		Name:        string(t.Name),
+		Function:    t.Function,
+		Subject:     t.Subject,
+		Stream:      t.Stream,
+		Consumer:    t.Consumer,
+		Filter:      t.Filter,
+		Concurrency: int32(t.Concurrency),
+		CreatedAt:   toPBTimestampOrNil(t.CreatedAt),
+		UpdatedAt:   toPBTimestampOrNil(t.UpdatedAt),
+	}
+}
+
+func toPBTimestampOrNil(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func toStatusErr(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, "request canceled")
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return status.Error(codes.DeadlineExceeded, "deadline exceeded")
+	}
+
+	switch {
+	case errors.Is(err, triggerdomain.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, triggerdomain.ErrAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, triggerdomain.ErrStreamNotFound),
+		errors.Is(err, triggerdomain.ErrConsumerNotFound):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, triggerdomain.ErrInvalidName),
+		errors.Is(err, triggerdomain.ErrInvalidSource),
+		errors.Is(err, triggerdomain.ErrInvalidFunction),
+		errors.Is(err, triggerdomain.ErrInvalidConcurrency),
+		errors.Is(err, triggerdomain.ErrInvalidFilter),
+		errors.Is(err, triggerdomain.ErrInvalidUpdateMask),
+		errors.Is(err, triggerdomain.ErrInvalidPageToken),
+		errors.Is(err, triggerdomain.ErrInvalidParameters):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,90 @@
+package triggerapi_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	triggerdomain "github.com/10Narratives/faas/internal/domains/triggers"
+	triggerapi "github.com/10Narratives/faas/internal/transport/grpc/api/triggers"
+	"github.com/10Narratives/faas/internal/transport/grpc/api/triggers/mocks"
+	faaspb "github.com/10Narratives/faas/pkg/faas/v1"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestCreateTrigger_MapsFields(t *testing.T) {
+	svc := mocks.NewTriggerService(t)
+	s := triggerapi.NewServer(svc)
+
+	created := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	svc.EXPECT().CreateTrigger(mock.Anything, mock.MatchedBy(func(a *triggerdomain.CreateTriggerArgs) bool {
+		return a.Trigger.Name == "triggers/orders" &&
+			a.Trigger.Function == "functions/orders@prod" &&
+			a.Trigger.Stream == "ORDERS" &&
+			a.Trigger.Subject == "orders.eu.>" &&
+			a.Trigger.Filter == `headers.Type = "created"` &&
+			a.Trigger.Concurrency == 8
+	})).
+		RunAndReturn(func(_ context.Context, a *triggerdomain.CreateTriggerArgs) (*triggerdomain.CreateTriggerResult, error) {
+			out := *a.Trigger
+			out.CreatedAt, out.UpdatedAt = created, created
+			return &triggerdomain.CreateTriggerResult{Trigger: &out}, nil
+		}).
+		Once()
+
+	got, err := s.CreateTrigger(context.Background(), &faaspb.CreateTriggerRequest{Trigger: &faaspb.Trigger{
+		Name:        "triggers/orders",
+		Function:    "functions/orders@prod",
+		Stream:      "ORDERS",
+		Subject:     "orders.eu.>",
+		Filter:      `headers.Type = "created"`,
+		Concurrency: 8,
+	}})
+	require.NoError(t, err)
+	require.Equal(t, int32(8), got.GetConcurrency())
+	require.Equal(t, created, got.GetCreatedAt().AsTime())
+}
+
+func TestCreateTrigger_StreamNotFound(t *testing.T) {
+	svc := mocks.NewTriggerService(t)
+	s := triggerapi.NewServer(svc)
+
+	svc.EXPECT().CreateTrigger(mock.Anything, mock.Anything).Return(nil, triggerdomain.ErrStreamNotFound).Once()
+
+	_, err := s.CreateTrigger(context.Background(), &faaspb.CreateTriggerRequest{Trigger: &faaspb.Trigger{
+		Name: "triggers/orders", Function: "functions/orders", Stream: "MISSING",
+	}})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestUpdateTrigger_PassesMask(t *testing.T) {
+	svc := mocks.NewTriggerService(t)
+	s := triggerapi.NewServer(svc)
+
+	svc.EXPECT().UpdateTrigger(mock.Anything, mock.MatchedBy(func(a *triggerdomain.UpdateTriggerArgs) bool {
+		return a.Name == "triggers/orders" && a.Trigger.Concurrency == 4 &&
+			len(a.Paths) == 1 && a.Paths[0] == triggerdomain.FieldConcurrency
+	})).
+		Return(&triggerdomain.UpdateTriggerResult{Trigger: &triggerdomain.Trigger{Name: "triggers/orders", Concurrency: 4}}, nil).
+		Once()
+
+	got, err := s.UpdateTrigger(context.Background(), &faaspb.UpdateTriggerRequest{
+		Trigger:    &faaspb.Trigger{Name: "triggers/orders", Concurrency: 4},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"concurrency"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(4), got.GetConcurrency())
+}
+
+func TestGetTrigger_InvalidName(t *testing.T) {
+	svc := mocks.NewTriggerService(t)
+	s := triggerapi.NewServer(svc)
+
+	_, err := s.GetTrigger(context.Background(), &faaspb.GetTriggerRequest{Name: "schedules/orders"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,321 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	triggerdomain "github.com/10Narratives/faas/internal/domains/triggers"
+)
+
+// TriggerService is an autogenerated mock type for the TriggerService type
+type TriggerService struct {
+	mock.Mock
+}
+
+type TriggerService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TriggerService) EXPECT() *TriggerService_Expecter {
+	return &TriggerService_Expecter{mock: &_m.Mock}
+}
+
+// CreateTrigger provides a mock function with given fields: ctx, args
+func (_m *TriggerService) CreateTrigger(ctx context.Context, args *triggerdomain.CreateTriggerArgs) (*triggerdomain.CreateTriggerResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTrigger")
+	}
+
+	var r0 *triggerdomain.CreateTriggerResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *triggerdomain.CreateTriggerArgs) (*triggerdomain.CreateTriggerResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *triggerdomain.CreateTriggerArgs) *triggerdomain.CreateTriggerResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*triggerdomain.CreateTriggerResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *triggerdomain.CreateTriggerArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TriggerService_CreateTrigger_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTrigger'
+type TriggerService_CreateTrigger_Call struct {
+	*mock.Call
+}
+
+// CreateTrigger is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *triggerdomain.CreateTriggerArgs
+func (_e *TriggerService_Expecter) CreateTrigger(ctx interface{}, args interface{}) *TriggerService_CreateTrigger_Call {
+	return &TriggerService_CreateTrigger_Call{Call: _e.mock.On("CreateTrigger", ctx, args)}
+}
+
+func (_c *TriggerService_CreateTrigger_Call) Run(run func(ctx context.Context, args *triggerdomain.CreateTriggerArgs)) *TriggerService_CreateTrigger_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*triggerdomain.CreateTriggerArgs))
+	})
+	return _c
+}
+
+func (_c *TriggerService_CreateTrigger_Call) Return(_a0 *triggerdomain.CreateTriggerResult, _a1 error) *TriggerService_CreateTrigger_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TriggerService_CreateTrigger_Call) RunAndReturn(run func(context.Context, *triggerdomain.CreateTriggerArgs) (*triggerdomain.CreateTriggerResult, error)) *TriggerService_CreateTrigger_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTrigger provides a mock function with given fields: ctx, args
+func (_m *TriggerService) DeleteTrigger(ctx context.Context, args *triggerdomain.DeleteTriggerArgs) error {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTrigger")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *triggerdomain.DeleteTriggerArgs) error); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TriggerService_DeleteTrigger_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTrigger'
+type TriggerService_DeleteTrigger_Call struct {
+	*mock.Call
+}
+
+// DeleteTrigger is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *triggerdomain.DeleteTriggerArgs
+func (_e *TriggerService_Expecter) DeleteTrigger(ctx interface{}, args interface{}) *TriggerService_DeleteTrigger_Call {
+	return &TriggerService_DeleteTrigger_Call{Call: _e.mock.On("DeleteTrigger", ctx, args)}
+}
+
+func (_c *TriggerService_DeleteTrigger_Call) Run(run func(ctx context.Context, args *triggerdomain.DeleteTriggerArgs)) *TriggerService_DeleteTrigger_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*triggerdomain.DeleteTriggerArgs))
+	})
+	return _c
+}
+
+func (_c *TriggerService_DeleteTrigger_Call) Return(_a0 error) *TriggerService_DeleteTrigger_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TriggerService_DeleteTrigger_Call) RunAndReturn(run func(context.Context, *triggerdomain.DeleteTriggerArgs) error) *TriggerService_DeleteTrigger_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTrigger provides a mock function with given fields: ctx, args
+func (_m *TriggerService) GetTrigger(ctx context.Context, args *triggerdomain.GetTriggerArgs) (*triggerdomain.GetTriggerResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrigger")
+	}
+
+	var r0 *triggerdomain.GetTriggerResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *triggerdomain.GetTriggerArgs) (*triggerdomain.GetTriggerResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *triggerdomain.GetTriggerArgs) *triggerdomain.GetTriggerResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*triggerdomain.GetTriggerResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *triggerdomain.GetTriggerArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TriggerService_GetTrigger_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrigger'
+type TriggerService_GetTrigger_Call struct {
+	*mock.Call
+}
+
+// GetTrigger is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *triggerdomain.GetTriggerArgs
+func (_e *TriggerService_Expecter) GetTrigger(ctx interface{}, args interface{}) *TriggerService_GetTrigger_Call {
+	return &TriggerService_GetTrigger_Call{Call: _e.mock.On("GetTrigger", ctx, args)}
+}
+
+func (_c *TriggerService_GetTrigger_Call) Run(run func(ctx context.Context, args *triggerdomain.GetTriggerArgs)) *TriggerService_GetTrigger_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*triggerdomain.GetTriggerArgs))
+	})
+	return _c
+}
+
+func (_c *TriggerService_GetTrigger_Call) Return(_a0 *triggerdomain.GetTriggerResult, _a1 error) *TriggerService_GetTrigger_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TriggerService_GetTrigger_Call) RunAndReturn(run func(context.Context, *triggerdomain.GetTriggerArgs) (*triggerdomain.GetTriggerResult, error)) *TriggerService_GetTrigger_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTriggers provides a mock function with given fields: ctx, args
+func (_m *TriggerService) ListTriggers(ctx context.Context, args *triggerdomain.ListTriggersArgs) (*triggerdomain.ListTriggersResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTriggers")
+	}
+
+	var r0 *triggerdomain.ListTriggersResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *triggerdomain.ListTriggersArgs) (*triggerdomain.ListTriggersResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *triggerdomain.ListTriggersArgs) *triggerdomain.ListTriggersResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*triggerdomain.ListTriggersResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *triggerdomain.ListTriggersArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TriggerService_ListTriggers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTriggers'
+type TriggerService_ListTriggers_Call struct {
+	*mock.Call
+}
+
+// ListTriggers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *triggerdomain.ListTriggersArgs
+func (_e *TriggerService_Expecter) ListTriggers(ctx interface{}, args interface{}) *TriggerService_ListTriggers_Call {
+	return &TriggerService_ListTriggers_Call{Call: _e.mock.On("ListTriggers", ctx, args)}
+}
+
+func (_c *TriggerService_ListTriggers_Call) Run(run func(ctx context.Context, args *triggerdomain.ListTriggersArgs)) *TriggerService_ListTriggers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*triggerdomain.ListTriggersArgs))
+	})
+	return _c
+}
+
+func (_c *TriggerService_ListTriggers_Call) Return(_a0 *triggerdomain.ListTriggersResult, _a1 error) *TriggerService_ListTriggers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TriggerService_ListTriggers_Call) RunAndReturn(run func(context.Context, *triggerdomain.ListTriggersArgs) (*triggerdomain.ListTriggersResult, error)) *TriggerService_ListTriggers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTrigger provides a mock function with given fields: ctx, args
+func (_m *TriggerService) UpdateTrigger(ctx context.Context, args *triggerdomain.UpdateTriggerArgs) (*triggerdomain.UpdateTriggerResult, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTrigger")
+	}
+
+	var r0 *triggerdomain.UpdateTriggerResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *triggerdomain.UpdateTriggerArgs) (*triggerdomain.UpdateTriggerResult, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *triggerdomain.UpdateTriggerArgs) *triggerdomain.UpdateTriggerResult); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*triggerdomain.UpdateTriggerResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *triggerdomain.UpdateTriggerArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TriggerService_UpdateTrigger_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTrigger'
+type TriggerService_UpdateTrigger_Call struct {
+	*mock.Call
+}
+
+// UpdateTrigger is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *triggerdomain.UpdateTriggerArgs
+func (_e *TriggerService_Expecter) UpdateTrigger(ctx interface{}, args interface{}) *TriggerService_UpdateTrigger_Call {
+	return &TriggerService_UpdateTrigger_Call{Call: _e.mock.On("UpdateTrigger", ctx, args)}
+}
+
+func (_c *TriggerService_UpdateTrigger_Call) Run(run func(ctx context.Context, args *triggerdomain.UpdateTriggerArgs)) *TriggerService_UpdateTrigger_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*triggerdomain.UpdateTriggerArgs))
+	})
+	return _c
+}
+
+func (_c *TriggerService_UpdateTrigger_Call) Return(_a0 *triggerdomain.UpdateTriggerResult, _a1 error) *TriggerService_UpdateTrigger_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TriggerService_UpdateTrigger_Call) RunAndReturn(run func(context.Context, *triggerdomain.UpdateTriggerArgs) (*triggerdomain.UpdateTriggerResult, error)) *TriggerService_UpdateTrigger_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTriggerService creates a new instance of TriggerService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTriggerService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TriggerService {
+	mock := &TriggerService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,174 @@
+package taskconsumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	funcdomain "github.com/10Narratives/faas/internal/domains/functions"
+	taskdomain "github.com/10Narratives/faas/internal/domains/tasks"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.uber.org/zap"
+)
+
+const (
+	streamTasks        = "TASKS"
+	subjectTaskExecute = "task.execute"
+	subjectTaskBuild   = "task.build"
+
+	executeDurable = "faas-agents"
+	buildDurable   = "faas-builders"
+
+	// ackWait is three times inProgressEvery, as the executor takes over a
+	// task after three missed heartbeats of its default interval.
+	ackWait         = 30 * time.Second
+	inProgressEvery = 10 * time.Second
+	// maxAckPending leaves room for messages waiting out a retry delay,
+	// which stay pending on the consumer; what an agent fetches at once is
+	// bounded by its concurrency instead.
+	maxAckPending = 4096
+)
+
+type TaskExecutor interface {
+	ExecuteTask(ctx context.Context, name taskdomain.TaskName) error
+}
+
+type FunctionBuilder interface {
+	funcdomain.FunctionBuilder
+}
+
+// errMalformed marks messages that can never be processed and must not be
+// redelivered.
+var errMalformed = errors.New("malformed message")
+
+type Consumer struct {
+	js          jetstream.JetStream
+	durable     string
+	subject     string
+	concurrency int
+	handle      func(ctx context.Context, data []byte) error
+	log         *zap.Logger
+}
+
+// NewConsumer consumes task.execute messages. All agents share one durable
+// consumer, so every message is handled by a single agent.
+func NewConsumer(js jetstream.JetStream, executor TaskExecutor, concurrency int, log *zap.Logger) *Consumer {
+	return newConsumer(js, executeDurable, subjectTaskExecute, concurrency, log, func(ctx context.Context, data []byte) error {
+		var m taskdomain.ExecuteTaskMessage
+		if err := json.Unmarshal(data, &m); err != nil || m.TaskName == "" {
+			return errMalformed
+		}
+		return executor.ExecuteTask(ctx, m.TaskName)
+	})
+}
+
+// NewBuildConsumer consumes task.build messages published after uploads.
+func NewBuildConsumer(js jetstream.JetStream, builder FunctionBuilder, concurrency int, log *zap.Logger) *Consumer {
+	return newConsumer(js, buildDurable, subjectTaskBuild, concurrency, log, func(ctx context.Context, data []byte) error {
+		var m funcdomain.BuildFunctionMessage
+		if err := json.Unmarshal(data, &m); err != nil || m.FunctionName == "" {
+			return errMalformed
+		}
+		return builder.BuildFunction(ctx, &funcdomain.BuildFunctionArgs{
+			Name:     m.FunctionName,
+			Revision: m.Revision,
+			BuildID:  m.BuildID,
+		})
+	})
+}
+
+func newConsumer(
+	js jetstream.JetStream,
+	durable, subject string,
+	concurrency int,
+	log *zap.Logger,
+	handle func(ctx context.Context, data []byte) error,
+) *Consumer {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	return &Consumer{
+		js:          js,
+		durable:     durable,
+		subject:     subject,
+		concurrency: concurrency,
+		handle:      handle,
+		log:         log.With(zap.String("subject", subject)),
+	}
+}
+
+// Startup consumes messages until ctx is done.
+func (c *Consumer) Startup(ctx context.Context) error {
+	cons, err := c.js.CreateOrUpdateConsumer(ctx, streamTasks, jetstream.ConsumerConfig{
+		Durable:       c.durable,
+		FilterSubject: c.subject,
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		AckWait:       ackWait,
+		MaxAckPending: maxAckPending,
+	})
+	if err != nil {
+		return fmt.Errorf("create consumer %s: %w", c.durable, err)
+	}
+
+	sem := make(chan struct{}, c.concurrency)
+	var wg sync.WaitGroup
+
+	cc, err := cons.Consume(func(msg jetstream.Msg) {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			_ = msg.Nak()
+			return
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			c.process(ctx, msg)
+		}()
+	}, jetstream.PullMaxMessages(c.concurrency*4))
+	if err != nil {
+		return fmt.Errorf("consume %s: %w", c.subject, err)
+	}
+
+	<-ctx.Done()
+	cc.Stop()
+	wg.Wait()
+	return nil
+}
+
+func (c *Consumer) process(ctx context.Context, msg jetstream.Msg) {
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		t := time.NewTicker(inProgressEvery)
+		defer t.Stop()
+		for {
+			select {
+			case <-t.C:
+				_ = msg.InProgress()
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	err := c.handle(ctx, msg.Data())
+	var retry *taskdomain.RetryError
+	switch {
+	case errors.As(err, &retry):
+		_ = msg.NakWithDelay(retry.Delay)
+	case errors.Is(err, errMalformed):
+		c.log.Warn("dropping malformed message")
+		_ = msg.Term()
+	case err != nil:
+		c.log.Error("message handling failed", zap.Error(err))
+		_ = msg.Nak()
+	default:
+		_ = msg.Ack()
+	}
+}
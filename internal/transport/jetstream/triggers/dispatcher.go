@@ -0,0 +1,316 @@
+package triggerconsumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	triggerdomain "github.com/10Narratives/faas/internal/domains/triggers"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.uber.org/zap"
+)
+
+const (
+	listPageSize = 500
+	// nakDelay spaces out redeliveries of messages whose task could not be
+	// created, e.g. while the function is not ready. It doubles with every
+	// delivery up to maxNakDelay.
+	nakDelay    = 5 * time.Second
+	maxNakDelay = 5 * time.Minute
+)
+
+type TriggerService interface {
+	triggerdomain.TriggerLister
+	triggerdomain.TriggerFirer
+}
+
+type ConsumerSource interface {
+	Consumer(ctx context.Context, t *triggerdomain.Trigger) (jetstream.Consumer, error)
+}
+
+// Dispatcher runs the triggers on this gateway. Every gateway runs all of
+// them: subject triggers share a queue group and stream triggers a
+// consumer, so each message reaches one gateway.
+type Dispatcher struct {
+	nc           *nats.Conn
+	consumers    ConsumerSource
+	triggers     TriggerService
+	syncInterval time.Duration
+	log          *zap.Logger
+
+	running map[triggerdomain.TriggerName]*runner
+}
+
+type runner struct {
+	trigger triggerdomain.Trigger
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+func NewDispatcher(nc *nats.Conn, consumers ConsumerSource, triggers TriggerService, syncInterval time.Duration, log *zap.Logger) *Dispatcher {
+	return &Dispatcher{
+		nc:           nc,
+		consumers:    consumers,
+		triggers:     triggers,
+		syncInterval: syncInterval,
+		log:          log,
+		running:      make(map[triggerdomain.TriggerName]*runner),
+	}
+}
+
+// Startup runs the stored triggers, picking up changes every sync
+// interval, until ctx is done.
+func (d *Dispatcher) Startup(ctx context.Context) error {
+	ticker := time.NewTicker(d.syncInterval)
+	defer ticker.Stop()
+	defer d.stopAll()
+
+	for {
+		if err := d.sync(ctx); err != nil && ctx.Err() == nil {
+			d.log.Warn("cannot sync triggers", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// sync starts new triggers, restarts changed ones and stops deleted ones.
+// A trigger that cannot start is retried on the next sync.
+func (d *Dispatcher) sync(ctx context.Context) error {
+	desired := make(map[triggerdomain.TriggerName]*triggerdomain.Trigger)
+	token := ""
+	for {
+		page, err := d.triggers.ListTriggers(ctx, &triggerdomain.ListTriggersArgs{PageSize: listPageSize, PageToken: token})
+		if err != nil {
+			return err
+		}
+		for _, t := range page.Triggers {
+			desired[t.Name] = t
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		token = page.NextPageToken
+	}
+
+	for name, r := range d.running {
+		if t, ok := desired[name]; ok && t.UpdatedAt.Equal(r.trigger.UpdatedAt) {
+			continue
+		}
+		d.stop(name)
+	}
+
+	for name, t := range desired {
+		if _, ok := d.running[name]; ok {
+			continue
+		}
+		if err := d.start(ctx, t); err != nil {
+			d.log.Warn("cannot start trigger", zap.String("trigger", string(name)), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func (d *Dispatcher) start(ctx context.Context, t *triggerdomain.Trigger) error {
+	runCtx, cancel := context.WithCancel(ctx)
+	r := &runner{trigger: *t, cancel: cancel, done: make(chan struct{})}
+	log := d.log.With(zap.String("trigger", string(t.Name)))
+
+	var (
+		stop func()
+		err  error
+	)
+	if t.Stream != "" {
+		stop, err = d.consumeStream(runCtx, &r.trigger, log)
+	} else {
+		stop, err = d.subscribe(runCtx, &r.trigger, log)
+	}
+	if err != nil {
+		cancel()
+		return err
+	}
+
+	go func() {
+		defer close(r.done)
+		<-runCtx.Done()
+		stop()
+	}()
+
+	d.running[t.Name] = r
+	log.Info("trigger started", zap.String("subject", t.Subject), zap.String("stream", t.Stream))
+	return nil
+}
+
+func (d *Dispatcher) stop(name triggerdomain.TriggerName) {
+	r := d.running[name]
+	r.cancel()
+	<-r.done
+	delete(d.running, name)
+	d.log.Info("trigger stopped", zap.String("trigger", string(name)))
+}
+
+func (d *Dispatcher) stopAll() {
+	for name := range d.running {
+		d.stop(name)
+	}
+}
+
+// subscribe reads a core NATS subject. Core NATS has no acknowledgements:
+// a message is lost if its task cannot be created. Requests get a reply
+// naming the task.
+func (d *Dispatcher) subscribe(ctx context.Context, t *triggerdomain.Trigger, log *zap.Logger) (func(), error) {
+	pool := newWorkerPool(t.Concurrency)
+
+	sub, err := d.nc.QueueSubscribe(t.Subject, t.QueueGroup(), func(msg *nats.Msg) {
+		pool.run(ctx, func() {
+			res, err := d.fire(ctx, t, &triggerdomain.Message{
+				Subject: msg.Subject,
+				Headers: msg.Header,
+				Data:    msg.Data,
+			})
+			if err != nil {
+				log.Warn("dropping message", zap.String("subject", msg.Subject), zap.Error(err))
+			}
+			if msg.Reply != "" {
+				_ = msg.Respond(replyPayload(res, err))
+			}
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("subscribe %s: %w", t.Subject, err)
+	}
+
+	return func() {
+		_ = sub.Unsubscribe()
+		pool.wait()
+	}, nil
+}
+
+// consumeStream reads a stream through the trigger's consumer. A message
+// is acknowledged once its task exists, so it is delivered at least once;
+// a redelivery after a lost acknowledgement creates a second task.
+func (d *Dispatcher) consumeStream(ctx context.Context, t *triggerdomain.Trigger, log *zap.Logger) (func(), error) {
+	cons, err := d.consumers.Consumer(ctx, t)
+	if err != nil {
+		return nil, fmt.Errorf("consumer of %s: %w", t.Stream, err)
+	}
+
+	pool := newWorkerPool(t.Concurrency)
+
+	cc, err := cons.Consume(func(msg jetstream.Msg) {
+		started := pool.run(ctx, func() {
+			m := &triggerdomain.Message{
+				Subject: msg.Subject(),
+				Headers: msg.Headers(),
+				Data:    msg.Data(),
+			}
+			var delivered uint64
+			if meta, err := msg.Metadata(); err == nil {
+				m.Sequence, delivered = meta.Sequence.Stream, meta.NumDelivered
+			}
+
+			_, err := d.fire(ctx, t, m)
+			switch {
+			case errors.Is(err, triggerdomain.ErrMessageRejected):
+				log.Warn("dropping rejected message", zap.Uint64("sequence", m.Sequence), zap.Error(err))
+				_ = msg.Term()
+			case err != nil:
+				log.Warn("cannot create task for message", zap.Uint64("sequence", m.Sequence), zap.Error(err))
+				_ = msg.NakWithDelay(redeliveryDelay(delivered))
+			default:
+				_ = msg.Ack()
+			}
+		})
+		if !started {
+			_ = msg.Nak()
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("consume %s: %w", t.Stream, err)
+	}
+
+	return func() {
+		cc.Stop()
+		pool.wait()
+	}, nil
+}
+
+// redeliveryDelay is the delay before the next delivery of a message
+// delivered the given number of times.
+func redeliveryDelay(delivered uint64) time.Duration {
+	delay := nakDelay
+	for i := uint64(1); i < delivered && delay < maxNakDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxNakDelay)
+}
+
+func (d *Dispatcher) fire(ctx context.Context, t *triggerdomain.Trigger, m *triggerdomain.Message) (*triggerdomain.FireTriggerResult, error) {
+	return d.triggers.FireTrigger(ctx, &triggerdomain.FireTriggerArgs{Trigger: t, Message: m})
+}
+
+type reply struct {
+	Task     string `json:"task,omitempty"`
+	Filtered bool   `json:"filtered,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+func replyPayload(res *triggerdomain.FireTriggerResult, err error) []byte {
+	var r reply
+	switch {
+	case err != nil:
+		r.Error = err.Error()
+	case res != nil:
+		r.Task, r.Filtered = res.TaskName, res.Filtered
+	}
+	b, _ := json.Marshal(r)
+	return b
+}
+
+// workerPool runs up to its size of functions at once; run blocks while
+// the pool is full, which holds back further deliveries, and reports
+// false if ctx ended first.
+type workerPool struct {
+	sem chan struct{}
+	wg  sync.WaitGroup
+}
+
+func newWorkerPool(size int) *workerPool {
+	if size <= 0 {
+		size = 1
+	}
+	return &workerPool{sem: make(chan struct{}, size)}
+}
+
+func (p *workerPool) run(ctx context.Context, fn func()) bool {
+	// select picks at random when both are ready; a stopped trigger must
+	// not take a free slot.
+	if ctx.Err() != nil {
+		return false
+	}
+	select {
+	case p.sem <- struct{}{}:
+	case <-ctx.Done():
+		return false
+	}
+
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		defer func() { <-p.sem }()
+		fn()
+	}()
+	return true
+}
+
+func (p *workerPool) wait() {
+	p.wg.Wait()
+}
@@ -0,0 +1,299 @@
+package triggerconsumer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	triggerdomain "github.com/10Narratives/faas/internal/domains/triggers"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// fakeTriggers lists a settable set of triggers and fires through fire.
+type fakeTriggers struct {
+	mu       sync.Mutex
+	triggers []*triggerdomain.Trigger
+	fire     func(args *triggerdomain.FireTriggerArgs) (*triggerdomain.FireTriggerResult, error)
+}
+
+func (f *fakeTriggers) set(triggers ...*triggerdomain.Trigger) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.triggers = triggers
+}
+
+func (f *fakeTriggers) ListTriggers(context.Context, *triggerdomain.ListTriggersArgs) (*triggerdomain.ListTriggersResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return &triggerdomain.ListTriggersResult{Triggers: append([]*triggerdomain.Trigger(nil), f.triggers...)}, nil
+}
+
+func (f *fakeTriggers) FireTrigger(_ context.Context, args *triggerdomain.FireTriggerArgs) (*triggerdomain.FireTriggerResult, error) {
+	return f.fire(args)
+}
+
+// fakeSource hands out one fakeConsumer per started trigger.
+type fakeSource struct {
+	mu      sync.Mutex
+	started []*fakeConsumer
+}
+
+func (s *fakeSource) Consumer(_ context.Context, t *triggerdomain.Trigger) (jetstream.Consumer, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c := &fakeConsumer{trigger: t.Name}
+	s.started = append(s.started, c)
+	return c, nil
+}
+
+func (s *fakeSource) consumers() []*fakeConsumer {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*fakeConsumer(nil), s.started...)
+}
+
+type fakeConsumer struct {
+	jetstream.Consumer
+
+	trigger triggerdomain.TriggerName
+	handler jetstream.MessageHandler
+	stopped atomic.Bool
+}
+
+func (c *fakeConsumer) Consume(handler jetstream.MessageHandler, _ ...jetstream.PullConsumeOpt) (jetstream.ConsumeContext, error) {
+	c.handler = handler
+	return &fakeConsumeContext{consumer: c}, nil
+}
+
+type fakeConsumeContext struct {
+	jetstream.ConsumeContext
+	consumer *fakeConsumer
+}
+
+func (c *fakeConsumeContext) Stop() { c.consumer.stopped.Store(true) }
+
+// fakeMsg records how the dispatcher settled it.
+type fakeMsg struct {
+	jetstream.Msg
+
+	delivered uint64
+	settled   chan string
+	delay     time.Duration
+}
+
+func newFakeMsg(delivered uint64) *fakeMsg {
+	return &fakeMsg{delivered: delivered, settled: make(chan string, 1)}
+}
+
+func (m *fakeMsg) Subject() string      { return "orders.eu" }
+func (m *fakeMsg) Headers() nats.Header { return nil }
+func (m *fakeMsg) Data() []byte         { return []byte("{}") }
+
+func (m *fakeMsg) Metadata() (*jetstream.MsgMetadata, error) {
+	return &jetstream.MsgMetadata{Sequence: jetstream.SequencePair{Stream: 7}, NumDelivered: m.delivered}, nil
+}
+
+func (m *fakeMsg) Ack() error  { m.settled <- "ack"; return nil }
+func (m *fakeMsg) Nak() error  { m.settled <- "nak"; return nil }
+func (m *fakeMsg) Term() error { m.settled <- "term"; return nil }
+
+func (m *fakeMsg) NakWithDelay(delay time.Duration) error {
+	m.delay = delay
+	m.settled <- "nak"
+	return nil
+}
+
+func (m *fakeMsg) wait(t *testing.T) string {
+	t.Helper()
+	select {
+	case s := <-m.settled:
+		return s
+	case <-time.After(5 * time.Second):
+		t.Fatal("message was not settled")
+		return ""
+	}
+}
+
+func streamTrigger(name string, concurrency int, updated time.Time) *triggerdomain.Trigger {
+	return &triggerdomain.Trigger{
+		Name:        triggerdomain.TriggerName(name),
+		Function:    "functions/orders",
+		Stream:      "ORDERS",
+		Concurrency: concurrency,
+		UpdatedAt:   updated,
+	}
+}
+
+func newTestDispatcher(triggers *fakeTriggers, source *fakeSource) *Dispatcher {
+	return NewDispatcher(nil, source, triggers, time.Hour, zap.NewNop())
+}
+
+func TestDispatcher_Sync(t *testing.T) {
+	ctx := context.Background()
+	triggers := &fakeTriggers{}
+	source := &fakeSource{}
+	d := newTestDispatcher(triggers, source)
+	defer d.stopAll()
+
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	triggers.set(streamTrigger("triggers/a", 1, created), streamTrigger("triggers/b", 1, created))
+
+	require.NoError(t, d.sync(ctx))
+	require.Len(t, source.consumers(), 2)
+	require.Len(t, d.running, 2)
+
+	// Unchanged triggers keep running.
+	require.NoError(t, d.sync(ctx))
+	require.Len(t, source.consumers(), 2)
+
+	// An update restarts the trigger, a deletion stops it.
+	triggers.set(streamTrigger("triggers/a", 1, created.Add(time.Minute)))
+	require.NoError(t, d.sync(ctx))
+
+	started := source.consumers()
+	require.Len(t, started, 3)
+	for _, c := range started[:2] {
+		require.True(t, c.stopped.Load(), "%s was not stopped", c.trigger)
+	}
+	require.Equal(t, triggerdomain.TriggerName("triggers/a"), started[2].trigger)
+	require.False(t, started[2].stopped.Load())
+	require.Len(t, d.running, 1)
+	require.Equal(t, created.Add(time.Minute), d.running["triggers/a"].trigger.UpdatedAt)
+}
+
+func TestDispatcher_ConsumeStream_SettlesByError(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		delivered uint64
+		want      string
+		wantDelay time.Duration
+	}{
+		{name: "task created", want: "ack"},
+		{name: "rejected", err: triggerdomain.ErrMessageRejected, want: "term"},
+		{name: "transient", err: errors.New("kv unavailable"), delivered: 1, want: "nak", wantDelay: nakDelay},
+		{name: "transient again", err: errors.New("kv unavailable"), delivered: 3, want: "nak", wantDelay: 4 * nakDelay},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			triggers := &fakeTriggers{
+				fire: func(args *triggerdomain.FireTriggerArgs) (*triggerdomain.FireTriggerResult, error) {
+					require.Equal(t, uint64(7), args.Message.Sequence)
+					if tt.err != nil {
+						return nil, tt.err
+					}
+					return &triggerdomain.FireTriggerResult{TaskName: "tasks/1"}, nil
+				},
+			}
+			source := &fakeSource{}
+			d := newTestDispatcher(triggers, source)
+			defer d.stopAll()
+
+			triggers.set(streamTrigger("triggers/a", 1, time.Time{}))
+			require.NoError(t, d.sync(context.Background()))
+
+			msg := newFakeMsg(tt.delivered)
+			source.consumers()[0].handler(msg)
+			require.Equal(t, tt.want, msg.wait(t))
+			require.Equal(t, tt.wantDelay, msg.delay)
+		})
+	}
+}
+
+func TestDispatcher_ConsumeStream_BoundsConcurrency(t *testing.T) {
+	const concurrency = 2
+
+	var (
+		inFlight, peak atomic.Int32
+		release        = make(chan struct{})
+	)
+	triggers := &fakeTriggers{
+		fire: func(*triggerdomain.FireTriggerArgs) (*triggerdomain.FireTriggerResult, error) {
+			n := inFlight.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			<-release
+			inFlight.Add(-1)
+			return &triggerdomain.FireTriggerResult{TaskName: "tasks/1"}, nil
+		},
+	}
+	source := &fakeSource{}
+	d := newTestDispatcher(triggers, source)
+	defer d.stopAll()
+
+	triggers.set(streamTrigger("triggers/a", concurrency, time.Time{}))
+	require.NoError(t, d.sync(context.Background()))
+	handler := source.consumers()[0].handler
+
+	msgs := make([]*fakeMsg, concurrency+1)
+	delivered := make(chan struct{}, len(msgs))
+	for i := range msgs {
+		msgs[i] = newFakeMsg(1)
+		go func() {
+			handler(msgs[i])
+			delivered <- struct{}{}
+		}()
+	}
+
+	// The pool takes two messages; the delivery of the third blocks.
+	require.Eventually(t, func() bool { return inFlight.Load() == concurrency }, 5*time.Second, time.Millisecond)
+	for range concurrency {
+		<-delivered
+	}
+	select {
+	case <-delivered:
+		t.Fatal("delivery was not held back by the full pool")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	for _, m := range msgs {
+		require.Equal(t, "ack", m.wait(t))
+	}
+	require.Equal(t, int32(concurrency), peak.Load())
+}
+
+func TestDispatcher_ConsumeStream_NaksAfterStop(t *testing.T) {
+	triggers := &fakeTriggers{}
+	source := &fakeSource{}
+	d := newTestDispatcher(triggers, source)
+
+	triggers.set(streamTrigger("triggers/a", 1, time.Time{}))
+	require.NoError(t, d.sync(context.Background()))
+	handler := source.consumers()[0].handler
+
+	// A message still handed over while the trigger stops goes back to
+	// the stream without firing.
+	d.stopAll()
+	msg := newFakeMsg(1)
+	handler(msg)
+	require.Equal(t, "nak", msg.wait(t))
+	require.Zero(t, msg.delay)
+}
+
+func TestRedeliveryDelay(t *testing.T) {
+	tests := []struct {
+		delivered uint64
+		want      time.Duration
+	}{
+		{delivered: 0, want: nakDelay},
+		{delivered: 1, want: nakDelay},
+		{delivered: 2, want: 2 * nakDelay},
+		{delivered: 5, want: 16 * nakDelay},
+		{delivered: 7, want: maxNakDelay},
+		{delivered: 1000, want: maxNakDelay},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, redeliveryDelay(tt.delivered), "delivered %d", tt.delivered)
+	}
+}
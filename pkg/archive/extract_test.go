@@ -0,0 +1,129 @@
+package archiveutils
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSafeJoin(t *testing.T) {
+	dst := filepath.Join(t.TempDir(), "dst")
+
+	tests := []struct {
+		name    string
+		entry   string
+		want    string
+		wantErr bool
+	}{
+		{name: "file", entry: "main.py", want: filepath.Join(dst, "main.py")},
+		{name: "nested", entry: "pkg/util/io.py", want: filepath.Join(dst, "pkg", "util", "io.py")},
+		{name: "dot segments inside", entry: "pkg/../main.py", want: filepath.Join(dst, "main.py")},
+		{name: "directory", entry: "pkg/", want: filepath.Join(dst, "pkg")},
+		{name: "parent", entry: "../main.py", wantErr: true},
+		{name: "parent only", entry: "..", wantErr: true},
+		{name: "escape after descent", entry: "pkg/../../main.py", wantErr: true},
+		{name: "sibling prefix", entry: "../dst-evil/main.py", wantErr: true},
+		{name: "absolute", entry: "/etc/passwd", wantErr: true},
+		{name: "absolute with parent", entry: "/../etc/passwd", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := safeJoin(dst, tt.entry)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExtract_RejectsUnsafeEntries(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		build  func(t *testing.T) []byte
+	}{
+		{
+			name:   "tar parent",
+			format: FormatTarGZ,
+			build:  tarGZ(&tar.Header{Name: "../evil.sh", Typeflag: tar.TypeReg, Mode: 0o644}),
+		},
+		{
+			name:   "tar absolute",
+			format: FormatTarGZ,
+			build:  tarGZ(&tar.Header{Name: "/tmp/evil.sh", Typeflag: tar.TypeReg, Mode: 0o644}),
+		},
+		{
+			name:   "tar symlink",
+			format: FormatTarGZ,
+			build:  tarGZ(&tar.Header{Name: "link", Typeflag: tar.TypeSymlink, Linkname: "/etc"}),
+		},
+		{
+			name:   "tar hardlink",
+			format: FormatTarGZ,
+			build:  tarGZ(&tar.Header{Name: "link", Typeflag: tar.TypeLink, Linkname: "/etc/passwd"}),
+		},
+		{
+			name:   "zip parent",
+			format: FormatZip,
+			build:  zipWith("../evil.sh", 0o644),
+		},
+		{
+			name:   "zip symlink",
+			format: FormatZip,
+			build:  zipWith("link", os.ModeSymlink|0o777),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			dst := filepath.Join(root, "dst")
+
+			err := Extract(bytes.NewReader(tt.build(t)), tt.format, dst)
+			require.Error(t, err)
+
+			entries, err := os.ReadDir(root)
+			require.NoError(t, err)
+			require.Len(t, entries, 1, "nothing may be written next to dst")
+			_, err = os.Lstat(filepath.Join(dst, "link"))
+			require.ErrorIs(t, err, os.ErrNotExist)
+		})
+	}
+}
+
+func tarGZ(headers ...*tar.Header) func(t *testing.T) []byte {
+	return func(t *testing.T) []byte {
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		tw := tar.NewWriter(gz)
+		for _, h := range headers {
+			require.NoError(t, tw.WriteHeader(h))
+		}
+		require.NoError(t, tw.Close())
+		require.NoError(t, gz.Close())
+		return buf.Bytes()
+	}
+}
+
+func zipWith(name string, mode os.FileMode) func(t *testing.T) []byte {
+	return func(t *testing.T) []byte {
+		var buf bytes.Buffer
+		zw := zip.NewWriter(&buf)
+		h := &zip.FileHeader{Name: name}
+		h.SetMode(mode)
+		w, err := zw.CreateHeader(h)
+		require.NoError(t, err)
+		_, err = w.Write([]byte("/etc"))
+		require.NoError(t, err)
+		require.NoError(t, zw.Close())
+		return buf.Bytes()
+	}
+}
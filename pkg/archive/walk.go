@@ -0,0 +1,81 @@
+package archiveutils
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+// WalkFunc is called for every regular file in an archive. name is the
+// cleaned slash-separated path; r is valid only during the call.
+type WalkFunc func(name string, r io.Reader) error
+
+// Walk calls fn for each regular file of the archive without extracting it.
+func Walk(src io.Reader, format string, fn WalkFunc) error {
+	switch format {
+	case FormatZip:
+		return walkZip(src, fn)
+	case FormatTarGZ:
+		return walkTarGZ(src, fn)
+	default:
+		return fmt.Errorf("unsupported archive format %q", format)
+	}
+}
+
+func walkZip(src io.Reader, fn WalkFunc) error {
+	zr, cleanup, err := spoolZip(src)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	for _, f := range zr.File {
+		if !f.Mode().IsRegular() {
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return err
+		}
+		err = fn(cleanName(f.Name), rc)
+		rc.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func walkTarGZ(src io.Reader, fn WalkFunc) error {
+	gz, err := gzip.NewReader(src)
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if h.Typeflag != tar.TypeReg {
+			continue
+		}
+		if err := fn(cleanName(h.Name), tr); err != nil {
+			return err
+		}
+	}
+}
+
+func cleanName(name string) string {
+	return strings.TrimPrefix(path.Clean("/"+name), "/")
+}
@@ -0,0 +1,53 @@
+package bufferutils
+
+import "bytes"
+
+// LimitedBuffer keeps the first Limit bytes written to it and records
+// whether anything was dropped. Writes never fail, so it is safe to use as
+// a process output sink.
+type LimitedBuffer struct {
+	buf       bytes.Buffer
+	limit     int
+	truncated bool
+}
+
+func NewLimitedBuffer(limit int) *LimitedBuffer {
+	return &LimitedBuffer{limit: limit}
+}
+
+func (b *LimitedBuffer) Write(p []byte) (int, error) {
+	n := len(p)
+	if room := b.limit - b.buf.Len(); room < len(p) {
+		p = p[:max(room, 0)]
+		b.truncated = true
+	}
+	b.buf.Write(p)
+	return n, nil
+}
+
+func (b *LimitedBuffer) Bytes() []byte   { return b.buf.Bytes() }
+func (b *LimitedBuffer) String() string  { return b.buf.String() }
+func (b *LimitedBuffer) Truncated() bool { return b.truncated }
+
+// TailBuffer keeps the last Limit bytes written to it.
+type TailBuffer struct {
+	buf       bytes.Buffer
+	limit     int
+	truncated bool
+}
+
+func NewTailBuffer(limit int) *TailBuffer {
+	return &TailBuffer{limit: limit}
+}
+
+func (b *TailBuffer) Write(p []byte) (int, error) {
+	b.buf.Write(p)
+	if over := b.buf.Len() - b.limit; over > 0 {
+		b.buf.Next(over)
+		b.truncated = true
+	}
+	return len(p), nil
+}
+
+func (b *TailBuffer) String() string  { return b.buf.String() }
+func (b *TailBuffer) Truncated() bool { return b.truncated }
@@ -0,0 +1,90 @@
+package digestutils
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"strings"
+)
+
+var (
+	ErrInvalidDigest = errors.New("invalid sha256 digest")
+	ErrMismatch      = errors.New("sha256 mismatch")
+)
+
+// objectStorePrefix is how the NATS object store reports digests
+// ("SHA-256=<base64url>").
+const objectStorePrefix = "SHA-256="
+
+// NormalizeSHA256 returns s as lowercase hex. It accepts hex in any case and
+// the NATS object store representation.
+func NormalizeSHA256(s string) (string, error) {
+	s = strings.TrimSpace(s)
+
+	if rest, ok := strings.CutPrefix(s, objectStorePrefix); ok {
+		b, err := base64.URLEncoding.DecodeString(rest)
+		if err != nil || len(b) != sha256.Size {
+			return "", fmt.Errorf("%w: %q", ErrInvalidDigest, s)
+		}
+		return hex.EncodeToString(b), nil
+	}
+
+	s = strings.ToLower(s)
+	if len(s) != hex.EncodedLen(sha256.Size) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidDigest, s)
+	}
+	if _, err := hex.DecodeString(s); err != nil {
+		return "", fmt.Errorf("%w: %q", ErrInvalidDigest, s)
+	}
+	return s, nil
+}
+
+// Reader hashes everything read through it.
+type Reader struct {
+	r io.Reader
+	h hash.Hash
+	n int64
+}
+
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: r, h: sha256.New()}
+}
+
+func (r *Reader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if n > 0 {
+		r.h.Write(p[:n])
+		r.n += int64(n)
+	}
+	return n, err
+}
+
+// SHA256 returns the lowercase hex digest of the bytes read so far.
+func (r *Reader) SHA256() string {
+	return hex.EncodeToString(r.h.Sum(nil))
+}
+
+// Size returns the number of bytes read so far.
+func (r *Reader) Size() int64 {
+	return r.n
+}
+
+// Verify drains the rest of the stream and compares the digest with want.
+func (r *Reader) Verify(want string) error {
+	if _, err := io.Copy(io.Discard, r); err != nil {
+		return err
+	}
+
+	want, err := NormalizeSHA256(want)
+	if err != nil {
+		return err
+	}
+	if got := r.SHA256(); got != want {
+		return fmt.Errorf("%w: expected %s, got %s", ErrMismatch, want, got)
+	}
+	return nil
+}
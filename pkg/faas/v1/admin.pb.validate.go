@@ -0,0 +1,450 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: faas/v1/admin.proto
+
+package faaspb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CollectGarbageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CollectGarbageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CollectGarbageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CollectGarbageRequestMultiError, or nil if none found.
+func (m *CollectGarbageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CollectGarbageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Delete
+
+	if all {
+		switch v := interface{}(m.GetMinAge()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CollectGarbageRequestValidationError{
+					field:  "MinAge",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CollectGarbageRequestValidationError{
+					field:  "MinAge",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMinAge()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CollectGarbageRequestValidationError{
+				field:  "MinAge",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CollectGarbageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CollectGarbageRequestMultiError is an error wrapping multiple validation
+// errors returned by CollectGarbageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CollectGarbageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CollectGarbageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CollectGarbageRequestMultiError) AllErrors() []error { return m }
+
+// CollectGarbageRequestValidationError is the validation error returned by
+// CollectGarbageRequest.Validate if the designated constraints aren't met.
+type CollectGarbageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CollectGarbageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CollectGarbageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CollectGarbageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CollectGarbageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CollectGarbageRequestValidationError) ErrorName() string {
+	return "CollectGarbageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CollectGarbageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCollectGarbageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CollectGarbageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CollectGarbageRequestValidationError{}
+
+// Validate checks the field values on Orphan with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Orphan) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Orphan with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in OrphanMultiError, or nil if none found.
+func (m *Orphan) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Orphan) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kind
+
+	// no validation rules for Store
+
+	// no validation rules for Key
+
+	// no validation rules for Size
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrphanValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrphanValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrphanValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Reason
+
+	// no validation rules for Deleted
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return OrphanMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrphanMultiError is an error wrapping multiple validation errors returned by
+// Orphan.ValidateAll() if the designated constraints aren't met.
+type OrphanMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrphanMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrphanMultiError) AllErrors() []error { return m }
+
+// OrphanValidationError is the validation error returned by Orphan.Validate if
+// the designated constraints aren't met.
+type OrphanValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrphanValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrphanValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrphanValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrphanValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrphanValidationError) ErrorName() string { return "OrphanValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrphanValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrphan.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrphanValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrphanValidationError{}
+
+// Validate checks the field values on CollectGarbageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CollectGarbageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CollectGarbageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CollectGarbageResponseMultiError, or nil if none found.
+func (m *CollectGarbageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CollectGarbageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOrphans() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CollectGarbageResponseValidationError{
+						field:  fmt.Sprintf("Orphans[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CollectGarbageResponseValidationError{
+						field:  fmt.Sprintf("Orphans[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CollectGarbageResponseValidationError{
+					field:  fmt.Sprintf("Orphans[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Deleted
+
+	// no validation rules for Failed
+
+	if len(errors) > 0 {
+		return CollectGarbageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CollectGarbageResponseMultiError is an error wrapping multiple validation
+// errors returned by CollectGarbageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CollectGarbageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CollectGarbageResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CollectGarbageResponseMultiError) AllErrors() []error { return m }
+
+// CollectGarbageResponseValidationError is the validation error returned by
+// CollectGarbageResponse.Validate if the designated constraints aren't met.
+type CollectGarbageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CollectGarbageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CollectGarbageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CollectGarbageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CollectGarbageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CollectGarbageResponseValidationError) ErrorName() string {
+	return "CollectGarbageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CollectGarbageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCollectGarbageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CollectGarbageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CollectGarbageResponseValidationError{}
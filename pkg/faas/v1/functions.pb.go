@@ -8,9 +8,12 @@ package faaspb
 
 import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	status "google.golang.org/genproto/googleapis/rpc/status"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -24,6 +27,107 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type FunctionState int32
+
+const (
+	FunctionState_FUNCTION_STATE_UNSPECIFIED FunctionState = 0
+	FunctionState_FUNCTION_STATE_ACTIVE      FunctionState = 1
+	FunctionState_FUNCTION_STATE_DELETED     FunctionState = 2
+)
+
+// Enum value maps for FunctionState.
+var (
+	FunctionState_name = map[int32]string{
+		0: "FUNCTION_STATE_UNSPECIFIED",
+		1: "FUNCTION_STATE_ACTIVE",
+		2: "FUNCTION_STATE_DELETED",
+	}
+	FunctionState_value = map[string]int32{
+		"FUNCTION_STATE_UNSPECIFIED": 0,
+		"FUNCTION_STATE_ACTIVE":      1,
+		"FUNCTION_STATE_DELETED":     2,
+	}
+)
+
+func (x FunctionState) Enum() *FunctionState {
+	p := new(FunctionState)
+	*p = x
+	return p
+}
+
+func (x FunctionState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FunctionState) Descriptor() protoreflect.EnumDescriptor {
+	return file_faas_v1_functions_proto_enumTypes[0].Descriptor()
+}
+
+func (FunctionState) Type() protoreflect.EnumType {
+	return &file_faas_v1_functions_proto_enumTypes[0]
+}
+
+func (x FunctionState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FunctionState.Descriptor instead.
+func (FunctionState) EnumDescriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{0}
+}
+
+type BuildState int32
+
+const (
+	BuildState_BUILD_STATE_UNSPECIFIED  BuildState = 0
+	BuildState_BUILD_STATE_BUILDING     BuildState = 1
+	BuildState_BUILD_STATE_READY        BuildState = 2
+	BuildState_BUILD_STATE_BUILD_FAILED BuildState = 3
+)
+
+// Enum value maps for BuildState.
+var (
+	BuildState_name = map[int32]string{
+		0: "BUILD_STATE_UNSPECIFIED",
+		1: "BUILD_STATE_BUILDING",
+		2: "BUILD_STATE_READY",
+		3: "BUILD_STATE_BUILD_FAILED",
+	}
+	BuildState_value = map[string]int32{
+		"BUILD_STATE_UNSPECIFIED":  0,
+		"BUILD_STATE_BUILDING":     1,
+		"BUILD_STATE_READY":        2,
+		"BUILD_STATE_BUILD_FAILED": 3,
+	}
+)
+
+func (x BuildState) Enum() *BuildState {
+	p := new(BuildState)
+	*p = x
+	return p
+}
+
+func (x BuildState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BuildState) Descriptor() protoreflect.EnumDescriptor {
+	return file_faas_v1_functions_proto_enumTypes[1].Descriptor()
+}
+
+func (BuildState) Type() protoreflect.EnumType {
+	return &file_faas_v1_functions_proto_enumTypes[1]
+}
+
+func (x BuildState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BuildState.Descriptor instead.
+func (BuildState) EnumDescriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{1}
+}
+
 type UploadFunctionMetadata_Format int32
 
 const (
@@ -57,11 +161,11 @@ func (x UploadFunctionMetadata_Format) String() string {
 }
 
 func (UploadFunctionMetadata_Format) Descriptor() protoreflect.EnumDescriptor {
-	return file_faas_v1_functions_proto_enumTypes[0].Descriptor()
+	return file_faas_v1_functions_proto_enumTypes[2].Descriptor()
 }
 
 func (UploadFunctionMetadata_Format) Type() protoreflect.EnumType {
-	return &file_faas_v1_functions_proto_enumTypes[0]
+	return &file_faas_v1_functions_proto_enumTypes[2]
 }
 
 func (x UploadFunctionMetadata_Format) Number() protoreflect.EnumNumber {
@@ -70,17 +174,55 @@ func (x UploadFunctionMetadata_Format) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UploadFunctionMetadata_Format.Descriptor instead.
 func (UploadFunctionMetadata_Format) EnumDescriptor() ([]byte, []int) {
-	return file_faas_v1_functions_proto_rawDescGZIP(), []int{3, 0}
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{7, 0}
 }
 
 type Function struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	UploadedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=uploaded_at,json=uploadedAt,proto3" json:"uploaded_at,omitempty"`
-	SourceBundle  *SourceBundle          `protobuf:"bytes,4,opt,name=source_bundle,json=sourceBundle,proto3" json:"source_bundle,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Name         string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DisplayName  string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	UploadedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=uploaded_at,json=uploadedAt,proto3" json:"uploaded_at,omitempty"`
+	SourceBundle *SourceBundle          `protobuf:"bytes,4,opt,name=source_bundle,json=sourceBundle,proto3" json:"source_bundle,omitempty"`
+	Env          map[string]string      `protobuf:"bytes,5,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	SecretEnv    map[string]string      `protobuf:"bytes,6,rep,name=secret_env,json=secretEnv,proto3" json:"secret_env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Build        *FunctionBuild         `protobuf:"bytes,7,opt,name=build,proto3" json:"build,omitempty"`
+	// Revisions are numbered from 1; each upload adds one.
+	Revision uint64 `protobuf:"varint,8,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Changes whenever the latest revision is modified; pass it back in
+	// UpdateFunction to detect concurrent writers.
+	Etag        string            `protobuf:"bytes,9,opt,name=etag,proto3" json:"etag,omitempty"`
+	Description string            `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
+	Labels      map[string]string `protobuf:"bytes,11,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Execution timeout; unset uses the agent default.
+	Timeout     *durationpb.Duration `protobuf:"bytes,12,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	MemoryBytes uint64               `protobuf:"varint,13,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
+	// Language runtime, e.g. "python3.12".
+	Runtime string `protobuf:"bytes,14,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	// Fields below are resolved from the bundle manifest.
+	Entrypoint  string       `protobuf:"bytes,15,opt,name=entrypoint,proto3" json:"entrypoint,omitempty"`
+	Handler     string       `protobuf:"bytes,16,opt,name=handler,proto3" json:"handler,omitempty"`
+	RetryPolicy *RetryPolicy `protobuf:"bytes,17,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	// JSON Schema the parameters of ExecuteFunction must satisfy, from the
+	// manifest or UpdateFunction. Violations fail with INVALID_ARGUMENT and
+	// BadRequest field violations. Empty accepts any parameters.
+	ParametersSchema string `protobuf:"bytes,18,opt,name=parameters_schema,json=parametersSchema,proto3" json:"parameters_schema,omitempty"`
+	// Free-form metadata; unlike labels it cannot be filtered on.
+	Annotations map[string]string `protobuf:"bytes,19,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	State       FunctionState     `protobuf:"varint,20,opt,name=state,proto3,enum=faas.v1.functions.FunctionState" json:"state,omitempty"`
+	// Set while the function is deleted; it can be undeleted until purge_time,
+	// after which it is removed together with its bundles.
+	DeleteTime *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=delete_time,json=deleteTime,proto3" json:"delete_time,omitempty"`
+	PurgeTime  *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=purge_time,json=purgeTime,proto3" json:"purge_time,omitempty"`
+	// JSON Schema a successful output must satisfy, from the manifest or
+	// UpdateFunction. Agents fail tasks whose output does not match with an
+	// "output contract violation".
+	OutputSchema string `protobuf:"bytes,23,opt,name=output_schema,json=outputSchema,proto3" json:"output_schema,omitempty"`
+	// Media type of the output, e.g. "application/json" or "text/csv";
+	// application/json when only output_schema is set. Copied to
+	// TaskResult.content_type.
+	OutputContentType string `protobuf:"bytes,24,opt,name=output_content_type,json=outputContentType,proto3" json:"output_content_type,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Function) Reset() {
@@ -141,19 +283,213 @@ func (x *Function) GetSourceBundle() *SourceBundle {
 	return nil
 }
 
-type SourceBundle struct {
+func (x *Function) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *Function) GetSecretEnv() map[string]string {
+	if x != nil {
+		return x.SecretEnv
+	}
+	return nil
+}
+
+func (x *Function) GetBuild() *FunctionBuild {
+	if x != nil {
+		return x.Build
+	}
+	return nil
+}
+
+func (x *Function) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *Function) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *Function) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Function) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Function) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Function) GetMemoryBytes() uint64 {
+	if x != nil {
+		return x.MemoryBytes
+	}
+	return 0
+}
+
+func (x *Function) GetRuntime() string {
+	if x != nil {
+		return x.Runtime
+	}
+	return ""
+}
+
+func (x *Function) GetEntrypoint() string {
+	if x != nil {
+		return x.Entrypoint
+	}
+	return ""
+}
+
+func (x *Function) GetHandler() string {
+	if x != nil {
+		return x.Handler
+	}
+	return ""
+}
+
+func (x *Function) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *Function) GetParametersSchema() string {
+	if x != nil {
+		return x.ParametersSchema
+	}
+	return ""
+}
+
+func (x *Function) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *Function) GetState() FunctionState {
+	if x != nil {
+		return x.State
+	}
+	return FunctionState_FUNCTION_STATE_UNSPECIFIED
+}
+
+func (x *Function) GetDeleteTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeleteTime
+	}
+	return nil
+}
+
+func (x *Function) GetPurgeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeTime
+	}
+	return nil
+}
+
+func (x *Function) GetOutputSchema() string {
+	if x != nil {
+		return x.OutputSchema
+	}
+	return ""
+}
+
+func (x *Function) GetOutputContentType() string {
+	if x != nil {
+		return x.OutputContentType
+	}
+	return ""
+}
+
+type RetryPolicy struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
-	ObjectKey     string                 `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
-	Size          uint64                 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-	Sha256        string                 `protobuf:"bytes,4,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	MaxAttempts   int32                  `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	Backoff       *durationpb.Duration   `protobuf:"bytes,2,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
+	mi := &file_faas_v1_functions_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPolicy) ProtoMessage() {}
+
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RetryPolicy) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.Backoff
+	}
+	return nil
+}
+
+type SourceBundle struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Bucket    string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	ObjectKey string                 `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	Size      uint64                 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Sha256    string                 `protobuf:"bytes,4,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	// Archive format, "zip" or "tar.gz".
+	Format        string `protobuf:"bytes,5,opt,name=format,proto3" json:"format,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SourceBundle) Reset() {
 	*x = SourceBundle{}
-	mi := &file_faas_v1_functions_proto_msgTypes[1]
+	mi := &file_faas_v1_functions_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -165,7 +501,7 @@ func (x *SourceBundle) String() string {
 func (*SourceBundle) ProtoMessage() {}
 
 func (x *SourceBundle) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_functions_proto_msgTypes[1]
+	mi := &file_faas_v1_functions_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -178,7 +514,7 @@ func (x *SourceBundle) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SourceBundle.ProtoReflect.Descriptor instead.
 func (*SourceBundle) Descriptor() ([]byte, []int) {
-	return file_faas_v1_functions_proto_rawDescGZIP(), []int{1}
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *SourceBundle) GetBucket() string {
@@ -209,32 +545,1906 @@ func (x *SourceBundle) GetSha256() string {
 	return ""
 }
 
-type UploadFunctionRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Payload:
-	//
-	//	*UploadFunctionRequest_UploadFunctionMetadata
-	//	*UploadFunctionRequest_UploadFunctionData
-	Payload       isUploadFunctionRequest_Payload `protobuf_oneof:"payload"`
+func (x *SourceBundle) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type FunctionBuild struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	State         BuildState             `protobuf:"varint,2,opt,name=state,proto3,enum=faas.v1.functions.BuildState" json:"state,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	Artifact      *SourceBundle          `protobuf:"bytes,5,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	Log           string                 `protobuf:"bytes,6,opt,name=log,proto3" json:"log,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadFunctionRequest) Reset() {
-	*x = UploadFunctionRequest{}
-	mi := &file_faas_v1_functions_proto_msgTypes[2]
+func (x *FunctionBuild) Reset() {
+	*x = FunctionBuild{}
+	mi := &file_faas_v1_functions_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FunctionBuild) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FunctionBuild) ProtoMessage() {}
+
+func (x *FunctionBuild) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FunctionBuild.ProtoReflect.Descriptor instead.
+func (*FunctionBuild) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FunctionBuild) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FunctionBuild) GetState() BuildState {
+	if x != nil {
+		return x.State
+	}
+	return BuildState_BUILD_STATE_UNSPECIFIED
+}
+
+func (x *FunctionBuild) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *FunctionBuild) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+func (x *FunctionBuild) GetArtifact() *SourceBundle {
+	if x != nil {
+		return x.Artifact
+	}
+	return nil
+}
+
+func (x *FunctionBuild) GetLog() string {
+	if x != nil {
+		return x.Log
+	}
+	return ""
+}
+
+func (x *FunctionBuild) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// A named pointer to one revision, or a weighted split across two.
+type FunctionAlias struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Function string                 `protobuf:"bytes,1,opt,name=function,proto3" json:"function,omitempty"`
+	Name     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// One route, or two whose weights add up to 100. The weight of a single
+	// route may be left 0.
+	Routes        []*AliasRoute          `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FunctionAlias) Reset() {
+	*x = FunctionAlias{}
+	mi := &file_faas_v1_functions_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FunctionAlias) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FunctionAlias) ProtoMessage() {}
+
+func (x *FunctionAlias) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FunctionAlias.ProtoReflect.Descriptor instead.
+func (*FunctionAlias) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FunctionAlias) GetFunction() string {
+	if x != nil {
+		return x.Function
+	}
+	return ""
+}
+
+func (x *FunctionAlias) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FunctionAlias) GetRoutes() []*AliasRoute {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+func (x *FunctionAlias) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type AliasRoute struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      uint64                 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Weight        uint32                 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AliasRoute) Reset() {
+	*x = AliasRoute{}
+	mi := &file_faas_v1_functions_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AliasRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AliasRoute) ProtoMessage() {}
+
+func (x *AliasRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AliasRoute.ProtoReflect.Descriptor instead.
+func (*AliasRoute) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AliasRoute) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *AliasRoute) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type UploadFunctionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*UploadFunctionRequest_UploadFunctionMetadata
+	//	*UploadFunctionRequest_UploadFunctionData
+	Payload       isUploadFunctionRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadFunctionRequest) Reset() {
+	*x = UploadFunctionRequest{}
+	mi := &file_faas_v1_functions_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadFunctionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFunctionRequest) ProtoMessage() {}
+
+func (x *UploadFunctionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFunctionRequest.ProtoReflect.Descriptor instead.
+func (*UploadFunctionRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UploadFunctionRequest) GetPayload() isUploadFunctionRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *UploadFunctionRequest) GetUploadFunctionMetadata() *UploadFunctionMetadata {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadFunctionRequest_UploadFunctionMetadata); ok {
+			return x.UploadFunctionMetadata
+		}
+	}
+	return nil
+}
+
+func (x *UploadFunctionRequest) GetUploadFunctionData() *UploadFunctionData {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadFunctionRequest_UploadFunctionData); ok {
+			return x.UploadFunctionData
+		}
+	}
+	return nil
+}
+
+type isUploadFunctionRequest_Payload interface {
+	isUploadFunctionRequest_Payload()
+}
+
+type UploadFunctionRequest_UploadFunctionMetadata struct {
+	UploadFunctionMetadata *UploadFunctionMetadata `protobuf:"bytes,1,opt,name=upload_function_metadata,json=uploadFunctionMetadata,proto3,oneof"`
+}
+
+type UploadFunctionRequest_UploadFunctionData struct {
+	UploadFunctionData *UploadFunctionData `protobuf:"bytes,2,opt,name=upload_function_data,json=uploadFunctionData,proto3,oneof"`
+}
+
+func (*UploadFunctionRequest_UploadFunctionMetadata) isUploadFunctionRequest_Payload() {}
+
+func (*UploadFunctionRequest_UploadFunctionData) isUploadFunctionRequest_Payload() {}
+
+type UploadFunctionMetadata struct {
+	state        protoimpl.MessageState        `protogen:"open.v1"`
+	FunctionName string                        `protobuf:"bytes,1,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
+	Format       UploadFunctionMetadata_Format `protobuf:"varint,3,opt,name=format,proto3,enum=faas.v1.functions.UploadFunctionMetadata_Format" json:"format,omitempty"`
+	Env          map[string]string             `protobuf:"bytes,4,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	SecretEnv    map[string]string             `protobuf:"bytes,5,rep,name=secret_env,json=secretEnv,proto3" json:"secret_env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Lowercase hex sha256 of the whole archive, verified by the server.
+	Sha256 string `protobuf:"bytes,6,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	// Unset fields below keep the value of the previous revision.
+	DisplayName   string               `protobuf:"bytes,7,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Description   string               `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	Labels        map[string]string    `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Timeout       *durationpb.Duration `protobuf:"bytes,10,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	MemoryBytes   uint64               `protobuf:"varint,11,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
+	Runtime       string               `protobuf:"bytes,12,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	Annotations   map[string]string    `protobuf:"bytes,13,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadFunctionMetadata) Reset() {
+	*x = UploadFunctionMetadata{}
+	mi := &file_faas_v1_functions_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadFunctionMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFunctionMetadata) ProtoMessage() {}
+
+func (x *UploadFunctionMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFunctionMetadata.ProtoReflect.Descriptor instead.
+func (*UploadFunctionMetadata) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UploadFunctionMetadata) GetFunctionName() string {
+	if x != nil {
+		return x.FunctionName
+	}
+	return ""
+}
+
+func (x *UploadFunctionMetadata) GetFormat() UploadFunctionMetadata_Format {
+	if x != nil {
+		return x.Format
+	}
+	return UploadFunctionMetadata_FORMAT_UNSPECIFIED
+}
+
+func (x *UploadFunctionMetadata) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *UploadFunctionMetadata) GetSecretEnv() map[string]string {
+	if x != nil {
+		return x.SecretEnv
+	}
+	return nil
+}
+
+func (x *UploadFunctionMetadata) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *UploadFunctionMetadata) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UploadFunctionMetadata) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UploadFunctionMetadata) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *UploadFunctionMetadata) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *UploadFunctionMetadata) GetMemoryBytes() uint64 {
+	if x != nil {
+		return x.MemoryBytes
+	}
+	return 0
+}
+
+func (x *UploadFunctionMetadata) GetRuntime() string {
+	if x != nil {
+		return x.Runtime
+	}
+	return ""
+}
+
+func (x *UploadFunctionMetadata) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+type UploadFunctionData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadFunctionData) Reset() {
+	*x = UploadFunctionData{}
+	mi := &file_faas_v1_functions_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadFunctionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFunctionData) ProtoMessage() {}
+
+func (x *UploadFunctionData) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFunctionData.ProtoReflect.Descriptor instead.
+func (*UploadFunctionData) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UploadFunctionData) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Abandoned sessions are removed once expire_time passes.
+type UploadSession struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "uploads/<id>"
+	Name         string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	FunctionName string `protobuf:"bytes,2,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
+	// Declared bundle size, 0 if unknown.
+	Size            uint64                 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	CommittedOffset uint64                 `protobuf:"varint,4,opt,name=committed_offset,json=committedOffset,proto3" json:"committed_offset,omitempty"`
+	CreateTime      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	ExpireTime      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UploadSession) Reset() {
+	*x = UploadSession{}
+	mi := &file_faas_v1_functions_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadSession) ProtoMessage() {}
+
+func (x *UploadSession) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadSession.ProtoReflect.Descriptor instead.
+func (*UploadSession) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UploadSession) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UploadSession) GetFunctionName() string {
+	if x != nil {
+		return x.FunctionName
+	}
+	return ""
+}
+
+func (x *UploadSession) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *UploadSession) GetCommittedOffset() uint64 {
+	if x != nil {
+		return x.CommittedOffset
+	}
+	return 0
+}
+
+func (x *UploadSession) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *UploadSession) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+type StartUploadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sha256 is ignored here and declared on FinalizeUpload instead.
+	Metadata      *UploadFunctionMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Size          uint64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartUploadRequest) Reset() {
+	*x = StartUploadRequest{}
+	mi := &file_faas_v1_functions_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartUploadRequest) ProtoMessage() {}
+
+func (x *StartUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartUploadRequest.ProtoReflect.Descriptor instead.
+func (*StartUploadRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StartUploadRequest) GetMetadata() *UploadFunctionMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *StartUploadRequest) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type UploadChunkRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Offset uint64                 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Data   []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// CRC-32C (Castagnoli) of data.
+	Crc32C        uint32 `protobuf:"varint,4,opt,name=crc32c,proto3" json:"crc32c,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadChunkRequest) Reset() {
+	*x = UploadChunkRequest{}
+	mi := &file_faas_v1_functions_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadChunkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadChunkRequest) ProtoMessage() {}
+
+func (x *UploadChunkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadChunkRequest.ProtoReflect.Descriptor instead.
+func (*UploadChunkRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UploadChunkRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UploadChunkRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *UploadChunkRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UploadChunkRequest) GetCrc32C() uint32 {
+	if x != nil {
+		return x.Crc32C
+	}
+	return 0
+}
+
+type GetUploadSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUploadSessionRequest) Reset() {
+	*x = GetUploadSessionRequest{}
+	mi := &file_faas_v1_functions_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUploadSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUploadSessionRequest) ProtoMessage() {}
+
+func (x *GetUploadSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUploadSessionRequest.ProtoReflect.Descriptor instead.
+func (*GetUploadSessionRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetUploadSessionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type FinalizeUploadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Hex SHA-256 of the whole bundle.
+	Sha256        string `protobuf:"bytes,2,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinalizeUploadRequest) Reset() {
+	*x = FinalizeUploadRequest{}
+	mi := &file_faas_v1_functions_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinalizeUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinalizeUploadRequest) ProtoMessage() {}
+
+func (x *FinalizeUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinalizeUploadRequest.ProtoReflect.Descriptor instead.
+func (*FinalizeUploadRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FinalizeUploadRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FinalizeUploadRequest) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+type ExecuteFunctionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Function name, optionally with an alias: "functions/foo@prod".
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Parameters string `protobuf:"bytes,2,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	// Revision to run; 0 runs the latest one.
+	Revision uint64 `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Labels and annotations of the created task.
+	Labels      map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations map[string]string `protobuf:"bytes,5,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Function label keys copied to the task, "*" for all of them. Labels
+	// given explicitly take precedence.
+	InheritLabels []string `protobuf:"bytes,6,rep,name=inherit_labels,json=inheritLabels,proto3" json:"inherit_labels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecuteFunctionRequest) Reset() {
+	*x = ExecuteFunctionRequest{}
+	mi := &file_faas_v1_functions_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteFunctionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteFunctionRequest) ProtoMessage() {}
+
+func (x *ExecuteFunctionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteFunctionRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteFunctionRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExecuteFunctionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExecuteFunctionRequest) GetParameters() string {
+	if x != nil {
+		return x.Parameters
+	}
+	return ""
+}
+
+func (x *ExecuteFunctionRequest) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *ExecuteFunctionRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ExecuteFunctionRequest) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *ExecuteFunctionRequest) GetInheritLabels() []string {
+	if x != nil {
+		return x.InheritLabels
+	}
+	return nil
+}
+
+type ExecuteFunctionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecuteFunctionResponse) Reset() {
+	*x = ExecuteFunctionResponse{}
+	mi := &file_faas_v1_functions_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteFunctionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteFunctionResponse) ProtoMessage() {}
+
+func (x *ExecuteFunctionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteFunctionResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteFunctionResponse) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExecuteFunctionResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type InvokeFunctionRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Execute       *ExecuteFunctionRequest `protobuf:"bytes,1,opt,name=execute,proto3" json:"execute,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvokeFunctionRequest) Reset() {
+	*x = InvokeFunctionRequest{}
+	mi := &file_faas_v1_functions_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvokeFunctionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeFunctionRequest) ProtoMessage() {}
+
+func (x *InvokeFunctionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeFunctionRequest.ProtoReflect.Descriptor instead.
+func (*InvokeFunctionRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *InvokeFunctionRequest) GetExecute() *ExecuteFunctionRequest {
+	if x != nil {
+		return x.Execute
+	}
+	return nil
+}
+
+type InvokeFunctionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Task  *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	// False when the wait ran out first: the task is still running and can be
+	// followed with GetTask. Invoking again would start another task.
+	Done          bool `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvokeFunctionResponse) Reset() {
+	*x = InvokeFunctionResponse{}
+	mi := &file_faas_v1_functions_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvokeFunctionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeFunctionResponse) ProtoMessage() {}
+
+func (x *InvokeFunctionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeFunctionResponse.ProtoReflect.Descriptor instead.
+func (*InvokeFunctionResponse) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *InvokeFunctionResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *InvokeFunctionResponse) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+// The first message must be execute; its parameters are not used. Each
+// following message is an item.
+type BatchExecuteFunctionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*BatchExecuteFunctionRequest_Execute
+	//	*BatchExecuteFunctionRequest_Item
+	Payload isBatchExecuteFunctionRequest_Payload `protobuf_oneof:"payload"`
+	// Fraction of tasks, from 0 to 1, that may fail without failing the job.
+	// Read from the first message only.
+	FailureThreshold float64 `protobuf:"fixed64,3,opt,name=failure_threshold,json=failureThreshold,proto3" json:"failure_threshold,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BatchExecuteFunctionRequest) Reset() {
+	*x = BatchExecuteFunctionRequest{}
+	mi := &file_faas_v1_functions_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchExecuteFunctionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchExecuteFunctionRequest) ProtoMessage() {}
+
+func (x *BatchExecuteFunctionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchExecuteFunctionRequest.ProtoReflect.Descriptor instead.
+func (*BatchExecuteFunctionRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BatchExecuteFunctionRequest) GetPayload() isBatchExecuteFunctionRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *BatchExecuteFunctionRequest) GetExecute() *ExecuteFunctionRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*BatchExecuteFunctionRequest_Execute); ok {
+			return x.Execute
+		}
+	}
+	return nil
+}
+
+func (x *BatchExecuteFunctionRequest) GetItem() *BatchItem {
+	if x != nil {
+		if x, ok := x.Payload.(*BatchExecuteFunctionRequest_Item); ok {
+			return x.Item
+		}
+	}
+	return nil
+}
+
+func (x *BatchExecuteFunctionRequest) GetFailureThreshold() float64 {
+	if x != nil {
+		return x.FailureThreshold
+	}
+	return 0
+}
+
+type isBatchExecuteFunctionRequest_Payload interface {
+	isBatchExecuteFunctionRequest_Payload()
+}
+
+type BatchExecuteFunctionRequest_Execute struct {
+	Execute *ExecuteFunctionRequest `protobuf:"bytes,1,opt,name=execute,proto3,oneof"`
+}
+
+type BatchExecuteFunctionRequest_Item struct {
+	Item *BatchItem `protobuf:"bytes,2,opt,name=item,proto3,oneof"`
+}
+
+func (*BatchExecuteFunctionRequest_Execute) isBatchExecuteFunctionRequest_Payload() {}
+
+func (*BatchExecuteFunctionRequest_Item) isBatchExecuteFunctionRequest_Payload() {}
+
+type BatchItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Parameters    string                 `protobuf:"bytes,1,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchItem) Reset() {
+	*x = BatchItem{}
+	mi := &file_faas_v1_functions_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchItem) ProtoMessage() {}
+
+func (x *BatchItem) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchItem.ProtoReflect.Descriptor instead.
+func (*BatchItem) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BatchItem) GetParameters() string {
+	if x != nil {
+		return x.Parameters
+	}
+	return ""
+}
+
+type BatchExecuteFunctionResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	BatchId string                 `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// Position of the item in the request stream, from 0.
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// Name of the created task, empty when error is set.
+	Task          string         `protobuf:"bytes,3,opt,name=task,proto3" json:"task,omitempty"`
+	Error         *status.Status `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchExecuteFunctionResponse) Reset() {
+	*x = BatchExecuteFunctionResponse{}
+	mi := &file_faas_v1_functions_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchExecuteFunctionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchExecuteFunctionResponse) ProtoMessage() {}
+
+func (x *BatchExecuteFunctionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchExecuteFunctionResponse.ProtoReflect.Descriptor instead.
+func (*BatchExecuteFunctionResponse) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BatchExecuteFunctionResponse) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *BatchExecuteFunctionResponse) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchExecuteFunctionResponse) GetTask() string {
+	if x != nil {
+		return x.Task
+	}
+	return ""
+}
+
+func (x *BatchExecuteFunctionResponse) GetError() *status.Status {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// The first message must be execute. Each input starts with input_header
+// followed by any number of input_data chunks.
+type ExecuteFunctionWithInputsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ExecuteFunctionWithInputsRequest_Execute
+	//	*ExecuteFunctionWithInputsRequest_InputHeader
+	//	*ExecuteFunctionWithInputsRequest_InputData
+	Payload       isExecuteFunctionWithInputsRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecuteFunctionWithInputsRequest) Reset() {
+	*x = ExecuteFunctionWithInputsRequest{}
+	mi := &file_faas_v1_functions_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteFunctionWithInputsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteFunctionWithInputsRequest) ProtoMessage() {}
+
+func (x *ExecuteFunctionWithInputsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteFunctionWithInputsRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteFunctionWithInputsRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ExecuteFunctionWithInputsRequest) GetPayload() isExecuteFunctionWithInputsRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ExecuteFunctionWithInputsRequest) GetExecute() *ExecuteFunctionRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*ExecuteFunctionWithInputsRequest_Execute); ok {
+			return x.Execute
+		}
+	}
+	return nil
+}
+
+func (x *ExecuteFunctionWithInputsRequest) GetInputHeader() *TaskInputHeader {
+	if x != nil {
+		if x, ok := x.Payload.(*ExecuteFunctionWithInputsRequest_InputHeader); ok {
+			return x.InputHeader
+		}
+	}
+	return nil
+}
+
+func (x *ExecuteFunctionWithInputsRequest) GetInputData() *TaskInputData {
+	if x != nil {
+		if x, ok := x.Payload.(*ExecuteFunctionWithInputsRequest_InputData); ok {
+			return x.InputData
+		}
+	}
+	return nil
+}
+
+type isExecuteFunctionWithInputsRequest_Payload interface {
+	isExecuteFunctionWithInputsRequest_Payload()
+}
+
+type ExecuteFunctionWithInputsRequest_Execute struct {
+	Execute *ExecuteFunctionRequest `protobuf:"bytes,1,opt,name=execute,proto3,oneof"`
+}
+
+type ExecuteFunctionWithInputsRequest_InputHeader struct {
+	InputHeader *TaskInputHeader `protobuf:"bytes,2,opt,name=input_header,json=inputHeader,proto3,oneof"`
+}
+
+type ExecuteFunctionWithInputsRequest_InputData struct {
+	InputData *TaskInputData `protobuf:"bytes,3,opt,name=input_data,json=inputData,proto3,oneof"`
+}
+
+func (*ExecuteFunctionWithInputsRequest_Execute) isExecuteFunctionWithInputsRequest_Payload() {}
+
+func (*ExecuteFunctionWithInputsRequest_InputHeader) isExecuteFunctionWithInputsRequest_Payload() {}
+
+func (*ExecuteFunctionWithInputsRequest_InputData) isExecuteFunctionWithInputsRequest_Payload() {}
+
+type TaskInputHeader struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Path relative to the task's inputs directory, e.g. "data.csv".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional lowercase hex sha256, verified by the server.
+	Sha256        string `protobuf:"bytes,2,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskInputHeader) Reset() {
+	*x = TaskInputHeader{}
+	mi := &file_faas_v1_functions_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskInputHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskInputHeader) ProtoMessage() {}
+
+func (x *TaskInputHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskInputHeader.ProtoReflect.Descriptor instead.
+func (*TaskInputHeader) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TaskInputHeader) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaskInputHeader) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+type TaskInputData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskInputData) Reset() {
+	*x = TaskInputData{}
+	mi := &file_faas_v1_functions_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskInputData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskInputData) ProtoMessage() {}
+
+func (x *TaskInputData) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskInputData.ProtoReflect.Descriptor instead.
+func (*TaskInputData) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TaskInputData) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetFunctionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFunctionRequest) Reset() {
+	*x = GetFunctionRequest{}
+	mi := &file_faas_v1_functions_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFunctionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFunctionRequest) ProtoMessage() {}
+
+func (x *GetFunctionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFunctionRequest.ProtoReflect.Descriptor instead.
+func (*GetFunctionRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetFunctionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListFunctionsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// AIP-160 style filter, e.g. `labels.team = "images" AND runtime = "python3.12"`.
+	// Supports name and runtime (= and !=, trailing * for prefixes),
+	// labels.<key> (=, !=, labels:<key> for presence) and uploaded_at
+	// (=, !=, <, <=, >, >= with RFC 3339 values). Terms are joined with AND.
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Comma-separated fields with optional "desc": name, uploaded_at.
+	// Defaults to name.
+	OrderBy string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Also list deleted functions that have not been purged yet.
+	ShowDeleted   bool `protobuf:"varint,5,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFunctionsRequest) Reset() {
+	*x = ListFunctionsRequest{}
+	mi := &file_faas_v1_functions_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFunctionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFunctionsRequest) ProtoMessage() {}
+
+func (x *ListFunctionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFunctionsRequest.ProtoReflect.Descriptor instead.
+func (*ListFunctionsRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListFunctionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListFunctionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListFunctionsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListFunctionsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListFunctionsRequest) GetShowDeleted() bool {
+	if x != nil {
+		return x.ShowDeleted
+	}
+	return false
+}
+
+type ListFunctionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Functions     []*Function            `protobuf:"bytes,1,rep,name=functions,proto3" json:"functions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFunctionsResponse) Reset() {
+	*x = ListFunctionsResponse{}
+	mi := &file_faas_v1_functions_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFunctionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFunctionsResponse) ProtoMessage() {}
+
+func (x *ListFunctionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFunctionsResponse.ProtoReflect.Descriptor instead.
+func (*ListFunctionsResponse) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListFunctionsResponse) GetFunctions() []*Function {
+	if x != nil {
+		return x.Functions
+	}
+	return nil
+}
+
+func (x *ListFunctionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListFunctionRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFunctionRevisionsRequest) Reset() {
+	*x = ListFunctionRevisionsRequest{}
+	mi := &file_faas_v1_functions_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFunctionRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFunctionRevisionsRequest) ProtoMessage() {}
+
+func (x *ListFunctionRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFunctionRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListFunctionRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListFunctionRevisionsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListFunctionRevisionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListFunctionRevisionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListFunctionRevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*Function            `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFunctionRevisionsResponse) Reset() {
+	*x = ListFunctionRevisionsResponse{}
+	mi := &file_faas_v1_functions_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFunctionRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFunctionRevisionsResponse) ProtoMessage() {}
+
+func (x *ListFunctionRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFunctionRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListFunctionRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListFunctionRevisionsResponse) GetRevisions() []*Function {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *ListFunctionRevisionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetFunctionRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Revision      uint64                 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFunctionRevisionRequest) Reset() {
+	*x = GetFunctionRevisionRequest{}
+	mi := &file_faas_v1_functions_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFunctionRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFunctionRevisionRequest) ProtoMessage() {}
+
+func (x *GetFunctionRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFunctionRevisionRequest.ProtoReflect.Descriptor instead.
+func (*GetFunctionRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetFunctionRevisionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetFunctionRevisionRequest) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type UpdateFunctionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name identifies the function; etag, if set, must match the stored one.
+	Function *Function `protobuf:"bytes,1,opt,name=function,proto3" json:"function,omitempty"`
+	// Fields to update: display_name, description, labels, annotations,
+	// timeout, memory_bytes, runtime, env, secret_env, parameters_schema,
+	// output_schema, output_content_type.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFunctionRequest) Reset() {
+	*x = UpdateFunctionRequest{}
+	mi := &file_faas_v1_functions_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFunctionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFunctionRequest) ProtoMessage() {}
+
+func (x *UpdateFunctionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFunctionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFunctionRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateFunctionRequest) GetFunction() *Function {
+	if x != nil {
+		return x.Function
+	}
+	return nil
+}
+
+func (x *UpdateFunctionRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteFunctionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Delete even if the function has pending or processing tasks, and cancel
+	// them. Without it such a delete fails with FAILED_PRECONDITION.
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFunctionRequest) Reset() {
+	*x = DeleteFunctionRequest{}
+	mi := &file_faas_v1_functions_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFunctionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFunctionRequest) ProtoMessage() {}
+
+func (x *DeleteFunctionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFunctionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFunctionRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DeleteFunctionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteFunctionRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type UndeleteFunctionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndeleteFunctionRequest) Reset() {
+	*x = UndeleteFunctionRequest{}
+	mi := &file_faas_v1_functions_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadFunctionRequest) String() string {
+func (x *UndeleteFunctionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadFunctionRequest) ProtoMessage() {}
+func (*UndeleteFunctionRequest) ProtoMessage() {}
 
-func (x *UploadFunctionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_functions_proto_msgTypes[2]
+func (x *UndeleteFunctionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -245,75 +2455,91 @@ func (x *UploadFunctionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadFunctionRequest.ProtoReflect.Descriptor instead.
-func (*UploadFunctionRequest) Descriptor() ([]byte, []int) {
-	return file_faas_v1_functions_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use UndeleteFunctionRequest.ProtoReflect.Descriptor instead.
+func (*UndeleteFunctionRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *UploadFunctionRequest) GetPayload() isUploadFunctionRequest_Payload {
+func (x *UndeleteFunctionRequest) GetName() string {
 	if x != nil {
-		return x.Payload
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *UploadFunctionRequest) GetUploadFunctionMetadata() *UploadFunctionMetadata {
-	if x != nil {
-		if x, ok := x.Payload.(*UploadFunctionRequest_UploadFunctionMetadata); ok {
-			return x.UploadFunctionMetadata
-		}
-	}
-	return nil
+// Functions named "functions/<namespace>/<id>" belong to <namespace>, all
+// others to "default".
+type GetNamespaceUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadFunctionRequest) GetUploadFunctionData() *UploadFunctionData {
-	if x != nil {
-		if x, ok := x.Payload.(*UploadFunctionRequest_UploadFunctionData); ok {
-			return x.UploadFunctionData
-		}
-	}
-	return nil
+func (x *GetNamespaceUsageRequest) Reset() {
+	*x = GetNamespaceUsageRequest{}
+	mi := &file_faas_v1_functions_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type isUploadFunctionRequest_Payload interface {
-	isUploadFunctionRequest_Payload()
+func (x *GetNamespaceUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type UploadFunctionRequest_UploadFunctionMetadata struct {
-	UploadFunctionMetadata *UploadFunctionMetadata `protobuf:"bytes,1,opt,name=upload_function_metadata,json=uploadFunctionMetadata,proto3,oneof"`
-}
+func (*GetNamespaceUsageRequest) ProtoMessage() {}
 
-type UploadFunctionRequest_UploadFunctionData struct {
-	UploadFunctionData *UploadFunctionData `protobuf:"bytes,2,opt,name=upload_function_data,json=uploadFunctionData,proto3,oneof"`
+func (x *GetNamespaceUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*UploadFunctionRequest_UploadFunctionMetadata) isUploadFunctionRequest_Payload() {}
+// Deprecated: Use GetNamespaceUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetNamespaceUsageRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{33}
+}
 
-func (*UploadFunctionRequest_UploadFunctionData) isUploadFunctionRequest_Payload() {}
+func (x *GetNamespaceUsageRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
 
-type UploadFunctionMetadata struct {
-	state         protoimpl.MessageState        `protogen:"open.v1"`
-	FunctionName  string                        `protobuf:"bytes,1,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
-	Format        UploadFunctionMetadata_Format `protobuf:"varint,3,opt,name=format,proto3,enum=faas.v1.functions.UploadFunctionMetadata_Format" json:"format,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// bundle_bytes counts each distinct bundle once, however many revisions
+// share it. Zero limits mean unlimited.
+type NamespaceUsage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Namespace      string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	BundleBytes    uint64                 `protobuf:"varint,2,opt,name=bundle_bytes,json=bundleBytes,proto3" json:"bundle_bytes,omitempty"`
+	QuotaBytes     uint64                 `protobuf:"varint,3,opt,name=quota_bytes,json=quotaBytes,proto3" json:"quota_bytes,omitempty"`
+	MaxBundleBytes uint64                 `protobuf:"varint,4,opt,name=max_bundle_bytes,json=maxBundleBytes,proto3" json:"max_bundle_bytes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UploadFunctionMetadata) Reset() {
-	*x = UploadFunctionMetadata{}
-	mi := &file_faas_v1_functions_proto_msgTypes[3]
+func (x *NamespaceUsage) Reset() {
+	*x = NamespaceUsage{}
+	mi := &file_faas_v1_functions_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadFunctionMetadata) String() string {
+func (x *NamespaceUsage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadFunctionMetadata) ProtoMessage() {}
+func (*NamespaceUsage) ProtoMessage() {}
 
-func (x *UploadFunctionMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_functions_proto_msgTypes[3]
+func (x *NamespaceUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -324,47 +2550,63 @@ func (x *UploadFunctionMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadFunctionMetadata.ProtoReflect.Descriptor instead.
-func (*UploadFunctionMetadata) Descriptor() ([]byte, []int) {
-	return file_faas_v1_functions_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use NamespaceUsage.ProtoReflect.Descriptor instead.
+func (*NamespaceUsage) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *UploadFunctionMetadata) GetFunctionName() string {
+func (x *NamespaceUsage) GetNamespace() string {
 	if x != nil {
-		return x.FunctionName
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *UploadFunctionMetadata) GetFormat() UploadFunctionMetadata_Format {
+func (x *NamespaceUsage) GetBundleBytes() uint64 {
 	if x != nil {
-		return x.Format
+		return x.BundleBytes
 	}
-	return UploadFunctionMetadata_FORMAT_UNSPECIFIED
+	return 0
 }
 
-type UploadFunctionData struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+func (x *NamespaceUsage) GetQuotaBytes() uint64 {
+	if x != nil {
+		return x.QuotaBytes
+	}
+	return 0
+}
+
+func (x *NamespaceUsage) GetMaxBundleBytes() uint64 {
+	if x != nil {
+		return x.MaxBundleBytes
+	}
+	return 0
+}
+
+type DownloadFunctionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Revision to download; 0 means the latest.
+	Revision      uint64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadFunctionData) Reset() {
-	*x = UploadFunctionData{}
-	mi := &file_faas_v1_functions_proto_msgTypes[4]
+func (x *DownloadFunctionRequest) Reset() {
+	*x = DownloadFunctionRequest{}
+	mi := &file_faas_v1_functions_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadFunctionData) String() string {
+func (x *DownloadFunctionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadFunctionData) ProtoMessage() {}
+func (*DownloadFunctionRequest) ProtoMessage() {}
 
-func (x *UploadFunctionData) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_functions_proto_msgTypes[4]
+func (x *DownloadFunctionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -375,41 +2617,53 @@ func (x *UploadFunctionData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadFunctionData.ProtoReflect.Descriptor instead.
-func (*UploadFunctionData) Descriptor() ([]byte, []int) {
-	return file_faas_v1_functions_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use DownloadFunctionRequest.ProtoReflect.Descriptor instead.
+func (*DownloadFunctionRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *UploadFunctionData) GetData() []byte {
+func (x *DownloadFunctionRequest) GetName() string {
 	if x != nil {
-		return x.Data
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type ExecuteFunctionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Parameters    string                 `protobuf:"bytes,2,opt,name=parameters,proto3" json:"parameters,omitempty"`
+func (x *DownloadFunctionRequest) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+// The first message carries the function and its bundle metadata, the rest
+// carry bundle data.
+type DownloadFunctionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*DownloadFunctionResponse_Function
+	//	*DownloadFunctionResponse_Data
+	Payload       isDownloadFunctionResponse_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExecuteFunctionRequest) Reset() {
-	*x = ExecuteFunctionRequest{}
-	mi := &file_faas_v1_functions_proto_msgTypes[5]
+func (x *DownloadFunctionResponse) Reset() {
+	*x = DownloadFunctionResponse{}
+	mi := &file_faas_v1_functions_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecuteFunctionRequest) String() string {
+func (x *DownloadFunctionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecuteFunctionRequest) ProtoMessage() {}
+func (*DownloadFunctionResponse) ProtoMessage() {}
 
-func (x *ExecuteFunctionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_functions_proto_msgTypes[5]
+func (x *DownloadFunctionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -420,47 +2674,74 @@ func (x *ExecuteFunctionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecuteFunctionRequest.ProtoReflect.Descriptor instead.
-func (*ExecuteFunctionRequest) Descriptor() ([]byte, []int) {
-	return file_faas_v1_functions_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use DownloadFunctionResponse.ProtoReflect.Descriptor instead.
+func (*DownloadFunctionResponse) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *ExecuteFunctionRequest) GetName() string {
+func (x *DownloadFunctionResponse) GetPayload() isDownloadFunctionResponse_Payload {
 	if x != nil {
-		return x.Name
+		return x.Payload
 	}
-	return ""
+	return nil
 }
 
-func (x *ExecuteFunctionRequest) GetParameters() string {
+func (x *DownloadFunctionResponse) GetFunction() *Function {
 	if x != nil {
-		return x.Parameters
+		if x, ok := x.Payload.(*DownloadFunctionResponse_Function); ok {
+			return x.Function
+		}
 	}
-	return ""
+	return nil
 }
 
-type ExecuteFunctionResponse struct {
+func (x *DownloadFunctionResponse) GetData() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*DownloadFunctionResponse_Data); ok {
+			return x.Data
+		}
+	}
+	return nil
+}
+
+type isDownloadFunctionResponse_Payload interface {
+	isDownloadFunctionResponse_Payload()
+}
+
+type DownloadFunctionResponse_Function struct {
+	Function *Function `protobuf:"bytes,1,opt,name=function,proto3,oneof"`
+}
+
+type DownloadFunctionResponse_Data struct {
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3,oneof"`
+}
+
+func (*DownloadFunctionResponse_Function) isDownloadFunctionResponse_Payload() {}
+
+func (*DownloadFunctionResponse_Data) isDownloadFunctionResponse_Payload() {}
+
+type UpdateAliasRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Alias         *FunctionAlias         `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExecuteFunctionResponse) Reset() {
-	*x = ExecuteFunctionResponse{}
-	mi := &file_faas_v1_functions_proto_msgTypes[6]
+func (x *UpdateAliasRequest) Reset() {
+	*x = UpdateAliasRequest{}
+	mi := &file_faas_v1_functions_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecuteFunctionResponse) String() string {
+func (x *UpdateAliasRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecuteFunctionResponse) ProtoMessage() {}
+func (*UpdateAliasRequest) ProtoMessage() {}
 
-func (x *ExecuteFunctionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_functions_proto_msgTypes[6]
+func (x *UpdateAliasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -471,40 +2752,41 @@ func (x *ExecuteFunctionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecuteFunctionResponse.ProtoReflect.Descriptor instead.
-func (*ExecuteFunctionResponse) Descriptor() ([]byte, []int) {
-	return file_faas_v1_functions_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use UpdateAliasRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAliasRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ExecuteFunctionResponse) GetName() string {
+func (x *UpdateAliasRequest) GetAlias() *FunctionAlias {
 	if x != nil {
-		return x.Name
+		return x.Alias
 	}
-	return ""
+	return nil
 }
 
-type GetFunctionRequest struct {
+type GetAliasRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Function      string                 `protobuf:"bytes,1,opt,name=function,proto3" json:"function,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFunctionRequest) Reset() {
-	*x = GetFunctionRequest{}
-	mi := &file_faas_v1_functions_proto_msgTypes[7]
+func (x *GetAliasRequest) Reset() {
+	*x = GetAliasRequest{}
+	mi := &file_faas_v1_functions_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFunctionRequest) String() string {
+func (x *GetAliasRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFunctionRequest) ProtoMessage() {}
+func (*GetAliasRequest) ProtoMessage() {}
 
-func (x *GetFunctionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_functions_proto_msgTypes[7]
+func (x *GetAliasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -515,41 +2797,47 @@ func (x *GetFunctionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFunctionRequest.ProtoReflect.Descriptor instead.
-func (*GetFunctionRequest) Descriptor() ([]byte, []int) {
-	return file_faas_v1_functions_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetAliasRequest.ProtoReflect.Descriptor instead.
+func (*GetAliasRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetFunctionRequest) GetName() string {
+func (x *GetAliasRequest) GetFunction() string {
+	if x != nil {
+		return x.Function
+	}
+	return ""
+}
+
+func (x *GetAliasRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type ListFunctionsRequest struct {
+type ListAliasesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Function      string                 `protobuf:"bytes,1,opt,name=function,proto3" json:"function,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListFunctionsRequest) Reset() {
-	*x = ListFunctionsRequest{}
-	mi := &file_faas_v1_functions_proto_msgTypes[8]
+func (x *ListAliasesRequest) Reset() {
+	*x = ListAliasesRequest{}
+	mi := &file_faas_v1_functions_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListFunctionsRequest) String() string {
+func (x *ListAliasesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFunctionsRequest) ProtoMessage() {}
+func (*ListAliasesRequest) ProtoMessage() {}
 
-func (x *ListFunctionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_functions_proto_msgTypes[8]
+func (x *ListAliasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -560,48 +2848,40 @@ func (x *ListFunctionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListFunctionsRequest.ProtoReflect.Descriptor instead.
-func (*ListFunctionsRequest) Descriptor() ([]byte, []int) {
-	return file_faas_v1_functions_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *ListFunctionsRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+// Deprecated: Use ListAliasesRequest.ProtoReflect.Descriptor instead.
+func (*ListAliasesRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *ListFunctionsRequest) GetPageToken() string {
+func (x *ListAliasesRequest) GetFunction() string {
 	if x != nil {
-		return x.PageToken
+		return x.Function
 	}
 	return ""
 }
 
-type ListFunctionsResponse struct {
+type ListAliasesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Functions     []*Function            `protobuf:"bytes,1,rep,name=functions,proto3" json:"functions,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Aliases       []*FunctionAlias       `protobuf:"bytes,1,rep,name=aliases,proto3" json:"aliases,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListFunctionsResponse) Reset() {
-	*x = ListFunctionsResponse{}
-	mi := &file_faas_v1_functions_proto_msgTypes[9]
+func (x *ListAliasesResponse) Reset() {
+	*x = ListAliasesResponse{}
+	mi := &file_faas_v1_functions_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListFunctionsResponse) String() string {
+func (x *ListAliasesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFunctionsResponse) ProtoMessage() {}
+func (*ListAliasesResponse) ProtoMessage() {}
 
-func (x *ListFunctionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_functions_proto_msgTypes[9]
+func (x *ListAliasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -612,47 +2892,41 @@ func (x *ListFunctionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListFunctionsResponse.ProtoReflect.Descriptor instead.
-func (*ListFunctionsResponse) Descriptor() ([]byte, []int) {
-	return file_faas_v1_functions_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ListAliasesResponse.ProtoReflect.Descriptor instead.
+func (*ListAliasesResponse) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *ListFunctionsResponse) GetFunctions() []*Function {
+func (x *ListAliasesResponse) GetAliases() []*FunctionAlias {
 	if x != nil {
-		return x.Functions
+		return x.Aliases
 	}
 	return nil
 }
 
-func (x *ListFunctionsResponse) GetNextPageToken() string {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return ""
-}
-
-type DeleteFunctionRequest struct {
+type DeleteAliasRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Function      string                 `protobuf:"bytes,1,opt,name=function,proto3" json:"function,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteFunctionRequest) Reset() {
-	*x = DeleteFunctionRequest{}
-	mi := &file_faas_v1_functions_proto_msgTypes[10]
+func (x *DeleteAliasRequest) Reset() {
+	*x = DeleteAliasRequest{}
+	mi := &file_faas_v1_functions_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteFunctionRequest) String() string {
+func (x *DeleteAliasRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteFunctionRequest) ProtoMessage() {}
+func (*DeleteAliasRequest) ProtoMessage() {}
 
-func (x *DeleteFunctionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_functions_proto_msgTypes[10]
+func (x *DeleteAliasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_functions_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -663,12 +2937,19 @@ func (x *DeleteFunctionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteFunctionRequest.ProtoReflect.Descriptor instead.
-func (*DeleteFunctionRequest) Descriptor() ([]byte, []int) {
-	return file_faas_v1_functions_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use DeleteAliasRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAliasRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_functions_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *DeleteFunctionRequest) GetName() string {
+func (x *DeleteAliasRequest) GetFunction() string {
+	if x != nil {
+		return x.Function
+	}
+	return ""
+}
+
+func (x *DeleteAliasRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
@@ -679,57 +2960,280 @@ var File_faas_v1_functions_proto protoreflect.FileDescriptor
 
 const file_faas_v1_functions_proto_rawDesc = "" +
 	"\n" +
-	"\x17faas/v1/functions.proto\x12\x11faas.v1.functions\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\"\xc4\x01\n" +
+	"\x17faas/v1/functions.proto\x12\x11faas.v1.functions\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x17google/rpc/status.proto\x1a\x13faas/v1/tasks.proto\"\xf4\n" +
+	"\n" +
 	"\bFunction\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12;\n" +
 	"\vuploaded_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"uploadedAt\x12D\n" +
-	"\rsource_bundle\x18\x04 \x01(\v2\x1f.faas.v1.functions.SourceBundleR\fsourceBundle\"q\n" +
+	"\rsource_bundle\x18\x04 \x01(\v2\x1f.faas.v1.functions.SourceBundleR\fsourceBundle\x126\n" +
+	"\x03env\x18\x05 \x03(\v2$.faas.v1.functions.Function.EnvEntryR\x03env\x12I\n" +
+	"\n" +
+	"secret_env\x18\x06 \x03(\v2*.faas.v1.functions.Function.SecretEnvEntryR\tsecretEnv\x126\n" +
+	"\x05build\x18\a \x01(\v2 .faas.v1.functions.FunctionBuildR\x05build\x12\x1a\n" +
+	"\brevision\x18\b \x01(\x04R\brevision\x12\x12\n" +
+	"\x04etag\x18\t \x01(\tR\x04etag\x12 \n" +
+	"\vdescription\x18\n" +
+	" \x01(\tR\vdescription\x12?\n" +
+	"\x06labels\x18\v \x03(\v2'.faas.v1.functions.Function.LabelsEntryR\x06labels\x123\n" +
+	"\atimeout\x18\f \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12!\n" +
+	"\fmemory_bytes\x18\r \x01(\x04R\vmemoryBytes\x12\x18\n" +
+	"\aruntime\x18\x0e \x01(\tR\aruntime\x12\x1e\n" +
+	"\n" +
+	"entrypoint\x18\x0f \x01(\tR\n" +
+	"entrypoint\x12\x18\n" +
+	"\ahandler\x18\x10 \x01(\tR\ahandler\x12A\n" +
+	"\fretry_policy\x18\x11 \x01(\v2\x1e.faas.v1.functions.RetryPolicyR\vretryPolicy\x12+\n" +
+	"\x11parameters_schema\x18\x12 \x01(\tR\x10parametersSchema\x12N\n" +
+	"\vannotations\x18\x13 \x03(\v2,.faas.v1.functions.Function.AnnotationsEntryR\vannotations\x126\n" +
+	"\x05state\x18\x14 \x01(\x0e2 .faas.v1.functions.FunctionStateR\x05state\x12;\n" +
+	"\vdelete_time\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"deleteTime\x129\n" +
+	"\n" +
+	"purge_time\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampR\tpurgeTime\x12#\n" +
+	"\routput_schema\x18\x17 \x01(\tR\foutputSchema\x12.\n" +
+	"\x13output_content_type\x18\x18 \x01(\tR\x11outputContentType\x1a6\n" +
+	"\bEnvEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a<\n" +
+	"\x0eSecretEnvEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"e\n" +
+	"\vRetryPolicy\x12!\n" +
+	"\fmax_attempts\x18\x01 \x01(\x05R\vmaxAttempts\x123\n" +
+	"\abackoff\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\abackoff\"\x89\x01\n" +
 	"\fSourceBundle\x12\x16\n" +
 	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x1d\n" +
 	"\n" +
 	"object_key\x18\x02 \x01(\tR\tobjectKey\x12\x12\n" +
 	"\x04size\x18\x03 \x01(\x04R\x04size\x12\x16\n" +
-	"\x06sha256\x18\x04 \x01(\tR\x06sha256\"\xe4\x01\n" +
+	"\x06sha256\x18\x04 \x01(\tR\x06sha256\x12\x16\n" +
+	"\x06format\x18\x05 \x01(\tR\x06format\"\xba\x02\n" +
+	"\rFunctionBuild\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x123\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x1d.faas.v1.functions.BuildStateR\x05state\x129\n" +
+	"\n" +
+	"started_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x125\n" +
+	"\bended_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendedAt\x12;\n" +
+	"\bartifact\x18\x05 \x01(\v2\x1f.faas.v1.functions.SourceBundleR\bartifact\x12\x10\n" +
+	"\x03log\x18\x06 \x01(\tR\x03log\x12#\n" +
+	"\rerror_message\x18\a \x01(\tR\ferrorMessage\"\xb1\x01\n" +
+	"\rFunctionAlias\x12\x1a\n" +
+	"\bfunction\x18\x01 \x01(\tR\bfunction\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x125\n" +
+	"\x06routes\x18\x03 \x03(\v2\x1d.faas.v1.functions.AliasRouteR\x06routes\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"@\n" +
+	"\n" +
+	"AliasRoute\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x04R\brevision\x12\x16\n" +
+	"\x06weight\x18\x02 \x01(\rR\x06weight\"\xe4\x01\n" +
 	"\x15UploadFunctionRequest\x12e\n" +
 	"\x18upload_function_metadata\x18\x01 \x01(\v2).faas.v1.functions.UploadFunctionMetadataH\x00R\x16uploadFunctionMetadata\x12Y\n" +
 	"\x14upload_function_data\x18\x02 \x01(\v2%.faas.v1.functions.UploadFunctionDataH\x00R\x12uploadFunctionDataB\t\n" +
-	"\apayload\"\xcc\x01\n" +
+	"\apayload\"\xd8\a\n" +
 	"\x16UploadFunctionMetadata\x12#\n" +
 	"\rfunction_name\x18\x01 \x01(\tR\ffunctionName\x12H\n" +
-	"\x06format\x18\x03 \x01(\x0e20.faas.v1.functions.UploadFunctionMetadata.FormatR\x06format\"C\n" +
+	"\x06format\x18\x03 \x01(\x0e20.faas.v1.functions.UploadFunctionMetadata.FormatR\x06format\x12D\n" +
+	"\x03env\x18\x04 \x03(\v22.faas.v1.functions.UploadFunctionMetadata.EnvEntryR\x03env\x12W\n" +
+	"\n" +
+	"secret_env\x18\x05 \x03(\v28.faas.v1.functions.UploadFunctionMetadata.SecretEnvEntryR\tsecretEnv\x12\x16\n" +
+	"\x06sha256\x18\x06 \x01(\tR\x06sha256\x12!\n" +
+	"\fdisplay_name\x18\a \x01(\tR\vdisplayName\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x12M\n" +
+	"\x06labels\x18\t \x03(\v25.faas.v1.functions.UploadFunctionMetadata.LabelsEntryR\x06labels\x123\n" +
+	"\atimeout\x18\n" +
+	" \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12!\n" +
+	"\fmemory_bytes\x18\v \x01(\x04R\vmemoryBytes\x12\x18\n" +
+	"\aruntime\x18\f \x01(\tR\aruntime\x12\\\n" +
+	"\vannotations\x18\r \x03(\v2:.faas.v1.functions.UploadFunctionMetadata.AnnotationsEntryR\vannotations\x1a6\n" +
+	"\bEnvEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a<\n" +
+	"\x0eSecretEnvEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"C\n" +
 	"\x06Format\x12\x16\n" +
 	"\x12FORMAT_UNSPECIFIED\x10\x00\x12\x0e\n" +
 	"\n" +
 	"FORMAT_ZIP\x10\x01\x12\x11\n" +
 	"\rFORMAT_TAR_GZ\x10\x02\"(\n" +
 	"\x12UploadFunctionData\x12\x12\n" +
-	"\x04data\x18\x01 \x01(\fR\x04data\"L\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"\x81\x02\n" +
+	"\rUploadSession\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
+	"\rfunction_name\x18\x02 \x01(\tR\ffunctionName\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x04R\x04size\x12)\n" +
+	"\x10committed_offset\x18\x04 \x01(\x04R\x0fcommittedOffset\x12;\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vexpire_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\"o\n" +
+	"\x12StartUploadRequest\x12E\n" +
+	"\bmetadata\x18\x01 \x01(\v2).faas.v1.functions.UploadFunctionMetadataR\bmetadata\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x04R\x04size\"l\n" +
+	"\x12UploadChunkRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x04R\x06offset\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\x12\x16\n" +
+	"\x06crc32c\x18\x04 \x01(\rR\x06crc32c\"-\n" +
+	"\x17GetUploadSessionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"C\n" +
+	"\x15FinalizeUploadRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06sha256\x18\x02 \x01(\tR\x06sha256\"\xb7\x03\n" +
 	"\x16ExecuteFunctionRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1e\n" +
 	"\n" +
 	"parameters\x18\x02 \x01(\tR\n" +
-	"parameters\"-\n" +
+	"parameters\x12\x1a\n" +
+	"\brevision\x18\x03 \x01(\x04R\brevision\x12M\n" +
+	"\x06labels\x18\x04 \x03(\v25.faas.v1.functions.ExecuteFunctionRequest.LabelsEntryR\x06labels\x12\\\n" +
+	"\vannotations\x18\x05 \x03(\v2:.faas.v1.functions.ExecuteFunctionRequest.AnnotationsEntryR\vannotations\x12%\n" +
+	"\x0einherit_labels\x18\x06 \x03(\tR\rinheritLabels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"-\n" +
 	"\x17ExecuteFunctionResponse\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"(\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\\\n" +
+	"\x15InvokeFunctionRequest\x12C\n" +
+	"\aexecute\x18\x01 \x01(\v2).faas.v1.functions.ExecuteFunctionRequestR\aexecute\"O\n" +
+	"\x16InvokeFunctionResponse\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.faas.v1.TaskR\x04task\x12\x12\n" +
+	"\x04done\x18\x02 \x01(\bR\x04done\"\xd0\x01\n" +
+	"\x1bBatchExecuteFunctionRequest\x12E\n" +
+	"\aexecute\x18\x01 \x01(\v2).faas.v1.functions.ExecuteFunctionRequestH\x00R\aexecute\x122\n" +
+	"\x04item\x18\x02 \x01(\v2\x1c.faas.v1.functions.BatchItemH\x00R\x04item\x12+\n" +
+	"\x11failure_threshold\x18\x03 \x01(\x01R\x10failureThresholdB\t\n" +
+	"\apayload\"+\n" +
+	"\tBatchItem\x12\x1e\n" +
+	"\n" +
+	"parameters\x18\x01 \x01(\tR\n" +
+	"parameters\"\x8d\x01\n" +
+	"\x1cBatchExecuteFunctionResponse\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\tR\abatchId\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\x04R\x05index\x12\x12\n" +
+	"\x04task\x18\x03 \x01(\tR\x04task\x12(\n" +
+	"\x05error\x18\x04 \x01(\v2\x12.google.rpc.StatusR\x05error\"\x80\x02\n" +
+	" ExecuteFunctionWithInputsRequest\x12E\n" +
+	"\aexecute\x18\x01 \x01(\v2).faas.v1.functions.ExecuteFunctionRequestH\x00R\aexecute\x12G\n" +
+	"\finput_header\x18\x02 \x01(\v2\".faas.v1.functions.TaskInputHeaderH\x00R\vinputHeader\x12A\n" +
+	"\n" +
+	"input_data\x18\x03 \x01(\v2 .faas.v1.functions.TaskInputDataH\x00R\tinputDataB\t\n" +
+	"\apayload\"=\n" +
+	"\x0fTaskInputHeader\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06sha256\x18\x02 \x01(\tR\x06sha256\"#\n" +
+	"\rTaskInputData\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"(\n" +
 	"\x12GetFunctionRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"R\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xa8\x01\n" +
 	"\x14ListFunctionsRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x02 \x01(\tR\tpageToken\"z\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\x12!\n" +
+	"\fshow_deleted\x18\x05 \x01(\bR\vshowDeleted\"z\n" +
 	"\x15ListFunctionsResponse\x129\n" +
 	"\tfunctions\x18\x01 \x03(\v2\x1b.faas.v1.functions.FunctionR\tfunctions\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"+\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"n\n" +
+	"\x1cListFunctionRevisionsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x82\x01\n" +
+	"\x1dListFunctionRevisionsResponse\x129\n" +
+	"\trevisions\x18\x01 \x03(\v2\x1b.faas.v1.functions.FunctionR\trevisions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"L\n" +
+	"\x1aGetFunctionRevisionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x04R\brevision\"\x8d\x01\n" +
+	"\x15UpdateFunctionRequest\x127\n" +
+	"\bfunction\x18\x01 \x01(\v2\x1b.faas.v1.functions.FunctionR\bfunction\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"A\n" +
 	"\x15DeleteFunctionRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name2\xdb\x03\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"-\n" +
+	"\x17UndeleteFunctionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"8\n" +
+	"\x18GetNamespaceUsageRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\"\x9c\x01\n" +
+	"\x0eNamespaceUsage\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12!\n" +
+	"\fbundle_bytes\x18\x02 \x01(\x04R\vbundleBytes\x12\x1f\n" +
+	"\vquota_bytes\x18\x03 \x01(\x04R\n" +
+	"quotaBytes\x12(\n" +
+	"\x10max_bundle_bytes\x18\x04 \x01(\x04R\x0emaxBundleBytes\"I\n" +
+	"\x17DownloadFunctionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x04R\brevision\"v\n" +
+	"\x18DownloadFunctionResponse\x129\n" +
+	"\bfunction\x18\x01 \x01(\v2\x1b.faas.v1.functions.FunctionH\x00R\bfunction\x12\x14\n" +
+	"\x04data\x18\x02 \x01(\fH\x00R\x04dataB\t\n" +
+	"\apayload\"L\n" +
+	"\x12UpdateAliasRequest\x126\n" +
+	"\x05alias\x18\x01 \x01(\v2 .faas.v1.functions.FunctionAliasR\x05alias\"A\n" +
+	"\x0fGetAliasRequest\x12\x1a\n" +
+	"\bfunction\x18\x01 \x01(\tR\bfunction\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"0\n" +
+	"\x12ListAliasesRequest\x12\x1a\n" +
+	"\bfunction\x18\x01 \x01(\tR\bfunction\"Q\n" +
+	"\x13ListAliasesResponse\x12:\n" +
+	"\aaliases\x18\x01 \x03(\v2 .faas.v1.functions.FunctionAliasR\aaliases\"D\n" +
+	"\x12DeleteAliasRequest\x12\x1a\n" +
+	"\bfunction\x18\x01 \x01(\tR\bfunction\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name*f\n" +
+	"\rFunctionState\x12\x1e\n" +
+	"\x1aFUNCTION_STATE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15FUNCTION_STATE_ACTIVE\x10\x01\x12\x1a\n" +
+	"\x16FUNCTION_STATE_DELETED\x10\x02*x\n" +
+	"\n" +
+	"BuildState\x12\x1b\n" +
+	"\x17BUILD_STATE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14BUILD_STATE_BUILDING\x10\x01\x12\x15\n" +
+	"\x11BUILD_STATE_READY\x10\x02\x12\x1c\n" +
+	"\x18BUILD_STATE_BUILD_FAILED\x10\x032\xec\x10\n" +
 	"\tFunctions\x12Y\n" +
-	"\x0eUploadFunction\x12(.faas.v1.functions.UploadFunctionRequest\x1a\x1b.faas.v1.functions.Function(\x01\x12h\n" +
-	"\x0fExecuteFunction\x12).faas.v1.functions.ExecuteFunctionRequest\x1a*.faas.v1.functions.ExecuteFunctionResponse\x12Q\n" +
+	"\x0eUploadFunction\x12(.faas.v1.functions.UploadFunctionRequest\x1a\x1b.faas.v1.functions.Function(\x01\x12V\n" +
+	"\vStartUpload\x12%.faas.v1.functions.StartUploadRequest\x1a .faas.v1.functions.UploadSession\x12Y\n" +
+	"\fUploadChunks\x12%.faas.v1.functions.UploadChunkRequest\x1a .faas.v1.functions.UploadSession(\x01\x12`\n" +
+	"\x10GetUploadSession\x12*.faas.v1.functions.GetUploadSessionRequest\x1a .faas.v1.functions.UploadSession\x12W\n" +
+	"\x0eFinalizeUpload\x12(.faas.v1.functions.FinalizeUploadRequest\x1a\x1b.faas.v1.functions.Function\x12h\n" +
+	"\x0fExecuteFunction\x12).faas.v1.functions.ExecuteFunctionRequest\x1a*.faas.v1.functions.ExecuteFunctionResponse\x12~\n" +
+	"\x19ExecuteFunctionWithInputs\x123.faas.v1.functions.ExecuteFunctionWithInputsRequest\x1a*.faas.v1.functions.ExecuteFunctionResponse(\x01\x12e\n" +
+	"\x0eInvokeFunction\x12(.faas.v1.functions.InvokeFunctionRequest\x1a).faas.v1.functions.InvokeFunctionResponse\x12{\n" +
+	"\x14BatchExecuteFunction\x12..faas.v1.functions.BatchExecuteFunctionRequest\x1a/.faas.v1.functions.BatchExecuteFunctionResponse(\x010\x01\x12Q\n" +
 	"\vGetFunction\x12%.faas.v1.functions.GetFunctionRequest\x1a\x1b.faas.v1.functions.Function\x12b\n" +
-	"\rListFunctions\x12'.faas.v1.functions.ListFunctionsRequest\x1a(.faas.v1.functions.ListFunctionsResponse\x12R\n" +
-	"\x0eDeleteFunction\x12(.faas.v1.functions.DeleteFunctionRequest\x1a\x16.google.protobuf.EmptyB2Z0github.com/10Narratives/faas/pkg/faas/v1/;faaspbb\x06proto3"
+	"\rListFunctions\x12'.faas.v1.functions.ListFunctionsRequest\x1a(.faas.v1.functions.ListFunctionsResponse\x12z\n" +
+	"\x15ListFunctionRevisions\x12/.faas.v1.functions.ListFunctionRevisionsRequest\x1a0.faas.v1.functions.ListFunctionRevisionsResponse\x12a\n" +
+	"\x13GetFunctionRevision\x12-.faas.v1.functions.GetFunctionRevisionRequest\x1a\x1b.faas.v1.functions.Function\x12W\n" +
+	"\x0eUpdateFunction\x12(.faas.v1.functions.UpdateFunctionRequest\x1a\x1b.faas.v1.functions.Function\x12R\n" +
+	"\x0eDeleteFunction\x12(.faas.v1.functions.DeleteFunctionRequest\x1a\x16.google.protobuf.Empty\x12[\n" +
+	"\x10UndeleteFunction\x12*.faas.v1.functions.UndeleteFunctionRequest\x1a\x1b.faas.v1.functions.Function\x12m\n" +
+	"\x10DownloadFunction\x12*.faas.v1.functions.DownloadFunctionRequest\x1a+.faas.v1.functions.DownloadFunctionResponse0\x01\x12c\n" +
+	"\x11GetNamespaceUsage\x12+.faas.v1.functions.GetNamespaceUsageRequest\x1a!.faas.v1.functions.NamespaceUsage\x12V\n" +
+	"\vUpdateAlias\x12%.faas.v1.functions.UpdateAliasRequest\x1a .faas.v1.functions.FunctionAlias\x12P\n" +
+	"\bGetAlias\x12\".faas.v1.functions.GetAliasRequest\x1a .faas.v1.functions.FunctionAlias\x12\\\n" +
+	"\vListAliases\x12%.faas.v1.functions.ListAliasesRequest\x1a&.faas.v1.functions.ListAliasesResponse\x12L\n" +
+	"\vDeleteAlias\x12%.faas.v1.functions.DeleteAliasRequest\x1a\x16.google.protobuf.EmptyB2Z0github.com/10Narratives/faas/pkg/faas/v1/;faaspbb\x06proto3"
 
 var (
 	file_faas_v1_functions_proto_rawDescOnce sync.Once
@@ -743,46 +3247,168 @@ func file_faas_v1_functions_proto_rawDescGZIP() []byte {
 	return file_faas_v1_functions_proto_rawDescData
 }
 
-var file_faas_v1_functions_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_faas_v1_functions_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_faas_v1_functions_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_faas_v1_functions_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
 var file_faas_v1_functions_proto_goTypes = []any{
-	(UploadFunctionMetadata_Format)(0), // 0: faas.v1.functions.UploadFunctionMetadata.Format
-	(*Function)(nil),                   // 1: faas.v1.functions.Function
-	(*SourceBundle)(nil),               // 2: faas.v1.functions.SourceBundle
-	(*UploadFunctionRequest)(nil),      // 3: faas.v1.functions.UploadFunctionRequest
-	(*UploadFunctionMetadata)(nil),     // 4: faas.v1.functions.UploadFunctionMetadata
-	(*UploadFunctionData)(nil),         // 5: faas.v1.functions.UploadFunctionData
-	(*ExecuteFunctionRequest)(nil),     // 6: faas.v1.functions.ExecuteFunctionRequest
-	(*ExecuteFunctionResponse)(nil),    // 7: faas.v1.functions.ExecuteFunctionResponse
-	(*GetFunctionRequest)(nil),         // 8: faas.v1.functions.GetFunctionRequest
-	(*ListFunctionsRequest)(nil),       // 9: faas.v1.functions.ListFunctionsRequest
-	(*ListFunctionsResponse)(nil),      // 10: faas.v1.functions.ListFunctionsResponse
-	(*DeleteFunctionRequest)(nil),      // 11: faas.v1.functions.DeleteFunctionRequest
-	(*timestamppb.Timestamp)(nil),      // 12: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),              // 13: google.protobuf.Empty
+	(FunctionState)(0),                       // 0: faas.v1.functions.FunctionState
+	(BuildState)(0),                          // 1: faas.v1.functions.BuildState
+	(UploadFunctionMetadata_Format)(0),       // 2: faas.v1.functions.UploadFunctionMetadata.Format
+	(*Function)(nil),                         // 3: faas.v1.functions.Function
+	(*RetryPolicy)(nil),                      // 4: faas.v1.functions.RetryPolicy
+	(*SourceBundle)(nil),                     // 5: faas.v1.functions.SourceBundle
+	(*FunctionBuild)(nil),                    // 6: faas.v1.functions.FunctionBuild
+	(*FunctionAlias)(nil),                    // 7: faas.v1.functions.FunctionAlias
+	(*AliasRoute)(nil),                       // 8: faas.v1.functions.AliasRoute
+	(*UploadFunctionRequest)(nil),            // 9: faas.v1.functions.UploadFunctionRequest
+	(*UploadFunctionMetadata)(nil),           // 10: faas.v1.functions.UploadFunctionMetadata
+	(*UploadFunctionData)(nil),               // 11: faas.v1.functions.UploadFunctionData
+	(*UploadSession)(nil),                    // 12: faas.v1.functions.UploadSession
+	(*StartUploadRequest)(nil),               // 13: faas.v1.functions.StartUploadRequest
+	(*UploadChunkRequest)(nil),               // 14: faas.v1.functions.UploadChunkRequest
+	(*GetUploadSessionRequest)(nil),          // 15: faas.v1.functions.GetUploadSessionRequest
+	(*FinalizeUploadRequest)(nil),            // 16: faas.v1.functions.FinalizeUploadRequest
+	(*ExecuteFunctionRequest)(nil),           // 17: faas.v1.functions.ExecuteFunctionRequest
+	(*ExecuteFunctionResponse)(nil),          // 18: faas.v1.functions.ExecuteFunctionResponse
+	(*InvokeFunctionRequest)(nil),            // 19: faas.v1.functions.InvokeFunctionRequest
+	(*InvokeFunctionResponse)(nil),           // 20: faas.v1.functions.InvokeFunctionResponse
+	(*BatchExecuteFunctionRequest)(nil),      // 21: faas.v1.functions.BatchExecuteFunctionRequest
+	(*BatchItem)(nil),                        // 22: faas.v1.functions.BatchItem
+	(*BatchExecuteFunctionResponse)(nil),     // 23: faas.v1.functions.BatchExecuteFunctionResponse
+	(*ExecuteFunctionWithInputsRequest)(nil), // 24: faas.v1.functions.ExecuteFunctionWithInputsRequest
+	(*TaskInputHeader)(nil),                  // 25: faas.v1.functions.TaskInputHeader
+	(*TaskInputData)(nil),                    // 26: faas.v1.functions.TaskInputData
+	(*GetFunctionRequest)(nil),               // 27: faas.v1.functions.GetFunctionRequest
+	(*ListFunctionsRequest)(nil),             // 28: faas.v1.functions.ListFunctionsRequest
+	(*ListFunctionsResponse)(nil),            // 29: faas.v1.functions.ListFunctionsResponse
+	(*ListFunctionRevisionsRequest)(nil),     // 30: faas.v1.functions.ListFunctionRevisionsRequest
+	(*ListFunctionRevisionsResponse)(nil),    // 31: faas.v1.functions.ListFunctionRevisionsResponse
+	(*GetFunctionRevisionRequest)(nil),       // 32: faas.v1.functions.GetFunctionRevisionRequest
+	(*UpdateFunctionRequest)(nil),            // 33: faas.v1.functions.UpdateFunctionRequest
+	(*DeleteFunctionRequest)(nil),            // 34: faas.v1.functions.DeleteFunctionRequest
+	(*UndeleteFunctionRequest)(nil),          // 35: faas.v1.functions.UndeleteFunctionRequest
+	(*GetNamespaceUsageRequest)(nil),         // 36: faas.v1.functions.GetNamespaceUsageRequest
+	(*NamespaceUsage)(nil),                   // 37: faas.v1.functions.NamespaceUsage
+	(*DownloadFunctionRequest)(nil),          // 38: faas.v1.functions.DownloadFunctionRequest
+	(*DownloadFunctionResponse)(nil),         // 39: faas.v1.functions.DownloadFunctionResponse
+	(*UpdateAliasRequest)(nil),               // 40: faas.v1.functions.UpdateAliasRequest
+	(*GetAliasRequest)(nil),                  // 41: faas.v1.functions.GetAliasRequest
+	(*ListAliasesRequest)(nil),               // 42: faas.v1.functions.ListAliasesRequest
+	(*ListAliasesResponse)(nil),              // 43: faas.v1.functions.ListAliasesResponse
+	(*DeleteAliasRequest)(nil),               // 44: faas.v1.functions.DeleteAliasRequest
+	nil,                                      // 45: faas.v1.functions.Function.EnvEntry
+	nil,                                      // 46: faas.v1.functions.Function.SecretEnvEntry
+	nil,                                      // 47: faas.v1.functions.Function.LabelsEntry
+	nil,                                      // 48: faas.v1.functions.Function.AnnotationsEntry
+	nil,                                      // 49: faas.v1.functions.UploadFunctionMetadata.EnvEntry
+	nil,                                      // 50: faas.v1.functions.UploadFunctionMetadata.SecretEnvEntry
+	nil,                                      // 51: faas.v1.functions.UploadFunctionMetadata.LabelsEntry
+	nil,                                      // 52: faas.v1.functions.UploadFunctionMetadata.AnnotationsEntry
+	nil,                                      // 53: faas.v1.functions.ExecuteFunctionRequest.LabelsEntry
+	nil,                                      // 54: faas.v1.functions.ExecuteFunctionRequest.AnnotationsEntry
+	(*timestamppb.Timestamp)(nil),            // 55: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),              // 56: google.protobuf.Duration
+	(*Task)(nil),                             // 57: faas.v1.Task
+	(*status.Status)(nil),                    // 58: google.rpc.Status
+	(*fieldmaskpb.FieldMask)(nil),            // 59: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                    // 60: google.protobuf.Empty
 }
 var file_faas_v1_functions_proto_depIdxs = []int32{
-	12, // 0: faas.v1.functions.Function.uploaded_at:type_name -> google.protobuf.Timestamp
-	2,  // 1: faas.v1.functions.Function.source_bundle:type_name -> faas.v1.functions.SourceBundle
-	4,  // 2: faas.v1.functions.UploadFunctionRequest.upload_function_metadata:type_name -> faas.v1.functions.UploadFunctionMetadata
-	5,  // 3: faas.v1.functions.UploadFunctionRequest.upload_function_data:type_name -> faas.v1.functions.UploadFunctionData
-	0,  // 4: faas.v1.functions.UploadFunctionMetadata.format:type_name -> faas.v1.functions.UploadFunctionMetadata.Format
-	1,  // 5: faas.v1.functions.ListFunctionsResponse.functions:type_name -> faas.v1.functions.Function
-	3,  // 6: faas.v1.functions.Functions.UploadFunction:input_type -> faas.v1.functions.UploadFunctionRequest
-	6,  // 7: faas.v1.functions.Functions.ExecuteFunction:input_type -> faas.v1.functions.ExecuteFunctionRequest
-	8,  // 8: faas.v1.functions.Functions.GetFunction:input_type -> faas.v1.functions.GetFunctionRequest
-	9,  // 9: faas.v1.functions.Functions.ListFunctions:input_type -> faas.v1.functions.ListFunctionsRequest
-	11, // 10: faas.v1.functions.Functions.DeleteFunction:input_type -> faas.v1.functions.DeleteFunctionRequest
-	1,  // 11: faas.v1.functions.Functions.UploadFunction:output_type -> faas.v1.functions.Function
-	7,  // 12: faas.v1.functions.Functions.ExecuteFunction:output_type -> faas.v1.functions.ExecuteFunctionResponse
-	1,  // 13: faas.v1.functions.Functions.GetFunction:output_type -> faas.v1.functions.Function
-	10, // 14: faas.v1.functions.Functions.ListFunctions:output_type -> faas.v1.functions.ListFunctionsResponse
-	13, // 15: faas.v1.functions.Functions.DeleteFunction:output_type -> google.protobuf.Empty
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	55, // 0: faas.v1.functions.Function.uploaded_at:type_name -> google.protobuf.Timestamp
+	5,  // 1: faas.v1.functions.Function.source_bundle:type_name -> faas.v1.functions.SourceBundle
+	45, // 2: faas.v1.functions.Function.env:type_name -> faas.v1.functions.Function.EnvEntry
+	46, // 3: faas.v1.functions.Function.secret_env:type_name -> faas.v1.functions.Function.SecretEnvEntry
+	6,  // 4: faas.v1.functions.Function.build:type_name -> faas.v1.functions.FunctionBuild
+	47, // 5: faas.v1.functions.Function.labels:type_name -> faas.v1.functions.Function.LabelsEntry
+	56, // 6: faas.v1.functions.Function.timeout:type_name -> google.protobuf.Duration
+	4,  // 7: faas.v1.functions.Function.retry_policy:type_name -> faas.v1.functions.RetryPolicy
+	48, // 8: faas.v1.functions.Function.annotations:type_name -> faas.v1.functions.Function.AnnotationsEntry
+	0,  // 9: faas.v1.functions.Function.state:type_name -> faas.v1.functions.FunctionState
+	55, // 10: faas.v1.functions.Function.delete_time:type_name -> google.protobuf.Timestamp
+	55, // 11: faas.v1.functions.Function.purge_time:type_name -> google.protobuf.Timestamp
+	56, // 12: faas.v1.functions.RetryPolicy.backoff:type_name -> google.protobuf.Duration
+	1,  // 13: faas.v1.functions.FunctionBuild.state:type_name -> faas.v1.functions.BuildState
+	55, // 14: faas.v1.functions.FunctionBuild.started_at:type_name -> google.protobuf.Timestamp
+	55, // 15: faas.v1.functions.FunctionBuild.ended_at:type_name -> google.protobuf.Timestamp
+	5,  // 16: faas.v1.functions.FunctionBuild.artifact:type_name -> faas.v1.functions.SourceBundle
+	8,  // 17: faas.v1.functions.FunctionAlias.routes:type_name -> faas.v1.functions.AliasRoute
+	55, // 18: faas.v1.functions.FunctionAlias.updated_at:type_name -> google.protobuf.Timestamp
+	10, // 19: faas.v1.functions.UploadFunctionRequest.upload_function_metadata:type_name -> faas.v1.functions.UploadFunctionMetadata
+	11, // 20: faas.v1.functions.UploadFunctionRequest.upload_function_data:type_name -> faas.v1.functions.UploadFunctionData
+	2,  // 21: faas.v1.functions.UploadFunctionMetadata.format:type_name -> faas.v1.functions.UploadFunctionMetadata.Format
+	49, // 22: faas.v1.functions.UploadFunctionMetadata.env:type_name -> faas.v1.functions.UploadFunctionMetadata.EnvEntry
+	50, // 23: faas.v1.functions.UploadFunctionMetadata.secret_env:type_name -> faas.v1.functions.UploadFunctionMetadata.SecretEnvEntry
+	51, // 24: faas.v1.functions.UploadFunctionMetadata.labels:type_name -> faas.v1.functions.UploadFunctionMetadata.LabelsEntry
+	56, // 25: faas.v1.functions.UploadFunctionMetadata.timeout:type_name -> google.protobuf.Duration
+	52, // 26: faas.v1.functions.UploadFunctionMetadata.annotations:type_name -> faas.v1.functions.UploadFunctionMetadata.AnnotationsEntry
+	55, // 27: faas.v1.functions.UploadSession.create_time:type_name -> google.protobuf.Timestamp
+	55, // 28: faas.v1.functions.UploadSession.expire_time:type_name -> google.protobuf.Timestamp
+	10, // 29: faas.v1.functions.StartUploadRequest.metadata:type_name -> faas.v1.functions.UploadFunctionMetadata
+	53, // 30: faas.v1.functions.ExecuteFunctionRequest.labels:type_name -> faas.v1.functions.ExecuteFunctionRequest.LabelsEntry
+	54, // 31: faas.v1.functions.ExecuteFunctionRequest.annotations:type_name -> faas.v1.functions.ExecuteFunctionRequest.AnnotationsEntry
+	17, // 32: faas.v1.functions.InvokeFunctionRequest.execute:type_name -> faas.v1.functions.ExecuteFunctionRequest
+	57, // 33: faas.v1.functions.InvokeFunctionResponse.task:type_name -> faas.v1.Task
+	17, // 34: faas.v1.functions.BatchExecuteFunctionRequest.execute:type_name -> faas.v1.functions.ExecuteFunctionRequest
+	22, // 35: faas.v1.functions.BatchExecuteFunctionRequest.item:type_name -> faas.v1.functions.BatchItem
+	58, // 36: faas.v1.functions.BatchExecuteFunctionResponse.error:type_name -> google.rpc.Status
+	17, // 37: faas.v1.functions.ExecuteFunctionWithInputsRequest.execute:type_name -> faas.v1.functions.ExecuteFunctionRequest
+	25, // 38: faas.v1.functions.ExecuteFunctionWithInputsRequest.input_header:type_name -> faas.v1.functions.TaskInputHeader
+	26, // 39: faas.v1.functions.ExecuteFunctionWithInputsRequest.input_data:type_name -> faas.v1.functions.TaskInputData
+	3,  // 40: faas.v1.functions.ListFunctionsResponse.functions:type_name -> faas.v1.functions.Function
+	3,  // 41: faas.v1.functions.ListFunctionRevisionsResponse.revisions:type_name -> faas.v1.functions.Function
+	3,  // 42: faas.v1.functions.UpdateFunctionRequest.function:type_name -> faas.v1.functions.Function
+	59, // 43: faas.v1.functions.UpdateFunctionRequest.update_mask:type_name -> google.protobuf.FieldMask
+	3,  // 44: faas.v1.functions.DownloadFunctionResponse.function:type_name -> faas.v1.functions.Function
+	7,  // 45: faas.v1.functions.UpdateAliasRequest.alias:type_name -> faas.v1.functions.FunctionAlias
+	7,  // 46: faas.v1.functions.ListAliasesResponse.aliases:type_name -> faas.v1.functions.FunctionAlias
+	9,  // 47: faas.v1.functions.Functions.UploadFunction:input_type -> faas.v1.functions.UploadFunctionRequest
+	13, // 48: faas.v1.functions.Functions.StartUpload:input_type -> faas.v1.functions.StartUploadRequest
+	14, // 49: faas.v1.functions.Functions.UploadChunks:input_type -> faas.v1.functions.UploadChunkRequest
+	15, // 50: faas.v1.functions.Functions.GetUploadSession:input_type -> faas.v1.functions.GetUploadSessionRequest
+	16, // 51: faas.v1.functions.Functions.FinalizeUpload:input_type -> faas.v1.functions.FinalizeUploadRequest
+	17, // 52: faas.v1.functions.Functions.ExecuteFunction:input_type -> faas.v1.functions.ExecuteFunctionRequest
+	24, // 53: faas.v1.functions.Functions.ExecuteFunctionWithInputs:input_type -> faas.v1.functions.ExecuteFunctionWithInputsRequest
+	19, // 54: faas.v1.functions.Functions.InvokeFunction:input_type -> faas.v1.functions.InvokeFunctionRequest
+	21, // 55: faas.v1.functions.Functions.BatchExecuteFunction:input_type -> faas.v1.functions.BatchExecuteFunctionRequest
+	27, // 56: faas.v1.functions.Functions.GetFunction:input_type -> faas.v1.functions.GetFunctionRequest
+	28, // 57: faas.v1.functions.Functions.ListFunctions:input_type -> faas.v1.functions.ListFunctionsRequest
+	30, // 58: faas.v1.functions.Functions.ListFunctionRevisions:input_type -> faas.v1.functions.ListFunctionRevisionsRequest
+	32, // 59: faas.v1.functions.Functions.GetFunctionRevision:input_type -> faas.v1.functions.GetFunctionRevisionRequest
+	33, // 60: faas.v1.functions.Functions.UpdateFunction:input_type -> faas.v1.functions.UpdateFunctionRequest
+	34, // 61: faas.v1.functions.Functions.DeleteFunction:input_type -> faas.v1.functions.DeleteFunctionRequest
+	35, // 62: faas.v1.functions.Functions.UndeleteFunction:input_type -> faas.v1.functions.UndeleteFunctionRequest
+	38, // 63: faas.v1.functions.Functions.DownloadFunction:input_type -> faas.v1.functions.DownloadFunctionRequest
+	36, // 64: faas.v1.functions.Functions.GetNamespaceUsage:input_type -> faas.v1.functions.GetNamespaceUsageRequest
+	40, // 65: faas.v1.functions.Functions.UpdateAlias:input_type -> faas.v1.functions.UpdateAliasRequest
+	41, // 66: faas.v1.functions.Functions.GetAlias:input_type -> faas.v1.functions.GetAliasRequest
+	42, // 67: faas.v1.functions.Functions.ListAliases:input_type -> faas.v1.functions.ListAliasesRequest
+	44, // 68: faas.v1.functions.Functions.DeleteAlias:input_type -> faas.v1.functions.DeleteAliasRequest
+	3,  // 69: faas.v1.functions.Functions.UploadFunction:output_type -> faas.v1.functions.Function
+	12, // 70: faas.v1.functions.Functions.StartUpload:output_type -> faas.v1.functions.UploadSession
+	12, // 71: faas.v1.functions.Functions.UploadChunks:output_type -> faas.v1.functions.UploadSession
+	12, // 72: faas.v1.functions.Functions.GetUploadSession:output_type -> faas.v1.functions.UploadSession
+	3,  // 73: faas.v1.functions.Functions.FinalizeUpload:output_type -> faas.v1.functions.Function
+	18, // 74: faas.v1.functions.Functions.ExecuteFunction:output_type -> faas.v1.functions.ExecuteFunctionResponse
+	18, // 75: faas.v1.functions.Functions.ExecuteFunctionWithInputs:output_type -> faas.v1.functions.ExecuteFunctionResponse
+	20, // 76: faas.v1.functions.Functions.InvokeFunction:output_type -> faas.v1.functions.InvokeFunctionResponse
+	23, // 77: faas.v1.functions.Functions.BatchExecuteFunction:output_type -> faas.v1.functions.BatchExecuteFunctionResponse
+	3,  // 78: faas.v1.functions.Functions.GetFunction:output_type -> faas.v1.functions.Function
+	29, // 79: faas.v1.functions.Functions.ListFunctions:output_type -> faas.v1.functions.ListFunctionsResponse
+	31, // 80: faas.v1.functions.Functions.ListFunctionRevisions:output_type -> faas.v1.functions.ListFunctionRevisionsResponse
+	3,  // 81: faas.v1.functions.Functions.GetFunctionRevision:output_type -> faas.v1.functions.Function
+	3,  // 82: faas.v1.functions.Functions.UpdateFunction:output_type -> faas.v1.functions.Function
+	60, // 83: faas.v1.functions.Functions.DeleteFunction:output_type -> google.protobuf.Empty
+	3,  // 84: faas.v1.functions.Functions.UndeleteFunction:output_type -> faas.v1.functions.Function
+	39, // 85: faas.v1.functions.Functions.DownloadFunction:output_type -> faas.v1.functions.DownloadFunctionResponse
+	37, // 86: faas.v1.functions.Functions.GetNamespaceUsage:output_type -> faas.v1.functions.NamespaceUsage
+	7,  // 87: faas.v1.functions.Functions.UpdateAlias:output_type -> faas.v1.functions.FunctionAlias
+	7,  // 88: faas.v1.functions.Functions.GetAlias:output_type -> faas.v1.functions.FunctionAlias
+	43, // 89: faas.v1.functions.Functions.ListAliases:output_type -> faas.v1.functions.ListAliasesResponse
+	60, // 90: faas.v1.functions.Functions.DeleteAlias:output_type -> google.protobuf.Empty
+	69, // [69:91] is the sub-list for method output_type
+	47, // [47:69] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
 }
 
 func init() { file_faas_v1_functions_proto_init() }
@@ -790,17 +3416,31 @@ func file_faas_v1_functions_proto_init() {
 	if File_faas_v1_functions_proto != nil {
 		return
 	}
-	file_faas_v1_functions_proto_msgTypes[2].OneofWrappers = []any{
+	file_faas_v1_tasks_proto_init()
+	file_faas_v1_functions_proto_msgTypes[6].OneofWrappers = []any{
 		(*UploadFunctionRequest_UploadFunctionMetadata)(nil),
 		(*UploadFunctionRequest_UploadFunctionData)(nil),
 	}
+	file_faas_v1_functions_proto_msgTypes[18].OneofWrappers = []any{
+		(*BatchExecuteFunctionRequest_Execute)(nil),
+		(*BatchExecuteFunctionRequest_Item)(nil),
+	}
+	file_faas_v1_functions_proto_msgTypes[21].OneofWrappers = []any{
+		(*ExecuteFunctionWithInputsRequest_Execute)(nil),
+		(*ExecuteFunctionWithInputsRequest_InputHeader)(nil),
+		(*ExecuteFunctionWithInputsRequest_InputData)(nil),
+	}
+	file_faas_v1_functions_proto_msgTypes[36].OneofWrappers = []any{
+		(*DownloadFunctionResponse_Function)(nil),
+		(*DownloadFunctionResponse_Data)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_faas_v1_functions_proto_rawDesc), len(file_faas_v1_functions_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   11,
+			NumEnums:      3,
+			NumMessages:   52,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -119,6 +119,181 @@ func (m *Function) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Env
+
+	// no validation rules for SecretEnv
+
+	if all {
+		switch v := interface{}(m.GetBuild()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FunctionValidationError{
+					field:  "Build",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FunctionValidationError{
+					field:  "Build",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBuild()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FunctionValidationError{
+				field:  "Build",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Revision
+
+	// no validation rules for Etag
+
+	// no validation rules for Description
+
+	// no validation rules for Labels
+
+	if all {
+		switch v := interface{}(m.GetTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FunctionValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FunctionValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FunctionValidationError{
+				field:  "Timeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MemoryBytes
+
+	// no validation rules for Runtime
+
+	// no validation rules for Entrypoint
+
+	// no validation rules for Handler
+
+	if all {
+		switch v := interface{}(m.GetRetryPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FunctionValidationError{
+					field:  "RetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FunctionValidationError{
+					field:  "RetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetryPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FunctionValidationError{
+				field:  "RetryPolicy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ParametersSchema
+
+	// no validation rules for Annotations
+
+	// no validation rules for State
+
+	if all {
+		switch v := interface{}(m.GetDeleteTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FunctionValidationError{
+					field:  "DeleteTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FunctionValidationError{
+					field:  "DeleteTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeleteTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FunctionValidationError{
+				field:  "DeleteTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPurgeTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FunctionValidationError{
+					field:  "PurgeTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FunctionValidationError{
+					field:  "PurgeTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPurgeTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FunctionValidationError{
+				field:  "PurgeTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for OutputSchema
+
+	// no validation rules for OutputContentType
+
 	if len(errors) > 0 {
 		return FunctionMultiError(errors)
 	}
@@ -126,12 +301,4103 @@ func (m *Function) validate(all bool) error {
 	return nil
 }
 
-// FunctionMultiError is an error wrapping multiple validation errors returned
-// by Function.ValidateAll() if the designated constraints aren't met.
-type FunctionMultiError []error
+// FunctionMultiError is an error wrapping multiple validation errors returned
+// by Function.ValidateAll() if the designated constraints aren't met.
+type FunctionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FunctionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FunctionMultiError) AllErrors() []error { return m }
+
+// FunctionValidationError is the validation error returned by
+// Function.Validate if the designated constraints aren't met.
+type FunctionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FunctionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FunctionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FunctionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FunctionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FunctionValidationError) ErrorName() string { return "FunctionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FunctionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFunction.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FunctionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FunctionValidationError{}
+
+// Validate checks the field values on RetryPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RetryPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RetryPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RetryPolicyMultiError, or
+// nil if none found.
+func (m *RetryPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetryPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MaxAttempts
+
+	if all {
+		switch v := interface{}(m.GetBackoff()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RetryPolicyValidationError{
+					field:  "Backoff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RetryPolicyValidationError{
+					field:  "Backoff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBackoff()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RetryPolicyValidationError{
+				field:  "Backoff",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RetryPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RetryPolicyMultiError is an error wrapping multiple validation errors
+// returned by RetryPolicy.ValidateAll() if the designated constraints aren't met.
+type RetryPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RetryPolicyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RetryPolicyMultiError) AllErrors() []error { return m }
+
+// RetryPolicyValidationError is the validation error returned by
+// RetryPolicy.Validate if the designated constraints aren't met.
+type RetryPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetryPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetryPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetryPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetryPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetryPolicyValidationError) ErrorName() string { return "RetryPolicyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RetryPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetryPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetryPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetryPolicyValidationError{}
+
+// Validate checks the field values on SourceBundle with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SourceBundle) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SourceBundle with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SourceBundleMultiError, or
+// nil if none found.
+func (m *SourceBundle) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SourceBundle) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Bucket
+
+	// no validation rules for ObjectKey
+
+	// no validation rules for Size
+
+	// no validation rules for Sha256
+
+	// no validation rules for Format
+
+	if len(errors) > 0 {
+		return SourceBundleMultiError(errors)
+	}
+
+	return nil
+}
+
+// SourceBundleMultiError is an error wrapping multiple validation errors
+// returned by SourceBundle.ValidateAll() if the designated constraints aren't met.
+type SourceBundleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SourceBundleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SourceBundleMultiError) AllErrors() []error { return m }
+
+// SourceBundleValidationError is the validation error returned by
+// SourceBundle.Validate if the designated constraints aren't met.
+type SourceBundleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SourceBundleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SourceBundleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SourceBundleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SourceBundleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SourceBundleValidationError) ErrorName() string { return "SourceBundleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SourceBundleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSourceBundle.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SourceBundleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SourceBundleValidationError{}
+
+// Validate checks the field values on FunctionBuild with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FunctionBuild) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FunctionBuild with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FunctionBuildMultiError, or
+// nil if none found.
+func (m *FunctionBuild) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FunctionBuild) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for State
+
+	if all {
+		switch v := interface{}(m.GetStartedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FunctionBuildValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FunctionBuildValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FunctionBuildValidationError{
+				field:  "StartedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FunctionBuildValidationError{
+					field:  "EndedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FunctionBuildValidationError{
+					field:  "EndedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FunctionBuildValidationError{
+				field:  "EndedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetArtifact()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FunctionBuildValidationError{
+					field:  "Artifact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FunctionBuildValidationError{
+					field:  "Artifact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArtifact()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FunctionBuildValidationError{
+				field:  "Artifact",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Log
+
+	// no validation rules for ErrorMessage
+
+	if len(errors) > 0 {
+		return FunctionBuildMultiError(errors)
+	}
+
+	return nil
+}
+
+// FunctionBuildMultiError is an error wrapping multiple validation errors
+// returned by FunctionBuild.ValidateAll() if the designated constraints
+// aren't met.
+type FunctionBuildMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FunctionBuildMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FunctionBuildMultiError) AllErrors() []error { return m }
+
+// FunctionBuildValidationError is the validation error returned by
+// FunctionBuild.Validate if the designated constraints aren't met.
+type FunctionBuildValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FunctionBuildValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FunctionBuildValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FunctionBuildValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FunctionBuildValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FunctionBuildValidationError) ErrorName() string { return "FunctionBuildValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FunctionBuildValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFunctionBuild.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FunctionBuildValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FunctionBuildValidationError{}
+
+// Validate checks the field values on FunctionAlias with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FunctionAlias) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FunctionAlias with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FunctionAliasMultiError, or
+// nil if none found.
+func (m *FunctionAlias) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FunctionAlias) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Function
+
+	// no validation rules for Name
+
+	for idx, item := range m.GetRoutes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FunctionAliasValidationError{
+						field:  fmt.Sprintf("Routes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FunctionAliasValidationError{
+						field:  fmt.Sprintf("Routes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FunctionAliasValidationError{
+					field:  fmt.Sprintf("Routes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FunctionAliasValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FunctionAliasValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FunctionAliasValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FunctionAliasMultiError(errors)
+	}
+
+	return nil
+}
+
+// FunctionAliasMultiError is an error wrapping multiple validation errors
+// returned by FunctionAlias.ValidateAll() if the designated constraints
+// aren't met.
+type FunctionAliasMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FunctionAliasMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FunctionAliasMultiError) AllErrors() []error { return m }
+
+// FunctionAliasValidationError is the validation error returned by
+// FunctionAlias.Validate if the designated constraints aren't met.
+type FunctionAliasValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FunctionAliasValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FunctionAliasValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FunctionAliasValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FunctionAliasValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FunctionAliasValidationError) ErrorName() string { return "FunctionAliasValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FunctionAliasValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFunctionAlias.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FunctionAliasValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FunctionAliasValidationError{}
+
+// Validate checks the field values on AliasRoute with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AliasRoute) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AliasRoute with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AliasRouteMultiError, or
+// nil if none found.
+func (m *AliasRoute) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AliasRoute) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Revision
+
+	// no validation rules for Weight
+
+	if len(errors) > 0 {
+		return AliasRouteMultiError(errors)
+	}
+
+	return nil
+}
+
+// AliasRouteMultiError is an error wrapping multiple validation errors
+// returned by AliasRoute.ValidateAll() if the designated constraints aren't met.
+type AliasRouteMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AliasRouteMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AliasRouteMultiError) AllErrors() []error { return m }
+
+// AliasRouteValidationError is the validation error returned by
+// AliasRoute.Validate if the designated constraints aren't met.
+type AliasRouteValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AliasRouteValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AliasRouteValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AliasRouteValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AliasRouteValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AliasRouteValidationError) ErrorName() string { return "AliasRouteValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AliasRouteValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAliasRoute.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AliasRouteValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AliasRouteValidationError{}
+
+// Validate checks the field values on UploadFunctionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadFunctionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadFunctionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadFunctionRequestMultiError, or nil if none found.
+func (m *UploadFunctionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadFunctionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Payload.(type) {
+	case *UploadFunctionRequest_UploadFunctionMetadata:
+		if v == nil {
+			err := UploadFunctionRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetUploadFunctionMetadata()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UploadFunctionRequestValidationError{
+						field:  "UploadFunctionMetadata",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UploadFunctionRequestValidationError{
+						field:  "UploadFunctionMetadata",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUploadFunctionMetadata()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UploadFunctionRequestValidationError{
+					field:  "UploadFunctionMetadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UploadFunctionRequest_UploadFunctionData:
+		if v == nil {
+			err := UploadFunctionRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetUploadFunctionData()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UploadFunctionRequestValidationError{
+						field:  "UploadFunctionData",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UploadFunctionRequestValidationError{
+						field:  "UploadFunctionData",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUploadFunctionData()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UploadFunctionRequestValidationError{
+					field:  "UploadFunctionData",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return UploadFunctionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadFunctionRequestMultiError is an error wrapping multiple validation
+// errors returned by UploadFunctionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UploadFunctionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadFunctionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadFunctionRequestMultiError) AllErrors() []error { return m }
+
+// UploadFunctionRequestValidationError is the validation error returned by
+// UploadFunctionRequest.Validate if the designated constraints aren't met.
+type UploadFunctionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadFunctionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadFunctionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadFunctionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadFunctionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadFunctionRequestValidationError) ErrorName() string {
+	return "UploadFunctionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadFunctionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadFunctionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadFunctionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadFunctionRequestValidationError{}
+
+// Validate checks the field values on UploadFunctionMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadFunctionMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadFunctionMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadFunctionMetadataMultiError, or nil if none found.
+func (m *UploadFunctionMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadFunctionMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FunctionName
+
+	// no validation rules for Format
+
+	// no validation rules for Env
+
+	// no validation rules for SecretEnv
+
+	// no validation rules for Sha256
+
+	// no validation rules for DisplayName
+
+	// no validation rules for Description
+
+	// no validation rules for Labels
+
+	if all {
+		switch v := interface{}(m.GetTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UploadFunctionMetadataValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UploadFunctionMetadataValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UploadFunctionMetadataValidationError{
+				field:  "Timeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MemoryBytes
+
+	// no validation rules for Runtime
+
+	// no validation rules for Annotations
+
+	if len(errors) > 0 {
+		return UploadFunctionMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadFunctionMetadataMultiError is an error wrapping multiple validation
+// errors returned by UploadFunctionMetadata.ValidateAll() if the designated
+// constraints aren't met.
+type UploadFunctionMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadFunctionMetadataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadFunctionMetadataMultiError) AllErrors() []error { return m }
+
+// UploadFunctionMetadataValidationError is the validation error returned by
+// UploadFunctionMetadata.Validate if the designated constraints aren't met.
+type UploadFunctionMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadFunctionMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadFunctionMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadFunctionMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadFunctionMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadFunctionMetadataValidationError) ErrorName() string {
+	return "UploadFunctionMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadFunctionMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadFunctionMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadFunctionMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadFunctionMetadataValidationError{}
+
+// Validate checks the field values on UploadFunctionData with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadFunctionData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadFunctionData with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadFunctionDataMultiError, or nil if none found.
+func (m *UploadFunctionData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadFunctionData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return UploadFunctionDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadFunctionDataMultiError is an error wrapping multiple validation errors
+// returned by UploadFunctionData.ValidateAll() if the designated constraints
+// aren't met.
+type UploadFunctionDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadFunctionDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadFunctionDataMultiError) AllErrors() []error { return m }
+
+// UploadFunctionDataValidationError is the validation error returned by
+// UploadFunctionData.Validate if the designated constraints aren't met.
+type UploadFunctionDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadFunctionDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadFunctionDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadFunctionDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadFunctionDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadFunctionDataValidationError) ErrorName() string {
+	return "UploadFunctionDataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadFunctionDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadFunctionData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadFunctionDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadFunctionDataValidationError{}
+
+// Validate checks the field values on UploadSession with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UploadSession) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadSession with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UploadSessionMultiError, or
+// nil if none found.
+func (m *UploadSession) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadSession) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for FunctionName
+
+	// no validation rules for Size
+
+	// no validation rules for CommittedOffset
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UploadSessionValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UploadSessionValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UploadSessionValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpireTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UploadSessionValidationError{
+					field:  "ExpireTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UploadSessionValidationError{
+					field:  "ExpireTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpireTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UploadSessionValidationError{
+				field:  "ExpireTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UploadSessionMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadSessionMultiError is an error wrapping multiple validation errors
+// returned by UploadSession.ValidateAll() if the designated constraints
+// aren't met.
+type UploadSessionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadSessionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadSessionMultiError) AllErrors() []error { return m }
+
+// UploadSessionValidationError is the validation error returned by
+// UploadSession.Validate if the designated constraints aren't met.
+type UploadSessionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadSessionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadSessionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadSessionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadSessionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadSessionValidationError) ErrorName() string { return "UploadSessionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UploadSessionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadSession.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadSessionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadSessionValidationError{}
+
+// Validate checks the field values on StartUploadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartUploadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartUploadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartUploadRequestMultiError, or nil if none found.
+func (m *StartUploadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartUploadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartUploadRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartUploadRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartUploadRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Size
+
+	if len(errors) > 0 {
+		return StartUploadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartUploadRequestMultiError is an error wrapping multiple validation errors
+// returned by StartUploadRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StartUploadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartUploadRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartUploadRequestMultiError) AllErrors() []error { return m }
+
+// StartUploadRequestValidationError is the validation error returned by
+// StartUploadRequest.Validate if the designated constraints aren't met.
+type StartUploadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartUploadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartUploadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartUploadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartUploadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartUploadRequestValidationError) ErrorName() string {
+	return "StartUploadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartUploadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartUploadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartUploadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartUploadRequestValidationError{}
+
+// Validate checks the field values on UploadChunkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadChunkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadChunkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadChunkRequestMultiError, or nil if none found.
+func (m *UploadChunkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadChunkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Offset
+
+	// no validation rules for Data
+
+	// no validation rules for Crc32C
+
+	if len(errors) > 0 {
+		return UploadChunkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadChunkRequestMultiError is an error wrapping multiple validation errors
+// returned by UploadChunkRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UploadChunkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadChunkRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadChunkRequestMultiError) AllErrors() []error { return m }
+
+// UploadChunkRequestValidationError is the validation error returned by
+// UploadChunkRequest.Validate if the designated constraints aren't met.
+type UploadChunkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadChunkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadChunkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadChunkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadChunkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadChunkRequestValidationError) ErrorName() string {
+	return "UploadChunkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadChunkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadChunkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadChunkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadChunkRequestValidationError{}
+
+// Validate checks the field values on GetUploadSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUploadSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUploadSessionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUploadSessionRequestMultiError, or nil if none found.
+func (m *GetUploadSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUploadSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return GetUploadSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUploadSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUploadSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUploadSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUploadSessionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUploadSessionRequestMultiError) AllErrors() []error { return m }
+
+// GetUploadSessionRequestValidationError is the validation error returned by
+// GetUploadSessionRequest.Validate if the designated constraints aren't met.
+type GetUploadSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUploadSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUploadSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUploadSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUploadSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUploadSessionRequestValidationError) ErrorName() string {
+	return "GetUploadSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUploadSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUploadSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUploadSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUploadSessionRequestValidationError{}
+
+// Validate checks the field values on FinalizeUploadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FinalizeUploadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FinalizeUploadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FinalizeUploadRequestMultiError, or nil if none found.
+func (m *FinalizeUploadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FinalizeUploadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Sha256
+
+	if len(errors) > 0 {
+		return FinalizeUploadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FinalizeUploadRequestMultiError is an error wrapping multiple validation
+// errors returned by FinalizeUploadRequest.ValidateAll() if the designated
+// constraints aren't met.
+type FinalizeUploadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FinalizeUploadRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FinalizeUploadRequestMultiError) AllErrors() []error { return m }
+
+// FinalizeUploadRequestValidationError is the validation error returned by
+// FinalizeUploadRequest.Validate if the designated constraints aren't met.
+type FinalizeUploadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FinalizeUploadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FinalizeUploadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FinalizeUploadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FinalizeUploadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FinalizeUploadRequestValidationError) ErrorName() string {
+	return "FinalizeUploadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FinalizeUploadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFinalizeUploadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FinalizeUploadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FinalizeUploadRequestValidationError{}
+
+// Validate checks the field values on ExecuteFunctionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExecuteFunctionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExecuteFunctionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExecuteFunctionRequestMultiError, or nil if none found.
+func (m *ExecuteFunctionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExecuteFunctionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Parameters
+
+	// no validation rules for Revision
+
+	// no validation rules for Labels
+
+	// no validation rules for Annotations
+
+	if len(errors) > 0 {
+		return ExecuteFunctionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExecuteFunctionRequestMultiError is an error wrapping multiple validation
+// errors returned by ExecuteFunctionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExecuteFunctionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExecuteFunctionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExecuteFunctionRequestMultiError) AllErrors() []error { return m }
+
+// ExecuteFunctionRequestValidationError is the validation error returned by
+// ExecuteFunctionRequest.Validate if the designated constraints aren't met.
+type ExecuteFunctionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExecuteFunctionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExecuteFunctionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExecuteFunctionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExecuteFunctionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExecuteFunctionRequestValidationError) ErrorName() string {
+	return "ExecuteFunctionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExecuteFunctionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExecuteFunctionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExecuteFunctionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExecuteFunctionRequestValidationError{}
+
+// Validate checks the field values on ExecuteFunctionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExecuteFunctionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExecuteFunctionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExecuteFunctionResponseMultiError, or nil if none found.
+func (m *ExecuteFunctionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExecuteFunctionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return ExecuteFunctionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExecuteFunctionResponseMultiError is an error wrapping multiple validation
+// errors returned by ExecuteFunctionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExecuteFunctionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExecuteFunctionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExecuteFunctionResponseMultiError) AllErrors() []error { return m }
+
+// ExecuteFunctionResponseValidationError is the validation error returned by
+// ExecuteFunctionResponse.Validate if the designated constraints aren't met.
+type ExecuteFunctionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExecuteFunctionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExecuteFunctionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExecuteFunctionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExecuteFunctionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExecuteFunctionResponseValidationError) ErrorName() string {
+	return "ExecuteFunctionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExecuteFunctionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExecuteFunctionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExecuteFunctionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExecuteFunctionResponseValidationError{}
+
+// Validate checks the field values on InvokeFunctionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InvokeFunctionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InvokeFunctionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InvokeFunctionRequestMultiError, or nil if none found.
+func (m *InvokeFunctionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InvokeFunctionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetExecute()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InvokeFunctionRequestValidationError{
+					field:  "Execute",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InvokeFunctionRequestValidationError{
+					field:  "Execute",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExecute()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InvokeFunctionRequestValidationError{
+				field:  "Execute",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InvokeFunctionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InvokeFunctionRequestMultiError is an error wrapping multiple validation
+// errors returned by InvokeFunctionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InvokeFunctionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InvokeFunctionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InvokeFunctionRequestMultiError) AllErrors() []error { return m }
+
+// InvokeFunctionRequestValidationError is the validation error returned by
+// InvokeFunctionRequest.Validate if the designated constraints aren't met.
+type InvokeFunctionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InvokeFunctionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InvokeFunctionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InvokeFunctionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InvokeFunctionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InvokeFunctionRequestValidationError) ErrorName() string {
+	return "InvokeFunctionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InvokeFunctionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInvokeFunctionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InvokeFunctionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InvokeFunctionRequestValidationError{}
+
+// Validate checks the field values on InvokeFunctionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InvokeFunctionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InvokeFunctionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InvokeFunctionResponseMultiError, or nil if none found.
+func (m *InvokeFunctionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InvokeFunctionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InvokeFunctionResponseValidationError{
+					field:  "Task",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InvokeFunctionResponseValidationError{
+					field:  "Task",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InvokeFunctionResponseValidationError{
+				field:  "Task",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Done
+
+	if len(errors) > 0 {
+		return InvokeFunctionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InvokeFunctionResponseMultiError is an error wrapping multiple validation
+// errors returned by InvokeFunctionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InvokeFunctionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InvokeFunctionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InvokeFunctionResponseMultiError) AllErrors() []error { return m }
+
+// InvokeFunctionResponseValidationError is the validation error returned by
+// InvokeFunctionResponse.Validate if the designated constraints aren't met.
+type InvokeFunctionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InvokeFunctionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InvokeFunctionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InvokeFunctionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InvokeFunctionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InvokeFunctionResponseValidationError) ErrorName() string {
+	return "InvokeFunctionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InvokeFunctionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInvokeFunctionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InvokeFunctionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InvokeFunctionResponseValidationError{}
+
+// Validate checks the field values on BatchExecuteFunctionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchExecuteFunctionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchExecuteFunctionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchExecuteFunctionRequestMultiError, or nil if none found.
+func (m *BatchExecuteFunctionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchExecuteFunctionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FailureThreshold
+
+	switch v := m.Payload.(type) {
+	case *BatchExecuteFunctionRequest_Execute:
+		if v == nil {
+			err := BatchExecuteFunctionRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetExecute()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchExecuteFunctionRequestValidationError{
+						field:  "Execute",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchExecuteFunctionRequestValidationError{
+						field:  "Execute",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExecute()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchExecuteFunctionRequestValidationError{
+					field:  "Execute",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *BatchExecuteFunctionRequest_Item:
+		if v == nil {
+			err := BatchExecuteFunctionRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetItem()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchExecuteFunctionRequestValidationError{
+						field:  "Item",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchExecuteFunctionRequestValidationError{
+						field:  "Item",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchExecuteFunctionRequestValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return BatchExecuteFunctionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchExecuteFunctionRequestMultiError is an error wrapping multiple
+// validation errors returned by BatchExecuteFunctionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type BatchExecuteFunctionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchExecuteFunctionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchExecuteFunctionRequestMultiError) AllErrors() []error { return m }
+
+// BatchExecuteFunctionRequestValidationError is the validation error returned
+// by BatchExecuteFunctionRequest.Validate if the designated constraints
+// aren't met.
+type BatchExecuteFunctionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchExecuteFunctionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchExecuteFunctionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchExecuteFunctionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchExecuteFunctionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchExecuteFunctionRequestValidationError) ErrorName() string {
+	return "BatchExecuteFunctionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchExecuteFunctionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchExecuteFunctionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchExecuteFunctionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchExecuteFunctionRequestValidationError{}
+
+// Validate checks the field values on BatchItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BatchItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BatchItemMultiError, or nil
+// if none found.
+func (m *BatchItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Parameters
+
+	if len(errors) > 0 {
+		return BatchItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchItemMultiError is an error wrapping multiple validation errors returned
+// by BatchItem.ValidateAll() if the designated constraints aren't met.
+type BatchItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchItemMultiError) AllErrors() []error { return m }
+
+// BatchItemValidationError is the validation error returned by
+// BatchItem.Validate if the designated constraints aren't met.
+type BatchItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchItemValidationError) ErrorName() string { return "BatchItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BatchItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchItemValidationError{}
+
+// Validate checks the field values on BatchExecuteFunctionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchExecuteFunctionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchExecuteFunctionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchExecuteFunctionResponseMultiError, or nil if none found.
+func (m *BatchExecuteFunctionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchExecuteFunctionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BatchId
+
+	// no validation rules for Index
+
+	// no validation rules for Task
+
+	if all {
+		switch v := interface{}(m.GetError()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BatchExecuteFunctionResponseValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BatchExecuteFunctionResponseValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BatchExecuteFunctionResponseValidationError{
+				field:  "Error",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BatchExecuteFunctionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchExecuteFunctionResponseMultiError is an error wrapping multiple
+// validation errors returned by BatchExecuteFunctionResponse.ValidateAll() if
+// the designated constraints aren't met.
+type BatchExecuteFunctionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchExecuteFunctionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchExecuteFunctionResponseMultiError) AllErrors() []error { return m }
+
+// BatchExecuteFunctionResponseValidationError is the validation error returned
+// by BatchExecuteFunctionResponse.Validate if the designated constraints
+// aren't met.
+type BatchExecuteFunctionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchExecuteFunctionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchExecuteFunctionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchExecuteFunctionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchExecuteFunctionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchExecuteFunctionResponseValidationError) ErrorName() string {
+	return "BatchExecuteFunctionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchExecuteFunctionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchExecuteFunctionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchExecuteFunctionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchExecuteFunctionResponseValidationError{}
+
+// Validate checks the field values on ExecuteFunctionWithInputsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ExecuteFunctionWithInputsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExecuteFunctionWithInputsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ExecuteFunctionWithInputsRequestMultiError, or nil if none found.
+func (m *ExecuteFunctionWithInputsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExecuteFunctionWithInputsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Payload.(type) {
+	case *ExecuteFunctionWithInputsRequest_Execute:
+		if v == nil {
+			err := ExecuteFunctionWithInputsRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetExecute()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExecuteFunctionWithInputsRequestValidationError{
+						field:  "Execute",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExecuteFunctionWithInputsRequestValidationError{
+						field:  "Execute",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExecute()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExecuteFunctionWithInputsRequestValidationError{
+					field:  "Execute",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ExecuteFunctionWithInputsRequest_InputHeader:
+		if v == nil {
+			err := ExecuteFunctionWithInputsRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetInputHeader()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExecuteFunctionWithInputsRequestValidationError{
+						field:  "InputHeader",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExecuteFunctionWithInputsRequestValidationError{
+						field:  "InputHeader",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetInputHeader()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExecuteFunctionWithInputsRequestValidationError{
+					field:  "InputHeader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ExecuteFunctionWithInputsRequest_InputData:
+		if v == nil {
+			err := ExecuteFunctionWithInputsRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetInputData()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExecuteFunctionWithInputsRequestValidationError{
+						field:  "InputData",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExecuteFunctionWithInputsRequestValidationError{
+						field:  "InputData",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetInputData()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExecuteFunctionWithInputsRequestValidationError{
+					field:  "InputData",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return ExecuteFunctionWithInputsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExecuteFunctionWithInputsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ExecuteFunctionWithInputsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExecuteFunctionWithInputsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExecuteFunctionWithInputsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExecuteFunctionWithInputsRequestMultiError) AllErrors() []error { return m }
+
+// ExecuteFunctionWithInputsRequestValidationError is the validation error
+// returned by ExecuteFunctionWithInputsRequest.Validate if the designated
+// constraints aren't met.
+type ExecuteFunctionWithInputsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExecuteFunctionWithInputsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExecuteFunctionWithInputsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExecuteFunctionWithInputsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExecuteFunctionWithInputsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExecuteFunctionWithInputsRequestValidationError) ErrorName() string {
+	return "ExecuteFunctionWithInputsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExecuteFunctionWithInputsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExecuteFunctionWithInputsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExecuteFunctionWithInputsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExecuteFunctionWithInputsRequestValidationError{}
+
+// Validate checks the field values on TaskInputHeader with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TaskInputHeader) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TaskInputHeader with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TaskInputHeaderMultiError, or nil if none found.
+func (m *TaskInputHeader) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TaskInputHeader) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Sha256
+
+	if len(errors) > 0 {
+		return TaskInputHeaderMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaskInputHeaderMultiError is an error wrapping multiple validation errors
+// returned by TaskInputHeader.ValidateAll() if the designated constraints
+// aren't met.
+type TaskInputHeaderMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaskInputHeaderMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaskInputHeaderMultiError) AllErrors() []error { return m }
+
+// TaskInputHeaderValidationError is the validation error returned by
+// TaskInputHeader.Validate if the designated constraints aren't met.
+type TaskInputHeaderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaskInputHeaderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaskInputHeaderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaskInputHeaderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaskInputHeaderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaskInputHeaderValidationError) ErrorName() string { return "TaskInputHeaderValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TaskInputHeaderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaskInputHeader.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaskInputHeaderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaskInputHeaderValidationError{}
+
+// Validate checks the field values on TaskInputData with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TaskInputData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TaskInputData with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TaskInputDataMultiError, or
+// nil if none found.
+func (m *TaskInputData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TaskInputData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return TaskInputDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaskInputDataMultiError is an error wrapping multiple validation errors
+// returned by TaskInputData.ValidateAll() if the designated constraints
+// aren't met.
+type TaskInputDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaskInputDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaskInputDataMultiError) AllErrors() []error { return m }
+
+// TaskInputDataValidationError is the validation error returned by
+// TaskInputData.Validate if the designated constraints aren't met.
+type TaskInputDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaskInputDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaskInputDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaskInputDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaskInputDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaskInputDataValidationError) ErrorName() string { return "TaskInputDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TaskInputDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaskInputData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaskInputDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaskInputDataValidationError{}
+
+// Validate checks the field values on GetFunctionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFunctionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFunctionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFunctionRequestMultiError, or nil if none found.
+func (m *GetFunctionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFunctionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return GetFunctionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFunctionRequestMultiError is an error wrapping multiple validation errors
+// returned by GetFunctionRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetFunctionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFunctionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFunctionRequestMultiError) AllErrors() []error { return m }
+
+// GetFunctionRequestValidationError is the validation error returned by
+// GetFunctionRequest.Validate if the designated constraints aren't met.
+type GetFunctionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFunctionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFunctionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFunctionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFunctionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFunctionRequestValidationError) ErrorName() string {
+	return "GetFunctionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFunctionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFunctionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFunctionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFunctionRequestValidationError{}
+
+// Validate checks the field values on ListFunctionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFunctionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFunctionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFunctionsRequestMultiError, or nil if none found.
+func (m *ListFunctionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFunctionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	// no validation rules for Filter
+
+	// no validation rules for OrderBy
+
+	// no validation rules for ShowDeleted
+
+	if len(errors) > 0 {
+		return ListFunctionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFunctionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListFunctionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListFunctionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFunctionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFunctionsRequestMultiError) AllErrors() []error { return m }
+
+// ListFunctionsRequestValidationError is the validation error returned by
+// ListFunctionsRequest.Validate if the designated constraints aren't met.
+type ListFunctionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFunctionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFunctionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFunctionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFunctionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFunctionsRequestValidationError) ErrorName() string {
+	return "ListFunctionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFunctionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFunctionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFunctionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFunctionsRequestValidationError{}
+
+// Validate checks the field values on ListFunctionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFunctionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFunctionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFunctionsResponseMultiError, or nil if none found.
+func (m *ListFunctionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFunctionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFunctions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListFunctionsResponseValidationError{
+						field:  fmt.Sprintf("Functions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListFunctionsResponseValidationError{
+						field:  fmt.Sprintf("Functions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListFunctionsResponseValidationError{
+					field:  fmt.Sprintf("Functions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListFunctionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFunctionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListFunctionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListFunctionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFunctionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFunctionsResponseMultiError) AllErrors() []error { return m }
+
+// ListFunctionsResponseValidationError is the validation error returned by
+// ListFunctionsResponse.Validate if the designated constraints aren't met.
+type ListFunctionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFunctionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFunctionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFunctionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFunctionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFunctionsResponseValidationError) ErrorName() string {
+	return "ListFunctionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFunctionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFunctionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFunctionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFunctionsResponseValidationError{}
+
+// Validate checks the field values on ListFunctionRevisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFunctionRevisionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFunctionRevisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFunctionRevisionsRequestMultiError, or nil if none found.
+func (m *ListFunctionRevisionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFunctionRevisionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListFunctionRevisionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFunctionRevisionsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListFunctionRevisionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListFunctionRevisionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFunctionRevisionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFunctionRevisionsRequestMultiError) AllErrors() []error { return m }
+
+// ListFunctionRevisionsRequestValidationError is the validation error returned
+// by ListFunctionRevisionsRequest.Validate if the designated constraints
+// aren't met.
+type ListFunctionRevisionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFunctionRevisionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFunctionRevisionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFunctionRevisionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFunctionRevisionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFunctionRevisionsRequestValidationError) ErrorName() string {
+	return "ListFunctionRevisionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFunctionRevisionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFunctionRevisionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFunctionRevisionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFunctionRevisionsRequestValidationError{}
+
+// Validate checks the field values on ListFunctionRevisionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFunctionRevisionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFunctionRevisionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListFunctionRevisionsResponseMultiError, or nil if none found.
+func (m *ListFunctionRevisionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFunctionRevisionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRevisions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListFunctionRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListFunctionRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListFunctionRevisionsResponseValidationError{
+					field:  fmt.Sprintf("Revisions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListFunctionRevisionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFunctionRevisionsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListFunctionRevisionsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListFunctionRevisionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFunctionRevisionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFunctionRevisionsResponseMultiError) AllErrors() []error { return m }
+
+// ListFunctionRevisionsResponseValidationError is the validation error
+// returned by ListFunctionRevisionsResponse.Validate if the designated
+// constraints aren't met.
+type ListFunctionRevisionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFunctionRevisionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFunctionRevisionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFunctionRevisionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFunctionRevisionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFunctionRevisionsResponseValidationError) ErrorName() string {
+	return "ListFunctionRevisionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFunctionRevisionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFunctionRevisionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFunctionRevisionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFunctionRevisionsResponseValidationError{}
+
+// Validate checks the field values on GetFunctionRevisionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFunctionRevisionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFunctionRevisionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFunctionRevisionRequestMultiError, or nil if none found.
+func (m *GetFunctionRevisionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFunctionRevisionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Revision
+
+	if len(errors) > 0 {
+		return GetFunctionRevisionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFunctionRevisionRequestMultiError is an error wrapping multiple
+// validation errors returned by GetFunctionRevisionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetFunctionRevisionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFunctionRevisionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFunctionRevisionRequestMultiError) AllErrors() []error { return m }
+
+// GetFunctionRevisionRequestValidationError is the validation error returned
+// by GetFunctionRevisionRequest.Validate if the designated constraints aren't met.
+type GetFunctionRevisionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFunctionRevisionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFunctionRevisionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFunctionRevisionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFunctionRevisionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFunctionRevisionRequestValidationError) ErrorName() string {
+	return "GetFunctionRevisionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFunctionRevisionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFunctionRevisionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFunctionRevisionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFunctionRevisionRequestValidationError{}
+
+// Validate checks the field values on UpdateFunctionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateFunctionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateFunctionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateFunctionRequestMultiError, or nil if none found.
+func (m *UpdateFunctionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateFunctionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFunction()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateFunctionRequestValidationError{
+					field:  "Function",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateFunctionRequestValidationError{
+					field:  "Function",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFunction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateFunctionRequestValidationError{
+				field:  "Function",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateFunctionRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateFunctionRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateFunctionRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateFunctionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateFunctionRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateFunctionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateFunctionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateFunctionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateFunctionRequestMultiError) AllErrors() []error { return m }
+
+// UpdateFunctionRequestValidationError is the validation error returned by
+// UpdateFunctionRequest.Validate if the designated constraints aren't met.
+type UpdateFunctionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateFunctionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateFunctionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateFunctionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateFunctionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateFunctionRequestValidationError) ErrorName() string {
+	return "UpdateFunctionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateFunctionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateFunctionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateFunctionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateFunctionRequestValidationError{}
+
+// Validate checks the field values on DeleteFunctionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteFunctionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteFunctionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteFunctionRequestMultiError, or nil if none found.
+func (m *DeleteFunctionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteFunctionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Force
+
+	if len(errors) > 0 {
+		return DeleteFunctionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteFunctionRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteFunctionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteFunctionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FunctionMultiError) Error() string {
+func (m DeleteFunctionRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -140,11 +4406,11 @@ func (m FunctionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FunctionMultiError) AllErrors() []error { return m }
+func (m DeleteFunctionRequestMultiError) AllErrors() []error { return m }
 
-// FunctionValidationError is the validation error returned by
-// Function.Validate if the designated constraints aren't met.
-type FunctionValidationError struct {
+// DeleteFunctionRequestValidationError is the validation error returned by
+// DeleteFunctionRequest.Validate if the designated constraints aren't met.
+type DeleteFunctionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -152,22 +4418,24 @@ type FunctionValidationError struct {
 }
 
 // Field function returns field value.
-func (e FunctionValidationError) Field() string { return e.field }
+func (e DeleteFunctionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FunctionValidationError) Reason() string { return e.reason }
+func (e DeleteFunctionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FunctionValidationError) Cause() error { return e.cause }
+func (e DeleteFunctionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FunctionValidationError) Key() bool { return e.key }
+func (e DeleteFunctionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FunctionValidationError) ErrorName() string { return "FunctionValidationError" }
+func (e DeleteFunctionRequestValidationError) ErrorName() string {
+	return "DeleteFunctionRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e FunctionValidationError) Error() string {
+func (e DeleteFunctionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -179,14 +4447,14 @@ func (e FunctionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFunction.%s: %s%s",
+		"invalid %sDeleteFunctionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FunctionValidationError{}
+var _ error = DeleteFunctionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -194,51 +4462,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FunctionValidationError{}
+} = DeleteFunctionRequestValidationError{}
 
-// Validate checks the field values on SourceBundle with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *SourceBundle) Validate() error {
+// Validate checks the field values on UndeleteFunctionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UndeleteFunctionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SourceBundle with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SourceBundleMultiError, or
-// nil if none found.
-func (m *SourceBundle) ValidateAll() error {
+// ValidateAll checks the field values on UndeleteFunctionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UndeleteFunctionRequestMultiError, or nil if none found.
+func (m *UndeleteFunctionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SourceBundle) validate(all bool) error {
+func (m *UndeleteFunctionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Bucket
-
-	// no validation rules for ObjectKey
-
-	// no validation rules for Size
-
-	// no validation rules for Sha256
+	// no validation rules for Name
 
 	if len(errors) > 0 {
-		return SourceBundleMultiError(errors)
+		return UndeleteFunctionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SourceBundleMultiError is an error wrapping multiple validation errors
-// returned by SourceBundle.ValidateAll() if the designated constraints aren't met.
-type SourceBundleMultiError []error
+// UndeleteFunctionRequestMultiError is an error wrapping multiple validation
+// errors returned by UndeleteFunctionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UndeleteFunctionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SourceBundleMultiError) Error() string {
+func (m UndeleteFunctionRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -247,11 +4510,11 @@ func (m SourceBundleMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SourceBundleMultiError) AllErrors() []error { return m }
+func (m UndeleteFunctionRequestMultiError) AllErrors() []error { return m }
 
-// SourceBundleValidationError is the validation error returned by
-// SourceBundle.Validate if the designated constraints aren't met.
-type SourceBundleValidationError struct {
+// UndeleteFunctionRequestValidationError is the validation error returned by
+// UndeleteFunctionRequest.Validate if the designated constraints aren't met.
+type UndeleteFunctionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -259,22 +4522,24 @@ type SourceBundleValidationError struct {
 }
 
 // Field function returns field value.
-func (e SourceBundleValidationError) Field() string { return e.field }
+func (e UndeleteFunctionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SourceBundleValidationError) Reason() string { return e.reason }
+func (e UndeleteFunctionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SourceBundleValidationError) Cause() error { return e.cause }
+func (e UndeleteFunctionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SourceBundleValidationError) Key() bool { return e.key }
+func (e UndeleteFunctionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SourceBundleValidationError) ErrorName() string { return "SourceBundleValidationError" }
+func (e UndeleteFunctionRequestValidationError) ErrorName() string {
+	return "UndeleteFunctionRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SourceBundleValidationError) Error() string {
+func (e UndeleteFunctionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -286,14 +4551,14 @@ func (e SourceBundleValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSourceBundle.%s: %s%s",
+		"invalid %sUndeleteFunctionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SourceBundleValidationError{}
+var _ error = UndeleteFunctionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -301,131 +4566,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SourceBundleValidationError{}
+} = UndeleteFunctionRequestValidationError{}
 
-// Validate checks the field values on UploadFunctionRequest with the rules
+// Validate checks the field values on GetNamespaceUsageRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UploadFunctionRequest) Validate() error {
+func (m *GetNamespaceUsageRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UploadFunctionRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetNamespaceUsageRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UploadFunctionRequestMultiError, or nil if none found.
-func (m *UploadFunctionRequest) ValidateAll() error {
+// GetNamespaceUsageRequestMultiError, or nil if none found.
+func (m *GetNamespaceUsageRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UploadFunctionRequest) validate(all bool) error {
+func (m *GetNamespaceUsageRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Payload.(type) {
-	case *UploadFunctionRequest_UploadFunctionMetadata:
-		if v == nil {
-			err := UploadFunctionRequestValidationError{
-				field:  "Payload",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetUploadFunctionMetadata()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UploadFunctionRequestValidationError{
-						field:  "UploadFunctionMetadata",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UploadFunctionRequestValidationError{
-						field:  "UploadFunctionMetadata",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetUploadFunctionMetadata()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return UploadFunctionRequestValidationError{
-					field:  "UploadFunctionMetadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *UploadFunctionRequest_UploadFunctionData:
-		if v == nil {
-			err := UploadFunctionRequestValidationError{
-				field:  "Payload",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetUploadFunctionData()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UploadFunctionRequestValidationError{
-						field:  "UploadFunctionData",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UploadFunctionRequestValidationError{
-						field:  "UploadFunctionData",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetUploadFunctionData()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return UploadFunctionRequestValidationError{
-					field:  "UploadFunctionData",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	default:
-		_ = v // ensures v is used
-	}
+	// no validation rules for Namespace
 
 	if len(errors) > 0 {
-		return UploadFunctionRequestMultiError(errors)
+		return GetNamespaceUsageRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UploadFunctionRequestMultiError is an error wrapping multiple validation
-// errors returned by UploadFunctionRequest.ValidateAll() if the designated
+// GetNamespaceUsageRequestMultiError is an error wrapping multiple validation
+// errors returned by GetNamespaceUsageRequest.ValidateAll() if the designated
 // constraints aren't met.
-type UploadFunctionRequestMultiError []error
+type GetNamespaceUsageRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UploadFunctionRequestMultiError) Error() string {
+func (m GetNamespaceUsageRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -434,11 +4614,11 @@ func (m UploadFunctionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UploadFunctionRequestMultiError) AllErrors() []error { return m }
+func (m GetNamespaceUsageRequestMultiError) AllErrors() []error { return m }
 
-// UploadFunctionRequestValidationError is the validation error returned by
-// UploadFunctionRequest.Validate if the designated constraints aren't met.
-type UploadFunctionRequestValidationError struct {
+// GetNamespaceUsageRequestValidationError is the validation error returned by
+// GetNamespaceUsageRequest.Validate if the designated constraints aren't met.
+type GetNamespaceUsageRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -446,24 +4626,24 @@ type UploadFunctionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UploadFunctionRequestValidationError) Field() string { return e.field }
+func (e GetNamespaceUsageRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UploadFunctionRequestValidationError) Reason() string { return e.reason }
+func (e GetNamespaceUsageRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UploadFunctionRequestValidationError) Cause() error { return e.cause }
+func (e GetNamespaceUsageRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UploadFunctionRequestValidationError) Key() bool { return e.key }
+func (e GetNamespaceUsageRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UploadFunctionRequestValidationError) ErrorName() string {
-	return "UploadFunctionRequestValidationError"
+func (e GetNamespaceUsageRequestValidationError) ErrorName() string {
+	return "GetNamespaceUsageRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UploadFunctionRequestValidationError) Error() string {
+func (e GetNamespaceUsageRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -475,14 +4655,14 @@ func (e UploadFunctionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUploadFunctionRequest.%s: %s%s",
+		"invalid %sGetNamespaceUsageRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UploadFunctionRequestValidationError{}
+var _ error = GetNamespaceUsageRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -490,48 +4670,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UploadFunctionRequestValidationError{}
+} = GetNamespaceUsageRequestValidationError{}
 
-// Validate checks the field values on UploadFunctionMetadata with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UploadFunctionMetadata) Validate() error {
+// Validate checks the field values on NamespaceUsage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *NamespaceUsage) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UploadFunctionMetadata with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UploadFunctionMetadataMultiError, or nil if none found.
-func (m *UploadFunctionMetadata) ValidateAll() error {
+// ValidateAll checks the field values on NamespaceUsage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NamespaceUsageMultiError,
+// or nil if none found.
+func (m *NamespaceUsage) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UploadFunctionMetadata) validate(all bool) error {
+func (m *NamespaceUsage) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for FunctionName
+	// no validation rules for Namespace
 
-	// no validation rules for Format
+	// no validation rules for BundleBytes
+
+	// no validation rules for QuotaBytes
+
+	// no validation rules for MaxBundleBytes
 
 	if len(errors) > 0 {
-		return UploadFunctionMetadataMultiError(errors)
+		return NamespaceUsageMultiError(errors)
 	}
 
 	return nil
 }
 
-// UploadFunctionMetadataMultiError is an error wrapping multiple validation
-// errors returned by UploadFunctionMetadata.ValidateAll() if the designated
-// constraints aren't met.
-type UploadFunctionMetadataMultiError []error
+// NamespaceUsageMultiError is an error wrapping multiple validation errors
+// returned by NamespaceUsage.ValidateAll() if the designated constraints
+// aren't met.
+type NamespaceUsageMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UploadFunctionMetadataMultiError) Error() string {
+func (m NamespaceUsageMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -540,11 +4724,11 @@ func (m UploadFunctionMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UploadFunctionMetadataMultiError) AllErrors() []error { return m }
+func (m NamespaceUsageMultiError) AllErrors() []error { return m }
 
-// UploadFunctionMetadataValidationError is the validation error returned by
-// UploadFunctionMetadata.Validate if the designated constraints aren't met.
-type UploadFunctionMetadataValidationError struct {
+// NamespaceUsageValidationError is the validation error returned by
+// NamespaceUsage.Validate if the designated constraints aren't met.
+type NamespaceUsageValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -552,24 +4736,22 @@ type UploadFunctionMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e UploadFunctionMetadataValidationError) Field() string { return e.field }
+func (e NamespaceUsageValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UploadFunctionMetadataValidationError) Reason() string { return e.reason }
+func (e NamespaceUsageValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UploadFunctionMetadataValidationError) Cause() error { return e.cause }
+func (e NamespaceUsageValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UploadFunctionMetadataValidationError) Key() bool { return e.key }
+func (e NamespaceUsageValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UploadFunctionMetadataValidationError) ErrorName() string {
-	return "UploadFunctionMetadataValidationError"
-}
+func (e NamespaceUsageValidationError) ErrorName() string { return "NamespaceUsageValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UploadFunctionMetadataValidationError) Error() string {
+func (e NamespaceUsageValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -581,14 +4763,14 @@ func (e UploadFunctionMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUploadFunctionMetadata.%s: %s%s",
+		"invalid %sNamespaceUsage.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UploadFunctionMetadataValidationError{}
+var _ error = NamespaceUsageValidationError{}
 
 var _ interface {
 	Field() string
@@ -596,46 +4778,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UploadFunctionMetadataValidationError{}
+} = NamespaceUsageValidationError{}
 
-// Validate checks the field values on UploadFunctionData with the rules
+// Validate checks the field values on DownloadFunctionRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UploadFunctionData) Validate() error {
+func (m *DownloadFunctionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UploadFunctionData with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DownloadFunctionRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UploadFunctionDataMultiError, or nil if none found.
-func (m *UploadFunctionData) ValidateAll() error {
+// DownloadFunctionRequestMultiError, or nil if none found.
+func (m *DownloadFunctionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UploadFunctionData) validate(all bool) error {
+func (m *DownloadFunctionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Data
+	// no validation rules for Name
+
+	// no validation rules for Revision
 
 	if len(errors) > 0 {
-		return UploadFunctionDataMultiError(errors)
+		return DownloadFunctionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UploadFunctionDataMultiError is an error wrapping multiple validation errors
-// returned by UploadFunctionData.ValidateAll() if the designated constraints
-// aren't met.
-type UploadFunctionDataMultiError []error
+// DownloadFunctionRequestMultiError is an error wrapping multiple validation
+// errors returned by DownloadFunctionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DownloadFunctionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UploadFunctionDataMultiError) Error() string {
+func (m DownloadFunctionRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -643,12 +4827,12 @@ func (m UploadFunctionDataMultiError) Error() string {
 	return strings.Join(msgs, "; ")
 }
 
-// AllErrors returns a list of validation violation errors.
-func (m UploadFunctionDataMultiError) AllErrors() []error { return m }
-
-// UploadFunctionDataValidationError is the validation error returned by
-// UploadFunctionData.Validate if the designated constraints aren't met.
-type UploadFunctionDataValidationError struct {
+// AllErrors returns a list of validation violation errors.
+func (m DownloadFunctionRequestMultiError) AllErrors() []error { return m }
+
+// DownloadFunctionRequestValidationError is the validation error returned by
+// DownloadFunctionRequest.Validate if the designated constraints aren't met.
+type DownloadFunctionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -656,24 +4840,24 @@ type UploadFunctionDataValidationError struct {
 }
 
 // Field function returns field value.
-func (e UploadFunctionDataValidationError) Field() string { return e.field }
+func (e DownloadFunctionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UploadFunctionDataValidationError) Reason() string { return e.reason }
+func (e DownloadFunctionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UploadFunctionDataValidationError) Cause() error { return e.cause }
+func (e DownloadFunctionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UploadFunctionDataValidationError) Key() bool { return e.key }
+func (e DownloadFunctionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UploadFunctionDataValidationError) ErrorName() string {
-	return "UploadFunctionDataValidationError"
+func (e DownloadFunctionRequestValidationError) ErrorName() string {
+	return "DownloadFunctionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UploadFunctionDataValidationError) Error() string {
+func (e DownloadFunctionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -685,14 +4869,14 @@ func (e UploadFunctionDataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUploadFunctionData.%s: %s%s",
+		"invalid %sDownloadFunctionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UploadFunctionDataValidationError{}
+var _ error = DownloadFunctionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -700,48 +4884,102 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UploadFunctionDataValidationError{}
+} = DownloadFunctionRequestValidationError{}
 
-// Validate checks the field values on ExecuteFunctionRequest with the rules
+// Validate checks the field values on DownloadFunctionResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ExecuteFunctionRequest) Validate() error {
+func (m *DownloadFunctionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ExecuteFunctionRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DownloadFunctionResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ExecuteFunctionRequestMultiError, or nil if none found.
-func (m *ExecuteFunctionRequest) ValidateAll() error {
+// DownloadFunctionResponseMultiError, or nil if none found.
+func (m *DownloadFunctionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ExecuteFunctionRequest) validate(all bool) error {
+func (m *DownloadFunctionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	switch v := m.Payload.(type) {
+	case *DownloadFunctionResponse_Function:
+		if v == nil {
+			err := DownloadFunctionResponseValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-	// no validation rules for Parameters
+		if all {
+			switch v := interface{}(m.GetFunction()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DownloadFunctionResponseValidationError{
+						field:  "Function",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DownloadFunctionResponseValidationError{
+						field:  "Function",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFunction()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DownloadFunctionResponseValidationError{
+					field:  "Function",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *DownloadFunctionResponse_Data:
+		if v == nil {
+			err := DownloadFunctionResponseValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Data
+	default:
+		_ = v // ensures v is used
+	}
 
 	if len(errors) > 0 {
-		return ExecuteFunctionRequestMultiError(errors)
+		return DownloadFunctionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExecuteFunctionRequestMultiError is an error wrapping multiple validation
-// errors returned by ExecuteFunctionRequest.ValidateAll() if the designated
+// DownloadFunctionResponseMultiError is an error wrapping multiple validation
+// errors returned by DownloadFunctionResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ExecuteFunctionRequestMultiError []error
+type DownloadFunctionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExecuteFunctionRequestMultiError) Error() string {
+func (m DownloadFunctionResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -750,11 +4988,11 @@ func (m ExecuteFunctionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExecuteFunctionRequestMultiError) AllErrors() []error { return m }
+func (m DownloadFunctionResponseMultiError) AllErrors() []error { return m }
 
-// ExecuteFunctionRequestValidationError is the validation error returned by
-// ExecuteFunctionRequest.Validate if the designated constraints aren't met.
-type ExecuteFunctionRequestValidationError struct {
+// DownloadFunctionResponseValidationError is the validation error returned by
+// DownloadFunctionResponse.Validate if the designated constraints aren't met.
+type DownloadFunctionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -762,24 +5000,24 @@ type ExecuteFunctionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExecuteFunctionRequestValidationError) Field() string { return e.field }
+func (e DownloadFunctionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExecuteFunctionRequestValidationError) Reason() string { return e.reason }
+func (e DownloadFunctionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExecuteFunctionRequestValidationError) Cause() error { return e.cause }
+func (e DownloadFunctionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExecuteFunctionRequestValidationError) Key() bool { return e.key }
+func (e DownloadFunctionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExecuteFunctionRequestValidationError) ErrorName() string {
-	return "ExecuteFunctionRequestValidationError"
+func (e DownloadFunctionResponseValidationError) ErrorName() string {
+	return "DownloadFunctionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ExecuteFunctionRequestValidationError) Error() string {
+func (e DownloadFunctionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -791,14 +5029,14 @@ func (e ExecuteFunctionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExecuteFunctionRequest.%s: %s%s",
+		"invalid %sDownloadFunctionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExecuteFunctionRequestValidationError{}
+var _ error = DownloadFunctionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -806,46 +5044,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExecuteFunctionRequestValidationError{}
+} = DownloadFunctionResponseValidationError{}
 
-// Validate checks the field values on ExecuteFunctionResponse with the rules
+// Validate checks the field values on UpdateAliasRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ExecuteFunctionResponse) Validate() error {
+func (m *UpdateAliasRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ExecuteFunctionResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateAliasRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ExecuteFunctionResponseMultiError, or nil if none found.
-func (m *ExecuteFunctionResponse) ValidateAll() error {
+// UpdateAliasRequestMultiError, or nil if none found.
+func (m *UpdateAliasRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ExecuteFunctionResponse) validate(all bool) error {
+func (m *UpdateAliasRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	if all {
+		switch v := interface{}(m.GetAlias()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateAliasRequestValidationError{
+					field:  "Alias",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateAliasRequestValidationError{
+					field:  "Alias",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAlias()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateAliasRequestValidationError{
+				field:  "Alias",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return ExecuteFunctionResponseMultiError(errors)
+		return UpdateAliasRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExecuteFunctionResponseMultiError is an error wrapping multiple validation
-// errors returned by ExecuteFunctionResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ExecuteFunctionResponseMultiError []error
+// UpdateAliasRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateAliasRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateAliasRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExecuteFunctionResponseMultiError) Error() string {
+func (m UpdateAliasRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -854,11 +5119,11 @@ func (m ExecuteFunctionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExecuteFunctionResponseMultiError) AllErrors() []error { return m }
+func (m UpdateAliasRequestMultiError) AllErrors() []error { return m }
 
-// ExecuteFunctionResponseValidationError is the validation error returned by
-// ExecuteFunctionResponse.Validate if the designated constraints aren't met.
-type ExecuteFunctionResponseValidationError struct {
+// UpdateAliasRequestValidationError is the validation error returned by
+// UpdateAliasRequest.Validate if the designated constraints aren't met.
+type UpdateAliasRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -866,24 +5131,24 @@ type ExecuteFunctionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExecuteFunctionResponseValidationError) Field() string { return e.field }
+func (e UpdateAliasRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExecuteFunctionResponseValidationError) Reason() string { return e.reason }
+func (e UpdateAliasRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExecuteFunctionResponseValidationError) Cause() error { return e.cause }
+func (e UpdateAliasRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExecuteFunctionResponseValidationError) Key() bool { return e.key }
+func (e UpdateAliasRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExecuteFunctionResponseValidationError) ErrorName() string {
-	return "ExecuteFunctionResponseValidationError"
+func (e UpdateAliasRequestValidationError) ErrorName() string {
+	return "UpdateAliasRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ExecuteFunctionResponseValidationError) Error() string {
+func (e UpdateAliasRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -895,14 +5160,14 @@ func (e ExecuteFunctionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExecuteFunctionResponse.%s: %s%s",
+		"invalid %sUpdateAliasRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExecuteFunctionResponseValidationError{}
+var _ error = UpdateAliasRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -910,46 +5175,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExecuteFunctionResponseValidationError{}
+} = UpdateAliasRequestValidationError{}
 
-// Validate checks the field values on GetFunctionRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetFunctionRequest) Validate() error {
+// Validate checks the field values on GetAliasRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetAliasRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetFunctionRequest with the rules
+// ValidateAll checks the field values on GetAliasRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetFunctionRequestMultiError, or nil if none found.
-func (m *GetFunctionRequest) ValidateAll() error {
+// GetAliasRequestMultiError, or nil if none found.
+func (m *GetAliasRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetFunctionRequest) validate(all bool) error {
+func (m *GetAliasRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Function
+
 	// no validation rules for Name
 
 	if len(errors) > 0 {
-		return GetFunctionRequestMultiError(errors)
+		return GetAliasRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetFunctionRequestMultiError is an error wrapping multiple validation errors
-// returned by GetFunctionRequest.ValidateAll() if the designated constraints
+// GetAliasRequestMultiError is an error wrapping multiple validation errors
+// returned by GetAliasRequest.ValidateAll() if the designated constraints
 // aren't met.
-type GetFunctionRequestMultiError []error
+type GetAliasRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetFunctionRequestMultiError) Error() string {
+func (m GetAliasRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -958,11 +5225,11 @@ func (m GetFunctionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetFunctionRequestMultiError) AllErrors() []error { return m }
+func (m GetAliasRequestMultiError) AllErrors() []error { return m }
 
-// GetFunctionRequestValidationError is the validation error returned by
-// GetFunctionRequest.Validate if the designated constraints aren't met.
-type GetFunctionRequestValidationError struct {
+// GetAliasRequestValidationError is the validation error returned by
+// GetAliasRequest.Validate if the designated constraints aren't met.
+type GetAliasRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -970,24 +5237,22 @@ type GetFunctionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetFunctionRequestValidationError) Field() string { return e.field }
+func (e GetAliasRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetFunctionRequestValidationError) Reason() string { return e.reason }
+func (e GetAliasRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetFunctionRequestValidationError) Cause() error { return e.cause }
+func (e GetAliasRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetFunctionRequestValidationError) Key() bool { return e.key }
+func (e GetAliasRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetFunctionRequestValidationError) ErrorName() string {
-	return "GetFunctionRequestValidationError"
-}
+func (e GetAliasRequestValidationError) ErrorName() string { return "GetAliasRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetFunctionRequestValidationError) Error() string {
+func (e GetAliasRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -999,14 +5264,14 @@ func (e GetFunctionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetFunctionRequest.%s: %s%s",
+		"invalid %sGetAliasRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetFunctionRequestValidationError{}
+var _ error = GetAliasRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1014,48 +5279,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetFunctionRequestValidationError{}
+} = GetAliasRequestValidationError{}
 
-// Validate checks the field values on ListFunctionsRequest with the rules
+// Validate checks the field values on ListAliasesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListFunctionsRequest) Validate() error {
+func (m *ListAliasesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListFunctionsRequest with the rules
+// ValidateAll checks the field values on ListAliasesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListFunctionsRequestMultiError, or nil if none found.
-func (m *ListFunctionsRequest) ValidateAll() error {
+// ListAliasesRequestMultiError, or nil if none found.
+func (m *ListAliasesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListFunctionsRequest) validate(all bool) error {
+func (m *ListAliasesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PageSize
-
-	// no validation rules for PageToken
+	// no validation rules for Function
 
 	if len(errors) > 0 {
-		return ListFunctionsRequestMultiError(errors)
+		return ListAliasesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListFunctionsRequestMultiError is an error wrapping multiple validation
-// errors returned by ListFunctionsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListFunctionsRequestMultiError []error
+// ListAliasesRequestMultiError is an error wrapping multiple validation errors
+// returned by ListAliasesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListAliasesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListFunctionsRequestMultiError) Error() string {
+func (m ListAliasesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1064,11 +5327,11 @@ func (m ListFunctionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListFunctionsRequestMultiError) AllErrors() []error { return m }
+func (m ListAliasesRequestMultiError) AllErrors() []error { return m }
 
-// ListFunctionsRequestValidationError is the validation error returned by
-// ListFunctionsRequest.Validate if the designated constraints aren't met.
-type ListFunctionsRequestValidationError struct {
+// ListAliasesRequestValidationError is the validation error returned by
+// ListAliasesRequest.Validate if the designated constraints aren't met.
+type ListAliasesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1076,24 +5339,24 @@ type ListFunctionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListFunctionsRequestValidationError) Field() string { return e.field }
+func (e ListAliasesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListFunctionsRequestValidationError) Reason() string { return e.reason }
+func (e ListAliasesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListFunctionsRequestValidationError) Cause() error { return e.cause }
+func (e ListAliasesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListFunctionsRequestValidationError) Key() bool { return e.key }
+func (e ListAliasesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListFunctionsRequestValidationError) ErrorName() string {
-	return "ListFunctionsRequestValidationError"
+func (e ListAliasesRequestValidationError) ErrorName() string {
+	return "ListAliasesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListFunctionsRequestValidationError) Error() string {
+func (e ListAliasesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1105,14 +5368,14 @@ func (e ListFunctionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListFunctionsRequest.%s: %s%s",
+		"invalid %sListAliasesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListFunctionsRequestValidationError{}
+var _ error = ListAliasesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1120,47 +5383,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListFunctionsRequestValidationError{}
+} = ListAliasesRequestValidationError{}
 
-// Validate checks the field values on ListFunctionsResponse with the rules
+// Validate checks the field values on ListAliasesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListFunctionsResponse) Validate() error {
+func (m *ListAliasesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListFunctionsResponse with the rules
+// ValidateAll checks the field values on ListAliasesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListFunctionsResponseMultiError, or nil if none found.
-func (m *ListFunctionsResponse) ValidateAll() error {
+// ListAliasesResponseMultiError, or nil if none found.
+func (m *ListAliasesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListFunctionsResponse) validate(all bool) error {
+func (m *ListAliasesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetFunctions() {
+	for idx, item := range m.GetAliases() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListFunctionsResponseValidationError{
-						field:  fmt.Sprintf("Functions[%v]", idx),
+					errors = append(errors, ListAliasesResponseValidationError{
+						field:  fmt.Sprintf("Aliases[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListFunctionsResponseValidationError{
-						field:  fmt.Sprintf("Functions[%v]", idx),
+					errors = append(errors, ListAliasesResponseValidationError{
+						field:  fmt.Sprintf("Aliases[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -1168,8 +5431,8 @@ func (m *ListFunctionsResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListFunctionsResponseValidationError{
-					field:  fmt.Sprintf("Functions[%v]", idx),
+				return ListAliasesResponseValidationError{
+					field:  fmt.Sprintf("Aliases[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1178,22 +5441,20 @@ func (m *ListFunctionsResponse) validate(all bool) error {
 
 	}
 
-	// no validation rules for NextPageToken
-
 	if len(errors) > 0 {
-		return ListFunctionsResponseMultiError(errors)
+		return ListAliasesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListFunctionsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListFunctionsResponse.ValidateAll() if the designated
+// ListAliasesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAliasesResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ListFunctionsResponseMultiError []error
+type ListAliasesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListFunctionsResponseMultiError) Error() string {
+func (m ListAliasesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1202,11 +5463,11 @@ func (m ListFunctionsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListFunctionsResponseMultiError) AllErrors() []error { return m }
+func (m ListAliasesResponseMultiError) AllErrors() []error { return m }
 
-// ListFunctionsResponseValidationError is the validation error returned by
-// ListFunctionsResponse.Validate if the designated constraints aren't met.
-type ListFunctionsResponseValidationError struct {
+// ListAliasesResponseValidationError is the validation error returned by
+// ListAliasesResponse.Validate if the designated constraints aren't met.
+type ListAliasesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1214,24 +5475,24 @@ type ListFunctionsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListFunctionsResponseValidationError) Field() string { return e.field }
+func (e ListAliasesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListFunctionsResponseValidationError) Reason() string { return e.reason }
+func (e ListAliasesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListFunctionsResponseValidationError) Cause() error { return e.cause }
+func (e ListAliasesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListFunctionsResponseValidationError) Key() bool { return e.key }
+func (e ListAliasesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListFunctionsResponseValidationError) ErrorName() string {
-	return "ListFunctionsResponseValidationError"
+func (e ListAliasesResponseValidationError) ErrorName() string {
+	return "ListAliasesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListFunctionsResponseValidationError) Error() string {
+func (e ListAliasesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1243,14 +5504,14 @@ func (e ListFunctionsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListFunctionsResponse.%s: %s%s",
+		"invalid %sListAliasesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListFunctionsResponseValidationError{}
+var _ error = ListAliasesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1258,46 +5519,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListFunctionsResponseValidationError{}
+} = ListAliasesResponseValidationError{}
 
-// Validate checks the field values on DeleteFunctionRequest with the rules
+// Validate checks the field values on DeleteAliasRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteFunctionRequest) Validate() error {
+func (m *DeleteAliasRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteFunctionRequest with the rules
+// ValidateAll checks the field values on DeleteAliasRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteFunctionRequestMultiError, or nil if none found.
-func (m *DeleteFunctionRequest) ValidateAll() error {
+// DeleteAliasRequestMultiError, or nil if none found.
+func (m *DeleteAliasRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteFunctionRequest) validate(all bool) error {
+func (m *DeleteAliasRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Function
+
 	// no validation rules for Name
 
 	if len(errors) > 0 {
-		return DeleteFunctionRequestMultiError(errors)
+		return DeleteAliasRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteFunctionRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteFunctionRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteFunctionRequestMultiError []error
+// DeleteAliasRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteAliasRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteAliasRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteFunctionRequestMultiError) Error() string {
+func (m DeleteAliasRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1306,11 +5569,11 @@ func (m DeleteFunctionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteFunctionRequestMultiError) AllErrors() []error { return m }
+func (m DeleteAliasRequestMultiError) AllErrors() []error { return m }
 
-// DeleteFunctionRequestValidationError is the validation error returned by
-// DeleteFunctionRequest.Validate if the designated constraints aren't met.
-type DeleteFunctionRequestValidationError struct {
+// DeleteAliasRequestValidationError is the validation error returned by
+// DeleteAliasRequest.Validate if the designated constraints aren't met.
+type DeleteAliasRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1318,24 +5581,24 @@ type DeleteFunctionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteFunctionRequestValidationError) Field() string { return e.field }
+func (e DeleteAliasRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteFunctionRequestValidationError) Reason() string { return e.reason }
+func (e DeleteAliasRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteFunctionRequestValidationError) Cause() error { return e.cause }
+func (e DeleteAliasRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteFunctionRequestValidationError) Key() bool { return e.key }
+func (e DeleteAliasRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteFunctionRequestValidationError) ErrorName() string {
-	return "DeleteFunctionRequestValidationError"
+func (e DeleteAliasRequestValidationError) ErrorName() string {
+	return "DeleteAliasRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteFunctionRequestValidationError) Error() string {
+func (e DeleteAliasRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1347,14 +5610,14 @@ func (e DeleteFunctionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteFunctionRequest.%s: %s%s",
+		"invalid %sDeleteAliasRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteFunctionRequestValidationError{}
+var _ error = DeleteAliasRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1362,4 +5625,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteFunctionRequestValidationError{}
+} = DeleteAliasRequestValidationError{}
@@ -20,11 +20,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Functions_UploadFunction_FullMethodName  = "/faas.v1.functions.Functions/UploadFunction"
-	Functions_ExecuteFunction_FullMethodName = "/faas.v1.functions.Functions/ExecuteFunction"
-	Functions_GetFunction_FullMethodName     = "/faas.v1.functions.Functions/GetFunction"
-	Functions_ListFunctions_FullMethodName   = "/faas.v1.functions.Functions/ListFunctions"
-	Functions_DeleteFunction_FullMethodName  = "/faas.v1.functions.Functions/DeleteFunction"
+	Functions_UploadFunction_FullMethodName            = "/faas.v1.functions.Functions/UploadFunction"
+	Functions_StartUpload_FullMethodName               = "/faas.v1.functions.Functions/StartUpload"
+	Functions_UploadChunks_FullMethodName              = "/faas.v1.functions.Functions/UploadChunks"
+	Functions_GetUploadSession_FullMethodName          = "/faas.v1.functions.Functions/GetUploadSession"
+	Functions_FinalizeUpload_FullMethodName            = "/faas.v1.functions.Functions/FinalizeUpload"
+	Functions_ExecuteFunction_FullMethodName           = "/faas.v1.functions.Functions/ExecuteFunction"
+	Functions_ExecuteFunctionWithInputs_FullMethodName = "/faas.v1.functions.Functions/ExecuteFunctionWithInputs"
+	Functions_InvokeFunction_FullMethodName            = "/faas.v1.functions.Functions/InvokeFunction"
+	Functions_BatchExecuteFunction_FullMethodName      = "/faas.v1.functions.Functions/BatchExecuteFunction"
+	Functions_GetFunction_FullMethodName               = "/faas.v1.functions.Functions/GetFunction"
+	Functions_ListFunctions_FullMethodName             = "/faas.v1.functions.Functions/ListFunctions"
+	Functions_ListFunctionRevisions_FullMethodName     = "/faas.v1.functions.Functions/ListFunctionRevisions"
+	Functions_GetFunctionRevision_FullMethodName       = "/faas.v1.functions.Functions/GetFunctionRevision"
+	Functions_UpdateFunction_FullMethodName            = "/faas.v1.functions.Functions/UpdateFunction"
+	Functions_DeleteFunction_FullMethodName            = "/faas.v1.functions.Functions/DeleteFunction"
+	Functions_UndeleteFunction_FullMethodName          = "/faas.v1.functions.Functions/UndeleteFunction"
+	Functions_DownloadFunction_FullMethodName          = "/faas.v1.functions.Functions/DownloadFunction"
+	Functions_GetNamespaceUsage_FullMethodName         = "/faas.v1.functions.Functions/GetNamespaceUsage"
+	Functions_UpdateAlias_FullMethodName               = "/faas.v1.functions.Functions/UpdateAlias"
+	Functions_GetAlias_FullMethodName                  = "/faas.v1.functions.Functions/GetAlias"
+	Functions_ListAliases_FullMethodName               = "/faas.v1.functions.Functions/ListAliases"
+	Functions_DeleteAlias_FullMethodName               = "/faas.v1.functions.Functions/DeleteAlias"
 )
 
 // FunctionsClient is the client API for Functions service.
@@ -32,10 +49,49 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type FunctionsClient interface {
 	UploadFunction(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadFunctionRequest, Function], error)
+	// Starts a resumable upload. Chunks are sent with UploadChunks, the
+	// committed offset is read back with GetUploadSession after a reconnect,
+	// and FinalizeUpload creates the revision like UploadFunction does.
+	StartUpload(ctx context.Context, in *StartUploadRequest, opts ...grpc.CallOption) (*UploadSession, error)
+	// Appends chunks at the committed offset; each chunk is committed on its
+	// own, so an interrupted stream keeps what it already delivered.
+	UploadChunks(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadChunkRequest, UploadSession], error)
+	GetUploadSession(ctx context.Context, in *GetUploadSessionRequest, opts ...grpc.CallOption) (*UploadSession, error)
+	FinalizeUpload(ctx context.Context, in *FinalizeUploadRequest, opts ...grpc.CallOption) (*Function, error)
 	ExecuteFunction(ctx context.Context, in *ExecuteFunctionRequest, opts ...grpc.CallOption) (*ExecuteFunctionResponse, error)
+	// Like ExecuteFunction, but also uploads input files for the task. They
+	// are deleted with the task, which the gateway deletes once its task
+	// retention has passed after the task ended.
+	ExecuteFunctionWithInputs(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ExecuteFunctionWithInputsRequest, ExecuteFunctionResponse], error)
+	// Like ExecuteFunction, but waits for the task to end, up to the call's
+	// deadline and the server's limit, and returns it with its result.
+	InvokeFunction(ctx context.Context, in *InvokeFunctionRequest, opts ...grpc.CallOption) (*InvokeFunctionResponse, error)
+	// Creates one task per item, all with the same batch_id and revision,
+	// grouped by the job "jobs/<batch_id>". Each item gets a response, in
+	// order, with its task or why it was rejected; other failures end the
+	// call, as does canceling the job.
+	BatchExecuteFunction(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[BatchExecuteFunctionRequest, BatchExecuteFunctionResponse], error)
 	GetFunction(ctx context.Context, in *GetFunctionRequest, opts ...grpc.CallOption) (*Function, error)
 	ListFunctions(ctx context.Context, in *ListFunctionsRequest, opts ...grpc.CallOption) (*ListFunctionsResponse, error)
+	// Lists revisions of a function, newest first.
+	ListFunctionRevisions(ctx context.Context, in *ListFunctionRevisionsRequest, opts ...grpc.CallOption) (*ListFunctionRevisionsResponse, error)
+	GetFunctionRevision(ctx context.Context, in *GetFunctionRevisionRequest, opts ...grpc.CallOption) (*Function, error)
+	// Updates metadata of the latest revision. Code is changed by uploading.
+	UpdateFunction(ctx context.Context, in *UpdateFunctionRequest, opts ...grpc.CallOption) (*Function, error)
+	// Deletes the function; it can be undeleted until the retention window
+	// ends and it is purged.
 	DeleteFunction(ctx context.Context, in *DeleteFunctionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Restores a deleted function that has not been purged yet.
+	UndeleteFunction(ctx context.Context, in *UndeleteFunctionRequest, opts ...grpc.CallOption) (*Function, error)
+	// Streams the uploaded source bundle of a function revision.
+	DownloadFunction(ctx context.Context, in *DownloadFunctionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadFunctionResponse], error)
+	// Reports bundle storage used by a namespace and the configured limits.
+	GetNamespaceUsage(ctx context.Context, in *GetNamespaceUsageRequest, opts ...grpc.CallOption) (*NamespaceUsage, error)
+	// Creates the alias or replaces its routes.
+	UpdateAlias(ctx context.Context, in *UpdateAliasRequest, opts ...grpc.CallOption) (*FunctionAlias, error)
+	GetAlias(ctx context.Context, in *GetAliasRequest, opts ...grpc.CallOption) (*FunctionAlias, error)
+	ListAliases(ctx context.Context, in *ListAliasesRequest, opts ...grpc.CallOption) (*ListAliasesResponse, error)
+	DeleteAlias(ctx context.Context, in *DeleteAliasRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type functionsClient struct {
@@ -59,6 +115,49 @@ func (c *functionsClient) UploadFunction(ctx context.Context, opts ...grpc.CallO
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Functions_UploadFunctionClient = grpc.ClientStreamingClient[UploadFunctionRequest, Function]
 
+func (c *functionsClient) StartUpload(ctx context.Context, in *StartUploadRequest, opts ...grpc.CallOption) (*UploadSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadSession)
+	err := c.cc.Invoke(ctx, Functions_StartUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *functionsClient) UploadChunks(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadChunkRequest, UploadSession], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Functions_ServiceDesc.Streams[1], Functions_UploadChunks_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadChunkRequest, UploadSession]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Functions_UploadChunksClient = grpc.ClientStreamingClient[UploadChunkRequest, UploadSession]
+
+func (c *functionsClient) GetUploadSession(ctx context.Context, in *GetUploadSessionRequest, opts ...grpc.CallOption) (*UploadSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadSession)
+	err := c.cc.Invoke(ctx, Functions_GetUploadSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *functionsClient) FinalizeUpload(ctx context.Context, in *FinalizeUploadRequest, opts ...grpc.CallOption) (*Function, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Function)
+	err := c.cc.Invoke(ctx, Functions_FinalizeUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *functionsClient) ExecuteFunction(ctx context.Context, in *ExecuteFunctionRequest, opts ...grpc.CallOption) (*ExecuteFunctionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ExecuteFunctionResponse)
@@ -69,6 +168,42 @@ func (c *functionsClient) ExecuteFunction(ctx context.Context, in *ExecuteFuncti
 	return out, nil
 }
 
+func (c *functionsClient) ExecuteFunctionWithInputs(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ExecuteFunctionWithInputsRequest, ExecuteFunctionResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Functions_ServiceDesc.Streams[2], Functions_ExecuteFunctionWithInputs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExecuteFunctionWithInputsRequest, ExecuteFunctionResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Functions_ExecuteFunctionWithInputsClient = grpc.ClientStreamingClient[ExecuteFunctionWithInputsRequest, ExecuteFunctionResponse]
+
+func (c *functionsClient) InvokeFunction(ctx context.Context, in *InvokeFunctionRequest, opts ...grpc.CallOption) (*InvokeFunctionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvokeFunctionResponse)
+	err := c.cc.Invoke(ctx, Functions_InvokeFunction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *functionsClient) BatchExecuteFunction(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[BatchExecuteFunctionRequest, BatchExecuteFunctionResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Functions_ServiceDesc.Streams[3], Functions_BatchExecuteFunction_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[BatchExecuteFunctionRequest, BatchExecuteFunctionResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Functions_BatchExecuteFunctionClient = grpc.BidiStreamingClient[BatchExecuteFunctionRequest, BatchExecuteFunctionResponse]
+
 func (c *functionsClient) GetFunction(ctx context.Context, in *GetFunctionRequest, opts ...grpc.CallOption) (*Function, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Function)
@@ -89,6 +224,36 @@ func (c *functionsClient) ListFunctions(ctx context.Context, in *ListFunctionsRe
 	return out, nil
 }
 
+func (c *functionsClient) ListFunctionRevisions(ctx context.Context, in *ListFunctionRevisionsRequest, opts ...grpc.CallOption) (*ListFunctionRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFunctionRevisionsResponse)
+	err := c.cc.Invoke(ctx, Functions_ListFunctionRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *functionsClient) GetFunctionRevision(ctx context.Context, in *GetFunctionRevisionRequest, opts ...grpc.CallOption) (*Function, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Function)
+	err := c.cc.Invoke(ctx, Functions_GetFunctionRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *functionsClient) UpdateFunction(ctx context.Context, in *UpdateFunctionRequest, opts ...grpc.CallOption) (*Function, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Function)
+	err := c.cc.Invoke(ctx, Functions_UpdateFunction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *functionsClient) DeleteFunction(ctx context.Context, in *DeleteFunctionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -99,15 +264,133 @@ func (c *functionsClient) DeleteFunction(ctx context.Context, in *DeleteFunction
 	return out, nil
 }
 
+func (c *functionsClient) UndeleteFunction(ctx context.Context, in *UndeleteFunctionRequest, opts ...grpc.CallOption) (*Function, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Function)
+	err := c.cc.Invoke(ctx, Functions_UndeleteFunction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *functionsClient) DownloadFunction(ctx context.Context, in *DownloadFunctionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadFunctionResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Functions_ServiceDesc.Streams[4], Functions_DownloadFunction_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadFunctionRequest, DownloadFunctionResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Functions_DownloadFunctionClient = grpc.ServerStreamingClient[DownloadFunctionResponse]
+
+func (c *functionsClient) GetNamespaceUsage(ctx context.Context, in *GetNamespaceUsageRequest, opts ...grpc.CallOption) (*NamespaceUsage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NamespaceUsage)
+	err := c.cc.Invoke(ctx, Functions_GetNamespaceUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *functionsClient) UpdateAlias(ctx context.Context, in *UpdateAliasRequest, opts ...grpc.CallOption) (*FunctionAlias, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FunctionAlias)
+	err := c.cc.Invoke(ctx, Functions_UpdateAlias_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *functionsClient) GetAlias(ctx context.Context, in *GetAliasRequest, opts ...grpc.CallOption) (*FunctionAlias, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FunctionAlias)
+	err := c.cc.Invoke(ctx, Functions_GetAlias_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *functionsClient) ListAliases(ctx context.Context, in *ListAliasesRequest, opts ...grpc.CallOption) (*ListAliasesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAliasesResponse)
+	err := c.cc.Invoke(ctx, Functions_ListAliases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *functionsClient) DeleteAlias(ctx context.Context, in *DeleteAliasRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Functions_DeleteAlias_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FunctionsServer is the server API for Functions service.
 // All implementations must embed UnimplementedFunctionsServer
 // for forward compatibility.
 type FunctionsServer interface {
 	UploadFunction(grpc.ClientStreamingServer[UploadFunctionRequest, Function]) error
+	// Starts a resumable upload. Chunks are sent with UploadChunks, the
+	// committed offset is read back with GetUploadSession after a reconnect,
+	// and FinalizeUpload creates the revision like UploadFunction does.
+	StartUpload(context.Context, *StartUploadRequest) (*UploadSession, error)
+	// Appends chunks at the committed offset; each chunk is committed on its
+	// own, so an interrupted stream keeps what it already delivered.
+	UploadChunks(grpc.ClientStreamingServer[UploadChunkRequest, UploadSession]) error
+	GetUploadSession(context.Context, *GetUploadSessionRequest) (*UploadSession, error)
+	FinalizeUpload(context.Context, *FinalizeUploadRequest) (*Function, error)
 	ExecuteFunction(context.Context, *ExecuteFunctionRequest) (*ExecuteFunctionResponse, error)
+	// Like ExecuteFunction, but also uploads input files for the task. They
+	// are deleted with the task, which the gateway deletes once its task
+	// retention has passed after the task ended.
+	ExecuteFunctionWithInputs(grpc.ClientStreamingServer[ExecuteFunctionWithInputsRequest, ExecuteFunctionResponse]) error
+	// Like ExecuteFunction, but waits for the task to end, up to the call's
+	// deadline and the server's limit, and returns it with its result.
+	InvokeFunction(context.Context, *InvokeFunctionRequest) (*InvokeFunctionResponse, error)
+	// Creates one task per item, all with the same batch_id and revision,
+	// grouped by the job "jobs/<batch_id>". Each item gets a response, in
+	// order, with its task or why it was rejected; other failures end the
+	// call, as does canceling the job.
+	BatchExecuteFunction(grpc.BidiStreamingServer[BatchExecuteFunctionRequest, BatchExecuteFunctionResponse]) error
 	GetFunction(context.Context, *GetFunctionRequest) (*Function, error)
 	ListFunctions(context.Context, *ListFunctionsRequest) (*ListFunctionsResponse, error)
+	// Lists revisions of a function, newest first.
+	ListFunctionRevisions(context.Context, *ListFunctionRevisionsRequest) (*ListFunctionRevisionsResponse, error)
+	GetFunctionRevision(context.Context, *GetFunctionRevisionRequest) (*Function, error)
+	// Updates metadata of the latest revision. Code is changed by uploading.
+	UpdateFunction(context.Context, *UpdateFunctionRequest) (*Function, error)
+	// Deletes the function; it can be undeleted until the retention window
+	// ends and it is purged.
 	DeleteFunction(context.Context, *DeleteFunctionRequest) (*emptypb.Empty, error)
+	// Restores a deleted function that has not been purged yet.
+	UndeleteFunction(context.Context, *UndeleteFunctionRequest) (*Function, error)
+	// Streams the uploaded source bundle of a function revision.
+	DownloadFunction(*DownloadFunctionRequest, grpc.ServerStreamingServer[DownloadFunctionResponse]) error
+	// Reports bundle storage used by a namespace and the configured limits.
+	GetNamespaceUsage(context.Context, *GetNamespaceUsageRequest) (*NamespaceUsage, error)
+	// Creates the alias or replaces its routes.
+	UpdateAlias(context.Context, *UpdateAliasRequest) (*FunctionAlias, error)
+	GetAlias(context.Context, *GetAliasRequest) (*FunctionAlias, error)
+	ListAliases(context.Context, *ListAliasesRequest) (*ListAliasesResponse, error)
+	DeleteAlias(context.Context, *DeleteAliasRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedFunctionsServer()
 }
 
@@ -121,18 +404,69 @@ type UnimplementedFunctionsServer struct{}
 func (UnimplementedFunctionsServer) UploadFunction(grpc.ClientStreamingServer[UploadFunctionRequest, Function]) error {
 	return status.Error(codes.Unimplemented, "method UploadFunction not implemented")
 }
+func (UnimplementedFunctionsServer) StartUpload(context.Context, *StartUploadRequest) (*UploadSession, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartUpload not implemented")
+}
+func (UnimplementedFunctionsServer) UploadChunks(grpc.ClientStreamingServer[UploadChunkRequest, UploadSession]) error {
+	return status.Error(codes.Unimplemented, "method UploadChunks not implemented")
+}
+func (UnimplementedFunctionsServer) GetUploadSession(context.Context, *GetUploadSessionRequest) (*UploadSession, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUploadSession not implemented")
+}
+func (UnimplementedFunctionsServer) FinalizeUpload(context.Context, *FinalizeUploadRequest) (*Function, error) {
+	return nil, status.Error(codes.Unimplemented, "method FinalizeUpload not implemented")
+}
 func (UnimplementedFunctionsServer) ExecuteFunction(context.Context, *ExecuteFunctionRequest) (*ExecuteFunctionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ExecuteFunction not implemented")
 }
+func (UnimplementedFunctionsServer) ExecuteFunctionWithInputs(grpc.ClientStreamingServer[ExecuteFunctionWithInputsRequest, ExecuteFunctionResponse]) error {
+	return status.Error(codes.Unimplemented, "method ExecuteFunctionWithInputs not implemented")
+}
+func (UnimplementedFunctionsServer) InvokeFunction(context.Context, *InvokeFunctionRequest) (*InvokeFunctionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InvokeFunction not implemented")
+}
+func (UnimplementedFunctionsServer) BatchExecuteFunction(grpc.BidiStreamingServer[BatchExecuteFunctionRequest, BatchExecuteFunctionResponse]) error {
+	return status.Error(codes.Unimplemented, "method BatchExecuteFunction not implemented")
+}
 func (UnimplementedFunctionsServer) GetFunction(context.Context, *GetFunctionRequest) (*Function, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetFunction not implemented")
 }
 func (UnimplementedFunctionsServer) ListFunctions(context.Context, *ListFunctionsRequest) (*ListFunctionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListFunctions not implemented")
 }
+func (UnimplementedFunctionsServer) ListFunctionRevisions(context.Context, *ListFunctionRevisionsRequest) (*ListFunctionRevisionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFunctionRevisions not implemented")
+}
+func (UnimplementedFunctionsServer) GetFunctionRevision(context.Context, *GetFunctionRevisionRequest) (*Function, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFunctionRevision not implemented")
+}
+func (UnimplementedFunctionsServer) UpdateFunction(context.Context, *UpdateFunctionRequest) (*Function, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateFunction not implemented")
+}
 func (UnimplementedFunctionsServer) DeleteFunction(context.Context, *DeleteFunctionRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteFunction not implemented")
 }
+func (UnimplementedFunctionsServer) UndeleteFunction(context.Context, *UndeleteFunctionRequest) (*Function, error) {
+	return nil, status.Error(codes.Unimplemented, "method UndeleteFunction not implemented")
+}
+func (UnimplementedFunctionsServer) DownloadFunction(*DownloadFunctionRequest, grpc.ServerStreamingServer[DownloadFunctionResponse]) error {
+	return status.Error(codes.Unimplemented, "method DownloadFunction not implemented")
+}
+func (UnimplementedFunctionsServer) GetNamespaceUsage(context.Context, *GetNamespaceUsageRequest) (*NamespaceUsage, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNamespaceUsage not implemented")
+}
+func (UnimplementedFunctionsServer) UpdateAlias(context.Context, *UpdateAliasRequest) (*FunctionAlias, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateAlias not implemented")
+}
+func (UnimplementedFunctionsServer) GetAlias(context.Context, *GetAliasRequest) (*FunctionAlias, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAlias not implemented")
+}
+func (UnimplementedFunctionsServer) ListAliases(context.Context, *ListAliasesRequest) (*ListAliasesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAliases not implemented")
+}
+func (UnimplementedFunctionsServer) DeleteAlias(context.Context, *DeleteAliasRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAlias not implemented")
+}
 func (UnimplementedFunctionsServer) mustEmbedUnimplementedFunctionsServer() {}
 func (UnimplementedFunctionsServer) testEmbeddedByValue()                   {}
 
@@ -161,6 +495,67 @@ func _Functions_UploadFunction_Handler(srv interface{}, stream grpc.ServerStream
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Functions_UploadFunctionServer = grpc.ClientStreamingServer[UploadFunctionRequest, Function]
 
+func _Functions_StartUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FunctionsServer).StartUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Functions_StartUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FunctionsServer).StartUpload(ctx, req.(*StartUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Functions_UploadChunks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(FunctionsServer).UploadChunks(&grpc.GenericServerStream[UploadChunkRequest, UploadSession]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Functions_UploadChunksServer = grpc.ClientStreamingServer[UploadChunkRequest, UploadSession]
+
+func _Functions_GetUploadSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUploadSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FunctionsServer).GetUploadSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Functions_GetUploadSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FunctionsServer).GetUploadSession(ctx, req.(*GetUploadSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Functions_FinalizeUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizeUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FunctionsServer).FinalizeUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Functions_FinalizeUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FunctionsServer).FinalizeUpload(ctx, req.(*FinalizeUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Functions_ExecuteFunction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExecuteFunctionRequest)
 	if err := dec(in); err != nil {
@@ -179,6 +574,38 @@ func _Functions_ExecuteFunction_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Functions_ExecuteFunctionWithInputs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(FunctionsServer).ExecuteFunctionWithInputs(&grpc.GenericServerStream[ExecuteFunctionWithInputsRequest, ExecuteFunctionResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Functions_ExecuteFunctionWithInputsServer = grpc.ClientStreamingServer[ExecuteFunctionWithInputsRequest, ExecuteFunctionResponse]
+
+func _Functions_InvokeFunction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvokeFunctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FunctionsServer).InvokeFunction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Functions_InvokeFunction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FunctionsServer).InvokeFunction(ctx, req.(*InvokeFunctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Functions_BatchExecuteFunction_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(FunctionsServer).BatchExecuteFunction(&grpc.GenericServerStream[BatchExecuteFunctionRequest, BatchExecuteFunctionResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Functions_BatchExecuteFunctionServer = grpc.BidiStreamingServer[BatchExecuteFunctionRequest, BatchExecuteFunctionResponse]
+
 func _Functions_GetFunction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetFunctionRequest)
 	if err := dec(in); err != nil {
@@ -215,6 +642,60 @@ func _Functions_ListFunctions_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Functions_ListFunctionRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFunctionRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FunctionsServer).ListFunctionRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Functions_ListFunctionRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FunctionsServer).ListFunctionRevisions(ctx, req.(*ListFunctionRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Functions_GetFunctionRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFunctionRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FunctionsServer).GetFunctionRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Functions_GetFunctionRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FunctionsServer).GetFunctionRevision(ctx, req.(*GetFunctionRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Functions_UpdateFunction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFunctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FunctionsServer).UpdateFunction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Functions_UpdateFunction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FunctionsServer).UpdateFunction(ctx, req.(*UpdateFunctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Functions_DeleteFunction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteFunctionRequest)
 	if err := dec(in); err != nil {
@@ -233,6 +714,125 @@ func _Functions_DeleteFunction_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Functions_UndeleteFunction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteFunctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FunctionsServer).UndeleteFunction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Functions_UndeleteFunction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FunctionsServer).UndeleteFunction(ctx, req.(*UndeleteFunctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Functions_DownloadFunction_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadFunctionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FunctionsServer).DownloadFunction(m, &grpc.GenericServerStream[DownloadFunctionRequest, DownloadFunctionResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Functions_DownloadFunctionServer = grpc.ServerStreamingServer[DownloadFunctionResponse]
+
+func _Functions_GetNamespaceUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FunctionsServer).GetNamespaceUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Functions_GetNamespaceUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FunctionsServer).GetNamespaceUsage(ctx, req.(*GetNamespaceUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Functions_UpdateAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FunctionsServer).UpdateAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Functions_UpdateAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FunctionsServer).UpdateAlias(ctx, req.(*UpdateAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Functions_GetAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FunctionsServer).GetAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Functions_GetAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FunctionsServer).GetAlias(ctx, req.(*GetAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Functions_ListAliases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAliasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FunctionsServer).ListAliases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Functions_ListAliases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FunctionsServer).ListAliases(ctx, req.(*ListAliasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Functions_DeleteAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FunctionsServer).DeleteAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Functions_DeleteAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FunctionsServer).DeleteAlias(ctx, req.(*DeleteAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Functions_ServiceDesc is the grpc.ServiceDesc for Functions service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -240,10 +840,26 @@ var Functions_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "faas.v1.functions.Functions",
 	HandlerType: (*FunctionsServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartUpload",
+			Handler:    _Functions_StartUpload_Handler,
+		},
+		{
+			MethodName: "GetUploadSession",
+			Handler:    _Functions_GetUploadSession_Handler,
+		},
+		{
+			MethodName: "FinalizeUpload",
+			Handler:    _Functions_FinalizeUpload_Handler,
+		},
 		{
 			MethodName: "ExecuteFunction",
 			Handler:    _Functions_ExecuteFunction_Handler,
 		},
+		{
+			MethodName: "InvokeFunction",
+			Handler:    _Functions_InvokeFunction_Handler,
+		},
 		{
 			MethodName: "GetFunction",
 			Handler:    _Functions_GetFunction_Handler,
@@ -252,10 +868,46 @@ var Functions_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListFunctions",
 			Handler:    _Functions_ListFunctions_Handler,
 		},
+		{
+			MethodName: "ListFunctionRevisions",
+			Handler:    _Functions_ListFunctionRevisions_Handler,
+		},
+		{
+			MethodName: "GetFunctionRevision",
+			Handler:    _Functions_GetFunctionRevision_Handler,
+		},
+		{
+			MethodName: "UpdateFunction",
+			Handler:    _Functions_UpdateFunction_Handler,
+		},
 		{
 			MethodName: "DeleteFunction",
 			Handler:    _Functions_DeleteFunction_Handler,
 		},
+		{
+			MethodName: "UndeleteFunction",
+			Handler:    _Functions_UndeleteFunction_Handler,
+		},
+		{
+			MethodName: "GetNamespaceUsage",
+			Handler:    _Functions_GetNamespaceUsage_Handler,
+		},
+		{
+			MethodName: "UpdateAlias",
+			Handler:    _Functions_UpdateAlias_Handler,
+		},
+		{
+			MethodName: "GetAlias",
+			Handler:    _Functions_GetAlias_Handler,
+		},
+		{
+			MethodName: "ListAliases",
+			Handler:    _Functions_ListAliases_Handler,
+		},
+		{
+			MethodName: "DeleteAlias",
+			Handler:    _Functions_DeleteAlias_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -263,6 +915,27 @@ var Functions_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Functions_UploadFunction_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "UploadChunks",
+			Handler:       _Functions_UploadChunks_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExecuteFunctionWithInputs",
+			Handler:       _Functions_ExecuteFunctionWithInputs_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "BatchExecuteFunction",
+			Handler:       _Functions_BatchExecuteFunction_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadFunction",
+			Handler:       _Functions_DownloadFunction_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "faas/v1/functions.proto",
 }
@@ -0,0 +1,760 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: faas/v1/schedules.proto
+
+package faaspb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// What happens to slots that passed while no scheduler was running. A slot
+// is missed once it is more than the gateway's grace period late.
+type MissedRunPolicy int32
+
+const (
+	// Same as MISSED_RUN_POLICY_SKIP.
+	MissedRunPolicy_MISSED_RUN_POLICY_UNSPECIFIED MissedRunPolicy = 0
+	// Missed slots do not run.
+	MissedRunPolicy_MISSED_RUN_POLICY_SKIP MissedRunPolicy = 1
+	// Missed slots run once together.
+	MissedRunPolicy_MISSED_RUN_POLICY_RUN_ONCE MissedRunPolicy = 2
+	// Every missed slot runs.
+	MissedRunPolicy_MISSED_RUN_POLICY_CATCH_UP MissedRunPolicy = 3
+)
+
+// Enum value maps for MissedRunPolicy.
+var (
+	MissedRunPolicy_name = map[int32]string{
+		0: "MISSED_RUN_POLICY_UNSPECIFIED",
+		1: "MISSED_RUN_POLICY_SKIP",
+		2: "MISSED_RUN_POLICY_RUN_ONCE",
+		3: "MISSED_RUN_POLICY_CATCH_UP",
+	}
+	MissedRunPolicy_value = map[string]int32{
+		"MISSED_RUN_POLICY_UNSPECIFIED": 0,
+		"MISSED_RUN_POLICY_SKIP":        1,
+		"MISSED_RUN_POLICY_RUN_ONCE":    2,
+		"MISSED_RUN_POLICY_CATCH_UP":    3,
+	}
+)
+
+func (x MissedRunPolicy) Enum() *MissedRunPolicy {
+	p := new(MissedRunPolicy)
+	*p = x
+	return p
+}
+
+func (x MissedRunPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MissedRunPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_faas_v1_schedules_proto_enumTypes[0].Descriptor()
+}
+
+func (MissedRunPolicy) Type() protoreflect.EnumType {
+	return &file_faas_v1_schedules_proto_enumTypes[0]
+}
+
+func (x MissedRunPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MissedRunPolicy.Descriptor instead.
+func (MissedRunPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_faas_v1_schedules_proto_rawDescGZIP(), []int{0}
+}
+
+// A schedule executes a function with fixed parameters on a cron schedule.
+// Exactly one gateway fires each slot; its tasks carry the annotations
+// "faas/schedule" and "faas/scheduled-time".
+type Schedule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "schedules/<id>".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Standard five-field cron expression or a descriptor such as "@daily".
+	Cron string `protobuf:"bytes,2,opt,name=cron,proto3" json:"cron,omitempty"`
+	// IANA time zone the expression is evaluated in; empty means UTC.
+	TimeZone string `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// Function name, optionally with "@alias".
+	Function string `protobuf:"bytes,4,opt,name=function,proto3" json:"function,omitempty"`
+	// JSON parameters passed to every run.
+	Parameters      string          `protobuf:"bytes,5,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	MissedRunPolicy MissedRunPolicy `protobuf:"varint,6,opt,name=missed_run_policy,json=missedRunPolicy,proto3,enum=faas.v1.MissedRunPolicy" json:"missed_run_policy,omitempty"`
+	// Output only; see PauseSchedule and ResumeSchedule. May be set on
+	// create.
+	Paused    bool                   `protobuf:"varint,7,opt,name=paused,proto3" json:"paused,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Output only. Slot of the last run and the task it created, or why it
+	// could not create one.
+	LastRunTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_run_time,json=lastRunTime,proto3" json:"last_run_time,omitempty"`
+	LastTask    string                 `protobuf:"bytes,11,opt,name=last_task,json=lastTask,proto3" json:"last_task,omitempty"`
+	LastError   string                 `protobuf:"bytes,12,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// Output only. Next slot to fire; unset while paused.
+	NextRunTime   *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=next_run_time,json=nextRunTime,proto3" json:"next_run_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Schedule) Reset() {
+	*x = Schedule{}
+	mi := &file_faas_v1_schedules_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schedule) ProtoMessage() {}
+
+func (x *Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_schedules_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return file_faas_v1_schedules_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Schedule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Schedule) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *Schedule) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *Schedule) GetFunction() string {
+	if x != nil {
+		return x.Function
+	}
+	return ""
+}
+
+func (x *Schedule) GetParameters() string {
+	if x != nil {
+		return x.Parameters
+	}
+	return ""
+}
+
+func (x *Schedule) GetMissedRunPolicy() MissedRunPolicy {
+	if x != nil {
+		return x.MissedRunPolicy
+	}
+	return MissedRunPolicy_MISSED_RUN_POLICY_UNSPECIFIED
+}
+
+func (x *Schedule) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *Schedule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Schedule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Schedule) GetLastRunTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRunTime
+	}
+	return nil
+}
+
+func (x *Schedule) GetLastTask() string {
+	if x != nil {
+		return x.LastTask
+	}
+	return ""
+}
+
+func (x *Schedule) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Schedule) GetNextRunTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunTime
+	}
+	return nil
+}
+
+type CreateScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *Schedule              `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateScheduleRequest) Reset() {
+	*x = CreateScheduleRequest{}
+	mi := &file_faas_v1_schedules_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScheduleRequest) ProtoMessage() {}
+
+func (x *CreateScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_schedules_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CreateScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_schedules_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateScheduleRequest) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type GetScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScheduleRequest) Reset() {
+	*x = GetScheduleRequest{}
+	mi := &file_faas_v1_schedules_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduleRequest) ProtoMessage() {}
+
+func (x *GetScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_schedules_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_schedules_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetScheduleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListSchedulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesRequest) Reset() {
+	*x = ListSchedulesRequest{}
+	mi := &file_faas_v1_schedules_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesRequest) ProtoMessage() {}
+
+func (x *ListSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_schedules_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_schedules_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListSchedulesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSchedulesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListSchedulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedules     []*Schedule            `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesResponse) Reset() {
+	*x = ListSchedulesResponse{}
+	mi := &file_faas_v1_schedules_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesResponse) ProtoMessage() {}
+
+func (x *ListSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_schedules_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_faas_v1_schedules_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListSchedulesResponse) GetSchedules() []*Schedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+func (x *ListSchedulesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateScheduleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name identifies the schedule.
+	Schedule *Schedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// Fields to update: cron, time_zone, function, parameters,
+	// missed_run_policy.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateScheduleRequest) Reset() {
+	*x = UpdateScheduleRequest{}
+	mi := &file_faas_v1_schedules_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScheduleRequest) ProtoMessage() {}
+
+func (x *UpdateScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_schedules_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScheduleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_schedules_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateScheduleRequest) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *UpdateScheduleRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type PauseScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseScheduleRequest) Reset() {
+	*x = PauseScheduleRequest{}
+	mi := &file_faas_v1_schedules_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseScheduleRequest) ProtoMessage() {}
+
+func (x *PauseScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_schedules_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseScheduleRequest.ProtoReflect.Descriptor instead.
+func (*PauseScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_schedules_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PauseScheduleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ResumeScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeScheduleRequest) Reset() {
+	*x = ResumeScheduleRequest{}
+	mi := &file_faas_v1_schedules_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeScheduleRequest) ProtoMessage() {}
+
+func (x *ResumeScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_schedules_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeScheduleRequest.ProtoReflect.Descriptor instead.
+func (*ResumeScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_schedules_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ResumeScheduleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteScheduleRequest) Reset() {
+	*x = DeleteScheduleRequest{}
+	mi := &file_faas_v1_schedules_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScheduleRequest) ProtoMessage() {}
+
+func (x *DeleteScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_schedules_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteScheduleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_schedules_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteScheduleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_faas_v1_schedules_proto protoreflect.FileDescriptor
+
+const file_faas_v1_schedules_proto_rawDesc = "" +
+	"\n" +
+	"\x17faas/v1/schedules.proto\x12\afaas.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9b\x04\n" +
+	"\bSchedule\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04cron\x18\x02 \x01(\tR\x04cron\x12\x1b\n" +
+	"\ttime_zone\x18\x03 \x01(\tR\btimeZone\x12\x1a\n" +
+	"\bfunction\x18\x04 \x01(\tR\bfunction\x12\x1e\n" +
+	"\n" +
+	"parameters\x18\x05 \x01(\tR\n" +
+	"parameters\x12D\n" +
+	"\x11missed_run_policy\x18\x06 \x01(\x0e2\x18.faas.v1.MissedRunPolicyR\x0fmissedRunPolicy\x12\x16\n" +
+	"\x06paused\x18\a \x01(\bR\x06paused\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12>\n" +
+	"\rlast_run_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vlastRunTime\x12\x1b\n" +
+	"\tlast_task\x18\v \x01(\tR\blastTask\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\f \x01(\tR\tlastError\x12>\n" +
+	"\rnext_run_time\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\vnextRunTime\"F\n" +
+	"\x15CreateScheduleRequest\x12-\n" +
+	"\bschedule\x18\x01 \x01(\v2\x11.faas.v1.ScheduleR\bschedule\"(\n" +
+	"\x12GetScheduleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"R\n" +
+	"\x14ListSchedulesRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"p\n" +
+	"\x15ListSchedulesResponse\x12/\n" +
+	"\tschedules\x18\x01 \x03(\v2\x11.faas.v1.ScheduleR\tschedules\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x83\x01\n" +
+	"\x15UpdateScheduleRequest\x12-\n" +
+	"\bschedule\x18\x01 \x01(\v2\x11.faas.v1.ScheduleR\bschedule\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"*\n" +
+	"\x14PauseScheduleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"+\n" +
+	"\x15ResumeScheduleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"+\n" +
+	"\x15DeleteScheduleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name*\x90\x01\n" +
+	"\x0fMissedRunPolicy\x12!\n" +
+	"\x1dMISSED_RUN_POLICY_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16MISSED_RUN_POLICY_SKIP\x10\x01\x12\x1e\n" +
+	"\x1aMISSED_RUN_POLICY_RUN_ONCE\x10\x02\x12\x1e\n" +
+	"\x1aMISSED_RUN_POLICY_CATCH_UP\x10\x032\xf6\x03\n" +
+	"\tSchedules\x12C\n" +
+	"\x0eCreateSchedule\x12\x1e.faas.v1.CreateScheduleRequest\x1a\x11.faas.v1.Schedule\x12=\n" +
+	"\vGetSchedule\x12\x1b.faas.v1.GetScheduleRequest\x1a\x11.faas.v1.Schedule\x12N\n" +
+	"\rListSchedules\x12\x1d.faas.v1.ListSchedulesRequest\x1a\x1e.faas.v1.ListSchedulesResponse\x12C\n" +
+	"\x0eUpdateSchedule\x12\x1e.faas.v1.UpdateScheduleRequest\x1a\x11.faas.v1.Schedule\x12A\n" +
+	"\rPauseSchedule\x12\x1d.faas.v1.PauseScheduleRequest\x1a\x11.faas.v1.Schedule\x12C\n" +
+	"\x0eResumeSchedule\x12\x1e.faas.v1.ResumeScheduleRequest\x1a\x11.faas.v1.Schedule\x12H\n" +
+	"\x0eDeleteSchedule\x12\x1e.faas.v1.DeleteScheduleRequest\x1a\x16.google.protobuf.EmptyB2Z0github.com/10Narratives/faas/pkg/faas/v1/;faaspbb\x06proto3"
+
+var (
+	file_faas_v1_schedules_proto_rawDescOnce sync.Once
+	file_faas_v1_schedules_proto_rawDescData []byte
+)
+
+func file_faas_v1_schedules_proto_rawDescGZIP() []byte {
+	file_faas_v1_schedules_proto_rawDescOnce.Do(func() {
+		file_faas_v1_schedules_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_faas_v1_schedules_proto_rawDesc), len(file_faas_v1_schedules_proto_rawDesc)))
+	})
+	return file_faas_v1_schedules_proto_rawDescData
+}
+
+var file_faas_v1_schedules_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_faas_v1_schedules_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_faas_v1_schedules_proto_goTypes = []any{
+	(MissedRunPolicy)(0),          // 0: faas.v1.MissedRunPolicy
+	(*Schedule)(nil),              // 1: faas.v1.Schedule
+	(*CreateScheduleRequest)(nil), // 2: faas.v1.CreateScheduleRequest
+	(*GetScheduleRequest)(nil),    // 3: faas.v1.GetScheduleRequest
+	(*ListSchedulesRequest)(nil),  // 4: faas.v1.ListSchedulesRequest
+	(*ListSchedulesResponse)(nil), // 5: faas.v1.ListSchedulesResponse
+	(*UpdateScheduleRequest)(nil), // 6: faas.v1.UpdateScheduleRequest
+	(*PauseScheduleRequest)(nil),  // 7: faas.v1.PauseScheduleRequest
+	(*ResumeScheduleRequest)(nil), // 8: faas.v1.ResumeScheduleRequest
+	(*DeleteScheduleRequest)(nil), // 9: faas.v1.DeleteScheduleRequest
+	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil), // 11: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),         // 12: google.protobuf.Empty
+}
+var file_faas_v1_schedules_proto_depIdxs = []int32{
+	0,  // 0: faas.v1.Schedule.missed_run_policy:type_name -> faas.v1.MissedRunPolicy
+	10, // 1: faas.v1.Schedule.created_at:type_name -> google.protobuf.Timestamp
+	10, // 2: faas.v1.Schedule.updated_at:type_name -> google.protobuf.Timestamp
+	10, // 3: faas.v1.Schedule.last_run_time:type_name -> google.protobuf.Timestamp
+	10, // 4: faas.v1.Schedule.next_run_time:type_name -> google.protobuf.Timestamp
+	1,  // 5: faas.v1.CreateScheduleRequest.schedule:type_name -> faas.v1.Schedule
+	1,  // 6: faas.v1.ListSchedulesResponse.schedules:type_name -> faas.v1.Schedule
+	1,  // 7: faas.v1.UpdateScheduleRequest.schedule:type_name -> faas.v1.Schedule
+	11, // 8: faas.v1.UpdateScheduleRequest.update_mask:type_name -> google.protobuf.FieldMask
+	2,  // 9: faas.v1.Schedules.CreateSchedule:input_type -> faas.v1.CreateScheduleRequest
+	3,  // 10: faas.v1.Schedules.GetSchedule:input_type -> faas.v1.GetScheduleRequest
+	4,  // 11: faas.v1.Schedules.ListSchedules:input_type -> faas.v1.ListSchedulesRequest
+	6,  // 12: faas.v1.Schedules.UpdateSchedule:input_type -> faas.v1.UpdateScheduleRequest
+	7,  // 13: faas.v1.Schedules.PauseSchedule:input_type -> faas.v1.PauseScheduleRequest
+	8,  // 14: faas.v1.Schedules.ResumeSchedule:input_type -> faas.v1.ResumeScheduleRequest
+	9,  // 15: faas.v1.Schedules.DeleteSchedule:input_type -> faas.v1.DeleteScheduleRequest
+	1,  // 16: faas.v1.Schedules.CreateSchedule:output_type -> faas.v1.Schedule
+	1,  // 17: faas.v1.Schedules.GetSchedule:output_type -> faas.v1.Schedule
+	5,  // 18: faas.v1.Schedules.ListSchedules:output_type -> faas.v1.ListSchedulesResponse
+	1,  // 19: faas.v1.Schedules.UpdateSchedule:output_type -> faas.v1.Schedule
+	1,  // 20: faas.v1.Schedules.PauseSchedule:output_type -> faas.v1.Schedule
+	1,  // 21: faas.v1.Schedules.ResumeSchedule:output_type -> faas.v1.Schedule
+	12, // 22: faas.v1.Schedules.DeleteSchedule:output_type -> google.protobuf.Empty
+	16, // [16:23] is the sub-list for method output_type
+	9,  // [9:16] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_faas_v1_schedules_proto_init() }
+func file_faas_v1_schedules_proto_init() {
+	if File_faas_v1_schedules_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_faas_v1_schedules_proto_rawDesc), len(file_faas_v1_schedules_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_faas_v1_schedules_proto_goTypes,
+		DependencyIndexes: file_faas_v1_schedules_proto_depIdxs,
+		EnumInfos:         file_faas_v1_schedules_proto_enumTypes,
+		MessageInfos:      file_faas_v1_schedules_proto_msgTypes,
+	}.Build()
+	File_faas_v1_schedules_proto = out.File
+	file_faas_v1_schedules_proto_goTypes = nil
+	file_faas_v1_schedules_proto_depIdxs = nil
+}
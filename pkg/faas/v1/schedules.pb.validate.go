@@ -0,0 +1,1220 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: faas/v1/schedules.proto
+
+package faaspb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Schedule with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Schedule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Schedule with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ScheduleMultiError, or nil
+// if none found.
+func (m *Schedule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Schedule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Cron
+
+	// no validation rules for TimeZone
+
+	// no validation rules for Function
+
+	// no validation rules for Parameters
+
+	// no validation rules for MissedRunPolicy
+
+	// no validation rules for Paused
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduleValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduleValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduleValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduleValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduleValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduleValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastRunTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduleValidationError{
+					field:  "LastRunTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduleValidationError{
+					field:  "LastRunTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastRunTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduleValidationError{
+				field:  "LastRunTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LastTask
+
+	// no validation rules for LastError
+
+	if all {
+		switch v := interface{}(m.GetNextRunTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduleValidationError{
+					field:  "NextRunTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduleValidationError{
+					field:  "NextRunTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextRunTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduleValidationError{
+				field:  "NextRunTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ScheduleMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScheduleMultiError is an error wrapping multiple validation errors returned
+// by Schedule.ValidateAll() if the designated constraints aren't met.
+type ScheduleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScheduleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScheduleMultiError) AllErrors() []error { return m }
+
+// ScheduleValidationError is the validation error returned by
+// Schedule.Validate if the designated constraints aren't met.
+type ScheduleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScheduleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScheduleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScheduleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScheduleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScheduleValidationError) ErrorName() string { return "ScheduleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ScheduleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchedule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScheduleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScheduleValidationError{}
+
+// Validate checks the field values on CreateScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateScheduleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateScheduleRequestMultiError, or nil if none found.
+func (m *CreateScheduleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateScheduleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSchedule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateScheduleRequestValidationError{
+					field:  "Schedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateScheduleRequestValidationError{
+					field:  "Schedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchedule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateScheduleRequestValidationError{
+				field:  "Schedule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateScheduleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateScheduleRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateScheduleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateScheduleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateScheduleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateScheduleRequestMultiError) AllErrors() []error { return m }
+
+// CreateScheduleRequestValidationError is the validation error returned by
+// CreateScheduleRequest.Validate if the designated constraints aren't met.
+type CreateScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateScheduleRequestValidationError) ErrorName() string {
+	return "CreateScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateScheduleRequestValidationError{}
+
+// Validate checks the field values on GetScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetScheduleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetScheduleRequestMultiError, or nil if none found.
+func (m *GetScheduleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetScheduleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return GetScheduleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetScheduleRequestMultiError is an error wrapping multiple validation errors
+// returned by GetScheduleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetScheduleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetScheduleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetScheduleRequestMultiError) AllErrors() []error { return m }
+
+// GetScheduleRequestValidationError is the validation error returned by
+// GetScheduleRequest.Validate if the designated constraints aren't met.
+type GetScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetScheduleRequestValidationError) ErrorName() string {
+	return "GetScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetScheduleRequestValidationError{}
+
+// Validate checks the field values on ListSchedulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSchedulesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSchedulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSchedulesRequestMultiError, or nil if none found.
+func (m *ListSchedulesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSchedulesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListSchedulesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSchedulesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSchedulesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListSchedulesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSchedulesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSchedulesRequestMultiError) AllErrors() []error { return m }
+
+// ListSchedulesRequestValidationError is the validation error returned by
+// ListSchedulesRequest.Validate if the designated constraints aren't met.
+type ListSchedulesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSchedulesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSchedulesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSchedulesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSchedulesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSchedulesRequestValidationError) ErrorName() string {
+	return "ListSchedulesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSchedulesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSchedulesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSchedulesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSchedulesRequestValidationError{}
+
+// Validate checks the field values on ListSchedulesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSchedulesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSchedulesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSchedulesResponseMultiError, or nil if none found.
+func (m *ListSchedulesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSchedulesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSchedules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSchedulesResponseValidationError{
+						field:  fmt.Sprintf("Schedules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSchedulesResponseValidationError{
+						field:  fmt.Sprintf("Schedules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSchedulesResponseValidationError{
+					field:  fmt.Sprintf("Schedules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListSchedulesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSchedulesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSchedulesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSchedulesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSchedulesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSchedulesResponseMultiError) AllErrors() []error { return m }
+
+// ListSchedulesResponseValidationError is the validation error returned by
+// ListSchedulesResponse.Validate if the designated constraints aren't met.
+type ListSchedulesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSchedulesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSchedulesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSchedulesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSchedulesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSchedulesResponseValidationError) ErrorName() string {
+	return "ListSchedulesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSchedulesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSchedulesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSchedulesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSchedulesResponseValidationError{}
+
+// Validate checks the field values on UpdateScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateScheduleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateScheduleRequestMultiError, or nil if none found.
+func (m *UpdateScheduleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateScheduleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSchedule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateScheduleRequestValidationError{
+					field:  "Schedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateScheduleRequestValidationError{
+					field:  "Schedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchedule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateScheduleRequestValidationError{
+				field:  "Schedule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateScheduleRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateScheduleRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateScheduleRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateScheduleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateScheduleRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateScheduleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateScheduleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateScheduleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateScheduleRequestMultiError) AllErrors() []error { return m }
+
+// UpdateScheduleRequestValidationError is the validation error returned by
+// UpdateScheduleRequest.Validate if the designated constraints aren't met.
+type UpdateScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateScheduleRequestValidationError) ErrorName() string {
+	return "UpdateScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateScheduleRequestValidationError{}
+
+// Validate checks the field values on PauseScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PauseScheduleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PauseScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PauseScheduleRequestMultiError, or nil if none found.
+func (m *PauseScheduleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PauseScheduleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return PauseScheduleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PauseScheduleRequestMultiError is an error wrapping multiple validation
+// errors returned by PauseScheduleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PauseScheduleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PauseScheduleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PauseScheduleRequestMultiError) AllErrors() []error { return m }
+
+// PauseScheduleRequestValidationError is the validation error returned by
+// PauseScheduleRequest.Validate if the designated constraints aren't met.
+type PauseScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PauseScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PauseScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PauseScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PauseScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PauseScheduleRequestValidationError) ErrorName() string {
+	return "PauseScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PauseScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPauseScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PauseScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PauseScheduleRequestValidationError{}
+
+// Validate checks the field values on ResumeScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResumeScheduleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResumeScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResumeScheduleRequestMultiError, or nil if none found.
+func (m *ResumeScheduleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResumeScheduleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return ResumeScheduleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResumeScheduleRequestMultiError is an error wrapping multiple validation
+// errors returned by ResumeScheduleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ResumeScheduleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResumeScheduleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResumeScheduleRequestMultiError) AllErrors() []error { return m }
+
+// ResumeScheduleRequestValidationError is the validation error returned by
+// ResumeScheduleRequest.Validate if the designated constraints aren't met.
+type ResumeScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResumeScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResumeScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResumeScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResumeScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResumeScheduleRequestValidationError) ErrorName() string {
+	return "ResumeScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResumeScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResumeScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResumeScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResumeScheduleRequestValidationError{}
+
+// Validate checks the field values on DeleteScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteScheduleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteScheduleRequestMultiError, or nil if none found.
+func (m *DeleteScheduleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteScheduleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return DeleteScheduleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteScheduleRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteScheduleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteScheduleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteScheduleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteScheduleRequestMultiError) AllErrors() []error { return m }
+
+// DeleteScheduleRequestValidationError is the validation error returned by
+// DeleteScheduleRequest.Validate if the designated constraints aren't met.
+type DeleteScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteScheduleRequestValidationError) ErrorName() string {
+	return "DeleteScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteScheduleRequestValidationError{}
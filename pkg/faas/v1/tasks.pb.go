@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -82,15 +83,29 @@ func (TaskState) EnumDescriptor() ([]byte, []int) {
 }
 
 type Task struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Function      string                 `protobuf:"bytes,2,opt,name=function,proto3" json:"function,omitempty"`
-	Parameters    string                 `protobuf:"bytes,3,opt,name=parameters,proto3" json:"parameters,omitempty"`
-	State         TaskState              `protobuf:"varint,4,opt,name=state,proto3,enum=faas.v1.TaskState" json:"state,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	EndedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
-	Result        *TaskResult            `protobuf:"bytes,8,opt,name=result,proto3" json:"result,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Name       string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Function   string                 `protobuf:"bytes,2,opt,name=function,proto3" json:"function,omitempty"`
+	Parameters string                 `protobuf:"bytes,3,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	State      TaskState              `protobuf:"varint,4,opt,name=state,proto3,enum=faas.v1.TaskState" json:"state,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	StartedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	Result     *TaskResult            `protobuf:"bytes,8,opt,name=result,proto3" json:"result,omitempty"`
+	// Files uploaded with the execution request.
+	Inputs []*TaskArtifact `protobuf:"bytes,9,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	// Function revision the task runs; 0 for tasks created before revisions.
+	FunctionRevision uint64            `protobuf:"varint,10,opt,name=function_revision,json=functionRevision,proto3" json:"function_revision,omitempty"`
+	Labels           map[string]string `protobuf:"bytes,11,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Free-form metadata; unlike labels it cannot be filtered on.
+	Annotations map[string]string `protobuf:"bytes,12,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Shared by the tasks of one BatchExecuteFunction call.
+	BatchId string `protobuf:"bytes,13,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// Executions started so far; above 1 when the function's retry policy
+	// asked for more attempts.
+	Attempt int32 `protobuf:"varint,14,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// Why the previous attempt failed.
+	LastError     string `protobuf:"bytes,15,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -181,6 +196,55 @@ func (x *Task) GetResult() *TaskResult {
 	return nil
 }
 
+func (x *Task) GetInputs() []*TaskArtifact {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *Task) GetFunctionRevision() uint64 {
+	if x != nil {
+		return x.FunctionRevision
+	}
+	return 0
+}
+
+func (x *Task) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Task) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *Task) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *Task) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *Task) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
 type TaskResult struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Data:
@@ -188,7 +252,12 @@ type TaskResult struct {
 	//	*TaskResult_InlineResult
 	//	*TaskResult_ObjectKey
 	//	*TaskResult_ErrorMessage
-	Data          isTaskResult_Data `protobuf_oneof:"data"`
+	Data isTaskResult_Data `protobuf_oneof:"data"`
+	// Files the function wrote to its outputs directory.
+	Artifacts []*TaskArtifact `protobuf:"bytes,4,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	// Media type of inline_result: the function's output_content_type, or
+	// one detected from the output when it declares none.
+	ContentType   string `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -257,6 +326,20 @@ func (x *TaskResult) GetErrorMessage() string {
 	return ""
 }
 
+func (x *TaskResult) GetArtifacts() []*TaskArtifact {
+	if x != nil {
+		return x.Artifacts
+	}
+	return nil
+}
+
+func (x *TaskResult) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
 type isTaskResult_Data interface {
 	isTaskResult_Data()
 }
@@ -279,6 +362,75 @@ func (*TaskResult_ObjectKey) isTaskResult_Data() {}
 
 func (*TaskResult_ErrorMessage) isTaskResult_Data() {}
 
+type TaskArtifact struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Path relative to the outputs directory, e.g. "report.csv".
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Size          uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Sha256        string `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	ContentType   string `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskArtifact) Reset() {
+	*x = TaskArtifact{}
+	mi := &file_faas_v1_tasks_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskArtifact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskArtifact) ProtoMessage() {}
+
+func (x *TaskArtifact) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_tasks_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskArtifact.ProtoReflect.Descriptor instead.
+func (*TaskArtifact) Descriptor() ([]byte, []int) {
+	return file_faas_v1_tasks_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TaskArtifact) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaskArtifact) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *TaskArtifact) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *TaskArtifact) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
 type GetTaskRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -288,7 +440,7 @@ type GetTaskRequest struct {
 
 func (x *GetTaskRequest) Reset() {
 	*x = GetTaskRequest{}
-	mi := &file_faas_v1_tasks_proto_msgTypes[2]
+	mi := &file_faas_v1_tasks_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -300,7 +452,7 @@ func (x *GetTaskRequest) String() string {
 func (*GetTaskRequest) ProtoMessage() {}
 
 func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_tasks_proto_msgTypes[2]
+	mi := &file_faas_v1_tasks_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -313,7 +465,7 @@ func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
 func (*GetTaskRequest) Descriptor() ([]byte, []int) {
-	return file_faas_v1_tasks_proto_rawDescGZIP(), []int{2}
+	return file_faas_v1_tasks_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetTaskRequest) GetName() string {
@@ -324,16 +476,20 @@ func (x *GetTaskRequest) GetName() string {
 }
 
 type ListTasksRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// AIP-160 style filter over labels.<key>, function, batch_id, state and
+	// created_at,
+	// e.g. `labels.env = "dev" AND state = pending`. Terms are joined with AND.
+	Filter        string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListTasksRequest) Reset() {
 	*x = ListTasksRequest{}
-	mi := &file_faas_v1_tasks_proto_msgTypes[3]
+	mi := &file_faas_v1_tasks_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -345,7 +501,7 @@ func (x *ListTasksRequest) String() string {
 func (*ListTasksRequest) ProtoMessage() {}
 
 func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_tasks_proto_msgTypes[3]
+	mi := &file_faas_v1_tasks_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -358,7 +514,7 @@ func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
 func (*ListTasksRequest) Descriptor() ([]byte, []int) {
-	return file_faas_v1_tasks_proto_rawDescGZIP(), []int{3}
+	return file_faas_v1_tasks_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ListTasksRequest) GetPageSize() int32 {
@@ -375,6 +531,13 @@ func (x *ListTasksRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListTasksRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
 type ListTasksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
@@ -385,7 +548,7 @@ type ListTasksResponse struct {
 
 func (x *ListTasksResponse) Reset() {
 	*x = ListTasksResponse{}
-	mi := &file_faas_v1_tasks_proto_msgTypes[4]
+	mi := &file_faas_v1_tasks_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -397,7 +560,7 @@ func (x *ListTasksResponse) String() string {
 func (*ListTasksResponse) ProtoMessage() {}
 
 func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_tasks_proto_msgTypes[4]
+	mi := &file_faas_v1_tasks_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -410,7 +573,7 @@ func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
 func (*ListTasksResponse) Descriptor() ([]byte, []int) {
-	return file_faas_v1_tasks_proto_rawDescGZIP(), []int{4}
+	return file_faas_v1_tasks_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListTasksResponse) GetTasks() []*Task {
@@ -427,6 +590,60 @@ func (x *ListTasksResponse) GetNextPageToken() string {
 	return ""
 }
 
+type UpdateTaskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name identifies the task.
+	Task *Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	// Fields to update: labels, annotations.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskRequest) Reset() {
+	*x = UpdateTaskRequest{}
+	mi := &file_faas_v1_tasks_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskRequest) ProtoMessage() {}
+
+func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_tasks_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_tasks_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateTaskRequest) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
 type DeleteTaskRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -436,7 +653,7 @@ type DeleteTaskRequest struct {
 
 func (x *DeleteTaskRequest) Reset() {
 	*x = DeleteTaskRequest{}
-	mi := &file_faas_v1_tasks_proto_msgTypes[5]
+	mi := &file_faas_v1_tasks_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -448,7 +665,7 @@ func (x *DeleteTaskRequest) String() string {
 func (*DeleteTaskRequest) ProtoMessage() {}
 
 func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_tasks_proto_msgTypes[5]
+	mi := &file_faas_v1_tasks_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -461,7 +678,7 @@ func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
 func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
-	return file_faas_v1_tasks_proto_rawDescGZIP(), []int{5}
+	return file_faas_v1_tasks_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DeleteTaskRequest) GetName() string {
@@ -480,7 +697,7 @@ type CancelTaskRequest struct {
 
 func (x *CancelTaskRequest) Reset() {
 	*x = CancelTaskRequest{}
-	mi := &file_faas_v1_tasks_proto_msgTypes[6]
+	mi := &file_faas_v1_tasks_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -492,7 +709,7 @@ func (x *CancelTaskRequest) String() string {
 func (*CancelTaskRequest) ProtoMessage() {}
 
 func (x *CancelTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_tasks_proto_msgTypes[6]
+	mi := &file_faas_v1_tasks_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -505,7 +722,7 @@ func (x *CancelTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelTaskRequest.ProtoReflect.Descriptor instead.
 func (*CancelTaskRequest) Descriptor() ([]byte, []int) {
-	return file_faas_v1_tasks_proto_rawDescGZIP(), []int{6}
+	return file_faas_v1_tasks_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CancelTaskRequest) GetName() string {
@@ -515,11 +732,234 @@ func (x *CancelTaskRequest) GetName() string {
 	return ""
 }
 
+type ListTaskArtifactsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskArtifactsRequest) Reset() {
+	*x = ListTaskArtifactsRequest{}
+	mi := &file_faas_v1_tasks_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskArtifactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskArtifactsRequest) ProtoMessage() {}
+
+func (x *ListTaskArtifactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_tasks_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskArtifactsRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskArtifactsRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_tasks_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListTaskArtifactsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListTaskArtifactsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Artifacts     []*TaskArtifact        `protobuf:"bytes,1,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskArtifactsResponse) Reset() {
+	*x = ListTaskArtifactsResponse{}
+	mi := &file_faas_v1_tasks_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskArtifactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskArtifactsResponse) ProtoMessage() {}
+
+func (x *ListTaskArtifactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_tasks_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskArtifactsResponse.ProtoReflect.Descriptor instead.
+func (*ListTaskArtifactsResponse) Descriptor() ([]byte, []int) {
+	return file_faas_v1_tasks_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListTaskArtifactsResponse) GetArtifacts() []*TaskArtifact {
+	if x != nil {
+		return x.Artifacts
+	}
+	return nil
+}
+
+type DownloadTaskArtifactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Artifact      string                 `protobuf:"bytes,2,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadTaskArtifactRequest) Reset() {
+	*x = DownloadTaskArtifactRequest{}
+	mi := &file_faas_v1_tasks_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadTaskArtifactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadTaskArtifactRequest) ProtoMessage() {}
+
+func (x *DownloadTaskArtifactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_tasks_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadTaskArtifactRequest.ProtoReflect.Descriptor instead.
+func (*DownloadTaskArtifactRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_tasks_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DownloadTaskArtifactRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DownloadTaskArtifactRequest) GetArtifact() string {
+	if x != nil {
+		return x.Artifact
+	}
+	return ""
+}
+
+// The first message carries the artifact metadata, the rest carry data.
+type DownloadTaskArtifactResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*DownloadTaskArtifactResponse_Artifact
+	//	*DownloadTaskArtifactResponse_Data
+	Payload       isDownloadTaskArtifactResponse_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadTaskArtifactResponse) Reset() {
+	*x = DownloadTaskArtifactResponse{}
+	mi := &file_faas_v1_tasks_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadTaskArtifactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadTaskArtifactResponse) ProtoMessage() {}
+
+func (x *DownloadTaskArtifactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_tasks_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadTaskArtifactResponse.ProtoReflect.Descriptor instead.
+func (*DownloadTaskArtifactResponse) Descriptor() ([]byte, []int) {
+	return file_faas_v1_tasks_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DownloadTaskArtifactResponse) GetPayload() isDownloadTaskArtifactResponse_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *DownloadTaskArtifactResponse) GetArtifact() *TaskArtifact {
+	if x != nil {
+		if x, ok := x.Payload.(*DownloadTaskArtifactResponse_Artifact); ok {
+			return x.Artifact
+		}
+	}
+	return nil
+}
+
+func (x *DownloadTaskArtifactResponse) GetData() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*DownloadTaskArtifactResponse_Data); ok {
+			return x.Data
+		}
+	}
+	return nil
+}
+
+type isDownloadTaskArtifactResponse_Payload interface {
+	isDownloadTaskArtifactResponse_Payload()
+}
+
+type DownloadTaskArtifactResponse_Artifact struct {
+	Artifact *TaskArtifact `protobuf:"bytes,1,opt,name=artifact,proto3,oneof"`
+}
+
+type DownloadTaskArtifactResponse_Data struct {
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3,oneof"`
+}
+
+func (*DownloadTaskArtifactResponse_Artifact) isDownloadTaskArtifactResponse_Payload() {}
+
+func (*DownloadTaskArtifactResponse_Data) isDownloadTaskArtifactResponse_Payload() {}
+
 var File_faas_v1_tasks_proto protoreflect.FileDescriptor
 
 const file_faas_v1_tasks_proto_rawDesc = "" +
 	"\n" +
-	"\x13faas/v1/tasks.proto\x12\afaas.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xda\x02\n" +
+	"\x13faas/v1/tasks.proto\x12\afaas.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfa\x05\n" +
 	"\x04Task\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
 	"\bfunction\x18\x02 \x01(\tR\bfunction\x12\x1e\n" +
@@ -532,41 +972,83 @@ const file_faas_v1_tasks_proto_rawDesc = "" +
 	"\n" +
 	"started_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x125\n" +
 	"\bended_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\aendedAt\x12+\n" +
-	"\x06result\x18\b \x01(\v2\x13.faas.v1.TaskResultR\x06result\"\x83\x01\n" +
+	"\x06result\x18\b \x01(\v2\x13.faas.v1.TaskResultR\x06result\x12-\n" +
+	"\x06inputs\x18\t \x03(\v2\x15.faas.v1.TaskArtifactR\x06inputs\x12+\n" +
+	"\x11function_revision\x18\n" +
+	" \x01(\x04R\x10functionRevision\x121\n" +
+	"\x06labels\x18\v \x03(\v2\x19.faas.v1.Task.LabelsEntryR\x06labels\x12@\n" +
+	"\vannotations\x18\f \x03(\v2\x1e.faas.v1.Task.AnnotationsEntryR\vannotations\x12\x19\n" +
+	"\bbatch_id\x18\r \x01(\tR\abatchId\x12\x18\n" +
+	"\aattempt\x18\x0e \x01(\x05R\aattempt\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x0f \x01(\tR\tlastError\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xdb\x01\n" +
 	"\n" +
 	"TaskResult\x12%\n" +
 	"\rinline_result\x18\x01 \x01(\fH\x00R\finlineResult\x12\x1f\n" +
 	"\n" +
 	"object_key\x18\x02 \x01(\tH\x00R\tobjectKey\x12%\n" +
-	"\rerror_message\x18\x03 \x01(\tH\x00R\ferrorMessageB\x06\n" +
-	"\x04data\"$\n" +
+	"\rerror_message\x18\x03 \x01(\tH\x00R\ferrorMessage\x123\n" +
+	"\tartifacts\x18\x04 \x03(\v2\x15.faas.v1.TaskArtifactR\tartifacts\x12!\n" +
+	"\fcontent_type\x18\x05 \x01(\tR\vcontentTypeB\x06\n" +
+	"\x04data\"q\n" +
+	"\fTaskArtifact\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x04R\x04size\x12\x16\n" +
+	"\x06sha256\x18\x03 \x01(\tR\x06sha256\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\"$\n" +
 	"\x0eGetTaskRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"N\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"f\n" +
 	"\x10ListTasksRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x02 \x01(\tR\tpageToken\"`\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\"`\n" +
 	"\x11ListTasksResponse\x12#\n" +
 	"\x05tasks\x18\x01 \x03(\v2\r.faas.v1.TaskR\x05tasks\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"'\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"s\n" +
+	"\x11UpdateTaskRequest\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.faas.v1.TaskR\x04task\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"'\n" +
 	"\x11DeleteTaskRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\"'\n" +
 	"\x11CancelTaskRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name*\xa4\x01\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\".\n" +
+	"\x18ListTaskArtifactsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"P\n" +
+	"\x19ListTaskArtifactsResponse\x123\n" +
+	"\tartifacts\x18\x01 \x03(\v2\x15.faas.v1.TaskArtifactR\tartifacts\"M\n" +
+	"\x1bDownloadTaskArtifactRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bartifact\x18\x02 \x01(\tR\bartifact\"t\n" +
+	"\x1cDownloadTaskArtifactResponse\x123\n" +
+	"\bartifact\x18\x01 \x01(\v2\x15.faas.v1.TaskArtifactH\x00R\bartifact\x12\x14\n" +
+	"\x04data\x18\x02 \x01(\fH\x00R\x04dataB\t\n" +
+	"\apayload*\xa4\x01\n" +
 	"\tTaskState\x12\x1a\n" +
 	"\x16TASK_STATE_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12TASK_STATE_PENDING\x10\x01\x12\x19\n" +
 	"\x15TASK_STATE_PROCESSING\x10\x02\x12\x18\n" +
 	"\x14TASK_STATE_SUCCEEDED\x10\x03\x12\x15\n" +
 	"\x11TASK_STATE_FAILED\x10\x04\x12\x17\n" +
-	"\x13TASK_STATE_CANCELED\x10\x052\xf9\x01\n" +
+	"\x13TASK_STATE_CANCELED\x10\x052\xf5\x03\n" +
 	"\x05Tasks\x121\n" +
 	"\aGetTask\x12\x17.faas.v1.GetTaskRequest\x1a\r.faas.v1.Task\x12B\n" +
-	"\tListTasks\x12\x19.faas.v1.ListTasksRequest\x1a\x1a.faas.v1.ListTasksResponse\x12@\n" +
+	"\tListTasks\x12\x19.faas.v1.ListTasksRequest\x1a\x1a.faas.v1.ListTasksResponse\x127\n" +
+	"\n" +
+	"UpdateTask\x12\x1a.faas.v1.UpdateTaskRequest\x1a\r.faas.v1.Task\x12@\n" +
 	"\n" +
 	"DeleteTask\x12\x1a.faas.v1.DeleteTaskRequest\x1a\x16.google.protobuf.Empty\x127\n" +
 	"\n" +
-	"CancelTask\x12\x1a.faas.v1.CancelTaskRequest\x1a\r.faas.v1.TaskB2Z0github.com/10Narratives/faas/pkg/faas/v1/;faaspbb\x06proto3"
+	"CancelTask\x12\x1a.faas.v1.CancelTaskRequest\x1a\r.faas.v1.Task\x12Z\n" +
+	"\x11ListTaskArtifacts\x12!.faas.v1.ListTaskArtifactsRequest\x1a\".faas.v1.ListTaskArtifactsResponse\x12e\n" +
+	"\x14DownloadTaskArtifact\x12$.faas.v1.DownloadTaskArtifactRequest\x1a%.faas.v1.DownloadTaskArtifactResponse0\x01B2Z0github.com/10Narratives/faas/pkg/faas/v1/;faaspbb\x06proto3"
 
 var (
 	file_faas_v1_tasks_proto_rawDescOnce sync.Once
@@ -581,39 +1063,62 @@ func file_faas_v1_tasks_proto_rawDescGZIP() []byte {
 }
 
 var file_faas_v1_tasks_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_faas_v1_tasks_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_faas_v1_tasks_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_faas_v1_tasks_proto_goTypes = []any{
-	(TaskState)(0),                // 0: faas.v1.TaskState
-	(*Task)(nil),                  // 1: faas.v1.Task
-	(*TaskResult)(nil),            // 2: faas.v1.TaskResult
-	(*GetTaskRequest)(nil),        // 3: faas.v1.GetTaskRequest
-	(*ListTasksRequest)(nil),      // 4: faas.v1.ListTasksRequest
-	(*ListTasksResponse)(nil),     // 5: faas.v1.ListTasksResponse
-	(*DeleteTaskRequest)(nil),     // 6: faas.v1.DeleteTaskRequest
-	(*CancelTaskRequest)(nil),     // 7: faas.v1.CancelTaskRequest
-	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 9: google.protobuf.Empty
+	(TaskState)(0),                       // 0: faas.v1.TaskState
+	(*Task)(nil),                         // 1: faas.v1.Task
+	(*TaskResult)(nil),                   // 2: faas.v1.TaskResult
+	(*TaskArtifact)(nil),                 // 3: faas.v1.TaskArtifact
+	(*GetTaskRequest)(nil),               // 4: faas.v1.GetTaskRequest
+	(*ListTasksRequest)(nil),             // 5: faas.v1.ListTasksRequest
+	(*ListTasksResponse)(nil),            // 6: faas.v1.ListTasksResponse
+	(*UpdateTaskRequest)(nil),            // 7: faas.v1.UpdateTaskRequest
+	(*DeleteTaskRequest)(nil),            // 8: faas.v1.DeleteTaskRequest
+	(*CancelTaskRequest)(nil),            // 9: faas.v1.CancelTaskRequest
+	(*ListTaskArtifactsRequest)(nil),     // 10: faas.v1.ListTaskArtifactsRequest
+	(*ListTaskArtifactsResponse)(nil),    // 11: faas.v1.ListTaskArtifactsResponse
+	(*DownloadTaskArtifactRequest)(nil),  // 12: faas.v1.DownloadTaskArtifactRequest
+	(*DownloadTaskArtifactResponse)(nil), // 13: faas.v1.DownloadTaskArtifactResponse
+	nil,                                  // 14: faas.v1.Task.LabelsEntry
+	nil,                                  // 15: faas.v1.Task.AnnotationsEntry
+	(*timestamppb.Timestamp)(nil),        // 16: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),        // 17: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                // 18: google.protobuf.Empty
 }
 var file_faas_v1_tasks_proto_depIdxs = []int32{
 	0,  // 0: faas.v1.Task.state:type_name -> faas.v1.TaskState
-	8,  // 1: faas.v1.Task.created_at:type_name -> google.protobuf.Timestamp
-	8,  // 2: faas.v1.Task.started_at:type_name -> google.protobuf.Timestamp
-	8,  // 3: faas.v1.Task.ended_at:type_name -> google.protobuf.Timestamp
+	16, // 1: faas.v1.Task.created_at:type_name -> google.protobuf.Timestamp
+	16, // 2: faas.v1.Task.started_at:type_name -> google.protobuf.Timestamp
+	16, // 3: faas.v1.Task.ended_at:type_name -> google.protobuf.Timestamp
 	2,  // 4: faas.v1.Task.result:type_name -> faas.v1.TaskResult
-	1,  // 5: faas.v1.ListTasksResponse.tasks:type_name -> faas.v1.Task
-	3,  // 6: faas.v1.Tasks.GetTask:input_type -> faas.v1.GetTaskRequest
-	4,  // 7: faas.v1.Tasks.ListTasks:input_type -> faas.v1.ListTasksRequest
-	6,  // 8: faas.v1.Tasks.DeleteTask:input_type -> faas.v1.DeleteTaskRequest
-	7,  // 9: faas.v1.Tasks.CancelTask:input_type -> faas.v1.CancelTaskRequest
-	1,  // 10: faas.v1.Tasks.GetTask:output_type -> faas.v1.Task
-	5,  // 11: faas.v1.Tasks.ListTasks:output_type -> faas.v1.ListTasksResponse
-	9,  // 12: faas.v1.Tasks.DeleteTask:output_type -> google.protobuf.Empty
-	1,  // 13: faas.v1.Tasks.CancelTask:output_type -> faas.v1.Task
-	10, // [10:14] is the sub-list for method output_type
-	6,  // [6:10] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	3,  // 5: faas.v1.Task.inputs:type_name -> faas.v1.TaskArtifact
+	14, // 6: faas.v1.Task.labels:type_name -> faas.v1.Task.LabelsEntry
+	15, // 7: faas.v1.Task.annotations:type_name -> faas.v1.Task.AnnotationsEntry
+	3,  // 8: faas.v1.TaskResult.artifacts:type_name -> faas.v1.TaskArtifact
+	1,  // 9: faas.v1.ListTasksResponse.tasks:type_name -> faas.v1.Task
+	1,  // 10: faas.v1.UpdateTaskRequest.task:type_name -> faas.v1.Task
+	17, // 11: faas.v1.UpdateTaskRequest.update_mask:type_name -> google.protobuf.FieldMask
+	3,  // 12: faas.v1.ListTaskArtifactsResponse.artifacts:type_name -> faas.v1.TaskArtifact
+	3,  // 13: faas.v1.DownloadTaskArtifactResponse.artifact:type_name -> faas.v1.TaskArtifact
+	4,  // 14: faas.v1.Tasks.GetTask:input_type -> faas.v1.GetTaskRequest
+	5,  // 15: faas.v1.Tasks.ListTasks:input_type -> faas.v1.ListTasksRequest
+	7,  // 16: faas.v1.Tasks.UpdateTask:input_type -> faas.v1.UpdateTaskRequest
+	8,  // 17: faas.v1.Tasks.DeleteTask:input_type -> faas.v1.DeleteTaskRequest
+	9,  // 18: faas.v1.Tasks.CancelTask:input_type -> faas.v1.CancelTaskRequest
+	10, // 19: faas.v1.Tasks.ListTaskArtifacts:input_type -> faas.v1.ListTaskArtifactsRequest
+	12, // 20: faas.v1.Tasks.DownloadTaskArtifact:input_type -> faas.v1.DownloadTaskArtifactRequest
+	1,  // 21: faas.v1.Tasks.GetTask:output_type -> faas.v1.Task
+	6,  // 22: faas.v1.Tasks.ListTasks:output_type -> faas.v1.ListTasksResponse
+	1,  // 23: faas.v1.Tasks.UpdateTask:output_type -> faas.v1.Task
+	18, // 24: faas.v1.Tasks.DeleteTask:output_type -> google.protobuf.Empty
+	1,  // 25: faas.v1.Tasks.CancelTask:output_type -> faas.v1.Task
+	11, // 26: faas.v1.Tasks.ListTaskArtifacts:output_type -> faas.v1.ListTaskArtifactsResponse
+	13, // 27: faas.v1.Tasks.DownloadTaskArtifact:output_type -> faas.v1.DownloadTaskArtifactResponse
+	21, // [21:28] is the sub-list for method output_type
+	14, // [14:21] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_faas_v1_tasks_proto_init() }
@@ -626,13 +1131,17 @@ func file_faas_v1_tasks_proto_init() {
 		(*TaskResult_ObjectKey)(nil),
 		(*TaskResult_ErrorMessage)(nil),
 	}
+	file_faas_v1_tasks_proto_msgTypes[12].OneofWrappers = []any{
+		(*DownloadTaskArtifactResponse_Artifact)(nil),
+		(*DownloadTaskArtifactResponse_Data)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_faas_v1_tasks_proto_rawDesc), len(file_faas_v1_tasks_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   7,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -180,6 +180,52 @@ func (m *Task) validate(all bool) error {
 		}
 	}
 
+	for idx, item := range m.GetInputs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TaskValidationError{
+						field:  fmt.Sprintf("Inputs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TaskValidationError{
+						field:  fmt.Sprintf("Inputs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TaskValidationError{
+					field:  fmt.Sprintf("Inputs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for FunctionRevision
+
+	// no validation rules for Labels
+
+	// no validation rules for Annotations
+
+	// no validation rules for BatchId
+
+	// no validation rules for Attempt
+
+	// no validation rules for LastError
+
 	if len(errors) > 0 {
 		return TaskMultiError(errors)
 	}
@@ -279,6 +325,42 @@ func (m *TaskResult) validate(all bool) error {
 
 	var errors []error
 
+	for idx, item := range m.GetArtifacts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TaskResultValidationError{
+						field:  fmt.Sprintf("Artifacts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TaskResultValidationError{
+						field:  fmt.Sprintf("Artifacts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TaskResultValidationError{
+					field:  fmt.Sprintf("Artifacts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ContentType
+
 	switch v := m.Data.(type) {
 	case *TaskResult_InlineResult:
 		if v == nil {
@@ -397,6 +479,113 @@ var _ interface {
 	ErrorName() string
 } = TaskResultValidationError{}
 
+// Validate checks the field values on TaskArtifact with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TaskArtifact) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TaskArtifact with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TaskArtifactMultiError, or
+// nil if none found.
+func (m *TaskArtifact) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TaskArtifact) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Size
+
+	// no validation rules for Sha256
+
+	// no validation rules for ContentType
+
+	if len(errors) > 0 {
+		return TaskArtifactMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaskArtifactMultiError is an error wrapping multiple validation errors
+// returned by TaskArtifact.ValidateAll() if the designated constraints aren't met.
+type TaskArtifactMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaskArtifactMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaskArtifactMultiError) AllErrors() []error { return m }
+
+// TaskArtifactValidationError is the validation error returned by
+// TaskArtifact.Validate if the designated constraints aren't met.
+type TaskArtifactValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaskArtifactValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaskArtifactValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaskArtifactValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaskArtifactValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaskArtifactValidationError) ErrorName() string { return "TaskArtifactValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TaskArtifactValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaskArtifact.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaskArtifactValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaskArtifactValidationError{}
+
 // Validate checks the field values on GetTaskRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -525,6 +714,8 @@ func (m *ListTasksRequest) validate(all bool) error {
 
 	// no validation rules for PageToken
 
+	// no validation rules for Filter
+
 	if len(errors) > 0 {
 		return ListTasksRequestMultiError(errors)
 	}
@@ -741,44 +932,100 @@ var _ interface {
 	ErrorName() string
 } = ListTasksResponseValidationError{}
 
-// Validate checks the field values on DeleteTaskRequest with the rules defined
+// Validate checks the field values on UpdateTaskRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *DeleteTaskRequest) Validate() error {
+func (m *UpdateTaskRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteTaskRequest with the rules
+// ValidateAll checks the field values on UpdateTaskRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteTaskRequestMultiError, or nil if none found.
-func (m *DeleteTaskRequest) ValidateAll() error {
+// UpdateTaskRequestMultiError, or nil if none found.
+func (m *UpdateTaskRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteTaskRequest) validate(all bool) error {
+func (m *UpdateTaskRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	if all {
+		switch v := interface{}(m.GetTask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTaskRequestValidationError{
+					field:  "Task",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTaskRequestValidationError{
+					field:  "Task",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTaskRequestValidationError{
+				field:  "Task",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTaskRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTaskRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTaskRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DeleteTaskRequestMultiError(errors)
+		return UpdateTaskRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteTaskRequestMultiError is an error wrapping multiple validation errors
-// returned by DeleteTaskRequest.ValidateAll() if the designated constraints
+// UpdateTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateTaskRequest.ValidateAll() if the designated constraints
 // aren't met.
-type DeleteTaskRequestMultiError []error
+type UpdateTaskRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteTaskRequestMultiError) Error() string {
+func (m UpdateTaskRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -787,11 +1034,11 @@ func (m DeleteTaskRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteTaskRequestMultiError) AllErrors() []error { return m }
+func (m UpdateTaskRequestMultiError) AllErrors() []error { return m }
 
-// DeleteTaskRequestValidationError is the validation error returned by
-// DeleteTaskRequest.Validate if the designated constraints aren't met.
-type DeleteTaskRequestValidationError struct {
+// UpdateTaskRequestValidationError is the validation error returned by
+// UpdateTaskRequest.Validate if the designated constraints aren't met.
+type UpdateTaskRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -799,24 +1046,24 @@ type DeleteTaskRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteTaskRequestValidationError) Field() string { return e.field }
+func (e UpdateTaskRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteTaskRequestValidationError) Reason() string { return e.reason }
+func (e UpdateTaskRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteTaskRequestValidationError) Cause() error { return e.cause }
+func (e UpdateTaskRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteTaskRequestValidationError) Key() bool { return e.key }
+func (e UpdateTaskRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteTaskRequestValidationError) ErrorName() string {
-	return "DeleteTaskRequestValidationError"
+func (e UpdateTaskRequestValidationError) ErrorName() string {
+	return "UpdateTaskRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteTaskRequestValidationError) Error() string {
+func (e UpdateTaskRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -828,14 +1075,14 @@ func (e DeleteTaskRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteTaskRequest.%s: %s%s",
+		"invalid %sUpdateTaskRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteTaskRequestValidationError{}
+var _ error = UpdateTaskRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -843,24 +1090,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteTaskRequestValidationError{}
+} = UpdateTaskRequestValidationError{}
 
-// Validate checks the field values on CancelTaskRequest with the rules defined
+// Validate checks the field values on DeleteTaskRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *CancelTaskRequest) Validate() error {
+func (m *DeleteTaskRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CancelTaskRequest with the rules
+// ValidateAll checks the field values on DeleteTaskRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CancelTaskRequestMultiError, or nil if none found.
-func (m *CancelTaskRequest) ValidateAll() error {
+// DeleteTaskRequestMultiError, or nil if none found.
+func (m *DeleteTaskRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CancelTaskRequest) validate(all bool) error {
+func (m *DeleteTaskRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -870,19 +1117,19 @@ func (m *CancelTaskRequest) validate(all bool) error {
 	// no validation rules for Name
 
 	if len(errors) > 0 {
-		return CancelTaskRequestMultiError(errors)
+		return DeleteTaskRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CancelTaskRequestMultiError is an error wrapping multiple validation errors
-// returned by CancelTaskRequest.ValidateAll() if the designated constraints
+// DeleteTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteTaskRequest.ValidateAll() if the designated constraints
 // aren't met.
-type CancelTaskRequestMultiError []error
+type DeleteTaskRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CancelTaskRequestMultiError) Error() string {
+func (m DeleteTaskRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -891,11 +1138,11 @@ func (m CancelTaskRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CancelTaskRequestMultiError) AllErrors() []error { return m }
+func (m DeleteTaskRequestMultiError) AllErrors() []error { return m }
 
-// CancelTaskRequestValidationError is the validation error returned by
-// CancelTaskRequest.Validate if the designated constraints aren't met.
-type CancelTaskRequestValidationError struct {
+// DeleteTaskRequestValidationError is the validation error returned by
+// DeleteTaskRequest.Validate if the designated constraints aren't met.
+type DeleteTaskRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -903,24 +1150,24 @@ type CancelTaskRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CancelTaskRequestValidationError) Field() string { return e.field }
+func (e DeleteTaskRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CancelTaskRequestValidationError) Reason() string { return e.reason }
+func (e DeleteTaskRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CancelTaskRequestValidationError) Cause() error { return e.cause }
+func (e DeleteTaskRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CancelTaskRequestValidationError) Key() bool { return e.key }
+func (e DeleteTaskRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CancelTaskRequestValidationError) ErrorName() string {
-	return "CancelTaskRequestValidationError"
+func (e DeleteTaskRequestValidationError) ErrorName() string {
+	return "DeleteTaskRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CancelTaskRequestValidationError) Error() string {
+func (e DeleteTaskRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -932,14 +1179,118 @@ func (e CancelTaskRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCancelTaskRequest.%s: %s%s",
+		"invalid %sDeleteTaskRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CancelTaskRequestValidationError{}
+var _ error = DeleteTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTaskRequestValidationError{}
+
+// Validate checks the field values on CancelTaskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CancelTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelTaskRequestMultiError, or nil if none found.
+func (m *CancelTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return CancelTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by CancelTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CancelTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelTaskRequestMultiError) AllErrors() []error { return m }
+
+// CancelTaskRequestValidationError is the validation error returned by
+// CancelTaskRequest.Validate if the designated constraints aren't met.
+type CancelTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelTaskRequestValidationError) ErrorName() string {
+	return "CancelTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelTaskRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -948,3 +1299,511 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = CancelTaskRequestValidationError{}
+
+// Validate checks the field values on ListTaskArtifactsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTaskArtifactsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTaskArtifactsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTaskArtifactsRequestMultiError, or nil if none found.
+func (m *ListTaskArtifactsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTaskArtifactsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return ListTaskArtifactsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTaskArtifactsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTaskArtifactsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTaskArtifactsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTaskArtifactsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTaskArtifactsRequestMultiError) AllErrors() []error { return m }
+
+// ListTaskArtifactsRequestValidationError is the validation error returned by
+// ListTaskArtifactsRequest.Validate if the designated constraints aren't met.
+type ListTaskArtifactsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTaskArtifactsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTaskArtifactsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTaskArtifactsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTaskArtifactsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTaskArtifactsRequestValidationError) ErrorName() string {
+	return "ListTaskArtifactsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTaskArtifactsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTaskArtifactsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTaskArtifactsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTaskArtifactsRequestValidationError{}
+
+// Validate checks the field values on ListTaskArtifactsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTaskArtifactsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTaskArtifactsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTaskArtifactsResponseMultiError, or nil if none found.
+func (m *ListTaskArtifactsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTaskArtifactsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetArtifacts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTaskArtifactsResponseValidationError{
+						field:  fmt.Sprintf("Artifacts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTaskArtifactsResponseValidationError{
+						field:  fmt.Sprintf("Artifacts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTaskArtifactsResponseValidationError{
+					field:  fmt.Sprintf("Artifacts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTaskArtifactsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTaskArtifactsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListTaskArtifactsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListTaskArtifactsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTaskArtifactsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTaskArtifactsResponseMultiError) AllErrors() []error { return m }
+
+// ListTaskArtifactsResponseValidationError is the validation error returned by
+// ListTaskArtifactsResponse.Validate if the designated constraints aren't met.
+type ListTaskArtifactsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTaskArtifactsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTaskArtifactsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTaskArtifactsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTaskArtifactsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTaskArtifactsResponseValidationError) ErrorName() string {
+	return "ListTaskArtifactsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTaskArtifactsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTaskArtifactsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTaskArtifactsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTaskArtifactsResponseValidationError{}
+
+// Validate checks the field values on DownloadTaskArtifactRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadTaskArtifactRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadTaskArtifactRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadTaskArtifactRequestMultiError, or nil if none found.
+func (m *DownloadTaskArtifactRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadTaskArtifactRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Artifact
+
+	if len(errors) > 0 {
+		return DownloadTaskArtifactRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadTaskArtifactRequestMultiError is an error wrapping multiple
+// validation errors returned by DownloadTaskArtifactRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DownloadTaskArtifactRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadTaskArtifactRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadTaskArtifactRequestMultiError) AllErrors() []error { return m }
+
+// DownloadTaskArtifactRequestValidationError is the validation error returned
+// by DownloadTaskArtifactRequest.Validate if the designated constraints
+// aren't met.
+type DownloadTaskArtifactRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadTaskArtifactRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadTaskArtifactRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadTaskArtifactRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadTaskArtifactRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadTaskArtifactRequestValidationError) ErrorName() string {
+	return "DownloadTaskArtifactRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadTaskArtifactRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadTaskArtifactRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadTaskArtifactRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadTaskArtifactRequestValidationError{}
+
+// Validate checks the field values on DownloadTaskArtifactResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadTaskArtifactResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadTaskArtifactResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadTaskArtifactResponseMultiError, or nil if none found.
+func (m *DownloadTaskArtifactResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadTaskArtifactResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Payload.(type) {
+	case *DownloadTaskArtifactResponse_Artifact:
+		if v == nil {
+			err := DownloadTaskArtifactResponseValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetArtifact()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DownloadTaskArtifactResponseValidationError{
+						field:  "Artifact",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DownloadTaskArtifactResponseValidationError{
+						field:  "Artifact",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetArtifact()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DownloadTaskArtifactResponseValidationError{
+					field:  "Artifact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *DownloadTaskArtifactResponse_Data:
+		if v == nil {
+			err := DownloadTaskArtifactResponseValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Data
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return DownloadTaskArtifactResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadTaskArtifactResponseMultiError is an error wrapping multiple
+// validation errors returned by DownloadTaskArtifactResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DownloadTaskArtifactResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadTaskArtifactResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadTaskArtifactResponseMultiError) AllErrors() []error { return m }
+
+// DownloadTaskArtifactResponseValidationError is the validation error returned
+// by DownloadTaskArtifactResponse.Validate if the designated constraints
+// aren't met.
+type DownloadTaskArtifactResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadTaskArtifactResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadTaskArtifactResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadTaskArtifactResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadTaskArtifactResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadTaskArtifactResponseValidationError) ErrorName() string {
+	return "DownloadTaskArtifactResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadTaskArtifactResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadTaskArtifactResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadTaskArtifactResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadTaskArtifactResponseValidationError{}
@@ -20,10 +20,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Tasks_GetTask_FullMethodName    = "/faas.v1.Tasks/GetTask"
-	Tasks_ListTasks_FullMethodName  = "/faas.v1.Tasks/ListTasks"
-	Tasks_DeleteTask_FullMethodName = "/faas.v1.Tasks/DeleteTask"
-	Tasks_CancelTask_FullMethodName = "/faas.v1.Tasks/CancelTask"
+	Tasks_GetTask_FullMethodName              = "/faas.v1.Tasks/GetTask"
+	Tasks_ListTasks_FullMethodName            = "/faas.v1.Tasks/ListTasks"
+	Tasks_UpdateTask_FullMethodName           = "/faas.v1.Tasks/UpdateTask"
+	Tasks_DeleteTask_FullMethodName           = "/faas.v1.Tasks/DeleteTask"
+	Tasks_CancelTask_FullMethodName           = "/faas.v1.Tasks/CancelTask"
+	Tasks_ListTaskArtifacts_FullMethodName    = "/faas.v1.Tasks/ListTaskArtifacts"
+	Tasks_DownloadTaskArtifact_FullMethodName = "/faas.v1.Tasks/DownloadTaskArtifact"
 )
 
 // TasksClient is the client API for Tasks service.
@@ -32,8 +35,12 @@ const (
 type TasksClient interface {
 	GetTask(ctx context.Context, in *GetTaskRequest, opts ...grpc.CallOption) (*Task, error)
 	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
+	// Updates the labels or annotations of a task.
+	UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...grpc.CallOption) (*Task, error)
 	DeleteTask(ctx context.Context, in *DeleteTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CancelTask(ctx context.Context, in *CancelTaskRequest, opts ...grpc.CallOption) (*Task, error)
+	ListTaskArtifacts(ctx context.Context, in *ListTaskArtifactsRequest, opts ...grpc.CallOption) (*ListTaskArtifactsResponse, error)
+	DownloadTaskArtifact(ctx context.Context, in *DownloadTaskArtifactRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadTaskArtifactResponse], error)
 }
 
 type tasksClient struct {
@@ -64,6 +71,16 @@ func (c *tasksClient) ListTasks(ctx context.Context, in *ListTasksRequest, opts
 	return out, nil
 }
 
+func (c *tasksClient) UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...grpc.CallOption) (*Task, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Task)
+	err := c.cc.Invoke(ctx, Tasks_UpdateTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tasksClient) DeleteTask(ctx context.Context, in *DeleteTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -84,14 +101,47 @@ func (c *tasksClient) CancelTask(ctx context.Context, in *CancelTaskRequest, opt
 	return out, nil
 }
 
+func (c *tasksClient) ListTaskArtifacts(ctx context.Context, in *ListTaskArtifactsRequest, opts ...grpc.CallOption) (*ListTaskArtifactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaskArtifactsResponse)
+	err := c.cc.Invoke(ctx, Tasks_ListTaskArtifacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksClient) DownloadTaskArtifact(ctx context.Context, in *DownloadTaskArtifactRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadTaskArtifactResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Tasks_ServiceDesc.Streams[0], Tasks_DownloadTaskArtifact_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadTaskArtifactRequest, DownloadTaskArtifactResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Tasks_DownloadTaskArtifactClient = grpc.ServerStreamingClient[DownloadTaskArtifactResponse]
+
 // TasksServer is the server API for Tasks service.
 // All implementations must embed UnimplementedTasksServer
 // for forward compatibility.
 type TasksServer interface {
 	GetTask(context.Context, *GetTaskRequest) (*Task, error)
 	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
+	// Updates the labels or annotations of a task.
+	UpdateTask(context.Context, *UpdateTaskRequest) (*Task, error)
 	DeleteTask(context.Context, *DeleteTaskRequest) (*emptypb.Empty, error)
 	CancelTask(context.Context, *CancelTaskRequest) (*Task, error)
+	ListTaskArtifacts(context.Context, *ListTaskArtifactsRequest) (*ListTaskArtifactsResponse, error)
+	DownloadTaskArtifact(*DownloadTaskArtifactRequest, grpc.ServerStreamingServer[DownloadTaskArtifactResponse]) error
 	mustEmbedUnimplementedTasksServer()
 }
 
@@ -108,12 +158,21 @@ func (UnimplementedTasksServer) GetTask(context.Context, *GetTaskRequest) (*Task
 func (UnimplementedTasksServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListTasks not implemented")
 }
+func (UnimplementedTasksServer) UpdateTask(context.Context, *UpdateTaskRequest) (*Task, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTask not implemented")
+}
 func (UnimplementedTasksServer) DeleteTask(context.Context, *DeleteTaskRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteTask not implemented")
 }
 func (UnimplementedTasksServer) CancelTask(context.Context, *CancelTaskRequest) (*Task, error) {
 	return nil, status.Error(codes.Unimplemented, "method CancelTask not implemented")
 }
+func (UnimplementedTasksServer) ListTaskArtifacts(context.Context, *ListTaskArtifactsRequest) (*ListTaskArtifactsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTaskArtifacts not implemented")
+}
+func (UnimplementedTasksServer) DownloadTaskArtifact(*DownloadTaskArtifactRequest, grpc.ServerStreamingServer[DownloadTaskArtifactResponse]) error {
+	return status.Error(codes.Unimplemented, "method DownloadTaskArtifact not implemented")
+}
 func (UnimplementedTasksServer) mustEmbedUnimplementedTasksServer() {}
 func (UnimplementedTasksServer) testEmbeddedByValue()               {}
 
@@ -171,6 +230,24 @@ func _Tasks_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Tasks_UpdateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServer).UpdateTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tasks_UpdateTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServer).UpdateTask(ctx, req.(*UpdateTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Tasks_DeleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteTaskRequest)
 	if err := dec(in); err != nil {
@@ -207,6 +284,35 @@ func _Tasks_CancelTask_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Tasks_ListTaskArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskArtifactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServer).ListTaskArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tasks_ListTaskArtifacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServer).ListTaskArtifacts(ctx, req.(*ListTaskArtifactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tasks_DownloadTaskArtifact_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadTaskArtifactRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TasksServer).DownloadTaskArtifact(m, &grpc.GenericServerStream[DownloadTaskArtifactRequest, DownloadTaskArtifactResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Tasks_DownloadTaskArtifactServer = grpc.ServerStreamingServer[DownloadTaskArtifactResponse]
+
 // Tasks_ServiceDesc is the grpc.ServiceDesc for Tasks service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -222,6 +328,10 @@ var Tasks_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTasks",
 			Handler:    _Tasks_ListTasks_Handler,
 		},
+		{
+			MethodName: "UpdateTask",
+			Handler:    _Tasks_UpdateTask_Handler,
+		},
 		{
 			MethodName: "DeleteTask",
 			Handler:    _Tasks_DeleteTask_Handler,
@@ -230,7 +340,17 @@ var Tasks_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelTask",
 			Handler:    _Tasks_CancelTask_Handler,
 		},
+		{
+			MethodName: "ListTaskArtifacts",
+			Handler:    _Tasks_ListTaskArtifacts_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DownloadTaskArtifact",
+			Handler:       _Tasks_DownloadTaskArtifact_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "faas/v1/tasks.proto",
 }
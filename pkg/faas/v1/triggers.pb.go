@@ -0,0 +1,567 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: faas/v1/triggers.proto
+
+package faaspb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A trigger turns NATS messages into tasks of a function. Each message
+// becomes one task with the payload as parameters; its subject, stream
+// sequence and headers are set as the annotations "faas/subject",
+// "faas/stream-sequence" and "faas/header/<key>", next to "faas/trigger".
+//
+// The source is a core NATS subject or a JetStream stream. Stream messages
+// are acknowledged once their task exists, so each creates at least one
+// task; messages whose task can never be created, e.g. because the payload
+// does not match the parameters schema or the function is gone, are
+// terminated. Other failures are retried with a growing delay; a managed
+// consumer gives a message up after 10 deliveries. Core NATS messages
+// are delivered at most once; requests are answered with
+// {"task": "tasks/..."}, {"filtered": true} or {"error": "..."}.
+type Trigger struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "triggers/<id>".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Function name, optionally with "@alias".
+	Function string `protobuf:"bytes,2,opt,name=function,proto3" json:"function,omitempty"`
+	// Core NATS subject, wildcards allowed. With a stream and no consumer,
+	// filters the stream instead.
+	Subject string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	// JetStream stream to read.
+	Stream string `protobuf:"bytes,4,opt,name=stream,proto3" json:"stream,omitempty"`
+	// Existing durable consumer on the stream to read through. Without it the
+	// gateway manages a consumer "faas-trigger-<id>" that starts with
+	// messages published after the trigger was created.
+	Consumer string `protobuf:"bytes,5,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	// Messages to turn into tasks, e.g.
+	// `subject = "orders.eu.*" AND headers.Type = "created"`. subject accepts
+	// = and != with a trailing "*" for prefixes; headers.<key> = and !=
+	// against the first value, and :* for presence. Other messages are
+	// acknowledged without a task.
+	Filter string `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Messages turned into tasks at once, 1 to 256; defaults to 1. For a
+	// stream it bounds unacknowledged messages across all gateways.
+	Concurrency   int32                  `protobuf:"varint,7,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Trigger) Reset() {
+	*x = Trigger{}
+	mi := &file_faas_v1_triggers_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Trigger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trigger) ProtoMessage() {}
+
+func (x *Trigger) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_triggers_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Trigger.ProtoReflect.Descriptor instead.
+func (*Trigger) Descriptor() ([]byte, []int) {
+	return file_faas_v1_triggers_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Trigger) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Trigger) GetFunction() string {
+	if x != nil {
+		return x.Function
+	}
+	return ""
+}
+
+func (x *Trigger) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Trigger) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+func (x *Trigger) GetConsumer() string {
+	if x != nil {
+		return x.Consumer
+	}
+	return ""
+}
+
+func (x *Trigger) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *Trigger) GetConcurrency() int32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+func (x *Trigger) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Trigger) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateTriggerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Trigger       *Trigger               `protobuf:"bytes,1,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTriggerRequest) Reset() {
+	*x = CreateTriggerRequest{}
+	mi := &file_faas_v1_triggers_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTriggerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTriggerRequest) ProtoMessage() {}
+
+func (x *CreateTriggerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_triggers_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTriggerRequest.ProtoReflect.Descriptor instead.
+func (*CreateTriggerRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_triggers_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateTriggerRequest) GetTrigger() *Trigger {
+	if x != nil {
+		return x.Trigger
+	}
+	return nil
+}
+
+type GetTriggerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTriggerRequest) Reset() {
+	*x = GetTriggerRequest{}
+	mi := &file_faas_v1_triggers_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTriggerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTriggerRequest) ProtoMessage() {}
+
+func (x *GetTriggerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_triggers_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTriggerRequest.ProtoReflect.Descriptor instead.
+func (*GetTriggerRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_triggers_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetTriggerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListTriggersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTriggersRequest) Reset() {
+	*x = ListTriggersRequest{}
+	mi := &file_faas_v1_triggers_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTriggersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTriggersRequest) ProtoMessage() {}
+
+func (x *ListTriggersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_triggers_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTriggersRequest.ProtoReflect.Descriptor instead.
+func (*ListTriggersRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_triggers_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListTriggersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTriggersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListTriggersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Triggers      []*Trigger             `protobuf:"bytes,1,rep,name=triggers,proto3" json:"triggers,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTriggersResponse) Reset() {
+	*x = ListTriggersResponse{}
+	mi := &file_faas_v1_triggers_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTriggersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTriggersResponse) ProtoMessage() {}
+
+func (x *ListTriggersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_triggers_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTriggersResponse.ProtoReflect.Descriptor instead.
+func (*ListTriggersResponse) Descriptor() ([]byte, []int) {
+	return file_faas_v1_triggers_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListTriggersResponse) GetTriggers() []*Trigger {
+	if x != nil {
+		return x.Triggers
+	}
+	return nil
+}
+
+func (x *ListTriggersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateTriggerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name identifies the trigger.
+	Trigger *Trigger `protobuf:"bytes,1,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	// Fields to update: function, subject, stream, consumer, filter,
+	// concurrency.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTriggerRequest) Reset() {
+	*x = UpdateTriggerRequest{}
+	mi := &file_faas_v1_triggers_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTriggerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTriggerRequest) ProtoMessage() {}
+
+func (x *UpdateTriggerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_triggers_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTriggerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTriggerRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_triggers_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateTriggerRequest) GetTrigger() *Trigger {
+	if x != nil {
+		return x.Trigger
+	}
+	return nil
+}
+
+func (x *UpdateTriggerRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteTriggerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTriggerRequest) Reset() {
+	*x = DeleteTriggerRequest{}
+	mi := &file_faas_v1_triggers_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTriggerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTriggerRequest) ProtoMessage() {}
+
+func (x *DeleteTriggerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_triggers_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTriggerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTriggerRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_triggers_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteTriggerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_faas_v1_triggers_proto protoreflect.FileDescriptor
+
+const file_faas_v1_triggers_proto_rawDesc = "" +
+	"\n" +
+	"\x16faas/v1/triggers.proto\x12\afaas.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb7\x02\n" +
+	"\aTrigger\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bfunction\x18\x02 \x01(\tR\bfunction\x12\x18\n" +
+	"\asubject\x18\x03 \x01(\tR\asubject\x12\x16\n" +
+	"\x06stream\x18\x04 \x01(\tR\x06stream\x12\x1a\n" +
+	"\bconsumer\x18\x05 \x01(\tR\bconsumer\x12\x16\n" +
+	"\x06filter\x18\x06 \x01(\tR\x06filter\x12 \n" +
+	"\vconcurrency\x18\a \x01(\x05R\vconcurrency\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"B\n" +
+	"\x14CreateTriggerRequest\x12*\n" +
+	"\atrigger\x18\x01 \x01(\v2\x10.faas.v1.TriggerR\atrigger\"'\n" +
+	"\x11GetTriggerRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"Q\n" +
+	"\x13ListTriggersRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"l\n" +
+	"\x14ListTriggersResponse\x12,\n" +
+	"\btriggers\x18\x01 \x03(\v2\x10.faas.v1.TriggerR\btriggers\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x7f\n" +
+	"\x14UpdateTriggerRequest\x12*\n" +
+	"\atrigger\x18\x01 \x01(\v2\x10.faas.v1.TriggerR\atrigger\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"*\n" +
+	"\x14DeleteTriggerRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name2\xdf\x02\n" +
+	"\bTriggers\x12@\n" +
+	"\rCreateTrigger\x12\x1d.faas.v1.CreateTriggerRequest\x1a\x10.faas.v1.Trigger\x12:\n" +
+	"\n" +
+	"GetTrigger\x12\x1a.faas.v1.GetTriggerRequest\x1a\x10.faas.v1.Trigger\x12K\n" +
+	"\fListTriggers\x12\x1c.faas.v1.ListTriggersRequest\x1a\x1d.faas.v1.ListTriggersResponse\x12@\n" +
+	"\rUpdateTrigger\x12\x1d.faas.v1.UpdateTriggerRequest\x1a\x10.faas.v1.Trigger\x12F\n" +
+	"\rDeleteTrigger\x12\x1d.faas.v1.DeleteTriggerRequest\x1a\x16.google.protobuf.EmptyB2Z0github.com/10Narratives/faas/pkg/faas/v1/;faaspbb\x06proto3"
+
+var (
+	file_faas_v1_triggers_proto_rawDescOnce sync.Once
+	file_faas_v1_triggers_proto_rawDescData []byte
+)
+
+func file_faas_v1_triggers_proto_rawDescGZIP() []byte {
+	file_faas_v1_triggers_proto_rawDescOnce.Do(func() {
+		file_faas_v1_triggers_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_faas_v1_triggers_proto_rawDesc), len(file_faas_v1_triggers_proto_rawDesc)))
+	})
+	return file_faas_v1_triggers_proto_rawDescData
+}
+
+var file_faas_v1_triggers_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_faas_v1_triggers_proto_goTypes = []any{
+	(*Trigger)(nil),               // 0: faas.v1.Trigger
+	(*CreateTriggerRequest)(nil),  // 1: faas.v1.CreateTriggerRequest
+	(*GetTriggerRequest)(nil),     // 2: faas.v1.GetTriggerRequest
+	(*ListTriggersRequest)(nil),   // 3: faas.v1.ListTriggersRequest
+	(*ListTriggersResponse)(nil),  // 4: faas.v1.ListTriggersResponse
+	(*UpdateTriggerRequest)(nil),  // 5: faas.v1.UpdateTriggerRequest
+	(*DeleteTriggerRequest)(nil),  // 6: faas.v1.DeleteTriggerRequest
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil), // 8: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),         // 9: google.protobuf.Empty
+}
+var file_faas_v1_triggers_proto_depIdxs = []int32{
+	7,  // 0: faas.v1.Trigger.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 1: faas.v1.Trigger.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: faas.v1.CreateTriggerRequest.trigger:type_name -> faas.v1.Trigger
+	0,  // 3: faas.v1.ListTriggersResponse.triggers:type_name -> faas.v1.Trigger
+	0,  // 4: faas.v1.UpdateTriggerRequest.trigger:type_name -> faas.v1.Trigger
+	8,  // 5: faas.v1.UpdateTriggerRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 6: faas.v1.Triggers.CreateTrigger:input_type -> faas.v1.CreateTriggerRequest
+	2,  // 7: faas.v1.Triggers.GetTrigger:input_type -> faas.v1.GetTriggerRequest
+	3,  // 8: faas.v1.Triggers.ListTriggers:input_type -> faas.v1.ListTriggersRequest
+	5,  // 9: faas.v1.Triggers.UpdateTrigger:input_type -> faas.v1.UpdateTriggerRequest
+	6,  // 10: faas.v1.Triggers.DeleteTrigger:input_type -> faas.v1.DeleteTriggerRequest
+	0,  // 11: faas.v1.Triggers.CreateTrigger:output_type -> faas.v1.Trigger
+	0,  // 12: faas.v1.Triggers.GetTrigger:output_type -> faas.v1.Trigger
+	4,  // 13: faas.v1.Triggers.ListTriggers:output_type -> faas.v1.ListTriggersResponse
+	0,  // 14: faas.v1.Triggers.UpdateTrigger:output_type -> faas.v1.Trigger
+	9,  // 15: faas.v1.Triggers.DeleteTrigger:output_type -> google.protobuf.Empty
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_faas_v1_triggers_proto_init() }
+func file_faas_v1_triggers_proto_init() {
+	if File_faas_v1_triggers_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_faas_v1_triggers_proto_rawDesc), len(file_faas_v1_triggers_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_faas_v1_triggers_proto_goTypes,
+		DependencyIndexes: file_faas_v1_triggers_proto_depIdxs,
+		MessageInfos:      file_faas_v1_triggers_proto_msgTypes,
+	}.Build()
+	File_faas_v1_triggers_proto = out.File
+	file_faas_v1_triggers_proto_goTypes = nil
+	file_faas_v1_triggers_proto_depIdxs = nil
+}
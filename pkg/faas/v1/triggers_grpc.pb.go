@@ -0,0 +1,276 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: faas/v1/triggers.proto
+
+package faaspb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Triggers_CreateTrigger_FullMethodName = "/faas.v1.Triggers/CreateTrigger"
+	Triggers_GetTrigger_FullMethodName    = "/faas.v1.Triggers/GetTrigger"
+	Triggers_ListTriggers_FullMethodName  = "/faas.v1.Triggers/ListTriggers"
+	Triggers_UpdateTrigger_FullMethodName = "/faas.v1.Triggers/UpdateTrigger"
+	Triggers_DeleteTrigger_FullMethodName = "/faas.v1.Triggers/DeleteTrigger"
+)
+
+// TriggersClient is the client API for Triggers service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TriggersClient interface {
+	CreateTrigger(ctx context.Context, in *CreateTriggerRequest, opts ...grpc.CallOption) (*Trigger, error)
+	GetTrigger(ctx context.Context, in *GetTriggerRequest, opts ...grpc.CallOption) (*Trigger, error)
+	ListTriggers(ctx context.Context, in *ListTriggersRequest, opts ...grpc.CallOption) (*ListTriggersResponse, error)
+	UpdateTrigger(ctx context.Context, in *UpdateTriggerRequest, opts ...grpc.CallOption) (*Trigger, error)
+	// Also removes the consumer the gateway managed for the trigger.
+	DeleteTrigger(ctx context.Context, in *DeleteTriggerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type triggersClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTriggersClient(cc grpc.ClientConnInterface) TriggersClient {
+	return &triggersClient{cc}
+}
+
+func (c *triggersClient) CreateTrigger(ctx context.Context, in *CreateTriggerRequest, opts ...grpc.CallOption) (*Trigger, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Trigger)
+	err := c.cc.Invoke(ctx, Triggers_CreateTrigger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggersClient) GetTrigger(ctx context.Context, in *GetTriggerRequest, opts ...grpc.CallOption) (*Trigger, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Trigger)
+	err := c.cc.Invoke(ctx, Triggers_GetTrigger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggersClient) ListTriggers(ctx context.Context, in *ListTriggersRequest, opts ...grpc.CallOption) (*ListTriggersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTriggersResponse)
+	err := c.cc.Invoke(ctx, Triggers_ListTriggers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggersClient) UpdateTrigger(ctx context.Context, in *UpdateTriggerRequest, opts ...grpc.CallOption) (*Trigger, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Trigger)
+	err := c.cc.Invoke(ctx, Triggers_UpdateTrigger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggersClient) DeleteTrigger(ctx context.Context, in *DeleteTriggerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Triggers_DeleteTrigger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TriggersServer is the server API for Triggers service.
+// All implementations must embed UnimplementedTriggersServer
+// for forward compatibility.
+type TriggersServer interface {
+	CreateTrigger(context.Context, *CreateTriggerRequest) (*Trigger, error)
+	GetTrigger(context.Context, *GetTriggerRequest) (*Trigger, error)
+	ListTriggers(context.Context, *ListTriggersRequest) (*ListTriggersResponse, error)
+	UpdateTrigger(context.Context, *UpdateTriggerRequest) (*Trigger, error)
+	// Also removes the consumer the gateway managed for the trigger.
+	DeleteTrigger(context.Context, *DeleteTriggerRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedTriggersServer()
+}
+
+// UnimplementedTriggersServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTriggersServer struct{}
+
+func (UnimplementedTriggersServer) CreateTrigger(context.Context, *CreateTriggerRequest) (*Trigger, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTrigger not implemented")
+}
+func (UnimplementedTriggersServer) GetTrigger(context.Context, *GetTriggerRequest) (*Trigger, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTrigger not implemented")
+}
+func (UnimplementedTriggersServer) ListTriggers(context.Context, *ListTriggersRequest) (*ListTriggersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTriggers not implemented")
+}
+func (UnimplementedTriggersServer) UpdateTrigger(context.Context, *UpdateTriggerRequest) (*Trigger, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTrigger not implemented")
+}
+func (UnimplementedTriggersServer) DeleteTrigger(context.Context, *DeleteTriggerRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTrigger not implemented")
+}
+func (UnimplementedTriggersServer) mustEmbedUnimplementedTriggersServer() {}
+func (UnimplementedTriggersServer) testEmbeddedByValue()                  {}
+
+// UnsafeTriggersServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TriggersServer will
+// result in compilation errors.
+type UnsafeTriggersServer interface {
+	mustEmbedUnimplementedTriggersServer()
+}
+
+func RegisterTriggersServer(s grpc.ServiceRegistrar, srv TriggersServer) {
+	// If the following call panics, it indicates UnimplementedTriggersServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Triggers_ServiceDesc, srv)
+}
+
+func _Triggers_CreateTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTriggerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggersServer).CreateTrigger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Triggers_CreateTrigger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggersServer).CreateTrigger(ctx, req.(*CreateTriggerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Triggers_GetTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTriggerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggersServer).GetTrigger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Triggers_GetTrigger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggersServer).GetTrigger(ctx, req.(*GetTriggerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Triggers_ListTriggers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTriggersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggersServer).ListTriggers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Triggers_ListTriggers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggersServer).ListTriggers(ctx, req.(*ListTriggersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Triggers_UpdateTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTriggerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggersServer).UpdateTrigger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Triggers_UpdateTrigger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggersServer).UpdateTrigger(ctx, req.(*UpdateTriggerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Triggers_DeleteTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTriggerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggersServer).DeleteTrigger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Triggers_DeleteTrigger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggersServer).DeleteTrigger(ctx, req.(*DeleteTriggerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Triggers_ServiceDesc is the grpc.ServiceDesc for Triggers service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Triggers_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "faas.v1.Triggers",
+	HandlerType: (*TriggersServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTrigger",
+			Handler:    _Triggers_CreateTrigger_Handler,
+		},
+		{
+			MethodName: "GetTrigger",
+			Handler:    _Triggers_GetTrigger_Handler,
+		},
+		{
+			MethodName: "ListTriggers",
+			Handler:    _Triggers_ListTriggers_Handler,
+		},
+		{
+			MethodName: "UpdateTrigger",
+			Handler:    _Triggers_UpdateTrigger_Handler,
+		},
+		{
+			MethodName: "DeleteTrigger",
+			Handler:    _Triggers_DeleteTrigger_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "faas/v1/triggers.proto",
+}
@@ -0,0 +1,201 @@
+// Package filterutils parses the AIP-160 subset used by list filters:
+// comparisons joined with AND (or whitespace), optionally negated with NOT
+// or "-". OR and parentheses are not supported. Fields are left to the
+// caller to interpret.
+package filterutils
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var ErrInvalidFilter = errors.New("invalid filter")
+
+// Term is one comparison, e.g. `labels.team = "images"`.
+type Term struct {
+	Negate bool
+	Field  string
+	Op     string
+	Value  string
+	// Quoted reports whether Value was a string literal; an unquoted "*"
+	// is a presence test.
+	Quoted bool
+}
+
+// Parse splits a filter into its terms. An empty filter has no terms.
+func Parse(s string) ([]Term, error) {
+	toks, err := lex(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var terms []Term
+	for len(toks) > 0 {
+		switch {
+		case toks[0].isWord("AND") && len(terms) > 0:
+			toks = toks[1:]
+		case toks[0].isWord("OR"):
+			return nil, fmt.Errorf("%w: OR is not supported", ErrInvalidFilter)
+		}
+
+		var t Term
+		if len(toks) > 0 && (toks[0].isWord("NOT") || toks[0].kind == tokMinus) {
+			t.Negate = true
+			toks = toks[1:]
+		}
+		if len(toks) < 3 {
+			return nil, fmt.Errorf("%w: incomplete expression at end of %q", ErrInvalidFilter, s)
+		}
+		if toks[0].kind != tokText || toks[1].kind != tokOp || toks[2].kind != tokText {
+			return nil, fmt.Errorf("%w: expected <field> <operator> <value> near %q", ErrInvalidFilter, toks[0].text)
+		}
+
+		t.Field, t.Op, t.Value, t.Quoted = toks[0].text, toks[1].text, toks[2].text, toks[2].quoted
+		terms = append(terms, t)
+		toks = toks[3:]
+	}
+	return terms, nil
+}
+
+// Unsupported reports an operator the field does not accept.
+func (t Term) Unsupported() error {
+	return fmt.Errorf("%w: operator %q is not supported for %s", ErrInvalidFilter, t.Op, t.Field)
+}
+
+// Labels compiles a label term: labels.<key> with = and != (a missing label
+// is != anything), labels.<key>:* and labels:<key> for presence. ok is false
+// if the term is not about labels.
+func Labels(t Term) (match func(map[string]string) bool, ok bool, err error) {
+	v := t.Value
+	if key, found := strings.CutPrefix(t.Field, "labels."); found && key != "" {
+		switch {
+		case t.Op == ":" && v == "*" && !t.Quoted:
+			return func(l map[string]string) bool { _, ok := l[key]; return ok }, true, nil
+		case t.Op == "=":
+			return func(l map[string]string) bool { got, ok := l[key]; return ok && got == v }, true, nil
+		case t.Op == "!=":
+			return func(l map[string]string) bool { got, ok := l[key]; return !ok || got != v }, true, nil
+		}
+		return nil, true, t.Unsupported()
+	}
+	if t.Field == "labels" {
+		if t.Op != ":" {
+			return nil, true, t.Unsupported()
+		}
+		return func(l map[string]string) bool { _, ok := l[v]; return ok }, true, nil
+	}
+	return nil, false, nil
+}
+
+// String compiles = and != on a string field. A trailing "*" in the value
+// matches a prefix.
+func String(t Term) (func(string) bool, error) {
+	eq := func(got string) bool { return got == t.Value }
+	if prefix, ok := strings.CutSuffix(t.Value, "*"); ok {
+		eq = func(got string) bool { return strings.HasPrefix(got, prefix) }
+	}
+
+	switch t.Op {
+	case "=":
+		return eq, nil
+	case "!=":
+		return func(got string) bool { return !eq(got) }, nil
+	}
+	return nil, t.Unsupported()
+}
+
+// Compare turns a comparison operator into a test on the result of a
+// three-way comparison of the field against the value.
+func Compare(t Term) (func(c int) bool, error) {
+	switch t.Op {
+	case "=":
+		return func(c int) bool { return c == 0 }, nil
+	case "!=":
+		return func(c int) bool { return c != 0 }, nil
+	case "<":
+		return func(c int) bool { return c < 0 }, nil
+	case "<=":
+		return func(c int) bool { return c <= 0 }, nil
+	case ">":
+		return func(c int) bool { return c > 0 }, nil
+	case ">=":
+		return func(c int) bool { return c >= 0 }, nil
+	}
+	return nil, t.Unsupported()
+}
+
+type tokenKind int
+
+const (
+	tokText tokenKind = iota
+	tokOp
+	tokMinus
+)
+
+type token struct {
+	kind   tokenKind
+	text   string
+	quoted bool
+}
+
+func (t token) isWord(w string) bool {
+	return t.kind == tokText && !t.quoted && t.text == w
+}
+
+func lex(s string) ([]token, error) {
+	var toks []token
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case unicode.IsSpace(rune(c)):
+			i++
+
+		case c == '"':
+			j := i + 1
+			for ; j < len(s) && s[j] != '"'; j++ {
+				if s[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(s) {
+				return nil, fmt.Errorf("%w: unterminated string", ErrInvalidFilter)
+			}
+			v, err := strconv.Unquote(s[i : j+1])
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid string %s", ErrInvalidFilter, s[i:j+1])
+			}
+			toks = append(toks, token{kind: tokText, text: v, quoted: true})
+			i = j + 1
+
+		case c == '(' || c == ')':
+			return nil, fmt.Errorf("%w: parentheses are not supported", ErrInvalidFilter)
+
+		case strings.IndexByte("=!<>:", c) >= 0:
+			op := string(c)
+			if i+1 < len(s) && s[i+1] == '=' && c != '=' && c != ':' {
+				op += "="
+			}
+			if op == "!" {
+				return nil, fmt.Errorf("%w: unexpected %q", ErrInvalidFilter, op)
+			}
+			toks = append(toks, token{kind: tokOp, text: op})
+			i += len(op)
+
+		case c == '-' && (len(toks) == 0 || toks[len(toks)-1].kind != tokOp):
+			toks = append(toks, token{kind: tokMinus, text: "-"})
+			i++
+
+		default:
+			j := i
+			for j < len(s) && !unicode.IsSpace(rune(s[j])) && strings.IndexByte(`=!<>:"()`, s[j]) < 0 {
+				j++
+			}
+			toks = append(toks, token{kind: tokText, text: s[i:j]})
+			i = j
+		}
+	}
+	return toks, nil
+}
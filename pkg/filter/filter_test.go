@@ -0,0 +1,208 @@
+package filterutils_test
+
+import (
+	"testing"
+
+	filterutils "github.com/10Narratives/faas/pkg/filter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  string
+		want    []filterutils.Term
+		wantErr string
+	}{
+		{name: "empty", filter: "  "},
+		{
+			name:   "single",
+			filter: `function = "functions/resize"`,
+			want:   []filterutils.Term{{Field: "function", Op: "=", Value: "functions/resize", Quoted: true}},
+		},
+		{
+			name:   "operators without spaces",
+			filter: `created_at>="2026-01-01T00:00:00Z" state!=failed`,
+			want: []filterutils.Term{
+				{Field: "created_at", Op: ">=", Value: "2026-01-01T00:00:00Z", Quoted: true},
+				{Field: "state", Op: "!=", Value: "failed"},
+			},
+		},
+		{
+			name:   "AND and whitespace",
+			filter: `state = failed AND labels.team = images function = x`,
+			want: []filterutils.Term{
+				{Field: "state", Op: "=", Value: "failed"},
+				{Field: "labels.team", Op: "=", Value: "images"},
+				{Field: "function", Op: "=", Value: "x"},
+			},
+		},
+		{
+			name:   "NOT",
+			filter: `NOT state = failed`,
+			want:   []filterutils.Term{{Negate: true, Field: "state", Op: "=", Value: "failed"}},
+		},
+		{
+			name:   "minus",
+			filter: `-labels.team:* AND -state = failed`,
+			want: []filterutils.Term{
+				{Negate: true, Field: "labels.team", Op: ":", Value: "*"},
+				{Negate: true, Field: "state", Op: "=", Value: "failed"},
+			},
+		},
+		{
+			name:   "minus in a value",
+			filter: `priority > -1`,
+			want:   []filterutils.Term{{Field: "priority", Op: ">", Value: "-1"}},
+		},
+		{
+			name:   "presence",
+			filter: `labels.team:*`,
+			want:   []filterutils.Term{{Field: "labels.team", Op: ":", Value: "*"}},
+		},
+		{
+			name:   "quoted star",
+			filter: `labels.team:"*"`,
+			want:   []filterutils.Term{{Field: "labels.team", Op: ":", Value: "*", Quoted: true}},
+		},
+		{
+			name:   "quoted escapes",
+			filter: `display_name = "say \"hi\"\\n\t"`,
+			want:   []filterutils.Term{{Field: "display_name", Op: "=", Value: "say \"hi\"\\n\t", Quoted: true}},
+		},
+		{
+			name:   "quoted keywords are values",
+			filter: `name = "OR" AND note = "(x)"`,
+			want: []filterutils.Term{
+				{Field: "name", Op: "=", Value: "OR", Quoted: true},
+				{Field: "note", Op: "=", Value: "(x)", Quoted: true},
+			},
+		},
+		{name: "trailing AND", filter: `state = failed AND`, wantErr: "incomplete expression"},
+		{name: "leading AND", filter: `AND state = failed`, wantErr: "expected <field> <operator> <value>"},
+		{name: "double AND", filter: `a = b AND AND c = d`, wantErr: "expected <field> <operator> <value>"},
+		{name: "NOT alone", filter: `NOT`, wantErr: "incomplete expression"},
+		{name: "OR", filter: `state = failed OR state = canceled`, wantErr: "OR is not supported"},
+		{name: "leading OR", filter: `OR state = failed`, wantErr: "OR is not supported"},
+		{name: "parentheses", filter: `(state = failed)`, wantErr: "parentheses are not supported"},
+		{name: "parentheses after a term", filter: `a = b AND (c = d)`, wantErr: "parentheses are not supported"},
+		{name: "unterminated string", filter: `a = "open`, wantErr: "unterminated string"},
+		{name: "escaped closing quote", filter: `a = "open\"`, wantErr: "unterminated string"},
+		{name: "invalid escape", filter: `a = "\q"`, wantErr: "invalid string"},
+		{name: "bare bang", filter: `a ! b`, wantErr: "unexpected"},
+		{name: "missing operator", filter: `a b c`, wantErr: "expected <field> <operator> <value>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := filterutils.Parse(tt.filter)
+			if tt.wantErr != "" {
+				require.ErrorIs(t, err, filterutils.ErrInvalidFilter)
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLabels(t *testing.T) {
+	labels := map[string]string{"team": "images", "tier": ""}
+
+	tests := []struct {
+		filter  string
+		want    bool
+		notOK   bool
+		wantErr bool
+	}{
+		{filter: `labels.team:*`, want: true},
+		{filter: `labels.owner:*`, want: false},
+		{filter: `labels:tier`, want: true},
+		{filter: `labels:owner`, want: false},
+		{filter: `labels.team = images`, want: true},
+		{filter: `labels.team = "images"`, want: true},
+		{filter: `labels.team = video`, want: false},
+		{filter: `labels.team != video`, want: true},
+		{filter: `labels.owner != anyone`, want: true},
+		{filter: `labels.owner = ""`, want: false},
+		{filter: `labels.team:"*"`, wantErr: true},
+		{filter: `labels.team < b`, wantErr: true},
+		{filter: `labels = team`, wantErr: true},
+		{filter: `state = failed`, notOK: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			terms, err := filterutils.Parse(tt.filter)
+			require.NoError(t, err)
+			require.Len(t, terms, 1)
+
+			match, ok, err := filterutils.Labels(terms[0])
+			require.Equal(t, !tt.notOK, ok)
+			if tt.wantErr {
+				require.ErrorIs(t, err, filterutils.ErrInvalidFilter)
+				return
+			}
+			require.NoError(t, err)
+			if tt.notOK {
+				return
+			}
+			require.Equal(t, tt.want, match(labels))
+		})
+	}
+}
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		filter  string
+		value   string
+		want    bool
+		wantErr bool
+	}{
+		{filter: `function = functions/resize`, value: "functions/resize", want: true},
+		{filter: `function = functions/resize`, value: "functions/resize-v2", want: false},
+		{filter: `function = functions/*`, value: "functions/resize", want: true},
+		{filter: `function != functions/*`, value: "functions/resize", want: false},
+		{filter: `function != functions/*`, value: "jobs/1", want: true},
+		{filter: `function > a`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter+" "+tt.value, func(t *testing.T) {
+			terms, err := filterutils.Parse(tt.filter)
+			require.NoError(t, err)
+
+			match, err := filterutils.String(terms[0])
+			if tt.wantErr {
+				require.ErrorIs(t, err, filterutils.ErrInvalidFilter)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, match(tt.value))
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		op   string
+		want [3]bool // for c = -1, 0, 1
+	}{
+		{op: "=", want: [3]bool{false, true, false}},
+		{op: "!=", want: [3]bool{true, false, true}},
+		{op: "<", want: [3]bool{true, false, false}},
+		{op: "<=", want: [3]bool{true, true, false}},
+		{op: ">", want: [3]bool{false, false, true}},
+		{op: ">=", want: [3]bool{false, true, true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.op, func(t *testing.T) {
+			cmp, err := filterutils.Compare(filterutils.Term{Field: "created_at", Op: tt.op})
+			require.NoError(t, err)
+			for i, c := range []int{-1, 0, 1} {
+				require.Equal(t, tt.want[i], cmp(c), "c = %d", c)
+			}
+		})
+	}
+
+	_, err := filterutils.Compare(filterutils.Term{Field: "created_at", Op: ":"})
+	require.ErrorIs(t, err, filterutils.ErrInvalidFilter)
+}
@@ -0,0 +1,78 @@
+// Package labelutils validates the labels and annotations attached to
+// functions and tasks. Labels are short, selectable key/value pairs;
+// annotations hold arbitrary non-identifying metadata.
+package labelutils
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+const (
+	MaxLabels = 64
+	// MaxAnnotationsSize bounds the total size of annotation keys and values.
+	MaxAnnotationsSize = 64 << 10
+	maxAnnotationKey   = 253
+)
+
+var (
+	ErrInvalidLabels      = errors.New("invalid labels")
+	ErrInvalidAnnotations = errors.New("invalid annotations")
+)
+
+var (
+	labelKeyPattern      = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,62}$`)
+	labelValuePattern    = regexp.MustCompile(`^[a-z0-9_-]{0,63}$`)
+	annotationKeyPattern = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._/-]*[A-Za-z0-9])?$`)
+)
+
+// Validate checks label keys and values: lowercase letters, digits, "_" and
+// "-", at most 63 characters, keys starting with a letter.
+func Validate(labels map[string]string) error {
+	if len(labels) > MaxLabels {
+		return fmt.Errorf("%w: more than %d labels", ErrInvalidLabels, MaxLabels)
+	}
+	for k, v := range labels {
+		if !labelKeyPattern.MatchString(k) {
+			return fmt.Errorf("%w: invalid label key %q", ErrInvalidLabels, k)
+		}
+		if !labelValuePattern.MatchString(v) {
+			return fmt.Errorf("%w: invalid value for label %q", ErrInvalidLabels, k)
+		}
+	}
+	return nil
+}
+
+// ValidateAnnotations checks annotation keys, e.g. "example.com/owner", and
+// the total size. Values are free-form.
+func ValidateAnnotations(annotations map[string]string) error {
+	size := 0
+	for k, v := range annotations {
+		if len(k) > maxAnnotationKey || !annotationKeyPattern.MatchString(k) {
+			return fmt.Errorf("%w: invalid annotation key %q", ErrInvalidAnnotations, k)
+		}
+		size += len(k) + len(v)
+	}
+	if size > MaxAnnotationsSize {
+		return fmt.Errorf("%w: total size exceeds %d bytes", ErrInvalidAnnotations, MaxAnnotationsSize)
+	}
+	return nil
+}
+
+// Select returns the labels whose keys are listed; "*" selects all of them.
+func Select(labels map[string]string, keys []string) map[string]string {
+	out := make(map[string]string)
+	for _, k := range keys {
+		if k == "*" {
+			for k, v := range labels {
+				out[k] = v
+			}
+			continue
+		}
+		if v, ok := labels[k]; ok {
+			out[k] = v
+		}
+	}
+	return out
+}
@@ -6,4 +6,9 @@ NATS_URL="${NATS_URL:-nats://127.0.0.1:4222}"
 nats --server "$NATS_URL" str add TASKS --config /etc/nats/streams/tasks.json
 nats --server "$NATS_URL" kv add functions
 nats --server "$NATS_URL" kv add tasks
+nats --server "$NATS_URL" kv add secrets
+nats --server "$NATS_URL" kv add jobs
+nats --server "$NATS_URL" kv add schedules
+nats --server "$NATS_URL" kv add triggers
 nats --server "$NATS_URL" obj add functions
+nats --server "$NATS_URL" obj add tasks